@@ -96,10 +96,12 @@ class RouterTest(base.TestBase):
 
         if self.bfd:
             exec_docker(f"run -v {self.artifacts}/conf:/etc/scion -d "
+                        "-e SCION_TESTING_DRKEY_DUMMY_KEYS=true "
                         "--network container:pause --name router "
                         "scion/router:latest")
         else:
             exec_docker(f"run -v {self.artifacts}/conf:/etc/scion -d "
+                        "-e SCION_TESTING_DRKEY_DUMMY_KEYS=true "
                         "--network container:pause --name router "
                         "scion/router:latest "
                         "--config /etc/scion/router_nobfd.toml")
@@ -50,6 +50,36 @@ func (s *BaseTrafficClassListener) EnterMatchProtocol(ctx *MatchProtocolContext)
 // ExitMatchProtocol is called when production matchProtocol is exited.
 func (s *BaseTrafficClassListener) ExitMatchProtocol(ctx *MatchProtocolContext) {}
 
+// EnterMatchSrcIPv6 is called when production matchSrcIPv6 is entered.
+func (s *BaseTrafficClassListener) EnterMatchSrcIPv6(ctx *MatchSrcIPv6Context) {}
+
+// ExitMatchSrcIPv6 is called when production matchSrcIPv6 is exited.
+func (s *BaseTrafficClassListener) ExitMatchSrcIPv6(ctx *MatchSrcIPv6Context) {}
+
+// EnterMatchDstIPv6 is called when production matchDstIPv6 is entered.
+func (s *BaseTrafficClassListener) EnterMatchDstIPv6(ctx *MatchDstIPv6Context) {}
+
+// ExitMatchDstIPv6 is called when production matchDstIPv6 is exited.
+func (s *BaseTrafficClassListener) ExitMatchDstIPv6(ctx *MatchDstIPv6Context) {}
+
+// EnterMatchTrafficClass is called when production matchTrafficClass is entered.
+func (s *BaseTrafficClassListener) EnterMatchTrafficClass(ctx *MatchTrafficClassContext) {}
+
+// ExitMatchTrafficClass is called when production matchTrafficClass is exited.
+func (s *BaseTrafficClassListener) ExitMatchTrafficClass(ctx *MatchTrafficClassContext) {}
+
+// EnterMatchFlowLabel is called when production matchFlowLabel is entered.
+func (s *BaseTrafficClassListener) EnterMatchFlowLabel(ctx *MatchFlowLabelContext) {}
+
+// ExitMatchFlowLabel is called when production matchFlowLabel is exited.
+func (s *BaseTrafficClassListener) ExitMatchFlowLabel(ctx *MatchFlowLabelContext) {}
+
+// EnterMatchNextHeader is called when production matchNextHeader is entered.
+func (s *BaseTrafficClassListener) EnterMatchNextHeader(ctx *MatchNextHeaderContext) {}
+
+// ExitMatchNextHeader is called when production matchNextHeader is exited.
+func (s *BaseTrafficClassListener) ExitMatchNextHeader(ctx *MatchNextHeaderContext) {}
+
 // EnterMatchSrcPort is called when production matchSrcPort is entered.
 func (s *BaseTrafficClassListener) EnterMatchSrcPort(ctx *MatchSrcPortContext) {}
 
@@ -74,6 +104,24 @@ func (s *BaseTrafficClassListener) EnterMatchDstPortRange(ctx *MatchDstPortRange
 // ExitMatchDstPortRange is called when production matchDstPortRange is exited.
 func (s *BaseTrafficClassListener) ExitMatchDstPortRange(ctx *MatchDstPortRangeContext) {}
 
+// EnterMatchL4Protocol is called when production matchL4Protocol is entered.
+func (s *BaseTrafficClassListener) EnterMatchL4Protocol(ctx *MatchL4ProtocolContext) {}
+
+// ExitMatchL4Protocol is called when production matchL4Protocol is exited.
+func (s *BaseTrafficClassListener) ExitMatchL4Protocol(ctx *MatchL4ProtocolContext) {}
+
+// EnterMatchTCPFlags is called when production matchTCPFlags is entered.
+func (s *BaseTrafficClassListener) EnterMatchTCPFlags(ctx *MatchTCPFlagsContext) {}
+
+// ExitMatchTCPFlags is called when production matchTCPFlags is exited.
+func (s *BaseTrafficClassListener) ExitMatchTCPFlags(ctx *MatchTCPFlagsContext) {}
+
+// EnterMatchICMPType is called when production matchICMPType is entered.
+func (s *BaseTrafficClassListener) EnterMatchICMPType(ctx *MatchICMPTypeContext) {}
+
+// ExitMatchICMPType is called when production matchICMPType is exited.
+func (s *BaseTrafficClassListener) ExitMatchICMPType(ctx *MatchICMPTypeContext) {}
+
 // EnterCondCls is called when production condCls is entered.
 func (s *BaseTrafficClassListener) EnterCondCls(ctx *CondClsContext) {}
 
@@ -110,12 +158,24 @@ func (s *BaseTrafficClassListener) EnterCondIPv4(ctx *CondIPv4Context) {}
 // ExitCondIPv4 is called when production condIPv4 is exited.
 func (s *BaseTrafficClassListener) ExitCondIPv4(ctx *CondIPv4Context) {}
 
+// EnterCondIPv6 is called when production condIPv6 is entered.
+func (s *BaseTrafficClassListener) EnterCondIPv6(ctx *CondIPv6Context) {}
+
+// ExitCondIPv6 is called when production condIPv6 is exited.
+func (s *BaseTrafficClassListener) ExitCondIPv6(ctx *CondIPv6Context) {}
+
 // EnterCondPort is called when production condPort is entered.
 func (s *BaseTrafficClassListener) EnterCondPort(ctx *CondPortContext) {}
 
 // ExitCondPort is called when production condPort is exited.
 func (s *BaseTrafficClassListener) ExitCondPort(ctx *CondPortContext) {}
 
+// EnterCondL4 is called when production condL4 is entered.
+func (s *BaseTrafficClassListener) EnterCondL4(ctx *CondL4Context) {}
+
+// ExitCondL4 is called when production condL4 is exited.
+func (s *BaseTrafficClassListener) ExitCondL4(ctx *CondL4Context) {}
+
 // EnterCond is called when production cond is entered.
 func (s *BaseTrafficClassListener) EnterCond(ctx *CondContext) {}
 
@@ -14,113 +14,171 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 27, 238,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 35, 364,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
 	18, 9, 18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23,
-	9, 23, 4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 3, 2, 3, 2, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 7,
-	3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3,
-	10, 3, 10, 3, 10, 3, 11, 6, 11, 85, 10, 11, 13, 11, 14, 11, 86, 3, 11,
-	3, 11, 3, 12, 3, 12, 3, 12, 7, 12, 94, 10, 12, 12, 12, 14, 12, 97, 11,
-	12, 5, 12, 99, 10, 12, 3, 13, 6, 13, 102, 10, 13, 13, 13, 14, 13, 103,
-	3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3,
-	15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 5, 15, 122, 10, 15, 3, 16, 3, 16,
-	3, 16, 3, 16, 3, 16, 3, 16, 5, 16, 130, 10, 16, 3, 17, 3, 17, 3, 17, 3,
-	17, 3, 17, 3, 17, 5, 17, 138, 10, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18,
-	3, 18, 3, 18, 3, 18, 5, 18, 148, 10, 18, 3, 19, 3, 19, 3, 19, 3, 19, 3,
-	19, 3, 19, 5, 19, 156, 10, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20,
-	5, 20, 164, 10, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3,
-	21, 5, 21, 174, 10, 21, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 5, 22,
-	182, 10, 22, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3,
-	23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 5, 23, 200, 10, 23,
-	3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3,
-	24, 3, 24, 3, 24, 3, 24, 5, 24, 216, 10, 24, 3, 25, 3, 25, 3, 25, 3, 25,
-	3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 5,
-	25, 232, 10, 25, 3, 26, 6, 26, 235, 10, 26, 13, 26, 14, 26, 236, 2, 2,
-	27, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12,
-	23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21,
-	41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 3, 2, 7, 5, 2, 11, 12,
+	9, 23, 4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9,
+	28, 4, 29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33,
+	4, 34, 9, 34, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 4, 3, 5, 3,
+	5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3,
+	10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11,
+	3, 12, 6, 12, 103, 10, 12, 13, 12, 14, 12, 104, 3, 12, 3, 12, 3, 13, 3,
+	13, 3, 13, 7, 13, 112, 10, 13, 12, 13, 14, 13, 115, 11, 13, 5, 13, 117,
+	10, 13, 3, 14, 6, 14, 120, 10, 14, 13, 14, 14, 14, 121, 3, 15, 3, 15, 3,
+	15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 16, 7, 16, 135,
+	10, 16, 12, 16, 14, 16, 138, 11, 16, 3, 16, 3, 16, 7, 16, 142, 10, 16,
+	12, 16, 14, 16, 145, 11, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17,
+	3, 17, 3, 17, 3, 17, 5, 17, 156, 10, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3,
+	18, 3, 18, 5, 18, 164, 10, 18, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19,
+	5, 19, 172, 10, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3,
+	20, 5, 20, 182, 10, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 5, 21,
+	190, 10, 21, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 5, 22, 198, 10,
+	22, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 5, 23, 208,
+	10, 23, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 5, 24, 216, 10, 24, 3,
+	25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25,
+	3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 5, 25, 234, 10, 25, 3, 26, 3, 26, 3,
+	26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26,
+	3, 26, 5, 26, 250, 10, 26, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3,
+	27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 5, 27, 266, 10, 27,
+	3, 28, 3, 28, 3, 28, 3, 28, 5, 28, 272, 10, 28, 3, 29, 3, 29, 3, 29, 3,
+	29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29,
+	3, 29, 3, 29, 3, 29, 3, 29, 5, 29, 292, 10, 29, 3, 30, 3, 30, 3, 30, 3,
+	30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30,
+	5, 30, 308, 10, 30, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3,
+	31, 3, 31, 3, 31, 5, 31, 320, 10, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32,
+	3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3,
+	32, 5, 32, 338, 10, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33,
+	3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3,
+	33, 5, 33, 358, 10, 33, 3, 34, 6, 34, 361, 10, 34, 13, 34, 14, 34, 362,
+	2, 2, 35, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11,
+	21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20,
+	39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29,
+	57, 30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 3, 2, 9, 5, 2, 11, 12,
 	15, 15, 34, 34, 3, 2, 51, 59, 3, 2, 50, 59, 5, 2, 50, 59, 67, 72, 99, 104,
-	4, 2, 67, 92, 99, 124, 2, 253, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7,
-	3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2,
-	15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2,
-	2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2,
-	2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2,
-	2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3,
-	2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 3, 53,
-	3, 2, 2, 2, 5, 55, 3, 2, 2, 2, 7, 59, 3, 2, 2, 2, 9, 61, 3, 2, 2, 2, 11,
-	66, 3, 2, 2, 2, 13, 68, 3, 2, 2, 2, 15, 70, 3, 2, 2, 2, 17, 72, 3, 2, 2,
-	2, 19, 77, 3, 2, 2, 2, 21, 84, 3, 2, 2, 2, 23, 98, 3, 2, 2, 2, 25, 101,
-	3, 2, 2, 2, 27, 105, 3, 2, 2, 2, 29, 121, 3, 2, 2, 2, 31, 129, 3, 2, 2,
-	2, 33, 137, 3, 2, 2, 2, 35, 147, 3, 2, 2, 2, 37, 155, 3, 2, 2, 2, 39, 163,
-	3, 2, 2, 2, 41, 173, 3, 2, 2, 2, 43, 181, 3, 2, 2, 2, 45, 199, 3, 2, 2,
-	2, 47, 215, 3, 2, 2, 2, 49, 231, 3, 2, 2, 2, 51, 234, 3, 2, 2, 2, 53, 54,
-	7, 63, 2, 2, 54, 4, 3, 2, 2, 2, 55, 56, 7, 63, 2, 2, 56, 57, 7, 50, 2,
-	2, 57, 58, 7, 122, 2, 2, 58, 6, 3, 2, 2, 2, 59, 60, 7, 47, 2, 2, 60, 8,
-	3, 2, 2, 2, 61, 62, 7, 101, 2, 2, 62, 63, 7, 110, 2, 2, 63, 64, 7, 117,
-	2, 2, 64, 65, 7, 63, 2, 2, 65, 10, 3, 2, 2, 2, 66, 67, 7, 42, 2, 2, 67,
-	12, 3, 2, 2, 2, 68, 69, 7, 46, 2, 2, 69, 14, 3, 2, 2, 2, 70, 71, 7, 43,
-	2, 2, 71, 16, 3, 2, 2, 2, 72, 73, 7, 118, 2, 2, 73, 74, 7, 116, 2, 2, 74,
-	75, 7, 119, 2, 2, 75, 76, 7, 103, 2, 2, 76, 18, 3, 2, 2, 2, 77, 78, 7,
-	104, 2, 2, 78, 79, 7, 99, 2, 2, 79, 80, 7, 110, 2, 2, 80, 81, 7, 117, 2,
-	2, 81, 82, 7, 103, 2, 2, 82, 20, 3, 2, 2, 2, 83, 85, 9, 2, 2, 2, 84, 83,
-	3, 2, 2, 2, 85, 86, 3, 2, 2, 2, 86, 84, 3, 2, 2, 2, 86, 87, 3, 2, 2, 2,
-	87, 88, 3, 2, 2, 2, 88, 89, 8, 11, 2, 2, 89, 22, 3, 2, 2, 2, 90, 99, 7,
-	50, 2, 2, 91, 95, 9, 3, 2, 2, 92, 94, 9, 4, 2, 2, 93, 92, 3, 2, 2, 2, 94,
-	97, 3, 2, 2, 2, 95, 93, 3, 2, 2, 2, 95, 96, 3, 2, 2, 2, 96, 99, 3, 2, 2,
-	2, 97, 95, 3, 2, 2, 2, 98, 90, 3, 2, 2, 2, 98, 91, 3, 2, 2, 2, 99, 24,
-	3, 2, 2, 2, 100, 102, 9, 5, 2, 2, 101, 100, 3, 2, 2, 2, 102, 103, 3, 2,
-	2, 2, 103, 101, 3, 2, 2, 2, 103, 104, 3, 2, 2, 2, 104, 26, 3, 2, 2, 2,
-	105, 106, 5, 23, 12, 2, 106, 107, 7, 48, 2, 2, 107, 108, 5, 23, 12, 2,
-	108, 109, 7, 48, 2, 2, 109, 110, 5, 23, 12, 2, 110, 111, 7, 48, 2, 2, 111,
-	112, 5, 23, 12, 2, 112, 113, 7, 49, 2, 2, 113, 114, 5, 23, 12, 2, 114,
-	28, 3, 2, 2, 2, 115, 116, 7, 67, 2, 2, 116, 117, 7, 80, 2, 2, 117, 122,
-	7, 91, 2, 2, 118, 119, 7, 99, 2, 2, 119, 120, 7, 112, 2, 2, 120, 122, 7,
-	123, 2, 2, 121, 115, 3, 2, 2, 2, 121, 118, 3, 2, 2, 2, 122, 30, 3, 2, 2,
-	2, 123, 124, 7, 67, 2, 2, 124, 125, 7, 78, 2, 2, 125, 130, 7, 78, 2, 2,
-	126, 127, 7, 99, 2, 2, 127, 128, 7, 110, 2, 2, 128, 130, 7, 110, 2, 2,
-	129, 123, 3, 2, 2, 2, 129, 126, 3, 2, 2, 2, 130, 32, 3, 2, 2, 2, 131, 132,
-	7, 80, 2, 2, 132, 133, 7, 81, 2, 2, 133, 138, 7, 86, 2, 2, 134, 135, 7,
-	112, 2, 2, 135, 136, 7, 113, 2, 2, 136, 138, 7, 118, 2, 2, 137, 131, 3,
-	2, 2, 2, 137, 134, 3, 2, 2, 2, 138, 34, 3, 2, 2, 2, 139, 140, 7, 68, 2,
-	2, 140, 141, 7, 81, 2, 2, 141, 142, 7, 81, 2, 2, 142, 148, 7, 78, 2, 2,
-	143, 144, 7, 100, 2, 2, 144, 145, 7, 113, 2, 2, 145, 146, 7, 113, 2, 2,
-	146, 148, 7, 110, 2, 2, 147, 139, 3, 2, 2, 2, 147, 143, 3, 2, 2, 2, 148,
-	36, 3, 2, 2, 2, 149, 150, 7, 85, 2, 2, 150, 151, 7, 84, 2, 2, 151, 156,
-	7, 69, 2, 2, 152, 153, 7, 117, 2, 2, 153, 154, 7, 116, 2, 2, 154, 156,
-	7, 101, 2, 2, 155, 149, 3, 2, 2, 2, 155, 152, 3, 2, 2, 2, 156, 38, 3, 2,
-	2, 2, 157, 158, 7, 70, 2, 2, 158, 159, 7, 85, 2, 2, 159, 164, 7, 86, 2,
-	2, 160, 161, 7, 102, 2, 2, 161, 162, 7, 117, 2, 2, 162, 164, 7, 118, 2,
-	2, 163, 157, 3, 2, 2, 2, 163, 160, 3, 2, 2, 2, 164, 40, 3, 2, 2, 2, 165,
-	166, 7, 70, 2, 2, 166, 167, 7, 85, 2, 2, 167, 168, 7, 69, 2, 2, 168, 174,
-	7, 82, 2, 2, 169, 170, 7, 102, 2, 2, 170, 171, 7, 117, 2, 2, 171, 172,
-	7, 101, 2, 2, 172, 174, 7, 114, 2, 2, 173, 165, 3, 2, 2, 2, 173, 169, 3,
-	2, 2, 2, 174, 42, 3, 2, 2, 2, 175, 176, 7, 86, 2, 2, 176, 177, 7, 81, 2,
-	2, 177, 182, 7, 85, 2, 2, 178, 179, 7, 118, 2, 2, 179, 180, 7, 113, 2,
-	2, 180, 182, 7, 117, 2, 2, 181, 175, 3, 2, 2, 2, 181, 178, 3, 2, 2, 2,
-	182, 44, 3, 2, 2, 2, 183, 184, 7, 82, 2, 2, 184, 185, 7, 84, 2, 2, 185,
-	186, 7, 81, 2, 2, 186, 187, 7, 86, 2, 2, 187, 188, 7, 81, 2, 2, 188, 189,
-	7, 69, 2, 2, 189, 190, 7, 81, 2, 2, 190, 200, 7, 78, 2, 2, 191, 192, 7,
-	114, 2, 2, 192, 193, 7, 116, 2, 2, 193, 194, 7, 113, 2, 2, 194, 195, 7,
-	118, 2, 2, 195, 196, 7, 113, 2, 2, 196, 197, 7, 101, 2, 2, 197, 198, 7,
-	113, 2, 2, 198, 200, 7, 110, 2, 2, 199, 183, 3, 2, 2, 2, 199, 191, 3, 2,
-	2, 2, 200, 46, 3, 2, 2, 2, 201, 202, 7, 85, 2, 2, 202, 203, 7, 84, 2, 2,
-	203, 204, 7, 69, 2, 2, 204, 205, 7, 82, 2, 2, 205, 206, 7, 81, 2, 2, 206,
-	207, 7, 84, 2, 2, 207, 216, 7, 86, 2, 2, 208, 209, 7, 117, 2, 2, 209, 210,
-	7, 116, 2, 2, 210, 211, 7, 101, 2, 2, 211, 212, 7, 114, 2, 2, 212, 213,
-	7, 113, 2, 2, 213, 214, 7, 116, 2, 2, 214, 216, 7, 118, 2, 2, 215, 201,
-	3, 2, 2, 2, 215, 208, 3, 2, 2, 2, 216, 48, 3, 2, 2, 2, 217, 218, 7, 70,
-	2, 2, 218, 219, 7, 85, 2, 2, 219, 220, 7, 86, 2, 2, 220, 221, 7, 82, 2,
-	2, 221, 222, 7, 81, 2, 2, 222, 223, 7, 84, 2, 2, 223, 232, 7, 86, 2, 2,
-	224, 225, 7, 102, 2, 2, 225, 226, 7, 117, 2, 2, 226, 227, 7, 118, 2, 2,
-	227, 228, 7, 114, 2, 2, 228, 229, 7, 113, 2, 2, 229, 230, 7, 116, 2, 2,
-	230, 232, 7, 118, 2, 2, 231, 217, 3, 2, 2, 2, 231, 224, 3, 2, 2, 2, 232,
-	50, 3, 2, 2, 2, 233, 235, 9, 6, 2, 2, 234, 233, 3, 2, 2, 2, 235, 236, 3,
-	2, 2, 2, 236, 234, 3, 2, 2, 2, 236, 237, 3, 2, 2, 2, 237, 52, 3, 2, 2,
-	2, 20, 2, 86, 95, 98, 101, 103, 121, 129, 137, 147, 155, 163, 173, 181,
-	199, 215, 231, 236, 3, 8, 2, 2,
+	5, 2, 50, 60, 67, 72, 99, 104, 6, 2, 48, 48, 50, 60, 67, 72, 99, 104, 4,
+	2, 67, 92, 99, 124, 2, 387, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3,
+	2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15,
+	3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2,
+	23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2,
+	2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2,
+	2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2,
+	2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3,
+	2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61,
+	3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 3,
+	69, 3, 2, 2, 2, 5, 71, 3, 2, 2, 2, 7, 75, 3, 2, 2, 2, 9, 77, 3, 2, 2, 2,
+	11, 79, 3, 2, 2, 2, 13, 84, 3, 2, 2, 2, 15, 86, 3, 2, 2, 2, 17, 88, 3,
+	2, 2, 2, 19, 90, 3, 2, 2, 2, 21, 95, 3, 2, 2, 2, 23, 102, 3, 2, 2, 2, 25,
+	116, 3, 2, 2, 2, 27, 119, 3, 2, 2, 2, 29, 123, 3, 2, 2, 2, 31, 136, 3,
+	2, 2, 2, 33, 155, 3, 2, 2, 2, 35, 163, 3, 2, 2, 2, 37, 171, 3, 2, 2, 2,
+	39, 181, 3, 2, 2, 2, 41, 189, 3, 2, 2, 2, 43, 197, 3, 2, 2, 2, 45, 207,
+	3, 2, 2, 2, 47, 215, 3, 2, 2, 2, 49, 233, 3, 2, 2, 2, 51, 249, 3, 2, 2,
+	2, 53, 265, 3, 2, 2, 2, 55, 271, 3, 2, 2, 2, 57, 291, 3, 2, 2, 2, 59, 307,
+	3, 2, 2, 2, 61, 319, 3, 2, 2, 2, 63, 337, 3, 2, 2, 2, 65, 357, 3, 2, 2,
+	2, 67, 360, 3, 2, 2, 2, 69, 70, 7, 63, 2, 2, 70, 4, 3, 2, 2, 2, 71, 72,
+	7, 63, 2, 2, 72, 73, 7, 50, 2, 2, 73, 74, 7, 122, 2, 2, 74, 6, 3, 2, 2,
+	2, 75, 76, 7, 47, 2, 2, 76, 8, 3, 2, 2, 2, 77, 78, 7, 126, 2, 2, 78, 10,
+	3, 2, 2, 2, 79, 80, 7, 101, 2, 2, 80, 81, 7, 110, 2, 2, 81, 82, 7, 117,
+	2, 2, 82, 83, 7, 63, 2, 2, 83, 12, 3, 2, 2, 2, 84, 85, 7, 42, 2, 2, 85,
+	14, 3, 2, 2, 2, 86, 87, 7, 46, 2, 2, 87, 16, 3, 2, 2, 2, 88, 89, 7, 43,
+	2, 2, 89, 18, 3, 2, 2, 2, 90, 91, 7, 118, 2, 2, 91, 92, 7, 116, 2, 2, 92,
+	93, 7, 119, 2, 2, 93, 94, 7, 103, 2, 2, 94, 20, 3, 2, 2, 2, 95, 96, 7,
+	104, 2, 2, 96, 97, 7, 99, 2, 2, 97, 98, 7, 110, 2, 2, 98, 99, 7, 117, 2,
+	2, 99, 100, 7, 103, 2, 2, 100, 22, 3, 2, 2, 2, 101, 103, 9, 2, 2, 2, 102,
+	101, 3, 2, 2, 2, 103, 104, 3, 2, 2, 2, 104, 102, 3, 2, 2, 2, 104, 105,
+	3, 2, 2, 2, 105, 106, 3, 2, 2, 2, 106, 107, 8, 12, 2, 2, 107, 24, 3, 2,
+	2, 2, 108, 117, 7, 50, 2, 2, 109, 113, 9, 3, 2, 2, 110, 112, 9, 4, 2, 2,
+	111, 110, 3, 2, 2, 2, 112, 115, 3, 2, 2, 2, 113, 111, 3, 2, 2, 2, 113,
+	114, 3, 2, 2, 2, 114, 117, 3, 2, 2, 2, 115, 113, 3, 2, 2, 2, 116, 108,
+	3, 2, 2, 2, 116, 109, 3, 2, 2, 2, 117, 26, 3, 2, 2, 2, 118, 120, 9, 5,
+	2, 2, 119, 118, 3, 2, 2, 2, 120, 121, 3, 2, 2, 2, 121, 119, 3, 2, 2, 2,
+	121, 122, 3, 2, 2, 2, 122, 28, 3, 2, 2, 2, 123, 124, 5, 25, 13, 2, 124,
+	125, 7, 48, 2, 2, 125, 126, 5, 25, 13, 2, 126, 127, 7, 48, 2, 2, 127, 128,
+	5, 25, 13, 2, 128, 129, 7, 48, 2, 2, 129, 130, 5, 25, 13, 2, 130, 131,
+	7, 49, 2, 2, 131, 132, 5, 25, 13, 2, 132, 30, 3, 2, 2, 2, 133, 135, 9,
+	6, 2, 2, 134, 133, 3, 2, 2, 2, 135, 138, 3, 2, 2, 2, 136, 134, 3, 2, 2,
+	2, 136, 137, 3, 2, 2, 2, 137, 139, 3, 2, 2, 2, 138, 136, 3, 2, 2, 2, 139,
+	143, 7, 60, 2, 2, 140, 142, 9, 7, 2, 2, 141, 140, 3, 2, 2, 2, 142, 145,
+	3, 2, 2, 2, 143, 141, 3, 2, 2, 2, 143, 144, 3, 2, 2, 2, 144, 146, 3, 2,
+	2, 2, 145, 143, 3, 2, 2, 2, 146, 147, 7, 49, 2, 2, 147, 148, 5, 25, 13,
+	2, 148, 32, 3, 2, 2, 2, 149, 150, 7, 67, 2, 2, 150, 151, 7, 80, 2, 2, 151,
+	156, 7, 91, 2, 2, 152, 153, 7, 99, 2, 2, 153, 154, 7, 112, 2, 2, 154, 156,
+	7, 123, 2, 2, 155, 149, 3, 2, 2, 2, 155, 152, 3, 2, 2, 2, 156, 34, 3, 2,
+	2, 2, 157, 158, 7, 67, 2, 2, 158, 159, 7, 78, 2, 2, 159, 164, 7, 78, 2,
+	2, 160, 161, 7, 99, 2, 2, 161, 162, 7, 110, 2, 2, 162, 164, 7, 110, 2,
+	2, 163, 157, 3, 2, 2, 2, 163, 160, 3, 2, 2, 2, 164, 36, 3, 2, 2, 2, 165,
+	166, 7, 80, 2, 2, 166, 167, 7, 81, 2, 2, 167, 172, 7, 86, 2, 2, 168, 169,
+	7, 112, 2, 2, 169, 170, 7, 113, 2, 2, 170, 172, 7, 118, 2, 2, 171, 165,
+	3, 2, 2, 2, 171, 168, 3, 2, 2, 2, 172, 38, 3, 2, 2, 2, 173, 174, 7, 68,
+	2, 2, 174, 175, 7, 81, 2, 2, 175, 176, 7, 81, 2, 2, 176, 182, 7, 78, 2,
+	2, 177, 178, 7, 100, 2, 2, 178, 179, 7, 113, 2, 2, 179, 180, 7, 113, 2,
+	2, 180, 182, 7, 110, 2, 2, 181, 173, 3, 2, 2, 2, 181, 177, 3, 2, 2, 2,
+	182, 40, 3, 2, 2, 2, 183, 184, 7, 85, 2, 2, 184, 185, 7, 84, 2, 2, 185,
+	190, 7, 69, 2, 2, 186, 187, 7, 117, 2, 2, 187, 188, 7, 116, 2, 2, 188,
+	190, 7, 101, 2, 2, 189, 183, 3, 2, 2, 2, 189, 186, 3, 2, 2, 2, 190, 42,
+	3, 2, 2, 2, 191, 192, 7, 70, 2, 2, 192, 193, 7, 85, 2, 2, 193, 198, 7,
+	86, 2, 2, 194, 195, 7, 102, 2, 2, 195, 196, 7, 117, 2, 2, 196, 198, 7,
+	118, 2, 2, 197, 191, 3, 2, 2, 2, 197, 194, 3, 2, 2, 2, 198, 44, 3, 2, 2,
+	2, 199, 200, 7, 70, 2, 2, 200, 201, 7, 85, 2, 2, 201, 202, 7, 69, 2, 2,
+	202, 208, 7, 82, 2, 2, 203, 204, 7, 102, 2, 2, 204, 205, 7, 117, 2, 2,
+	205, 206, 7, 101, 2, 2, 206, 208, 7, 114, 2, 2, 207, 199, 3, 2, 2, 2, 207,
+	203, 3, 2, 2, 2, 208, 46, 3, 2, 2, 2, 209, 210, 7, 86, 2, 2, 210, 211,
+	7, 81, 2, 2, 211, 216, 7, 85, 2, 2, 212, 213, 7, 118, 2, 2, 213, 214, 7,
+	113, 2, 2, 214, 216, 7, 117, 2, 2, 215, 209, 3, 2, 2, 2, 215, 212, 3, 2,
+	2, 2, 216, 48, 3, 2, 2, 2, 217, 218, 7, 82, 2, 2, 218, 219, 7, 84, 2, 2,
+	219, 220, 7, 81, 2, 2, 220, 221, 7, 86, 2, 2, 221, 222, 7, 81, 2, 2, 222,
+	223, 7, 69, 2, 2, 223, 224, 7, 81, 2, 2, 224, 234, 7, 78, 2, 2, 225, 226,
+	7, 114, 2, 2, 226, 227, 7, 116, 2, 2, 227, 228, 7, 113, 2, 2, 228, 229,
+	7, 118, 2, 2, 229, 230, 7, 113, 2, 2, 230, 231, 7, 101, 2, 2, 231, 232,
+	7, 113, 2, 2, 232, 234, 7, 110, 2, 2, 233, 217, 3, 2, 2, 2, 233, 225, 3,
+	2, 2, 2, 234, 50, 3, 2, 2, 2, 235, 236, 7, 85, 2, 2, 236, 237, 7, 84, 2,
+	2, 237, 238, 7, 69, 2, 2, 238, 239, 7, 82, 2, 2, 239, 240, 7, 81, 2, 2,
+	240, 241, 7, 84, 2, 2, 241, 250, 7, 86, 2, 2, 242, 243, 7, 117, 2, 2, 243,
+	244, 7, 116, 2, 2, 244, 245, 7, 101, 2, 2, 245, 246, 7, 114, 2, 2, 246,
+	247, 7, 113, 2, 2, 247, 248, 7, 116, 2, 2, 248, 250, 7, 118, 2, 2, 249,
+	235, 3, 2, 2, 2, 249, 242, 3, 2, 2, 2, 250, 52, 3, 2, 2, 2, 251, 252, 7,
+	70, 2, 2, 252, 253, 7, 85, 2, 2, 253, 254, 7, 86, 2, 2, 254, 255, 7, 82,
+	2, 2, 255, 256, 7, 81, 2, 2, 256, 257, 7, 84, 2, 2, 257, 266, 7, 86, 2,
+	2, 258, 259, 7, 102, 2, 2, 259, 260, 7, 117, 2, 2, 260, 261, 7, 118, 2,
+	2, 261, 262, 7, 114, 2, 2, 262, 263, 7, 113, 2, 2, 263, 264, 7, 116, 2,
+	2, 264, 266, 7, 118, 2, 2, 265, 251, 3, 2, 2, 2, 265, 258, 3, 2, 2, 2,
+	266, 54, 3, 2, 2, 2, 267, 268, 7, 86, 2, 2, 268, 272, 7, 69, 2, 2, 269,
+	270, 7, 118, 2, 2, 270, 272, 7, 101, 2, 2, 271, 267, 3, 2, 2, 2, 271, 269,
+	3, 2, 2, 2, 272, 56, 3, 2, 2, 2, 273, 274, 7, 72, 2, 2, 274, 275, 7, 78,
+	2, 2, 275, 276, 7, 81, 2, 2, 276, 277, 7, 89, 2, 2, 277, 278, 7, 78, 2,
+	2, 278, 279, 7, 67, 2, 2, 279, 280, 7, 68, 2, 2, 280, 281, 7, 71, 2, 2,
+	281, 292, 7, 78, 2, 2, 282, 283, 7, 104, 2, 2, 283, 284, 7, 110, 2, 2,
+	284, 285, 7, 113, 2, 2, 285, 286, 7, 121, 2, 2, 286, 287, 7, 110, 2, 2,
+	287, 288, 7, 99, 2, 2, 288, 289, 7, 100, 2, 2, 289, 290, 7, 103, 2, 2,
+	290, 292, 7, 110, 2, 2, 291, 273, 3, 2, 2, 2, 291, 282, 3, 2, 2, 2, 292,
+	58, 3, 2, 2, 2, 293, 294, 7, 80, 2, 2, 294, 295, 7, 71, 2, 2, 295, 296,
+	7, 90, 2, 2, 296, 297, 7, 86, 2, 2, 297, 298, 7, 74, 2, 2, 298, 299, 7,
+	70, 2, 2, 299, 308, 7, 84, 2, 2, 300, 301, 7, 112, 2, 2, 301, 302, 7, 103,
+	2, 2, 302, 303, 7, 122, 2, 2, 303, 304, 7, 118, 2, 2, 304, 305, 7, 106,
+	2, 2, 305, 306, 7, 102, 2, 2, 306, 308, 7, 116, 2, 2, 307, 293, 3, 2, 2,
+	2, 307, 300, 3, 2, 2, 2, 308, 60, 3, 2, 2, 2, 309, 310, 7, 82, 2, 2, 310,
+	311, 7, 84, 2, 2, 311, 312, 7, 81, 2, 2, 312, 313, 7, 86, 2, 2, 313, 320,
+	7, 81, 2, 2, 314, 315, 7, 114, 2, 2, 315, 316, 7, 116, 2, 2, 316, 317,
+	7, 113, 2, 2, 317, 318, 7, 118, 2, 2, 318, 320, 7, 113, 2, 2, 319, 309,
+	3, 2, 2, 2, 319, 314, 3, 2, 2, 2, 320, 62, 3, 2, 2, 2, 321, 322, 7, 86,
+	2, 2, 322, 323, 7, 69, 2, 2, 323, 324, 7, 82, 2, 2, 324, 325, 7, 72, 2,
+	2, 325, 326, 7, 78, 2, 2, 326, 327, 7, 67, 2, 2, 327, 328, 7, 73, 2, 2,
+	328, 338, 7, 85, 2, 2, 329, 330, 7, 118, 2, 2, 330, 331, 7, 101, 2, 2,
+	331, 332, 7, 114, 2, 2, 332, 333, 7, 104, 2, 2, 333, 334, 7, 110, 2, 2,
+	334, 335, 7, 99, 2, 2, 335, 336, 7, 105, 2, 2, 336, 338, 7, 117, 2, 2,
+	337, 321, 3, 2, 2, 2, 337, 329, 3, 2, 2, 2, 338, 64, 3, 2, 2, 2, 339, 340,
+	7, 75, 2, 2, 340, 341, 7, 69, 2, 2, 341, 342, 7, 79, 2, 2, 342, 343, 7,
+	82, 2, 2, 343, 344, 7, 47, 2, 2, 344, 345, 7, 86, 2, 2, 345, 346, 7, 91,
+	2, 2, 346, 347, 7, 82, 2, 2, 347, 358, 7, 71, 2, 2, 348, 349, 7, 107, 2,
+	2, 349, 350, 7, 101, 2, 2, 350, 351, 7, 111, 2, 2, 351, 352, 7, 114, 2,
+	2, 352, 353, 7, 47, 2, 2, 353, 354, 7, 118, 2, 2, 354, 355, 7, 123, 2,
+	2, 355, 356, 7, 114, 2, 2, 356, 358, 7, 103, 2, 2, 357, 339, 3, 2, 2, 2,
+	357, 348, 3, 2, 2, 2, 358, 66, 3, 2, 2, 2, 359, 361, 9, 8, 2, 2, 360, 359,
+	3, 2, 2, 2, 361, 362, 3, 2, 2, 2, 362, 360, 3, 2, 2, 2, 362, 363, 3, 2,
+	2, 2, 363, 68, 3, 2, 2, 2, 28, 2, 104, 113, 116, 119, 121, 136, 143, 155,
+	163, 171, 181, 189, 197, 207, 215, 233, 249, 265, 271, 291, 307, 319, 337,
+	357, 362, 3, 8, 2, 2,
 }
 
 var lexerChannelNames = []string{
@@ -132,19 +190,22 @@ var lexerModeNames = []string{
 }
 
 var lexerLiteralNames = []string{
-	"", "'='", "'=0x'", "'-'", "'cls='", "'('", "','", "')'", "'true'", "'false'",
+	"", "'='", "'=0x'", "'-'", "'|'", "'cls='", "'('", "','", "')'", "'true'",
+	"'false'",
 }
 
 var lexerSymbolicNames = []string{
-	"", "", "", "", "", "", "", "", "", "", "WHITESPACE", "DIGITS", "HEX_DIGITS",
-	"NET", "ANY", "ALL", "NOT", "BOOL", "SRC", "DST", "DSCP", "TOS", "PROTOCOL",
-	"SRCPORT", "DSTPORT", "STRING",
+	"", "", "", "", "", "", "", "", "", "", "", "WHITESPACE", "DIGITS", "HEX_DIGITS",
+	"NET", "NET6", "ANY", "ALL", "NOT", "BOOL", "SRC", "DST", "DSCP", "TOS",
+	"PROTOCOL", "SRCPORT", "DSTPORT", "TC", "FLOWLABEL", "NEXTHDR", "PROTO",
+	"TCPFLAGS", "ICMPTYPE", "STRING",
 }
 
 var lexerRuleNames = []string{
 	"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "T__8",
-	"WHITESPACE", "DIGITS", "HEX_DIGITS", "NET", "ANY", "ALL", "NOT", "BOOL",
-	"SRC", "DST", "DSCP", "TOS", "PROTOCOL", "SRCPORT", "DSTPORT", "STRING",
+	"T__9", "WHITESPACE", "DIGITS", "HEX_DIGITS", "NET", "NET6", "ANY", "ALL",
+	"NOT", "BOOL", "SRC", "DST", "DSCP", "TOS", "PROTOCOL", "SRCPORT", "DSTPORT",
+	"TC", "FLOWLABEL", "NEXTHDR", "PROTO", "TCPFLAGS", "ICMPTYPE", "STRING",
 }
 
 type TrafficClassLexer struct {
@@ -193,20 +254,28 @@ const (
 	TrafficClassLexerT__6       = 7
 	TrafficClassLexerT__7       = 8
 	TrafficClassLexerT__8       = 9
-	TrafficClassLexerWHITESPACE = 10
-	TrafficClassLexerDIGITS     = 11
-	TrafficClassLexerHEX_DIGITS = 12
-	TrafficClassLexerNET        = 13
-	TrafficClassLexerANY        = 14
-	TrafficClassLexerALL        = 15
-	TrafficClassLexerNOT        = 16
-	TrafficClassLexerBOOL       = 17
-	TrafficClassLexerSRC        = 18
-	TrafficClassLexerDST        = 19
-	TrafficClassLexerDSCP       = 20
-	TrafficClassLexerTOS        = 21
-	TrafficClassLexerPROTOCOL   = 22
-	TrafficClassLexerSRCPORT    = 23
-	TrafficClassLexerDSTPORT    = 24
-	TrafficClassLexerSTRING     = 25
+	TrafficClassLexerT__9       = 10
+	TrafficClassLexerWHITESPACE = 11
+	TrafficClassLexerDIGITS     = 12
+	TrafficClassLexerHEX_DIGITS = 13
+	TrafficClassLexerNET        = 14
+	TrafficClassLexerNET6       = 15
+	TrafficClassLexerANY        = 16
+	TrafficClassLexerALL        = 17
+	TrafficClassLexerNOT        = 18
+	TrafficClassLexerBOOL       = 19
+	TrafficClassLexerSRC        = 20
+	TrafficClassLexerDST        = 21
+	TrafficClassLexerDSCP       = 22
+	TrafficClassLexerTOS        = 23
+	TrafficClassLexerPROTOCOL   = 24
+	TrafficClassLexerSRCPORT    = 25
+	TrafficClassLexerDSTPORT    = 26
+	TrafficClassLexerTC         = 27
+	TrafficClassLexerFLOWLABEL  = 28
+	TrafficClassLexerNEXTHDR    = 29
+	TrafficClassLexerPROTO      = 30
+	TrafficClassLexerTCPFLAGS   = 31
+	TrafficClassLexerICMPTYPE   = 32
+	TrafficClassLexerSTRING     = 33
 )
@@ -22,6 +22,21 @@ type TrafficClassListener interface {
 	// EnterMatchProtocol is called when entering the matchProtocol production.
 	EnterMatchProtocol(c *MatchProtocolContext)
 
+	// EnterMatchSrcIPv6 is called when entering the matchSrcIPv6 production.
+	EnterMatchSrcIPv6(c *MatchSrcIPv6Context)
+
+	// EnterMatchDstIPv6 is called when entering the matchDstIPv6 production.
+	EnterMatchDstIPv6(c *MatchDstIPv6Context)
+
+	// EnterMatchTrafficClass is called when entering the matchTrafficClass production.
+	EnterMatchTrafficClass(c *MatchTrafficClassContext)
+
+	// EnterMatchFlowLabel is called when entering the matchFlowLabel production.
+	EnterMatchFlowLabel(c *MatchFlowLabelContext)
+
+	// EnterMatchNextHeader is called when entering the matchNextHeader production.
+	EnterMatchNextHeader(c *MatchNextHeaderContext)
+
 	// EnterMatchSrcPort is called when entering the matchSrcPort production.
 	EnterMatchSrcPort(c *MatchSrcPortContext)
 
@@ -34,6 +49,15 @@ type TrafficClassListener interface {
 	// EnterMatchDstPortRange is called when entering the matchDstPortRange production.
 	EnterMatchDstPortRange(c *MatchDstPortRangeContext)
 
+	// EnterMatchL4Protocol is called when entering the matchL4Protocol production.
+	EnterMatchL4Protocol(c *MatchL4ProtocolContext)
+
+	// EnterMatchTCPFlags is called when entering the matchTCPFlags production.
+	EnterMatchTCPFlags(c *MatchTCPFlagsContext)
+
+	// EnterMatchICMPType is called when entering the matchICMPType production.
+	EnterMatchICMPType(c *MatchICMPTypeContext)
+
 	// EnterCondCls is called when entering the condCls production.
 	EnterCondCls(c *CondClsContext)
 
@@ -52,9 +76,15 @@ type TrafficClassListener interface {
 	// EnterCondIPv4 is called when entering the condIPv4 production.
 	EnterCondIPv4(c *CondIPv4Context)
 
+	// EnterCondIPv6 is called when entering the condIPv6 production.
+	EnterCondIPv6(c *CondIPv6Context)
+
 	// EnterCondPort is called when entering the condPort production.
 	EnterCondPort(c *CondPortContext)
 
+	// EnterCondL4 is called when entering the condL4 production.
+	EnterCondL4(c *CondL4Context)
+
 	// EnterCond is called when entering the cond production.
 	EnterCond(c *CondContext)
 
@@ -76,6 +106,21 @@ type TrafficClassListener interface {
 	// ExitMatchProtocol is called when exiting the matchProtocol production.
 	ExitMatchProtocol(c *MatchProtocolContext)
 
+	// ExitMatchSrcIPv6 is called when exiting the matchSrcIPv6 production.
+	ExitMatchSrcIPv6(c *MatchSrcIPv6Context)
+
+	// ExitMatchDstIPv6 is called when exiting the matchDstIPv6 production.
+	ExitMatchDstIPv6(c *MatchDstIPv6Context)
+
+	// ExitMatchTrafficClass is called when exiting the matchTrafficClass production.
+	ExitMatchTrafficClass(c *MatchTrafficClassContext)
+
+	// ExitMatchFlowLabel is called when exiting the matchFlowLabel production.
+	ExitMatchFlowLabel(c *MatchFlowLabelContext)
+
+	// ExitMatchNextHeader is called when exiting the matchNextHeader production.
+	ExitMatchNextHeader(c *MatchNextHeaderContext)
+
 	// ExitMatchSrcPort is called when exiting the matchSrcPort production.
 	ExitMatchSrcPort(c *MatchSrcPortContext)
 
@@ -88,6 +133,15 @@ type TrafficClassListener interface {
 	// ExitMatchDstPortRange is called when exiting the matchDstPortRange production.
 	ExitMatchDstPortRange(c *MatchDstPortRangeContext)
 
+	// ExitMatchL4Protocol is called when exiting the matchL4Protocol production.
+	ExitMatchL4Protocol(c *MatchL4ProtocolContext)
+
+	// ExitMatchTCPFlags is called when exiting the matchTCPFlags production.
+	ExitMatchTCPFlags(c *MatchTCPFlagsContext)
+
+	// ExitMatchICMPType is called when exiting the matchICMPType production.
+	ExitMatchICMPType(c *MatchICMPTypeContext)
+
 	// ExitCondCls is called when exiting the condCls production.
 	ExitCondCls(c *CondClsContext)
 
@@ -106,9 +160,15 @@ type TrafficClassListener interface {
 	// ExitCondIPv4 is called when exiting the condIPv4 production.
 	ExitCondIPv4(c *CondIPv4Context)
 
+	// ExitCondIPv6 is called when exiting the condIPv6 production.
+	ExitCondIPv6(c *CondIPv6Context)
+
 	// ExitCondPort is called when exiting the condPort production.
 	ExitCondPort(c *CondPortContext)
 
+	// ExitCondL4 is called when exiting the condL4 production.
+	ExitCondL4(c *CondL4Context)
+
 	// ExitCond is called when exiting the cond production.
 	ExitCond(c *CondContext)
 
@@ -15,76 +15,112 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 27, 140,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 35, 212,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
-	18, 4, 19, 9, 19, 3, 2, 3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
-	3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7,
-	3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9,
-	3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3,
-	12, 3, 12, 3, 12, 3, 12, 3, 12, 7, 12, 87, 10, 12, 12, 12, 14, 12, 90,
-	11, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 7, 13, 99, 10,
-	13, 12, 13, 14, 13, 102, 11, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3,
-	14, 3, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16,
-	5, 16, 120, 10, 16, 3, 17, 3, 17, 3, 17, 3, 17, 5, 17, 126, 10, 17, 3,
-	18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 5, 18, 135, 10, 18, 3, 19,
-	3, 19, 3, 19, 3, 19, 2, 2, 20, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22,
-	24, 26, 28, 30, 32, 34, 36, 2, 4, 3, 2, 13, 14, 3, 2, 10, 11, 2, 136, 2,
-	38, 3, 2, 2, 2, 4, 42, 3, 2, 2, 2, 6, 46, 3, 2, 2, 2, 8, 50, 3, 2, 2, 2,
-	10, 54, 3, 2, 2, 2, 12, 58, 3, 2, 2, 2, 14, 62, 3, 2, 2, 2, 16, 68, 3,
-	2, 2, 2, 18, 72, 3, 2, 2, 2, 20, 78, 3, 2, 2, 2, 22, 81, 3, 2, 2, 2, 24,
-	93, 3, 2, 2, 2, 26, 105, 3, 2, 2, 2, 28, 110, 3, 2, 2, 2, 30, 119, 3, 2,
-	2, 2, 32, 125, 3, 2, 2, 2, 34, 134, 3, 2, 2, 2, 36, 136, 3, 2, 2, 2, 38,
-	39, 7, 20, 2, 2, 39, 40, 7, 3, 2, 2, 40, 41, 7, 15, 2, 2, 41, 3, 3, 2,
-	2, 2, 42, 43, 7, 21, 2, 2, 43, 44, 7, 3, 2, 2, 44, 45, 7, 15, 2, 2, 45,
-	5, 3, 2, 2, 2, 46, 47, 7, 22, 2, 2, 47, 48, 7, 4, 2, 2, 48, 49, 9, 2, 2,
-	2, 49, 7, 3, 2, 2, 2, 50, 51, 7, 23, 2, 2, 51, 52, 7, 4, 2, 2, 52, 53,
-	9, 2, 2, 2, 53, 9, 3, 2, 2, 2, 54, 55, 7, 24, 2, 2, 55, 56, 7, 3, 2, 2,
-	56, 57, 7, 27, 2, 2, 57, 11, 3, 2, 2, 2, 58, 59, 7, 25, 2, 2, 59, 60, 7,
-	3, 2, 2, 60, 61, 7, 13, 2, 2, 61, 13, 3, 2, 2, 2, 62, 63, 7, 25, 2, 2,
-	63, 64, 7, 3, 2, 2, 64, 65, 7, 13, 2, 2, 65, 66, 7, 5, 2, 2, 66, 67, 7,
-	13, 2, 2, 67, 15, 3, 2, 2, 2, 68, 69, 7, 26, 2, 2, 69, 70, 7, 3, 2, 2,
-	70, 71, 7, 13, 2, 2, 71, 17, 3, 2, 2, 2, 72, 73, 7, 26, 2, 2, 73, 74, 7,
-	3, 2, 2, 74, 75, 7, 13, 2, 2, 75, 76, 7, 5, 2, 2, 76, 77, 7, 13, 2, 2,
-	77, 19, 3, 2, 2, 2, 78, 79, 7, 6, 2, 2, 79, 80, 7, 13, 2, 2, 80, 21, 3,
-	2, 2, 2, 81, 82, 7, 16, 2, 2, 82, 83, 7, 7, 2, 2, 83, 88, 5, 34, 18, 2,
-	84, 85, 7, 8, 2, 2, 85, 87, 5, 34, 18, 2, 86, 84, 3, 2, 2, 2, 87, 90, 3,
-	2, 2, 2, 88, 86, 3, 2, 2, 2, 88, 89, 3, 2, 2, 2, 89, 91, 3, 2, 2, 2, 90,
-	88, 3, 2, 2, 2, 91, 92, 7, 9, 2, 2, 92, 23, 3, 2, 2, 2, 93, 94, 7, 17,
-	2, 2, 94, 95, 7, 7, 2, 2, 95, 100, 5, 34, 18, 2, 96, 97, 7, 8, 2, 2, 97,
-	99, 5, 34, 18, 2, 98, 96, 3, 2, 2, 2, 99, 102, 3, 2, 2, 2, 100, 98, 3,
-	2, 2, 2, 100, 101, 3, 2, 2, 2, 101, 103, 3, 2, 2, 2, 102, 100, 3, 2, 2,
-	2, 103, 104, 7, 9, 2, 2, 104, 25, 3, 2, 2, 2, 105, 106, 7, 18, 2, 2, 106,
-	107, 7, 7, 2, 2, 107, 108, 5, 34, 18, 2, 108, 109, 7, 9, 2, 2, 109, 27,
-	3, 2, 2, 2, 110, 111, 7, 19, 2, 2, 111, 112, 7, 3, 2, 2, 112, 113, 9, 3,
-	2, 2, 113, 29, 3, 2, 2, 2, 114, 120, 5, 2, 2, 2, 115, 120, 5, 4, 3, 2,
-	116, 120, 5, 6, 4, 2, 117, 120, 5, 8, 5, 2, 118, 120, 5, 10, 6, 2, 119,
-	114, 3, 2, 2, 2, 119, 115, 3, 2, 2, 2, 119, 116, 3, 2, 2, 2, 119, 117,
-	3, 2, 2, 2, 119, 118, 3, 2, 2, 2, 120, 31, 3, 2, 2, 2, 121, 126, 5, 12,
-	7, 2, 122, 126, 5, 14, 8, 2, 123, 126, 5, 16, 9, 2, 124, 126, 5, 18, 10,
-	2, 125, 121, 3, 2, 2, 2, 125, 122, 3, 2, 2, 2, 125, 123, 3, 2, 2, 2, 125,
-	124, 3, 2, 2, 2, 126, 33, 3, 2, 2, 2, 127, 135, 5, 24, 13, 2, 128, 135,
-	5, 22, 12, 2, 129, 135, 5, 26, 14, 2, 130, 135, 5, 30, 16, 2, 131, 135,
-	5, 32, 17, 2, 132, 135, 5, 20, 11, 2, 133, 135, 5, 28, 15, 2, 134, 127,
-	3, 2, 2, 2, 134, 128, 3, 2, 2, 2, 134, 129, 3, 2, 2, 2, 134, 130, 3, 2,
-	2, 2, 134, 131, 3, 2, 2, 2, 134, 132, 3, 2, 2, 2, 134, 133, 3, 2, 2, 2,
-	135, 35, 3, 2, 2, 2, 136, 137, 5, 34, 18, 2, 137, 138, 7, 2, 2, 3, 138,
-	37, 3, 2, 2, 2, 7, 88, 100, 119, 125, 134,
+	18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23, 9, 23,
+	4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9, 28, 4,
+	29, 9, 29, 3, 2, 3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 4,
+	3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7,
+	3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3, 10, 3, 10,
+	3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3,
+	13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3, 15,
+	3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3,
+	17, 3, 17, 3, 17, 3, 17, 7, 17, 128, 10, 17, 12, 17, 14, 17, 131, 11, 17,
+	3, 18, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3,
+	20, 3, 20, 7, 20, 145, 10, 20, 12, 20, 14, 20, 148, 11, 20, 3, 20, 3, 20,
+	3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 7, 21, 157, 10, 21, 12, 21, 14, 21,
+	160, 11, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 23, 3,
+	23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 5, 24, 178, 10, 24,
+	3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 5, 25, 185, 10, 25, 3, 26, 3, 26, 3,
+	26, 3, 26, 5, 26, 191, 10, 26, 3, 27, 3, 27, 3, 27, 5, 27, 196, 10, 27,
+	3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 5, 28, 207,
+	10, 28, 3, 29, 3, 29, 3, 29, 3, 29, 2, 2, 30, 2, 4, 6, 8, 10, 12, 14, 16,
+	18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52,
+	54, 56, 2, 6, 3, 2, 14, 15, 4, 2, 14, 14, 35, 35, 4, 2, 15, 15, 35, 35,
+	3, 2, 11, 12, 2, 207, 2, 58, 3, 2, 2, 2, 4, 62, 3, 2, 2, 2, 6, 66, 3, 2,
+	2, 2, 8, 70, 3, 2, 2, 2, 10, 74, 3, 2, 2, 2, 12, 78, 3, 2, 2, 2, 14, 82,
+	3, 2, 2, 2, 16, 86, 3, 2, 2, 2, 18, 90, 3, 2, 2, 2, 20, 94, 3, 2, 2, 2,
+	22, 98, 3, 2, 2, 2, 24, 102, 3, 2, 2, 2, 26, 108, 3, 2, 2, 2, 28, 112,
+	3, 2, 2, 2, 30, 118, 3, 2, 2, 2, 32, 122, 3, 2, 2, 2, 34, 132, 3, 2, 2,
+	2, 36, 136, 3, 2, 2, 2, 38, 139, 3, 2, 2, 2, 40, 151, 3, 2, 2, 2, 42, 163,
+	3, 2, 2, 2, 44, 168, 3, 2, 2, 2, 46, 177, 3, 2, 2, 2, 48, 184, 3, 2, 2,
+	2, 50, 190, 3, 2, 2, 2, 52, 195, 3, 2, 2, 2, 54, 206, 3, 2, 2, 2, 56, 208,
+	3, 2, 2, 2, 58, 59, 7, 22, 2, 2, 59, 60, 7, 3, 2, 2, 60, 61, 7, 16, 2,
+	2, 61, 3, 3, 2, 2, 2, 62, 63, 7, 23, 2, 2, 63, 64, 7, 3, 2, 2, 64, 65,
+	7, 16, 2, 2, 65, 5, 3, 2, 2, 2, 66, 67, 7, 24, 2, 2, 67, 68, 7, 4, 2, 2,
+	68, 69, 9, 2, 2, 2, 69, 7, 3, 2, 2, 2, 70, 71, 7, 25, 2, 2, 71, 72, 7,
+	4, 2, 2, 72, 73, 9, 2, 2, 2, 73, 9, 3, 2, 2, 2, 74, 75, 7, 26, 2, 2, 75,
+	76, 7, 3, 2, 2, 76, 77, 7, 35, 2, 2, 77, 11, 3, 2, 2, 2, 78, 79, 7, 22,
+	2, 2, 79, 80, 7, 3, 2, 2, 80, 81, 7, 17, 2, 2, 81, 13, 3, 2, 2, 2, 82,
+	83, 7, 23, 2, 2, 83, 84, 7, 3, 2, 2, 84, 85, 7, 17, 2, 2, 85, 15, 3, 2,
+	2, 2, 86, 87, 7, 29, 2, 2, 87, 88, 7, 4, 2, 2, 88, 89, 9, 2, 2, 2, 89,
+	17, 3, 2, 2, 2, 90, 91, 7, 30, 2, 2, 91, 92, 7, 4, 2, 2, 92, 93, 9, 2,
+	2, 2, 93, 19, 3, 2, 2, 2, 94, 95, 7, 31, 2, 2, 95, 96, 7, 3, 2, 2, 96,
+	97, 7, 35, 2, 2, 97, 21, 3, 2, 2, 2, 98, 99, 7, 27, 2, 2, 99, 100, 7, 3,
+	2, 2, 100, 101, 7, 14, 2, 2, 101, 23, 3, 2, 2, 2, 102, 103, 7, 27, 2, 2,
+	103, 104, 7, 3, 2, 2, 104, 105, 7, 14, 2, 2, 105, 106, 7, 5, 2, 2, 106,
+	107, 7, 14, 2, 2, 107, 25, 3, 2, 2, 2, 108, 109, 7, 28, 2, 2, 109, 110,
+	7, 3, 2, 2, 110, 111, 7, 14, 2, 2, 111, 27, 3, 2, 2, 2, 112, 113, 7, 28,
+	2, 2, 113, 114, 7, 3, 2, 2, 114, 115, 7, 14, 2, 2, 115, 116, 7, 5, 2, 2,
+	116, 117, 7, 14, 2, 2, 117, 29, 3, 2, 2, 2, 118, 119, 7, 32, 2, 2, 119,
+	120, 7, 3, 2, 2, 120, 121, 9, 3, 2, 2, 121, 31, 3, 2, 2, 2, 122, 123, 7,
+	33, 2, 2, 123, 124, 7, 3, 2, 2, 124, 129, 9, 4, 2, 2, 125, 126, 7, 6, 2,
+	2, 126, 128, 9, 4, 2, 2, 127, 125, 3, 2, 2, 2, 128, 131, 3, 2, 2, 2, 129,
+	127, 3, 2, 2, 2, 129, 130, 3, 2, 2, 2, 130, 33, 3, 2, 2, 2, 131, 129, 3,
+	2, 2, 2, 132, 133, 7, 34, 2, 2, 133, 134, 7, 3, 2, 2, 134, 135, 9, 3, 2,
+	2, 135, 35, 3, 2, 2, 2, 136, 137, 7, 7, 2, 2, 137, 138, 7, 14, 2, 2, 138,
+	37, 3, 2, 2, 2, 139, 140, 7, 18, 2, 2, 140, 141, 7, 8, 2, 2, 141, 146,
+	5, 54, 28, 2, 142, 143, 7, 9, 2, 2, 143, 145, 5, 54, 28, 2, 144, 142, 3,
+	2, 2, 2, 145, 148, 3, 2, 2, 2, 146, 144, 3, 2, 2, 2, 146, 147, 3, 2, 2,
+	2, 147, 149, 3, 2, 2, 2, 148, 146, 3, 2, 2, 2, 149, 150, 7, 10, 2, 2, 150,
+	39, 3, 2, 2, 2, 151, 152, 7, 19, 2, 2, 152, 153, 7, 8, 2, 2, 153, 158,
+	5, 54, 28, 2, 154, 155, 7, 9, 2, 2, 155, 157, 5, 54, 28, 2, 156, 154, 3,
+	2, 2, 2, 157, 160, 3, 2, 2, 2, 158, 156, 3, 2, 2, 2, 158, 159, 3, 2, 2,
+	2, 159, 161, 3, 2, 2, 2, 160, 158, 3, 2, 2, 2, 161, 162, 7, 10, 2, 2, 162,
+	41, 3, 2, 2, 2, 163, 164, 7, 20, 2, 2, 164, 165, 7, 8, 2, 2, 165, 166,
+	5, 54, 28, 2, 166, 167, 7, 10, 2, 2, 167, 43, 3, 2, 2, 2, 168, 169, 7,
+	21, 2, 2, 169, 170, 7, 3, 2, 2, 170, 171, 9, 5, 2, 2, 171, 45, 3, 2, 2,
+	2, 172, 178, 5, 2, 2, 2, 173, 178, 5, 4, 3, 2, 174, 178, 5, 6, 4, 2, 175,
+	178, 5, 8, 5, 2, 176, 178, 5, 10, 6, 2, 177, 172, 3, 2, 2, 2, 177, 173,
+	3, 2, 2, 2, 177, 174, 3, 2, 2, 2, 177, 175, 3, 2, 2, 2, 177, 176, 3, 2,
+	2, 2, 178, 47, 3, 2, 2, 2, 179, 185, 5, 12, 7, 2, 180, 185, 5, 14, 8, 2,
+	181, 185, 5, 16, 9, 2, 182, 185, 5, 18, 10, 2, 183, 185, 5, 20, 11, 2,
+	184, 179, 3, 2, 2, 2, 184, 180, 3, 2, 2, 2, 184, 181, 3, 2, 2, 2, 184,
+	182, 3, 2, 2, 2, 184, 183, 3, 2, 2, 2, 185, 49, 3, 2, 2, 2, 186, 191, 5,
+	22, 12, 2, 187, 191, 5, 24, 13, 2, 188, 191, 5, 26, 14, 2, 189, 191, 5,
+	28, 15, 2, 190, 186, 3, 2, 2, 2, 190, 187, 3, 2, 2, 2, 190, 188, 3, 2,
+	2, 2, 190, 189, 3, 2, 2, 2, 191, 51, 3, 2, 2, 2, 192, 196, 5, 30, 16, 2,
+	193, 196, 5, 32, 17, 2, 194, 196, 5, 34, 18, 2, 195, 192, 3, 2, 2, 2, 195,
+	193, 3, 2, 2, 2, 195, 194, 3, 2, 2, 2, 196, 53, 3, 2, 2, 2, 197, 207, 5,
+	40, 21, 2, 198, 207, 5, 38, 20, 2, 199, 207, 5, 42, 22, 2, 200, 207, 5,
+	46, 24, 2, 201, 207, 5, 48, 25, 2, 202, 207, 5, 50, 26, 2, 203, 207, 5,
+	52, 27, 2, 204, 207, 5, 36, 19, 2, 205, 207, 5, 44, 23, 2, 206, 197, 3,
+	2, 2, 2, 206, 198, 3, 2, 2, 2, 206, 199, 3, 2, 2, 2, 206, 200, 3, 2, 2,
+	2, 206, 201, 3, 2, 2, 2, 206, 202, 3, 2, 2, 2, 206, 203, 3, 2, 2, 2, 206,
+	204, 3, 2, 2, 2, 206, 205, 3, 2, 2, 2, 207, 55, 3, 2, 2, 2, 208, 209, 5,
+	54, 28, 2, 209, 210, 7, 2, 2, 3, 210, 57, 3, 2, 2, 2, 10, 129, 146, 158,
+	177, 184, 190, 195, 206,
 }
 var literalNames = []string{
-	"", "'='", "'=0x'", "'-'", "'cls='", "'('", "','", "')'", "'true'", "'false'",
+	"", "'='", "'=0x'", "'-'", "'|'", "'cls='", "'('", "','", "')'", "'true'",
+	"'false'",
 }
 var symbolicNames = []string{
-	"", "", "", "", "", "", "", "", "", "", "WHITESPACE", "DIGITS", "HEX_DIGITS",
-	"NET", "ANY", "ALL", "NOT", "BOOL", "SRC", "DST", "DSCP", "TOS", "PROTOCOL",
-	"SRCPORT", "DSTPORT", "STRING",
+	"", "", "", "", "", "", "", "", "", "", "", "WHITESPACE", "DIGITS", "HEX_DIGITS",
+	"NET", "NET6", "ANY", "ALL", "NOT", "BOOL", "SRC", "DST", "DSCP", "TOS",
+	"PROTOCOL", "SRCPORT", "DSTPORT", "TC", "FLOWLABEL", "NEXTHDR", "PROTO",
+	"TCPFLAGS", "ICMPTYPE", "STRING",
 }
 
 var ruleNames = []string{
-	"matchSrc", "matchDst", "matchDSCP", "matchTOS", "matchProtocol", "matchSrcPort",
-	"matchSrcPortRange", "matchDstPort", "matchDstPortRange", "condCls", "condAny",
-	"condAll", "condNot", "condBool", "condIPv4", "condPort", "cond", "trafficClass",
+	"matchSrc", "matchDst", "matchDSCP", "matchTOS", "matchProtocol", "matchSrcIPv6",
+	"matchDstIPv6", "matchTrafficClass", "matchFlowLabel", "matchNextHeader",
+	"matchSrcPort", "matchSrcPortRange", "matchDstPort", "matchDstPortRange",
+	"matchL4Protocol", "matchTCPFlags", "matchICMPType", "condCls", "condAny",
+	"condAll", "condNot", "condBool", "condIPv4", "condIPv6", "condPort", "condL4",
+	"cond", "trafficClass",
 }
 
 type TrafficClassParser struct {
@@ -128,22 +164,30 @@ const (
 	TrafficClassParserT__6       = 7
 	TrafficClassParserT__7       = 8
 	TrafficClassParserT__8       = 9
-	TrafficClassParserWHITESPACE = 10
-	TrafficClassParserDIGITS     = 11
-	TrafficClassParserHEX_DIGITS = 12
-	TrafficClassParserNET        = 13
-	TrafficClassParserANY        = 14
-	TrafficClassParserALL        = 15
-	TrafficClassParserNOT        = 16
-	TrafficClassParserBOOL       = 17
-	TrafficClassParserSRC        = 18
-	TrafficClassParserDST        = 19
-	TrafficClassParserDSCP       = 20
-	TrafficClassParserTOS        = 21
-	TrafficClassParserPROTOCOL   = 22
-	TrafficClassParserSRCPORT    = 23
-	TrafficClassParserDSTPORT    = 24
-	TrafficClassParserSTRING     = 25
+	TrafficClassParserT__9       = 10
+	TrafficClassParserWHITESPACE = 11
+	TrafficClassParserDIGITS     = 12
+	TrafficClassParserHEX_DIGITS = 13
+	TrafficClassParserNET        = 14
+	TrafficClassParserNET6       = 15
+	TrafficClassParserANY        = 16
+	TrafficClassParserALL        = 17
+	TrafficClassParserNOT        = 18
+	TrafficClassParserBOOL       = 19
+	TrafficClassParserSRC        = 20
+	TrafficClassParserDST        = 21
+	TrafficClassParserDSCP       = 22
+	TrafficClassParserTOS        = 23
+	TrafficClassParserPROTOCOL   = 24
+	TrafficClassParserSRCPORT    = 25
+	TrafficClassParserDSTPORT    = 26
+	TrafficClassParserTC         = 27
+	TrafficClassParserFLOWLABEL  = 28
+	TrafficClassParserNEXTHDR    = 29
+	TrafficClassParserPROTO      = 30
+	TrafficClassParserTCPFLAGS   = 31
+	TrafficClassParserICMPTYPE   = 32
+	TrafficClassParserSTRING     = 33
 )
 
 // TrafficClassParser rules.
@@ -153,19 +197,29 @@ const (
 	TrafficClassParserRULE_matchDSCP         = 2
 	TrafficClassParserRULE_matchTOS          = 3
 	TrafficClassParserRULE_matchProtocol     = 4
-	TrafficClassParserRULE_matchSrcPort      = 5
-	TrafficClassParserRULE_matchSrcPortRange = 6
-	TrafficClassParserRULE_matchDstPort      = 7
-	TrafficClassParserRULE_matchDstPortRange = 8
-	TrafficClassParserRULE_condCls           = 9
-	TrafficClassParserRULE_condAny           = 10
-	TrafficClassParserRULE_condAll           = 11
-	TrafficClassParserRULE_condNot           = 12
-	TrafficClassParserRULE_condBool          = 13
-	TrafficClassParserRULE_condIPv4          = 14
-	TrafficClassParserRULE_condPort          = 15
-	TrafficClassParserRULE_cond              = 16
-	TrafficClassParserRULE_trafficClass      = 17
+	TrafficClassParserRULE_matchSrcIPv6      = 5
+	TrafficClassParserRULE_matchDstIPv6      = 6
+	TrafficClassParserRULE_matchTrafficClass = 7
+	TrafficClassParserRULE_matchFlowLabel    = 8
+	TrafficClassParserRULE_matchNextHeader   = 9
+	TrafficClassParserRULE_matchSrcPort      = 10
+	TrafficClassParserRULE_matchSrcPortRange = 11
+	TrafficClassParserRULE_matchDstPort      = 12
+	TrafficClassParserRULE_matchDstPortRange = 13
+	TrafficClassParserRULE_matchL4Protocol   = 14
+	TrafficClassParserRULE_matchTCPFlags     = 15
+	TrafficClassParserRULE_matchICMPType     = 16
+	TrafficClassParserRULE_condCls           = 17
+	TrafficClassParserRULE_condAny           = 18
+	TrafficClassParserRULE_condAll           = 19
+	TrafficClassParserRULE_condNot           = 20
+	TrafficClassParserRULE_condBool          = 21
+	TrafficClassParserRULE_condIPv4          = 22
+	TrafficClassParserRULE_condIPv6          = 23
+	TrafficClassParserRULE_condPort          = 24
+	TrafficClassParserRULE_condL4            = 25
+	TrafficClassParserRULE_cond              = 26
+	TrafficClassParserRULE_trafficClass      = 27
 )
 
 // IMatchSrcContext is an interface to support dynamic dispatch.
@@ -259,15 +313,15 @@ func (p *TrafficClassParser) MatchSrc() (localctx IMatchSrcContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(36)
+		p.SetState(56)
 		p.Match(TrafficClassParserSRC)
 	}
 	{
-		p.SetState(37)
+		p.SetState(57)
 		p.Match(TrafficClassParserT__0)
 	}
 	{
-		p.SetState(38)
+		p.SetState(58)
 		p.Match(TrafficClassParserNET)
 	}
 
@@ -365,15 +419,15 @@ func (p *TrafficClassParser) MatchDst() (localctx IMatchDstContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(40)
+		p.SetState(60)
 		p.Match(TrafficClassParserDST)
 	}
 	{
-		p.SetState(41)
+		p.SetState(61)
 		p.Match(TrafficClassParserT__0)
 	}
 	{
-		p.SetState(42)
+		p.SetState(62)
 		p.Match(TrafficClassParserNET)
 	}
 
@@ -476,15 +530,15 @@ func (p *TrafficClassParser) MatchDSCP() (localctx IMatchDSCPContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(44)
+		p.SetState(64)
 		p.Match(TrafficClassParserDSCP)
 	}
 	{
-		p.SetState(45)
+		p.SetState(65)
 		p.Match(TrafficClassParserT__1)
 	}
 	{
-		p.SetState(46)
+		p.SetState(66)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == TrafficClassParserDIGITS || _la == TrafficClassParserHEX_DIGITS) {
@@ -594,15 +648,15 @@ func (p *TrafficClassParser) MatchTOS() (localctx IMatchTOSContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(48)
+		p.SetState(68)
 		p.Match(TrafficClassParserTOS)
 	}
 	{
-		p.SetState(49)
+		p.SetState(69)
 		p.Match(TrafficClassParserT__1)
 	}
 	{
-		p.SetState(50)
+		p.SetState(70)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == TrafficClassParserDIGITS || _la == TrafficClassParserHEX_DIGITS) {
@@ -707,93 +761,93 @@ func (p *TrafficClassParser) MatchProtocol() (localctx IMatchProtocolContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(52)
+		p.SetState(72)
 		p.Match(TrafficClassParserPROTOCOL)
 	}
 	{
-		p.SetState(53)
+		p.SetState(73)
 		p.Match(TrafficClassParserT__0)
 	}
 	{
-		p.SetState(54)
+		p.SetState(74)
 		p.Match(TrafficClassParserSTRING)
 	}
 
 	return localctx
 }
 
-// IMatchSrcPortContext is an interface to support dynamic dispatch.
-type IMatchSrcPortContext interface {
+// IMatchSrcIPv6Context is an interface to support dynamic dispatch.
+type IMatchSrcIPv6Context interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsMatchSrcPortContext differentiates from other interfaces.
-	IsMatchSrcPortContext()
+	// IsMatchSrcIPv6Context differentiates from other interfaces.
+	IsMatchSrcIPv6Context()
 }
 
-type MatchSrcPortContext struct {
+type MatchSrcIPv6Context struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMatchSrcPortContext() *MatchSrcPortContext {
-	var p = new(MatchSrcPortContext)
+func NewEmptyMatchSrcIPv6Context() *MatchSrcIPv6Context {
+	var p = new(MatchSrcIPv6Context)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = TrafficClassParserRULE_matchSrcPort
+	p.RuleIndex = TrafficClassParserRULE_matchSrcIPv6
 	return p
 }
 
-func (*MatchSrcPortContext) IsMatchSrcPortContext() {}
+func (*MatchSrcIPv6Context) IsMatchSrcIPv6Context() {}
 
-func NewMatchSrcPortContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchSrcPortContext {
-	var p = new(MatchSrcPortContext)
+func NewMatchSrcIPv6Context(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchSrcIPv6Context {
+	var p = new(MatchSrcIPv6Context)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = TrafficClassParserRULE_matchSrcPort
+	p.RuleIndex = TrafficClassParserRULE_matchSrcIPv6
 
 	return p
 }
 
-func (s *MatchSrcPortContext) GetParser() antlr.Parser { return s.parser }
+func (s *MatchSrcIPv6Context) GetParser() antlr.Parser { return s.parser }
 
-func (s *MatchSrcPortContext) SRCPORT() antlr.TerminalNode {
-	return s.GetToken(TrafficClassParserSRCPORT, 0)
+func (s *MatchSrcIPv6Context) SRC() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserSRC, 0)
 }
 
-func (s *MatchSrcPortContext) DIGITS() antlr.TerminalNode {
-	return s.GetToken(TrafficClassParserDIGITS, 0)
+func (s *MatchSrcIPv6Context) NET6() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserNET6, 0)
 }
 
-func (s *MatchSrcPortContext) GetRuleContext() antlr.RuleContext {
+func (s *MatchSrcIPv6Context) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MatchSrcPortContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MatchSrcIPv6Context) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MatchSrcPortContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MatchSrcIPv6Context) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TrafficClassListener); ok {
-		listenerT.EnterMatchSrcPort(s)
+		listenerT.EnterMatchSrcIPv6(s)
 	}
 }
 
-func (s *MatchSrcPortContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MatchSrcIPv6Context) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TrafficClassListener); ok {
-		listenerT.ExitMatchSrcPort(s)
+		listenerT.ExitMatchSrcIPv6(s)
 	}
 }
 
-func (p *TrafficClassParser) MatchSrcPort() (localctx IMatchSrcPortContext) {
+func (p *TrafficClassParser) MatchSrcIPv6() (localctx IMatchSrcIPv6Context) {
 	this := p
 	_ = this
 
-	localctx = NewMatchSrcPortContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 10, TrafficClassParserRULE_matchSrcPort)
+	localctx = NewMatchSrcIPv6Context(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 10, TrafficClassParserRULE_matchSrcIPv6)
 
 	defer func() {
 		p.ExitRule()
@@ -813,97 +867,93 @@ func (p *TrafficClassParser) MatchSrcPort() (localctx IMatchSrcPortContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(56)
-		p.Match(TrafficClassParserSRCPORT)
+		p.SetState(76)
+		p.Match(TrafficClassParserSRC)
 	}
 	{
-		p.SetState(57)
+		p.SetState(77)
 		p.Match(TrafficClassParserT__0)
 	}
 	{
-		p.SetState(58)
-		p.Match(TrafficClassParserDIGITS)
+		p.SetState(78)
+		p.Match(TrafficClassParserNET6)
 	}
 
 	return localctx
 }
 
-// IMatchSrcPortRangeContext is an interface to support dynamic dispatch.
-type IMatchSrcPortRangeContext interface {
+// IMatchDstIPv6Context is an interface to support dynamic dispatch.
+type IMatchDstIPv6Context interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsMatchSrcPortRangeContext differentiates from other interfaces.
-	IsMatchSrcPortRangeContext()
+	// IsMatchDstIPv6Context differentiates from other interfaces.
+	IsMatchDstIPv6Context()
 }
 
-type MatchSrcPortRangeContext struct {
+type MatchDstIPv6Context struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMatchSrcPortRangeContext() *MatchSrcPortRangeContext {
-	var p = new(MatchSrcPortRangeContext)
+func NewEmptyMatchDstIPv6Context() *MatchDstIPv6Context {
+	var p = new(MatchDstIPv6Context)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = TrafficClassParserRULE_matchSrcPortRange
+	p.RuleIndex = TrafficClassParserRULE_matchDstIPv6
 	return p
 }
 
-func (*MatchSrcPortRangeContext) IsMatchSrcPortRangeContext() {}
+func (*MatchDstIPv6Context) IsMatchDstIPv6Context() {}
 
-func NewMatchSrcPortRangeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchSrcPortRangeContext {
-	var p = new(MatchSrcPortRangeContext)
+func NewMatchDstIPv6Context(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchDstIPv6Context {
+	var p = new(MatchDstIPv6Context)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = TrafficClassParserRULE_matchSrcPortRange
+	p.RuleIndex = TrafficClassParserRULE_matchDstIPv6
 
 	return p
 }
 
-func (s *MatchSrcPortRangeContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *MatchSrcPortRangeContext) SRCPORT() antlr.TerminalNode {
-	return s.GetToken(TrafficClassParserSRCPORT, 0)
-}
+func (s *MatchDstIPv6Context) GetParser() antlr.Parser { return s.parser }
 
-func (s *MatchSrcPortRangeContext) AllDIGITS() []antlr.TerminalNode {
-	return s.GetTokens(TrafficClassParserDIGITS)
+func (s *MatchDstIPv6Context) DST() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserDST, 0)
 }
 
-func (s *MatchSrcPortRangeContext) DIGITS(i int) antlr.TerminalNode {
-	return s.GetToken(TrafficClassParserDIGITS, i)
+func (s *MatchDstIPv6Context) NET6() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserNET6, 0)
 }
 
-func (s *MatchSrcPortRangeContext) GetRuleContext() antlr.RuleContext {
+func (s *MatchDstIPv6Context) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MatchSrcPortRangeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MatchDstIPv6Context) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MatchSrcPortRangeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MatchDstIPv6Context) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TrafficClassListener); ok {
-		listenerT.EnterMatchSrcPortRange(s)
+		listenerT.EnterMatchDstIPv6(s)
 	}
 }
 
-func (s *MatchSrcPortRangeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MatchDstIPv6Context) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TrafficClassListener); ok {
-		listenerT.ExitMatchSrcPortRange(s)
+		listenerT.ExitMatchDstIPv6(s)
 	}
 }
 
-func (p *TrafficClassParser) MatchSrcPortRange() (localctx IMatchSrcPortRangeContext) {
+func (p *TrafficClassParser) MatchDstIPv6() (localctx IMatchDstIPv6Context) {
 	this := p
 	_ = this
 
-	localctx = NewMatchSrcPortRangeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, TrafficClassParserRULE_matchSrcPortRange)
+	localctx = NewMatchDstIPv6Context(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 12, TrafficClassParserRULE_matchDstIPv6)
 
 	defer func() {
 		p.ExitRule()
@@ -923,101 +973,98 @@ func (p *TrafficClassParser) MatchSrcPortRange() (localctx IMatchSrcPortRangeCon
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(60)
-		p.Match(TrafficClassParserSRCPORT)
+		p.SetState(80)
+		p.Match(TrafficClassParserDST)
 	}
 	{
-		p.SetState(61)
+		p.SetState(81)
 		p.Match(TrafficClassParserT__0)
 	}
 	{
-		p.SetState(62)
-		p.Match(TrafficClassParserDIGITS)
-	}
-	{
-		p.SetState(63)
-		p.Match(TrafficClassParserT__2)
-	}
-	{
-		p.SetState(64)
-		p.Match(TrafficClassParserDIGITS)
+		p.SetState(82)
+		p.Match(TrafficClassParserNET6)
 	}
 
 	return localctx
 }
 
-// IMatchDstPortContext is an interface to support dynamic dispatch.
-type IMatchDstPortContext interface {
+// IMatchTrafficClassContext is an interface to support dynamic dispatch.
+type IMatchTrafficClassContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsMatchDstPortContext differentiates from other interfaces.
-	IsMatchDstPortContext()
+	// IsMatchTrafficClassContext differentiates from other interfaces.
+	IsMatchTrafficClassContext()
 }
 
-type MatchDstPortContext struct {
+type MatchTrafficClassContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMatchDstPortContext() *MatchDstPortContext {
-	var p = new(MatchDstPortContext)
+func NewEmptyMatchTrafficClassContext() *MatchTrafficClassContext {
+	var p = new(MatchTrafficClassContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = TrafficClassParserRULE_matchDstPort
+	p.RuleIndex = TrafficClassParserRULE_matchTrafficClass
 	return p
 }
 
-func (*MatchDstPortContext) IsMatchDstPortContext() {}
+func (*MatchTrafficClassContext) IsMatchTrafficClassContext() {}
 
-func NewMatchDstPortContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchDstPortContext {
-	var p = new(MatchDstPortContext)
+func NewMatchTrafficClassContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchTrafficClassContext {
+	var p = new(MatchTrafficClassContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = TrafficClassParserRULE_matchDstPort
+	p.RuleIndex = TrafficClassParserRULE_matchTrafficClass
 
 	return p
 }
 
-func (s *MatchDstPortContext) GetParser() antlr.Parser { return s.parser }
+func (s *MatchTrafficClassContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MatchDstPortContext) DSTPORT() antlr.TerminalNode {
-	return s.GetToken(TrafficClassParserDSTPORT, 0)
+func (s *MatchTrafficClassContext) TC() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserTC, 0)
 }
 
-func (s *MatchDstPortContext) DIGITS() antlr.TerminalNode {
+func (s *MatchTrafficClassContext) HEX_DIGITS() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserHEX_DIGITS, 0)
+}
+
+func (s *MatchTrafficClassContext) DIGITS() antlr.TerminalNode {
 	return s.GetToken(TrafficClassParserDIGITS, 0)
 }
 
-func (s *MatchDstPortContext) GetRuleContext() antlr.RuleContext {
+func (s *MatchTrafficClassContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MatchDstPortContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MatchTrafficClassContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MatchDstPortContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MatchTrafficClassContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TrafficClassListener); ok {
-		listenerT.EnterMatchDstPort(s)
+		listenerT.EnterMatchTrafficClass(s)
 	}
 }
 
-func (s *MatchDstPortContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MatchTrafficClassContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TrafficClassListener); ok {
-		listenerT.ExitMatchDstPort(s)
+		listenerT.ExitMatchTrafficClass(s)
 	}
 }
 
-func (p *TrafficClassParser) MatchDstPort() (localctx IMatchDstPortContext) {
+func (p *TrafficClassParser) MatchTrafficClass() (localctx IMatchTrafficClassContext) {
 	this := p
 	_ = this
 
-	localctx = NewMatchDstPortContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, TrafficClassParserRULE_matchDstPort)
+	localctx = NewMatchTrafficClassContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 14, TrafficClassParserRULE_matchTrafficClass)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -1037,97 +1084,105 @@ func (p *TrafficClassParser) MatchDstPort() (localctx IMatchDstPortContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(66)
-		p.Match(TrafficClassParserDSTPORT)
+		p.SetState(84)
+		p.Match(TrafficClassParserTC)
 	}
 	{
-		p.SetState(67)
-		p.Match(TrafficClassParserT__0)
+		p.SetState(85)
+		p.Match(TrafficClassParserT__1)
 	}
 	{
-		p.SetState(68)
-		p.Match(TrafficClassParserDIGITS)
+		p.SetState(86)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == TrafficClassParserDIGITS || _la == TrafficClassParserHEX_DIGITS) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
 	}
 
 	return localctx
 }
 
-// IMatchDstPortRangeContext is an interface to support dynamic dispatch.
-type IMatchDstPortRangeContext interface {
+// IMatchFlowLabelContext is an interface to support dynamic dispatch.
+type IMatchFlowLabelContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsMatchDstPortRangeContext differentiates from other interfaces.
-	IsMatchDstPortRangeContext()
+	// IsMatchFlowLabelContext differentiates from other interfaces.
+	IsMatchFlowLabelContext()
 }
 
-type MatchDstPortRangeContext struct {
+type MatchFlowLabelContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMatchDstPortRangeContext() *MatchDstPortRangeContext {
-	var p = new(MatchDstPortRangeContext)
+func NewEmptyMatchFlowLabelContext() *MatchFlowLabelContext {
+	var p = new(MatchFlowLabelContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = TrafficClassParserRULE_matchDstPortRange
+	p.RuleIndex = TrafficClassParserRULE_matchFlowLabel
 	return p
 }
 
-func (*MatchDstPortRangeContext) IsMatchDstPortRangeContext() {}
+func (*MatchFlowLabelContext) IsMatchFlowLabelContext() {}
 
-func NewMatchDstPortRangeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchDstPortRangeContext {
-	var p = new(MatchDstPortRangeContext)
+func NewMatchFlowLabelContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchFlowLabelContext {
+	var p = new(MatchFlowLabelContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = TrafficClassParserRULE_matchDstPortRange
+	p.RuleIndex = TrafficClassParserRULE_matchFlowLabel
 
 	return p
 }
 
-func (s *MatchDstPortRangeContext) GetParser() antlr.Parser { return s.parser }
+func (s *MatchFlowLabelContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MatchDstPortRangeContext) DSTPORT() antlr.TerminalNode {
-	return s.GetToken(TrafficClassParserDSTPORT, 0)
+func (s *MatchFlowLabelContext) FLOWLABEL() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserFLOWLABEL, 0)
 }
 
-func (s *MatchDstPortRangeContext) AllDIGITS() []antlr.TerminalNode {
-	return s.GetTokens(TrafficClassParserDIGITS)
+func (s *MatchFlowLabelContext) HEX_DIGITS() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserHEX_DIGITS, 0)
 }
 
-func (s *MatchDstPortRangeContext) DIGITS(i int) antlr.TerminalNode {
-	return s.GetToken(TrafficClassParserDIGITS, i)
+func (s *MatchFlowLabelContext) DIGITS() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserDIGITS, 0)
 }
 
-func (s *MatchDstPortRangeContext) GetRuleContext() antlr.RuleContext {
+func (s *MatchFlowLabelContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MatchDstPortRangeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MatchFlowLabelContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MatchDstPortRangeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MatchFlowLabelContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TrafficClassListener); ok {
-		listenerT.EnterMatchDstPortRange(s)
+		listenerT.EnterMatchFlowLabel(s)
 	}
 }
 
-func (s *MatchDstPortRangeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MatchFlowLabelContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TrafficClassListener); ok {
-		listenerT.ExitMatchDstPortRange(s)
+		listenerT.ExitMatchFlowLabel(s)
 	}
 }
 
-func (p *TrafficClassParser) MatchDstPortRange() (localctx IMatchDstPortRangeContext) {
+func (p *TrafficClassParser) MatchFlowLabel() (localctx IMatchFlowLabelContext) {
 	this := p
 	_ = this
 
-	localctx = NewMatchDstPortRangeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, TrafficClassParserRULE_matchDstPortRange)
+	localctx = NewMatchFlowLabelContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 16, TrafficClassParserRULE_matchFlowLabel)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -1147,97 +1202,100 @@ func (p *TrafficClassParser) MatchDstPortRange() (localctx IMatchDstPortRangeCon
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(70)
-		p.Match(TrafficClassParserDSTPORT)
-	}
-	{
-		p.SetState(71)
-		p.Match(TrafficClassParserT__0)
-	}
-	{
-		p.SetState(72)
-		p.Match(TrafficClassParserDIGITS)
+		p.SetState(88)
+		p.Match(TrafficClassParserFLOWLABEL)
 	}
 	{
-		p.SetState(73)
-		p.Match(TrafficClassParserT__2)
+		p.SetState(89)
+		p.Match(TrafficClassParserT__1)
 	}
 	{
-		p.SetState(74)
-		p.Match(TrafficClassParserDIGITS)
+		p.SetState(90)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == TrafficClassParserDIGITS || _la == TrafficClassParserHEX_DIGITS) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
 	}
 
 	return localctx
 }
 
-// ICondClsContext is an interface to support dynamic dispatch.
-type ICondClsContext interface {
+// IMatchNextHeaderContext is an interface to support dynamic dispatch.
+type IMatchNextHeaderContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCondClsContext differentiates from other interfaces.
-	IsCondClsContext()
+	// IsMatchNextHeaderContext differentiates from other interfaces.
+	IsMatchNextHeaderContext()
 }
 
-type CondClsContext struct {
+type MatchNextHeaderContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCondClsContext() *CondClsContext {
-	var p = new(CondClsContext)
+func NewEmptyMatchNextHeaderContext() *MatchNextHeaderContext {
+	var p = new(MatchNextHeaderContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = TrafficClassParserRULE_condCls
+	p.RuleIndex = TrafficClassParserRULE_matchNextHeader
 	return p
 }
 
-func (*CondClsContext) IsCondClsContext() {}
+func (*MatchNextHeaderContext) IsMatchNextHeaderContext() {}
 
-func NewCondClsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CondClsContext {
-	var p = new(CondClsContext)
+func NewMatchNextHeaderContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchNextHeaderContext {
+	var p = new(MatchNextHeaderContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = TrafficClassParserRULE_condCls
+	p.RuleIndex = TrafficClassParserRULE_matchNextHeader
 
 	return p
 }
 
-func (s *CondClsContext) GetParser() antlr.Parser { return s.parser }
+func (s *MatchNextHeaderContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CondClsContext) DIGITS() antlr.TerminalNode {
-	return s.GetToken(TrafficClassParserDIGITS, 0)
+func (s *MatchNextHeaderContext) NEXTHDR() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserNEXTHDR, 0)
 }
 
-func (s *CondClsContext) GetRuleContext() antlr.RuleContext {
+func (s *MatchNextHeaderContext) STRING() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserSTRING, 0)
+}
+
+func (s *MatchNextHeaderContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CondClsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MatchNextHeaderContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CondClsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MatchNextHeaderContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TrafficClassListener); ok {
-		listenerT.EnterCondCls(s)
+		listenerT.EnterMatchNextHeader(s)
 	}
 }
 
-func (s *CondClsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MatchNextHeaderContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TrafficClassListener); ok {
-		listenerT.ExitCondCls(s)
+		listenerT.ExitMatchNextHeader(s)
 	}
 }
 
-func (p *TrafficClassParser) CondCls() (localctx ICondClsContext) {
+func (p *TrafficClassParser) MatchNextHeader() (localctx IMatchNextHeaderContext) {
 	this := p
 	_ = this
 
-	localctx = NewCondClsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, TrafficClassParserRULE_condCls)
+	localctx = NewMatchNextHeaderContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, TrafficClassParserRULE_matchNextHeader)
 
 	defer func() {
 		p.ExitRule()
@@ -1257,62 +1315,999 @@ func (p *TrafficClassParser) CondCls() (localctx ICondClsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(76)
-		p.Match(TrafficClassParserT__3)
+		p.SetState(92)
+		p.Match(TrafficClassParserNEXTHDR)
 	}
 	{
-		p.SetState(77)
-		p.Match(TrafficClassParserDIGITS)
+		p.SetState(93)
+		p.Match(TrafficClassParserT__0)
+	}
+	{
+		p.SetState(94)
+		p.Match(TrafficClassParserSTRING)
 	}
 
 	return localctx
 }
 
-// ICondAnyContext is an interface to support dynamic dispatch.
-type ICondAnyContext interface {
+// IMatchSrcPortContext is an interface to support dynamic dispatch.
+type IMatchSrcPortContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCondAnyContext differentiates from other interfaces.
-	IsCondAnyContext()
+	// IsMatchSrcPortContext differentiates from other interfaces.
+	IsMatchSrcPortContext()
 }
 
-type CondAnyContext struct {
+type MatchSrcPortContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCondAnyContext() *CondAnyContext {
-	var p = new(CondAnyContext)
+func NewEmptyMatchSrcPortContext() *MatchSrcPortContext {
+	var p = new(MatchSrcPortContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = TrafficClassParserRULE_condAny
+	p.RuleIndex = TrafficClassParserRULE_matchSrcPort
 	return p
 }
 
-func (*CondAnyContext) IsCondAnyContext() {}
+func (*MatchSrcPortContext) IsMatchSrcPortContext() {}
 
-func NewCondAnyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CondAnyContext {
-	var p = new(CondAnyContext)
+func NewMatchSrcPortContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchSrcPortContext {
+	var p = new(MatchSrcPortContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = TrafficClassParserRULE_condAny
+	p.RuleIndex = TrafficClassParserRULE_matchSrcPort
 
 	return p
 }
 
-func (s *CondAnyContext) GetParser() antlr.Parser { return s.parser }
+func (s *MatchSrcPortContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CondAnyContext) ANY() antlr.TerminalNode {
-	return s.GetToken(TrafficClassParserANY, 0)
+func (s *MatchSrcPortContext) SRCPORT() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserSRCPORT, 0)
 }
 
-func (s *CondAnyContext) AllCond() []ICondContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ICondContext)(nil)).Elem())
-	var tst = make([]ICondContext, len(ts))
+func (s *MatchSrcPortContext) DIGITS() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserDIGITS, 0)
+}
+
+func (s *MatchSrcPortContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MatchSrcPortContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MatchSrcPortContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.EnterMatchSrcPort(s)
+	}
+}
+
+func (s *MatchSrcPortContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.ExitMatchSrcPort(s)
+	}
+}
+
+func (p *TrafficClassParser) MatchSrcPort() (localctx IMatchSrcPortContext) {
+	this := p
+	_ = this
+
+	localctx = NewMatchSrcPortContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, TrafficClassParserRULE_matchSrcPort)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(96)
+		p.Match(TrafficClassParserSRCPORT)
+	}
+	{
+		p.SetState(97)
+		p.Match(TrafficClassParserT__0)
+	}
+	{
+		p.SetState(98)
+		p.Match(TrafficClassParserDIGITS)
+	}
+
+	return localctx
+}
+
+// IMatchSrcPortRangeContext is an interface to support dynamic dispatch.
+type IMatchSrcPortRangeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsMatchSrcPortRangeContext differentiates from other interfaces.
+	IsMatchSrcPortRangeContext()
+}
+
+type MatchSrcPortRangeContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMatchSrcPortRangeContext() *MatchSrcPortRangeContext {
+	var p = new(MatchSrcPortRangeContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TrafficClassParserRULE_matchSrcPortRange
+	return p
+}
+
+func (*MatchSrcPortRangeContext) IsMatchSrcPortRangeContext() {}
+
+func NewMatchSrcPortRangeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchSrcPortRangeContext {
+	var p = new(MatchSrcPortRangeContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TrafficClassParserRULE_matchSrcPortRange
+
+	return p
+}
+
+func (s *MatchSrcPortRangeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MatchSrcPortRangeContext) SRCPORT() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserSRCPORT, 0)
+}
+
+func (s *MatchSrcPortRangeContext) AllDIGITS() []antlr.TerminalNode {
+	return s.GetTokens(TrafficClassParserDIGITS)
+}
+
+func (s *MatchSrcPortRangeContext) DIGITS(i int) antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserDIGITS, i)
+}
+
+func (s *MatchSrcPortRangeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MatchSrcPortRangeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MatchSrcPortRangeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.EnterMatchSrcPortRange(s)
+	}
+}
+
+func (s *MatchSrcPortRangeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.ExitMatchSrcPortRange(s)
+	}
+}
+
+func (p *TrafficClassParser) MatchSrcPortRange() (localctx IMatchSrcPortRangeContext) {
+	this := p
+	_ = this
+
+	localctx = NewMatchSrcPortRangeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, TrafficClassParserRULE_matchSrcPortRange)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(100)
+		p.Match(TrafficClassParserSRCPORT)
+	}
+	{
+		p.SetState(101)
+		p.Match(TrafficClassParserT__0)
+	}
+	{
+		p.SetState(102)
+		p.Match(TrafficClassParserDIGITS)
+	}
+	{
+		p.SetState(103)
+		p.Match(TrafficClassParserT__2)
+	}
+	{
+		p.SetState(104)
+		p.Match(TrafficClassParserDIGITS)
+	}
+
+	return localctx
+}
+
+// IMatchDstPortContext is an interface to support dynamic dispatch.
+type IMatchDstPortContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsMatchDstPortContext differentiates from other interfaces.
+	IsMatchDstPortContext()
+}
+
+type MatchDstPortContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMatchDstPortContext() *MatchDstPortContext {
+	var p = new(MatchDstPortContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TrafficClassParserRULE_matchDstPort
+	return p
+}
+
+func (*MatchDstPortContext) IsMatchDstPortContext() {}
+
+func NewMatchDstPortContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchDstPortContext {
+	var p = new(MatchDstPortContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TrafficClassParserRULE_matchDstPort
+
+	return p
+}
+
+func (s *MatchDstPortContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MatchDstPortContext) DSTPORT() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserDSTPORT, 0)
+}
+
+func (s *MatchDstPortContext) DIGITS() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserDIGITS, 0)
+}
+
+func (s *MatchDstPortContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MatchDstPortContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MatchDstPortContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.EnterMatchDstPort(s)
+	}
+}
+
+func (s *MatchDstPortContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.ExitMatchDstPort(s)
+	}
+}
+
+func (p *TrafficClassParser) MatchDstPort() (localctx IMatchDstPortContext) {
+	this := p
+	_ = this
+
+	localctx = NewMatchDstPortContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, TrafficClassParserRULE_matchDstPort)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(106)
+		p.Match(TrafficClassParserDSTPORT)
+	}
+	{
+		p.SetState(107)
+		p.Match(TrafficClassParserT__0)
+	}
+	{
+		p.SetState(108)
+		p.Match(TrafficClassParserDIGITS)
+	}
+
+	return localctx
+}
+
+// IMatchDstPortRangeContext is an interface to support dynamic dispatch.
+type IMatchDstPortRangeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsMatchDstPortRangeContext differentiates from other interfaces.
+	IsMatchDstPortRangeContext()
+}
+
+type MatchDstPortRangeContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMatchDstPortRangeContext() *MatchDstPortRangeContext {
+	var p = new(MatchDstPortRangeContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TrafficClassParserRULE_matchDstPortRange
+	return p
+}
+
+func (*MatchDstPortRangeContext) IsMatchDstPortRangeContext() {}
+
+func NewMatchDstPortRangeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchDstPortRangeContext {
+	var p = new(MatchDstPortRangeContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TrafficClassParserRULE_matchDstPortRange
+
+	return p
+}
+
+func (s *MatchDstPortRangeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MatchDstPortRangeContext) DSTPORT() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserDSTPORT, 0)
+}
+
+func (s *MatchDstPortRangeContext) AllDIGITS() []antlr.TerminalNode {
+	return s.GetTokens(TrafficClassParserDIGITS)
+}
+
+func (s *MatchDstPortRangeContext) DIGITS(i int) antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserDIGITS, i)
+}
+
+func (s *MatchDstPortRangeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MatchDstPortRangeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MatchDstPortRangeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.EnterMatchDstPortRange(s)
+	}
+}
+
+func (s *MatchDstPortRangeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.ExitMatchDstPortRange(s)
+	}
+}
+
+func (p *TrafficClassParser) MatchDstPortRange() (localctx IMatchDstPortRangeContext) {
+	this := p
+	_ = this
+
+	localctx = NewMatchDstPortRangeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, TrafficClassParserRULE_matchDstPortRange)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(110)
+		p.Match(TrafficClassParserDSTPORT)
+	}
+	{
+		p.SetState(111)
+		p.Match(TrafficClassParserT__0)
+	}
+	{
+		p.SetState(112)
+		p.Match(TrafficClassParserDIGITS)
+	}
+	{
+		p.SetState(113)
+		p.Match(TrafficClassParserT__2)
+	}
+	{
+		p.SetState(114)
+		p.Match(TrafficClassParserDIGITS)
+	}
+
+	return localctx
+}
+
+// IMatchL4ProtocolContext is an interface to support dynamic dispatch.
+type IMatchL4ProtocolContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsMatchL4ProtocolContext differentiates from other interfaces.
+	IsMatchL4ProtocolContext()
+}
+
+type MatchL4ProtocolContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMatchL4ProtocolContext() *MatchL4ProtocolContext {
+	var p = new(MatchL4ProtocolContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TrafficClassParserRULE_matchL4Protocol
+	return p
+}
+
+func (*MatchL4ProtocolContext) IsMatchL4ProtocolContext() {}
+
+func NewMatchL4ProtocolContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchL4ProtocolContext {
+	var p = new(MatchL4ProtocolContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TrafficClassParserRULE_matchL4Protocol
+
+	return p
+}
+
+func (s *MatchL4ProtocolContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MatchL4ProtocolContext) PROTO() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserPROTO, 0)
+}
+
+func (s *MatchL4ProtocolContext) STRING() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserSTRING, 0)
+}
+
+func (s *MatchL4ProtocolContext) DIGITS() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserDIGITS, 0)
+}
+
+func (s *MatchL4ProtocolContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MatchL4ProtocolContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MatchL4ProtocolContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.EnterMatchL4Protocol(s)
+	}
+}
+
+func (s *MatchL4ProtocolContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.ExitMatchL4Protocol(s)
+	}
+}
+
+func (p *TrafficClassParser) MatchL4Protocol() (localctx IMatchL4ProtocolContext) {
+	this := p
+	_ = this
+
+	localctx = NewMatchL4ProtocolContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, TrafficClassParserRULE_matchL4Protocol)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(116)
+		p.Match(TrafficClassParserPROTO)
+	}
+	{
+		p.SetState(117)
+		p.Match(TrafficClassParserT__0)
+	}
+	{
+		p.SetState(118)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == TrafficClassParserDIGITS || _la == TrafficClassParserSTRING) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+	return localctx
+}
+
+// IMatchTCPFlagsContext is an interface to support dynamic dispatch.
+type IMatchTCPFlagsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsMatchTCPFlagsContext differentiates from other interfaces.
+	IsMatchTCPFlagsContext()
+}
+
+type MatchTCPFlagsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMatchTCPFlagsContext() *MatchTCPFlagsContext {
+	var p = new(MatchTCPFlagsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TrafficClassParserRULE_matchTCPFlags
+	return p
+}
+
+func (*MatchTCPFlagsContext) IsMatchTCPFlagsContext() {}
+
+func NewMatchTCPFlagsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchTCPFlagsContext {
+	var p = new(MatchTCPFlagsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TrafficClassParserRULE_matchTCPFlags
+
+	return p
+}
+
+func (s *MatchTCPFlagsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MatchTCPFlagsContext) TCPFLAGS() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserTCPFLAGS, 0)
+}
+
+func (s *MatchTCPFlagsContext) AllSTRING() []antlr.TerminalNode {
+	return s.GetTokens(TrafficClassParserSTRING)
+}
+
+func (s *MatchTCPFlagsContext) STRING(i int) antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserSTRING, i)
+}
+
+func (s *MatchTCPFlagsContext) AllHEX_DIGITS() []antlr.TerminalNode {
+	return s.GetTokens(TrafficClassParserHEX_DIGITS)
+}
+
+func (s *MatchTCPFlagsContext) HEX_DIGITS(i int) antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserHEX_DIGITS, i)
+}
+
+func (s *MatchTCPFlagsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MatchTCPFlagsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MatchTCPFlagsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.EnterMatchTCPFlags(s)
+	}
+}
+
+func (s *MatchTCPFlagsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.ExitMatchTCPFlags(s)
+	}
+}
+
+func (p *TrafficClassParser) MatchTCPFlags() (localctx IMatchTCPFlagsContext) {
+	this := p
+	_ = this
+
+	localctx = NewMatchTCPFlagsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, TrafficClassParserRULE_matchTCPFlags)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(120)
+		p.Match(TrafficClassParserTCPFLAGS)
+	}
+	{
+		p.SetState(121)
+		p.Match(TrafficClassParserT__0)
+	}
+	{
+		p.SetState(122)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == TrafficClassParserHEX_DIGITS || _la == TrafficClassParserSTRING) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	p.SetState(127)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == TrafficClassParserT__3 {
+		{
+			p.SetState(123)
+			p.Match(TrafficClassParserT__3)
+		}
+		{
+			p.SetState(124)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == TrafficClassParserHEX_DIGITS || _la == TrafficClassParserSTRING) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+		p.SetState(129)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IMatchICMPTypeContext is an interface to support dynamic dispatch.
+type IMatchICMPTypeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsMatchICMPTypeContext differentiates from other interfaces.
+	IsMatchICMPTypeContext()
+}
+
+type MatchICMPTypeContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMatchICMPTypeContext() *MatchICMPTypeContext {
+	var p = new(MatchICMPTypeContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TrafficClassParserRULE_matchICMPType
+	return p
+}
+
+func (*MatchICMPTypeContext) IsMatchICMPTypeContext() {}
+
+func NewMatchICMPTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchICMPTypeContext {
+	var p = new(MatchICMPTypeContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TrafficClassParserRULE_matchICMPType
+
+	return p
+}
+
+func (s *MatchICMPTypeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MatchICMPTypeContext) ICMPTYPE() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserICMPTYPE, 0)
+}
+
+func (s *MatchICMPTypeContext) STRING() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserSTRING, 0)
+}
+
+func (s *MatchICMPTypeContext) DIGITS() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserDIGITS, 0)
+}
+
+func (s *MatchICMPTypeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MatchICMPTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MatchICMPTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.EnterMatchICMPType(s)
+	}
+}
+
+func (s *MatchICMPTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.ExitMatchICMPType(s)
+	}
+}
+
+func (p *TrafficClassParser) MatchICMPType() (localctx IMatchICMPTypeContext) {
+	this := p
+	_ = this
+
+	localctx = NewMatchICMPTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, TrafficClassParserRULE_matchICMPType)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(130)
+		p.Match(TrafficClassParserICMPTYPE)
+	}
+	{
+		p.SetState(131)
+		p.Match(TrafficClassParserT__0)
+	}
+	{
+		p.SetState(132)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == TrafficClassParserDIGITS || _la == TrafficClassParserSTRING) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+	return localctx
+}
+
+// ICondClsContext is an interface to support dynamic dispatch.
+type ICondClsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCondClsContext differentiates from other interfaces.
+	IsCondClsContext()
+}
+
+type CondClsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCondClsContext() *CondClsContext {
+	var p = new(CondClsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TrafficClassParserRULE_condCls
+	return p
+}
+
+func (*CondClsContext) IsCondClsContext() {}
+
+func NewCondClsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CondClsContext {
+	var p = new(CondClsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TrafficClassParserRULE_condCls
+
+	return p
+}
+
+func (s *CondClsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CondClsContext) DIGITS() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserDIGITS, 0)
+}
+
+func (s *CondClsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CondClsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CondClsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.EnterCondCls(s)
+	}
+}
+
+func (s *CondClsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.ExitCondCls(s)
+	}
+}
+
+func (p *TrafficClassParser) CondCls() (localctx ICondClsContext) {
+	this := p
+	_ = this
+
+	localctx = NewCondClsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, TrafficClassParserRULE_condCls)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(134)
+		p.Match(TrafficClassParserT__4)
+	}
+	{
+		p.SetState(135)
+		p.Match(TrafficClassParserDIGITS)
+	}
+
+	return localctx
+}
+
+// ICondAnyContext is an interface to support dynamic dispatch.
+type ICondAnyContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCondAnyContext differentiates from other interfaces.
+	IsCondAnyContext()
+}
+
+type CondAnyContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCondAnyContext() *CondAnyContext {
+	var p = new(CondAnyContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TrafficClassParserRULE_condAny
+	return p
+}
+
+func (*CondAnyContext) IsCondAnyContext() {}
+
+func NewCondAnyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CondAnyContext {
+	var p = new(CondAnyContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TrafficClassParserRULE_condAny
+
+	return p
+}
+
+func (s *CondAnyContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CondAnyContext) ANY() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserANY, 0)
+}
+
+func (s *CondAnyContext) AllCond() []ICondContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ICondContext)(nil)).Elem())
+	var tst = make([]ICondContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
@@ -1358,7 +2353,7 @@ func (p *TrafficClassParser) CondAny() (localctx ICondAnyContext) {
 	_ = this
 
 	localctx = NewCondAnyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, TrafficClassParserRULE_condAny)
+	p.EnterRule(localctx, 36, TrafficClassParserRULE_condAny)
 	var _la int
 
 	defer func() {
@@ -1379,38 +2374,38 @@ func (p *TrafficClassParser) CondAny() (localctx ICondAnyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(79)
+		p.SetState(137)
 		p.Match(TrafficClassParserANY)
 	}
 	{
-		p.SetState(80)
-		p.Match(TrafficClassParserT__4)
+		p.SetState(138)
+		p.Match(TrafficClassParserT__5)
 	}
 	{
-		p.SetState(81)
+		p.SetState(139)
 		p.Cond()
 	}
-	p.SetState(86)
+	p.SetState(144)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == TrafficClassParserT__5 {
+	for _la == TrafficClassParserT__6 {
 		{
-			p.SetState(82)
-			p.Match(TrafficClassParserT__5)
+			p.SetState(140)
+			p.Match(TrafficClassParserT__6)
 		}
 		{
-			p.SetState(83)
+			p.SetState(141)
 			p.Cond()
 		}
 
-		p.SetState(88)
+		p.SetState(146)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(89)
-		p.Match(TrafficClassParserT__6)
+		p.SetState(147)
+		p.Match(TrafficClassParserT__7)
 	}
 
 	return localctx
@@ -1506,7 +2501,7 @@ func (p *TrafficClassParser) CondAll() (localctx ICondAllContext) {
 	_ = this
 
 	localctx = NewCondAllContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, TrafficClassParserRULE_condAll)
+	p.EnterRule(localctx, 38, TrafficClassParserRULE_condAll)
 	var _la int
 
 	defer func() {
@@ -1527,38 +2522,38 @@ func (p *TrafficClassParser) CondAll() (localctx ICondAllContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(91)
+		p.SetState(149)
 		p.Match(TrafficClassParserALL)
 	}
 	{
-		p.SetState(92)
-		p.Match(TrafficClassParserT__4)
+		p.SetState(150)
+		p.Match(TrafficClassParserT__5)
 	}
 	{
-		p.SetState(93)
+		p.SetState(151)
 		p.Cond()
 	}
-	p.SetState(98)
+	p.SetState(156)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == TrafficClassParserT__5 {
+	for _la == TrafficClassParserT__6 {
 		{
-			p.SetState(94)
-			p.Match(TrafficClassParserT__5)
+			p.SetState(152)
+			p.Match(TrafficClassParserT__6)
 		}
 		{
-			p.SetState(95)
+			p.SetState(153)
 			p.Cond()
 		}
 
-		p.SetState(100)
+		p.SetState(158)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(101)
-		p.Match(TrafficClassParserT__6)
+		p.SetState(159)
+		p.Match(TrafficClassParserT__7)
 	}
 
 	return localctx
@@ -1641,7 +2636,7 @@ func (p *TrafficClassParser) CondNot() (localctx ICondNotContext) {
 	_ = this
 
 	localctx = NewCondNotContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, TrafficClassParserRULE_condNot)
+	p.EnterRule(localctx, 40, TrafficClassParserRULE_condNot)
 
 	defer func() {
 		p.ExitRule()
@@ -1661,20 +2656,20 @@ func (p *TrafficClassParser) CondNot() (localctx ICondNotContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(103)
+		p.SetState(161)
 		p.Match(TrafficClassParserNOT)
 	}
 	{
-		p.SetState(104)
-		p.Match(TrafficClassParserT__4)
+		p.SetState(162)
+		p.Match(TrafficClassParserT__5)
 	}
 	{
-		p.SetState(105)
+		p.SetState(163)
 		p.Cond()
 	}
 	{
-		p.SetState(106)
-		p.Match(TrafficClassParserT__6)
+		p.SetState(164)
+		p.Match(TrafficClassParserT__7)
 	}
 
 	return localctx
@@ -1716,39 +2711,194 @@ func NewCondBoolContext(parser antlr.Parser, parent antlr.ParserRuleContext, inv
 	return p
 }
 
-func (s *CondBoolContext) GetParser() antlr.Parser { return s.parser }
+func (s *CondBoolContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CondBoolContext) BOOL() antlr.TerminalNode {
+	return s.GetToken(TrafficClassParserBOOL, 0)
+}
+
+func (s *CondBoolContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CondBoolContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CondBoolContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.EnterCondBool(s)
+	}
+}
+
+func (s *CondBoolContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.ExitCondBool(s)
+	}
+}
+
+func (p *TrafficClassParser) CondBool() (localctx ICondBoolContext) {
+	this := p
+	_ = this
+
+	localctx = NewCondBoolContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, TrafficClassParserRULE_condBool)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(166)
+		p.Match(TrafficClassParserBOOL)
+	}
+	{
+		p.SetState(167)
+		p.Match(TrafficClassParserT__0)
+	}
+	{
+		p.SetState(168)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == TrafficClassParserT__8 || _la == TrafficClassParserT__9) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+	return localctx
+}
+
+// ICondIPv4Context is an interface to support dynamic dispatch.
+type ICondIPv4Context interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCondIPv4Context differentiates from other interfaces.
+	IsCondIPv4Context()
+}
+
+type CondIPv4Context struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCondIPv4Context() *CondIPv4Context {
+	var p = new(CondIPv4Context)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TrafficClassParserRULE_condIPv4
+	return p
+}
+
+func (*CondIPv4Context) IsCondIPv4Context() {}
+
+func NewCondIPv4Context(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CondIPv4Context {
+	var p = new(CondIPv4Context)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TrafficClassParserRULE_condIPv4
+
+	return p
+}
+
+func (s *CondIPv4Context) GetParser() antlr.Parser { return s.parser }
+
+func (s *CondIPv4Context) MatchSrc() IMatchSrcContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchSrcContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMatchSrcContext)
+}
+
+func (s *CondIPv4Context) MatchDst() IMatchDstContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchDstContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMatchDstContext)
+}
+
+func (s *CondIPv4Context) MatchDSCP() IMatchDSCPContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchDSCPContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMatchDSCPContext)
+}
+
+func (s *CondIPv4Context) MatchTOS() IMatchTOSContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchTOSContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMatchTOSContext)
+}
+
+func (s *CondIPv4Context) MatchProtocol() IMatchProtocolContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchProtocolContext)(nil)).Elem(), 0)
 
-func (s *CondBoolContext) BOOL() antlr.TerminalNode {
-	return s.GetToken(TrafficClassParserBOOL, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMatchProtocolContext)
 }
 
-func (s *CondBoolContext) GetRuleContext() antlr.RuleContext {
+func (s *CondIPv4Context) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CondBoolContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CondIPv4Context) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CondBoolContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CondIPv4Context) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TrafficClassListener); ok {
-		listenerT.EnterCondBool(s)
+		listenerT.EnterCondIPv4(s)
 	}
 }
 
-func (s *CondBoolContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CondIPv4Context) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TrafficClassListener); ok {
-		listenerT.ExitCondBool(s)
+		listenerT.ExitCondIPv4(s)
 	}
 }
 
-func (p *TrafficClassParser) CondBool() (localctx ICondBoolContext) {
+func (p *TrafficClassParser) CondIPv4() (localctx ICondIPv4Context) {
 	this := p
 	_ = this
 
-	localctx = NewCondBoolContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, TrafficClassParserRULE_condBool)
-	var _la int
+	localctx = NewCondIPv4Context(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, TrafficClassParserRULE_condIPv4)
 
 	defer func() {
 		p.ExitRule()
@@ -1766,144 +2916,166 @@ func (p *TrafficClassParser) CondBool() (localctx ICondBoolContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(108)
-		p.Match(TrafficClassParserBOOL)
-	}
-	{
-		p.SetState(109)
-		p.Match(TrafficClassParserT__0)
-	}
-	{
-		p.SetState(110)
-		_la = p.GetTokenStream().LA(1)
+	p.SetState(175)
+	p.GetErrorHandler().Sync(p)
 
-		if !(_la == TrafficClassParserT__7 || _la == TrafficClassParserT__8) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+	switch p.GetTokenStream().LA(1) {
+	case TrafficClassParserSRC:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(170)
+			p.MatchSrc()
+		}
+
+	case TrafficClassParserDST:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(171)
+			p.MatchDst()
+		}
+
+	case TrafficClassParserDSCP:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(172)
+			p.MatchDSCP()
+		}
+
+	case TrafficClassParserTOS:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(173)
+			p.MatchTOS()
+		}
+
+	case TrafficClassParserPROTOCOL:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(174)
+			p.MatchProtocol()
 		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// ICondIPv4Context is an interface to support dynamic dispatch.
-type ICondIPv4Context interface {
+// ICondIPv6Context is an interface to support dynamic dispatch.
+type ICondIPv6Context interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCondIPv4Context differentiates from other interfaces.
-	IsCondIPv4Context()
+	// IsCondIPv6Context differentiates from other interfaces.
+	IsCondIPv6Context()
 }
 
-type CondIPv4Context struct {
+type CondIPv6Context struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCondIPv4Context() *CondIPv4Context {
-	var p = new(CondIPv4Context)
+func NewEmptyCondIPv6Context() *CondIPv6Context {
+	var p = new(CondIPv6Context)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = TrafficClassParserRULE_condIPv4
+	p.RuleIndex = TrafficClassParserRULE_condIPv6
 	return p
 }
 
-func (*CondIPv4Context) IsCondIPv4Context() {}
+func (*CondIPv6Context) IsCondIPv6Context() {}
 
-func NewCondIPv4Context(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CondIPv4Context {
-	var p = new(CondIPv4Context)
+func NewCondIPv6Context(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CondIPv6Context {
+	var p = new(CondIPv6Context)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = TrafficClassParserRULE_condIPv4
+	p.RuleIndex = TrafficClassParserRULE_condIPv6
 
 	return p
 }
 
-func (s *CondIPv4Context) GetParser() antlr.Parser { return s.parser }
+func (s *CondIPv6Context) GetParser() antlr.Parser { return s.parser }
 
-func (s *CondIPv4Context) MatchSrc() IMatchSrcContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchSrcContext)(nil)).Elem(), 0)
+func (s *CondIPv6Context) MatchSrcIPv6() IMatchSrcIPv6Context {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchSrcIPv6Context)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IMatchSrcContext)
+	return t.(IMatchSrcIPv6Context)
 }
 
-func (s *CondIPv4Context) MatchDst() IMatchDstContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchDstContext)(nil)).Elem(), 0)
+func (s *CondIPv6Context) MatchDstIPv6() IMatchDstIPv6Context {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchDstIPv6Context)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IMatchDstContext)
+	return t.(IMatchDstIPv6Context)
 }
 
-func (s *CondIPv4Context) MatchDSCP() IMatchDSCPContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchDSCPContext)(nil)).Elem(), 0)
+func (s *CondIPv6Context) MatchTrafficClass() IMatchTrafficClassContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchTrafficClassContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IMatchDSCPContext)
+	return t.(IMatchTrafficClassContext)
 }
 
-func (s *CondIPv4Context) MatchTOS() IMatchTOSContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchTOSContext)(nil)).Elem(), 0)
+func (s *CondIPv6Context) MatchFlowLabel() IMatchFlowLabelContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchFlowLabelContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IMatchTOSContext)
+	return t.(IMatchFlowLabelContext)
 }
 
-func (s *CondIPv4Context) MatchProtocol() IMatchProtocolContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchProtocolContext)(nil)).Elem(), 0)
+func (s *CondIPv6Context) MatchNextHeader() IMatchNextHeaderContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchNextHeaderContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IMatchProtocolContext)
+	return t.(IMatchNextHeaderContext)
 }
 
-func (s *CondIPv4Context) GetRuleContext() antlr.RuleContext {
+func (s *CondIPv6Context) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CondIPv4Context) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CondIPv6Context) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CondIPv4Context) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CondIPv6Context) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TrafficClassListener); ok {
-		listenerT.EnterCondIPv4(s)
+		listenerT.EnterCondIPv6(s)
 	}
 }
 
-func (s *CondIPv4Context) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CondIPv6Context) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TrafficClassListener); ok {
-		listenerT.ExitCondIPv4(s)
+		listenerT.ExitCondIPv6(s)
 	}
 }
 
-func (p *TrafficClassParser) CondIPv4() (localctx ICondIPv4Context) {
+func (p *TrafficClassParser) CondIPv6() (localctx ICondIPv6Context) {
 	this := p
 	_ = this
 
-	localctx = NewCondIPv4Context(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, TrafficClassParserRULE_condIPv4)
+	localctx = NewCondIPv6Context(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, TrafficClassParserRULE_condIPv6)
 
 	defer func() {
 		p.ExitRule()
@@ -1921,43 +3093,43 @@ func (p *TrafficClassParser) CondIPv4() (localctx ICondIPv4Context) {
 		}
 	}()
 
-	p.SetState(117)
+	p.SetState(182)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case TrafficClassParserSRC:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(112)
-			p.MatchSrc()
+			p.SetState(177)
+			p.MatchSrcIPv6()
 		}
 
 	case TrafficClassParserDST:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(113)
-			p.MatchDst()
+			p.SetState(178)
+			p.MatchDstIPv6()
 		}
 
-	case TrafficClassParserDSCP:
+	case TrafficClassParserTC:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(114)
-			p.MatchDSCP()
+			p.SetState(179)
+			p.MatchTrafficClass()
 		}
 
-	case TrafficClassParserTOS:
+	case TrafficClassParserFLOWLABEL:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(115)
-			p.MatchTOS()
+			p.SetState(180)
+			p.MatchFlowLabel()
 		}
 
-	case TrafficClassParserPROTOCOL:
+	case TrafficClassParserNEXTHDR:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(116)
-			p.MatchProtocol()
+			p.SetState(181)
+			p.MatchNextHeader()
 		}
 
 	default:
@@ -2070,7 +3242,7 @@ func (p *TrafficClassParser) CondPort() (localctx ICondPortContext) {
 	_ = this
 
 	localctx = NewCondPortContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, TrafficClassParserRULE_condPort)
+	p.EnterRule(localctx, 48, TrafficClassParserRULE_condPort)
 
 	defer func() {
 		p.ExitRule()
@@ -2088,34 +3260,34 @@ func (p *TrafficClassParser) CondPort() (localctx ICondPortContext) {
 		}
 	}()
 
-	p.SetState(123)
+	p.SetState(188)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(119)
+			p.SetState(184)
 			p.MatchSrcPort()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(120)
+			p.SetState(185)
 			p.MatchSrcPortRange()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(121)
+			p.SetState(186)
 			p.MatchDstPort()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(122)
+			p.SetState(187)
 			p.MatchDstPortRange()
 		}
 
@@ -2124,6 +3296,149 @@ func (p *TrafficClassParser) CondPort() (localctx ICondPortContext) {
 	return localctx
 }
 
+// ICondL4Context is an interface to support dynamic dispatch.
+type ICondL4Context interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCondL4Context differentiates from other interfaces.
+	IsCondL4Context()
+}
+
+type CondL4Context struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCondL4Context() *CondL4Context {
+	var p = new(CondL4Context)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TrafficClassParserRULE_condL4
+	return p
+}
+
+func (*CondL4Context) IsCondL4Context() {}
+
+func NewCondL4Context(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CondL4Context {
+	var p = new(CondL4Context)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TrafficClassParserRULE_condL4
+
+	return p
+}
+
+func (s *CondL4Context) GetParser() antlr.Parser { return s.parser }
+
+func (s *CondL4Context) MatchL4Protocol() IMatchL4ProtocolContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchL4ProtocolContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMatchL4ProtocolContext)
+}
+
+func (s *CondL4Context) MatchTCPFlags() IMatchTCPFlagsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchTCPFlagsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMatchTCPFlagsContext)
+}
+
+func (s *CondL4Context) MatchICMPType() IMatchICMPTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchICMPTypeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMatchICMPTypeContext)
+}
+
+func (s *CondL4Context) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CondL4Context) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CondL4Context) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.EnterCondL4(s)
+	}
+}
+
+func (s *CondL4Context) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TrafficClassListener); ok {
+		listenerT.ExitCondL4(s)
+	}
+}
+
+func (p *TrafficClassParser) CondL4() (localctx ICondL4Context) {
+	this := p
+	_ = this
+
+	localctx = NewCondL4Context(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, TrafficClassParserRULE_condL4)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(193)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case TrafficClassParserPROTO:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(190)
+			p.MatchL4Protocol()
+		}
+
+	case TrafficClassParserTCPFLAGS:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(191)
+			p.MatchTCPFlags()
+		}
+
+	case TrafficClassParserICMPTYPE:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(192)
+			p.MatchICMPType()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
 // ICondContext is an interface to support dynamic dispatch.
 type ICondContext interface {
 	antlr.ParserRuleContext
@@ -2202,6 +3517,16 @@ func (s *CondContext) CondIPv4() ICondIPv4Context {
 	return t.(ICondIPv4Context)
 }
 
+func (s *CondContext) CondIPv6() ICondIPv6Context {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICondIPv6Context)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICondIPv6Context)
+}
+
 func (s *CondContext) CondPort() ICondPortContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICondPortContext)(nil)).Elem(), 0)
 
@@ -2212,6 +3537,16 @@ func (s *CondContext) CondPort() ICondPortContext {
 	return t.(ICondPortContext)
 }
 
+func (s *CondContext) CondL4() ICondL4Context {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICondL4Context)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICondL4Context)
+}
+
 func (s *CondContext) CondCls() ICondClsContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICondClsContext)(nil)).Elem(), 0)
 
@@ -2257,7 +3592,7 @@ func (p *TrafficClassParser) Cond() (localctx ICondContext) {
 	_ = this
 
 	localctx = NewCondContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, TrafficClassParserRULE_cond)
+	p.EnterRule(localctx, 52, TrafficClassParserRULE_cond)
 
 	defer func() {
 		p.ExitRule()
@@ -2275,61 +3610,72 @@ func (p *TrafficClassParser) Cond() (localctx ICondContext) {
 		}
 	}()
 
-	p.SetState(132)
+	p.SetState(204)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case TrafficClassParserALL:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 7, p.GetParserRuleContext()) {
+	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(125)
+			p.SetState(195)
 			p.CondAll()
 		}
 
-	case TrafficClassParserANY:
+	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(126)
+			p.SetState(196)
 			p.CondAny()
 		}
 
-	case TrafficClassParserNOT:
+	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(127)
+			p.SetState(197)
 			p.CondNot()
 		}
 
-	case TrafficClassParserSRC, TrafficClassParserDST, TrafficClassParserDSCP, TrafficClassParserTOS, TrafficClassParserPROTOCOL:
+	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(128)
+			p.SetState(198)
 			p.CondIPv4()
 		}
 
-	case TrafficClassParserSRCPORT, TrafficClassParserDSTPORT:
+	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(129)
-			p.CondPort()
+			p.SetState(199)
+			p.CondIPv6()
 		}
 
-	case TrafficClassParserT__3:
+	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(130)
-			p.CondCls()
+			p.SetState(200)
+			p.CondPort()
 		}
 
-	case TrafficClassParserBOOL:
+	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(131)
+			p.SetState(201)
+			p.CondL4()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(202)
+			p.CondCls()
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(203)
 			p.CondBool()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -2412,7 +3758,7 @@ func (p *TrafficClassParser) TrafficClass() (localctx ITrafficClassContext) {
 	_ = this
 
 	localctx = NewTrafficClassContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, TrafficClassParserRULE_trafficClass)
+	p.EnterRule(localctx, 54, TrafficClassParserRULE_trafficClass)
 
 	defer func() {
 		p.ExitRule()
@@ -2432,11 +3778,11 @@ func (p *TrafficClassParser) TrafficClass() (localctx ITrafficClassContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(134)
+		p.SetState(206)
 		p.Cond()
 	}
 	{
-		p.SetState(135)
+		p.SetState(207)
 		p.Match(TrafficClassParserEOF)
 	}
 
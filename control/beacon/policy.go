@@ -49,6 +49,27 @@ const (
 	DefaultMaxExpTime = uint8(63)
 )
 
+// SelectionAlgorithm is the name of the algorithm used to select the best
+// beacons from the candidate beacons.
+type SelectionAlgorithm string
+
+const (
+	// ShortestSelection selects the shortest beacons and tries to add one
+	// diverse beacon.
+	ShortestSelection SelectionAlgorithm = "Shortest"
+	// LatencySelection selects the beacons with the lowest accumulated
+	// latency announced in the static info extension and tries to add one
+	// diverse beacon.
+	LatencySelection SelectionAlgorithm = "Latency"
+	// BandwidthSelection selects the beacons with the highest bottleneck
+	// bandwidth announced in the static info extension and tries to add one
+	// diverse beacon.
+	BandwidthSelection SelectionAlgorithm = "Bandwidth"
+	// WeightedSelection selects the beacons with the highest weighted score
+	// of latency, bandwidth and diversity.
+	WeightedSelection SelectionAlgorithm = "Weighted"
+)
+
 // Policies keeps track of all policies for a non-core beacon store.
 type Policies struct {
 	// Prop is the propagation policy.
@@ -80,6 +101,11 @@ func (p *Policies) Validate() error {
 		return serrors.New("Invalid policy type",
 			"expected", DownRegPolicy, "actual", p.DownReg.Type)
 	}
+	for _, policy := range []Policy{p.Prop, p.UpReg, p.DownReg} {
+		if err := policy.Selection.Validate(); err != nil {
+			return serrors.Wrap("Invalid selection", err, "type", policy.Type)
+		}
+	}
 	return nil
 }
 
@@ -142,6 +168,11 @@ func (p *CorePolicies) Validate() error {
 		return serrors.New("Invalid policy type",
 			"expected", CoreRegPolicy, "actual", p.CoreReg.Type)
 	}
+	for _, policy := range []Policy{p.Prop, p.CoreReg} {
+		if err := policy.Selection.Validate(); err != nil {
+			return serrors.Wrap("Invalid selection", err, "type", policy.Type)
+		}
+	}
 	return nil
 }
 
@@ -186,6 +217,9 @@ type Policy struct {
 	MaxExpTime *uint8 `yaml:"MaxExpTime"`
 	// Filter is the filter applied to segments.
 	Filter Filter `yaml:"Filter"`
+	// Selection configures how the best beacons are selected from the
+	// candidate beacons.
+	Selection Selection `yaml:"Selection"`
 	// Type is the policy type.
 	Type PolicyType `yaml:"Type"`
 }
@@ -203,6 +237,7 @@ func (p *Policy) InitDefaults() {
 		p.MaxExpTime = &m
 	}
 	p.Filter.InitDefaults()
+	p.Selection.InitDefaults()
 }
 
 func (p *Policy) initDefaults(t PolicyType) {
@@ -223,6 +258,9 @@ func ParsePolicyYaml(b []byte, t PolicyType) (*Policy, error) {
 			"expected", t, "actual", p.Type)
 	}
 	p.initDefaults(t)
+	if err := p.Selection.Validate(); err != nil {
+		return nil, serrors.Wrap("Invalid selection", err)
+	}
 	return p, nil
 }
 
@@ -236,6 +274,56 @@ func LoadPolicyFromYaml(path string, t PolicyType) (*Policy, error) {
 	return ParsePolicyYaml(b, t)
 }
 
+// Selection configures the beacon selection algorithm.
+type Selection struct {
+	// Algorithm is the selection algorithm.
+	Algorithm SelectionAlgorithm `yaml:"Algorithm"`
+	// Weights are the weights used by the weighted selection algorithm.
+	Weights SelectionWeights `yaml:"Weights"`
+}
+
+// SelectionWeights are the weights of the criteria considered by the weighted
+// selection algorithm. Only the ratio between the weights matters.
+type SelectionWeights struct {
+	// Latency is the weight of the accumulated latency.
+	Latency float64 `yaml:"Latency"`
+	// Bandwidth is the weight of the bottleneck bandwidth.
+	Bandwidth float64 `yaml:"Bandwidth"`
+	// Diversity is the weight of the link diversity compared to the already
+	// selected beacons.
+	Diversity float64 `yaml:"Diversity"`
+}
+
+// InitDefaults initializes the default values for unset fields.
+func (s *Selection) InitDefaults() {
+	if s.Algorithm == "" {
+		s.Algorithm = ShortestSelection
+	}
+	if s.Algorithm == WeightedSelection && s.Weights == (SelectionWeights{}) {
+		s.Weights = SelectionWeights{Latency: 1, Bandwidth: 1, Diversity: 1}
+	}
+}
+
+// Validate checks that the selection algorithm is known and the weights are
+// sensible.
+func (s *Selection) Validate() error {
+	switch s.Algorithm {
+	case ShortestSelection, LatencySelection, BandwidthSelection:
+	case WeightedSelection:
+		w := s.Weights
+		if w.Latency < 0 || w.Bandwidth < 0 || w.Diversity < 0 {
+			return serrors.New("negative weight", "latency", w.Latency,
+				"bandwidth", w.Bandwidth, "diversity", w.Diversity)
+		}
+		if w == (SelectionWeights{}) {
+			return serrors.New("all weights are zero")
+		}
+	default:
+		return serrors.New("unknown selection algorithm", "algorithm", s.Algorithm)
+	}
+	return nil
+}
+
 // Filter filters beacons.
 type Filter struct {
 	// MaxHopsLength is the maximum number of hops a segment can have.
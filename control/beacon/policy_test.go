@@ -78,6 +78,60 @@ func TestLoadPolicyFromYaml(t *testing.T) {
 	}
 }
 
+func TestParsePolicySelection(t *testing.T) {
+	tests := map[string]struct {
+		Yaml         string
+		Expected     beacon.Selection
+		ErrAssertion assert.ErrorAssertionFunc
+	}{
+		"default": {
+			Yaml:         "BestSetSize: 6",
+			Expected:     beacon.Selection{Algorithm: beacon.ShortestSelection},
+			ErrAssertion: assert.NoError,
+		},
+		"latency": {
+			Yaml:         "Selection:\n  Algorithm: Latency",
+			Expected:     beacon.Selection{Algorithm: beacon.LatencySelection},
+			ErrAssertion: assert.NoError,
+		},
+		"weighted default weights": {
+			Yaml: "Selection:\n  Algorithm: Weighted",
+			Expected: beacon.Selection{
+				Algorithm: beacon.WeightedSelection,
+				Weights:   beacon.SelectionWeights{Latency: 1, Bandwidth: 1, Diversity: 1},
+			},
+			ErrAssertion: assert.NoError,
+		},
+		"weighted": {
+			Yaml: "Selection:\n  Algorithm: Weighted\n  Weights:\n    Latency: 2.5",
+			Expected: beacon.Selection{
+				Algorithm: beacon.WeightedSelection,
+				Weights:   beacon.SelectionWeights{Latency: 2.5},
+			},
+			ErrAssertion: assert.NoError,
+		},
+		"weighted negative weight": {
+			Yaml: "Selection:\n  Algorithm: Weighted\n  Weights:\n    Latency: -1\n" +
+				"    Bandwidth: 1",
+			ErrAssertion: assert.Error,
+		},
+		"unknown algorithm": {
+			Yaml:         "Selection:\n  Algorithm: Fastest",
+			ErrAssertion: assert.Error,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := beacon.ParsePolicyYaml([]byte(test.Yaml), beacon.PropPolicy)
+			test.ErrAssertion(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, test.Expected, p.Selection)
+		})
+	}
+}
+
 func TestFilterApply(t *testing.T) {
 	defaultFilter := &beacon.Filter{
 		MaxHopsLength: 2,
@@ -17,10 +17,13 @@ package beacon
 import (
 	"context"
 	"math"
+	"sort"
+	"time"
 
 	"github.com/patrickmn/go-cache"
 
 	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/segment/iface"
 	"github.com/scionproto/scion/private/segment/segverifier"
 )
 
@@ -78,18 +81,244 @@ func (baseAlgo) selectMostDiverse(beacons []Beacon, best Beacon) (Beacon, int) {
 	return diverse, maxDiversity
 }
 
+// newSelectionAlgorithm returns the selection algorithm configured in s.
+func newSelectionAlgorithm(s Selection) selectionAlgorithm {
+	switch s.Algorithm {
+	case LatencySelection:
+		return latencyAlgo{}
+	case BandwidthSelection:
+		return bandwidthAlgo{}
+	case WeightedSelection:
+		return weightedAlgo{weights: s.Weights}
+	default:
+		return baseAlgo{}
+	}
+}
+
+// latencyAlgo implements a selection algorithm that optimizes for low
+// accumulated latency, as announced in the static info extension. Beacons for
+// which the latency of some hops is unknown are ranked after the beacons with
+// complete information.
+type latencyAlgo struct{}
+
+// SelectBeacons orders the beacons by accumulated latency, and then selects
+// the best beacons in the same way as baseAlgo, i.e., the k-1 lowest latency
+// beacons and the most diverse one.
+func (latencyAlgo) SelectBeacons(ctx context.Context, beacons []Beacon, resultSize int) []Beacon {
+	if len(beacons) <= resultSize {
+		return beacons
+	}
+	sorted := sortByMetrics(beacons, func(a, b beaconMetrics) bool {
+		if a.latencyComplete != b.latencyComplete {
+			return a.latencyComplete
+		}
+		return a.latency < b.latency
+	})
+	return baseAlgo{}.SelectBeacons(ctx, sorted, resultSize)
+}
+
+// bandwidthAlgo implements a selection algorithm that optimizes for high
+// bottleneck bandwidth, as announced in the static info extension. Beacons for
+// which the bandwidth of some hops is unknown are ranked after the beacons
+// with complete information.
+type bandwidthAlgo struct{}
+
+// SelectBeacons orders the beacons by bottleneck bandwidth, and then selects
+// the best beacons in the same way as baseAlgo, i.e., the k-1 highest
+// bandwidth beacons and the most diverse one.
+func (bandwidthAlgo) SelectBeacons(
+	ctx context.Context,
+	beacons []Beacon,
+	resultSize int,
+) []Beacon {
+
+	if len(beacons) <= resultSize {
+		return beacons
+	}
+	sorted := sortByMetrics(beacons, func(a, b beaconMetrics) bool {
+		if a.bandwidthComplete != b.bandwidthComplete {
+			return a.bandwidthComplete
+		}
+		return a.bandwidth > b.bandwidth
+	})
+	return baseAlgo{}.SelectBeacons(ctx, sorted, resultSize)
+}
+
+// weightedAlgo implements a selection algorithm that greedily selects the
+// beacon with the highest weighted score of latency, bandwidth and diversity.
+// The latency and bandwidth scores are normalized to [0, 1] relative to the
+// best and worst candidate. The diversity score is the link diversity compared
+// to the closest already selected beacon, normalized by the beacon length.
+// Incomplete latency or bandwidth information gets the worst score.
+type weightedAlgo struct {
+	weights SelectionWeights
+}
+
+func (a weightedAlgo) SelectBeacons(_ context.Context, beacons []Beacon, resultSize int) []Beacon {
+	if len(beacons) <= resultSize {
+		return beacons
+	}
+	metrics := make([]beaconMetrics, len(beacons))
+	var minLat, maxLat time.Duration = math.MaxInt64, 0
+	var maxBw uint64
+	for i, b := range beacons {
+		m := computeMetrics(b)
+		metrics[i] = m
+		if m.latencyComplete {
+			minLat, maxLat = min(minLat, m.latency), max(maxLat, m.latency)
+		}
+		if m.bandwidthComplete {
+			maxBw = max(maxBw, m.bandwidth)
+		}
+	}
+	staticScores := make([]float64, len(beacons))
+	for i, m := range metrics {
+		if m.latencyComplete {
+			latScore := 1.0
+			if maxLat > minLat {
+				latScore = 1 - float64(m.latency-minLat)/float64(maxLat-minLat)
+			}
+			staticScores[i] += a.weights.Latency * latScore
+		}
+		if m.bandwidthComplete && maxBw > 0 {
+			staticScores[i] += a.weights.Bandwidth * float64(m.bandwidth) / float64(maxBw)
+		}
+	}
+
+	result := make([]Beacon, 0, resultSize)
+	selected := make([]bool, len(beacons))
+	for len(result) < resultSize {
+		best, bestScore := -1, math.Inf(-1)
+		for i, b := range beacons {
+			if selected[i] {
+				continue
+			}
+			score := staticScores[i] + a.weights.Diversity*diversityScore(b, result)
+			// The strict comparison keeps the order of the candidates on ties,
+			// i.e., prefers shorter beacons.
+			if score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+		selected[best] = true
+		result = append(result, beacons[best])
+	}
+	return result
+}
+
+// diversityScore returns the link diversity of the beacon compared to the
+// closest beacon in selected, normalized to [0, 1]. If nothing is selected
+// yet, the score is 0.
+func diversityScore(b Beacon, selected []Beacon) float64 {
+	if len(selected) == 0 || len(b.Segment.ASEntries) == 0 {
+		return 0
+	}
+	minDiversity := math.MaxInt
+	for _, s := range selected {
+		minDiversity = min(minDiversity, b.Diversity(s))
+	}
+	return float64(minDiversity) / float64(len(b.Segment.ASEntries))
+}
+
+// beaconMetrics contains the metrics of a beacon derived from the static info
+// extension.
+type beaconMetrics struct {
+	// latency is the accumulated latency of all hops with known latency.
+	latency time.Duration
+	// latencyComplete indicates whether the latency of all hops is known.
+	latencyComplete bool
+	// bandwidth is the minimum bandwidth of all hops with known bandwidth.
+	bandwidth uint64
+	// bandwidthComplete indicates whether the bandwidth of all hops is known.
+	bandwidthComplete bool
+}
+
+// computeMetrics accumulates the latency and bandwidth of the beacon. For
+// every AS entry, the intra-AS metrics between the ingress and the egress
+// interface and the inter-AS metrics of the link attached to the egress
+// interface are considered.
+func computeMetrics(b Beacon) beaconMetrics {
+	m := beaconMetrics{
+		bandwidth:         math.MaxUint64,
+		latencyComplete:   true,
+		bandwidthComplete: true,
+	}
+	for _, entry := range b.Segment.ASEntries {
+		hf := entry.HopEntry.HopField
+		ingress, egress := iface.ID(hf.ConsIngress), iface.ID(hf.ConsEgress)
+		info := entry.Extensions.StaticInfo
+		if info == nil {
+			m.latencyComplete, m.bandwidthComplete = false, false
+			continue
+		}
+		if ingress != 0 {
+			m.addLatency(info.Latency.Intra, ingress)
+			m.addBandwidth(info.Bandwidth.Intra, ingress)
+		}
+		if egress != 0 {
+			m.addLatency(info.Latency.Inter, egress)
+			m.addBandwidth(info.Bandwidth.Inter, egress)
+		}
+	}
+	if m.bandwidth == math.MaxUint64 {
+		m.bandwidth = 0
+	}
+	return m
+}
+
+func (m *beaconMetrics) addLatency(latencies map[iface.ID]time.Duration, id iface.ID) {
+	l, ok := latencies[id]
+	if !ok || l < 0 {
+		m.latencyComplete = false
+		return
+	}
+	m.latency += l
+}
+
+func (m *beaconMetrics) addBandwidth(bandwidths map[iface.ID]uint64, id iface.ID) {
+	bw, ok := bandwidths[id]
+	if !ok || bw == 0 {
+		m.bandwidthComplete = false
+		return
+	}
+	m.bandwidth = min(m.bandwidth, bw)
+}
+
+// sortByMetrics returns a copy of the beacons that is stably sorted according
+// to the metrics of the beacons.
+func sortByMetrics(beacons []Beacon, less func(a, b beaconMetrics) bool) []Beacon {
+	indices := make([]int, len(beacons))
+	metrics := make([]beaconMetrics, len(beacons))
+	for i, b := range beacons {
+		indices[i] = i
+		metrics[i] = computeMetrics(b)
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return less(metrics[indices[i]], metrics[indices[j]])
+	})
+	sorted := make([]Beacon, 0, len(beacons))
+	for _, i := range indices {
+		sorted = append(sorted, beacons[i])
+	}
+	return sorted
+}
+
+// chainsAvailableAlgo filters the beacons for which not all certificate
+// chains are available, and selects from the remaining ones with next.
 type chainsAvailableAlgo struct {
 	verifier     chainChecker
 	logThrottled *cache.Cache
+	next         selectionAlgorithm
 }
 
-func newChainsAvailableAlgo(engine ChainProvider) chainsAvailableAlgo {
+func newChainsAvailableAlgo(engine ChainProvider, next selectionAlgorithm) chainsAvailableAlgo {
 	return chainsAvailableAlgo{
 		verifier: chainChecker{
 			Engine: engine,
 			Cache:  cache.New(defaultCacheHitExpiration, defaultCacheHitExpiration),
 		},
 		logThrottled: cache.New(defaultCacheHitExpiration, defaultCacheHitExpiration),
+		next:         next,
 	}
 }
 
@@ -115,5 +344,5 @@ func (a chainsAvailableAlgo) SelectBeacons(
 			a.logThrottled.Set(id, struct{}{}, cache.DefaultExpiration)
 		}
 	}
-	return baseAlgo{}.SelectBeacons(ctx, withChain, resultSize)
+	return a.next.SelectBeacons(ctx, withChain, resultSize)
 }
@@ -0,0 +1,194 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beacon_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/control/beacon"
+	"github.com/scionproto/scion/control/beacon/mock_beacon"
+	"github.com/scionproto/scion/pkg/addr"
+	seg "github.com/scionproto/scion/pkg/segment"
+	"github.com/scionproto/scion/pkg/segment/extensions/staticinfo"
+	"github.com/scionproto/scion/pkg/segment/iface"
+)
+
+// staticHop describes an AS entry of a test beacon, and the static info
+// metrics from the ingress to the egress interface (intra) and on the link
+// attached to the egress interface (inter).
+type staticHop struct {
+	IA                addr.IA
+	Ingress, Egress   uint16
+	Intra, Inter      time.Duration
+	IntraBw, InterBw  uint64
+	WithoutStaticInfo bool
+}
+
+func newStaticInfoBeacon(hops ...staticHop) beacon.Beacon {
+	var entries []seg.ASEntry
+	for _, hop := range hops {
+		entry := seg.ASEntry{
+			Local: hop.IA,
+			HopEntry: seg.HopEntry{
+				HopField: seg.HopField{
+					ConsIngress: hop.Ingress,
+					ConsEgress:  hop.Egress,
+				},
+			},
+		}
+		if !hop.WithoutStaticInfo {
+			info := &staticinfo.Extension{
+				Latency: staticinfo.LatencyInfo{
+					Intra: map[iface.ID]time.Duration{},
+					Inter: map[iface.ID]time.Duration{
+						iface.ID(hop.Egress): hop.Inter,
+					},
+				},
+				Bandwidth: staticinfo.BandwidthInfo{
+					Intra: map[iface.ID]uint64{},
+					Inter: map[iface.ID]uint64{
+						iface.ID(hop.Egress): hop.InterBw,
+					},
+				},
+			}
+			if hop.Ingress != 0 {
+				info.Latency.Intra[iface.ID(hop.Ingress)] = hop.Intra
+				info.Bandwidth.Intra[iface.ID(hop.Ingress)] = hop.IntraBw
+			}
+			entry.Extensions.StaticInfo = info
+		}
+		entries = append(entries, entry)
+	}
+	return beacon.Beacon{
+		Segment: &seg.PathSegment{ASEntries: entries},
+	}
+}
+
+func TestSelectionAlgorithms(t *testing.T) {
+	// All beacons originate in 110 and are received by the local AS from 111,
+	// 112 or 113.
+	//
+	// short: 110 -> 111, 15ms, 100Mbps
+	// fast: 110 -> 112 -> 111, 7ms, 50Mbps
+	// wide: 110 -> 113 -> 111, 20ms, 1Gbps
+	// diverse: 110 -> 113, 30ms, 10Mbps
+	// unknown: 110 -> 112, no static info
+	short := newStaticInfoBeacon(
+		staticHop{IA: ia110, Egress: 1, Inter: 15 * time.Millisecond, InterBw: 100_000},
+		staticHop{IA: ia111, Ingress: 1, Egress: 2, Intra: 0, IntraBw: 1_000_000,
+			Inter: 0, InterBw: 1_000_000},
+	)
+	fast := newStaticInfoBeacon(
+		staticHop{IA: ia110, Egress: 3, Inter: 2 * time.Millisecond, InterBw: 1_000_000},
+		staticHop{IA: ia112, Ingress: 1, Egress: 2, Intra: 1 * time.Millisecond,
+			IntraBw: 50_000, Inter: 2 * time.Millisecond, InterBw: 1_000_000},
+		staticHop{IA: ia111, Ingress: 3, Egress: 2, Intra: 2 * time.Millisecond,
+			IntraBw: 1_000_000, Inter: 0, InterBw: 1_000_000},
+	)
+	wide := newStaticInfoBeacon(
+		staticHop{IA: ia110, Egress: 4, Inter: 10 * time.Millisecond, InterBw: 1_000_000},
+		staticHop{IA: ia113, Ingress: 1, Egress: 2, Intra: 5 * time.Millisecond,
+			IntraBw: 1_000_000, Inter: 5 * time.Millisecond, InterBw: 1_000_000},
+		staticHop{IA: ia111, Ingress: 4, Egress: 2, Intra: 0,
+			IntraBw: 1_000_000, Inter: 0, InterBw: 1_000_000},
+	)
+	diverse := newStaticInfoBeacon(
+		staticHop{IA: ia110, Egress: 5, Inter: 10 * time.Millisecond, InterBw: 10_000},
+		staticHop{IA: ia113, Ingress: 3, Egress: 4, Intra: 10 * time.Millisecond,
+			IntraBw: 1_000_000, Inter: 10 * time.Millisecond, InterBw: 1_000_000},
+	)
+	unknown := newStaticInfoBeacon(
+		staticHop{IA: ia110, Egress: 6, WithoutStaticInfo: true},
+		staticHop{IA: ia112, Ingress: 4, Egress: 5, WithoutStaticInfo: true},
+	)
+	// The database returns the candidates ordered by length.
+	candidates := []beacon.Beacon{short, diverse, unknown, fast, wide}
+
+	tests := map[string]struct {
+		Selection beacon.Selection
+		BestSize  int
+		Expected  []beacon.Beacon
+	}{
+		"shortest": {
+			Selection: beacon.Selection{Algorithm: beacon.ShortestSelection},
+			BestSize:  2,
+			Expected:  []beacon.Beacon{short, diverse},
+		},
+		"latency": {
+			Selection: beacon.Selection{Algorithm: beacon.LatencySelection},
+			BestSize:  3,
+			Expected:  []beacon.Beacon{fast, short, wide},
+		},
+		"latency unknown last": {
+			Selection: beacon.Selection{Algorithm: beacon.LatencySelection},
+			BestSize:  4,
+			Expected:  []beacon.Beacon{fast, short, wide, diverse},
+		},
+		"bandwidth": {
+			Selection: beacon.Selection{Algorithm: beacon.BandwidthSelection},
+			BestSize:  3,
+			Expected:  []beacon.Beacon{wide, short, fast},
+		},
+		"weighted latency only": {
+			Selection: beacon.Selection{
+				Algorithm: beacon.WeightedSelection,
+				Weights:   beacon.SelectionWeights{Latency: 1},
+			},
+			BestSize: 2,
+			Expected: []beacon.Beacon{fast, short},
+		},
+		"weighted latency and diversity": {
+			Selection: beacon.Selection{
+				Algorithm: beacon.WeightedSelection,
+				Weights:   beacon.SelectionWeights{Latency: 1, Diversity: 2},
+			},
+			BestSize: 2,
+			Expected: []beacon.Beacon{fast, wide},
+		},
+		"weighted bandwidth and latency": {
+			Selection: beacon.Selection{
+				Algorithm: beacon.WeightedSelection,
+				Weights:   beacon.SelectionWeights{Latency: 1, Bandwidth: 1},
+			},
+			BestSize: 1,
+			Expected: []beacon.Beacon{wide},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			db := mock_beacon.NewMockDB(mctrl)
+			policy := beacon.Policy{BestSetSize: test.BestSize, Selection: test.Selection}
+			policies := beacon.Policies{Prop: policy, UpReg: policy, DownReg: policy}
+			store, err := beacon.NewBeaconStore(policies, db)
+			require.NoError(t, err)
+
+			db.EXPECT().CandidateBeacons(
+				gomock.Any(), gomock.Any(), gomock.Any(), addr.IA(0),
+			).Return(
+				append([]beacon.Beacon{}, candidates...), nil,
+			)
+			res, err := store.BeaconsToPropagate(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, test.Expected, res)
+		})
+	}
+}
@@ -76,12 +76,12 @@ func NewBeaconStore(policies Policies, db DB, opts ...StoreOption) (*Store, erro
 	o := applyStoreOptions(opts)
 	s := &Store{
 		baseStore: baseStore{
-			db:   db,
-			algo: selectAlgo(o),
+			db: db,
 		},
 		policies: policies,
 	}
 	s.baseStore.usager = &s.policies
+	s.baseStore.algos = selectAlgos(o, &s.policies.Prop, &s.policies.UpReg, &s.policies.DownReg)
 	return s, nil
 }
 
@@ -113,7 +113,7 @@ func (s *Store) getBeacons(ctx context.Context, policy *Policy) ([]Beacon, error
 	if err != nil {
 		return nil, err
 	}
-	return s.algo.SelectBeacons(ctx, beacons, policy.BestSetSize), nil
+	return s.algos[policy].SelectBeacons(ctx, beacons, policy.BestSetSize), nil
 }
 
 // MaxExpTime returns the segment maximum expiration time for the given policy.
@@ -147,12 +147,12 @@ func NewCoreBeaconStore(policies CorePolicies, db DB, opts ...StoreOption) (*Cor
 	o := applyStoreOptions(opts)
 	s := &CoreStore{
 		baseStore: baseStore{
-			db:   db,
-			algo: selectAlgo(o),
+			db: db,
 		},
 		policies: policies,
 	}
 	s.usager = &s.policies
+	s.algos = selectAlgos(o, &s.policies.Prop, &s.policies.CoreReg)
 	return s, nil
 }
 
@@ -188,7 +188,8 @@ func (s *CoreStore) getBeacons(ctx context.Context, policy *Policy) ([]Beacon, e
 			log.FromCtx(ctx).Error("Error getting candidate beacons", "src", src, "err", err)
 			continue
 		}
-		selBeacons := s.algo.SelectBeacons(ctx, candidateBeacons, policy.BestSetSize)
+		selBeacons := s.algos[policy].SelectBeacons(ctx, candidateBeacons,
+			policy.BestSetSize)
 		beacons = append(beacons, selBeacons...)
 	}
 	return beacons, nil
@@ -209,7 +210,10 @@ func (s *CoreStore) MaxExpTime(policyType PolicyType) uint8 {
 type baseStore struct {
 	db     DB
 	usager usager
-	algo   selectionAlgorithm
+	// algos contains the selection algorithm for each policy of the store. It
+	// is keyed by the policy rather than its type, so that policies can not
+	// overwrite each other's algorithm.
+	algos map[*Policy]selectionAlgorithm
 }
 
 // PreFilter indicates whether the beacon will be filtered on insert by
@@ -236,9 +240,22 @@ func (s *baseStore) UpdatePolicy(ctx context.Context, policy Policy) error {
 	return serrors.New("policy update not supported")
 }
 
-func selectAlgo(o storeOptions) selectionAlgorithm {
+func selectAlgos(o storeOptions, policies ...*Policy) map[*Policy]selectionAlgorithm {
+	var chainsAvailable *chainsAvailableAlgo
 	if o.chainChecker != nil {
-		return newChainsAvailableAlgo(o.chainChecker)
+		// All policies share the caches of the chain checker.
+		a := newChainsAvailableAlgo(o.chainChecker, nil)
+		chainsAvailable = &a
 	}
-	return baseAlgo{}
+	algos := make(map[*Policy]selectionAlgorithm, len(policies))
+	for _, policy := range policies {
+		algo := newSelectionAlgorithm(policy.Selection)
+		if chainsAvailable != nil {
+			a := *chainsAvailable
+			a.next = algo
+			algo = a
+		}
+		algos[policy] = algo
+	}
+	return algos
 }
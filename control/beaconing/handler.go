@@ -64,6 +64,13 @@ func (h Handler) HandleBeacon(ctx context.Context, b beacon.Beacon, peer *snet.U
 		return err
 	}
 
+	if intf.Shutdown() {
+		err := serrors.New("received beacon on shut down interface",
+			"ingress_interface", b.InIfID)
+		h.updateMetric(span, labels.WithResult("err_shutdown"), err)
+		return err
+	}
+
 	upstream := intf.TopoInfo().IA
 	if span != nil {
 		span.SetTag("ingress_interface", b.InIfID)
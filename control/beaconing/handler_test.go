@@ -276,6 +276,29 @@ func TestHandlerHandleBeacon(t *testing.T) {
 	}
 }
 
+func TestHandlerShutdownInterface(t *testing.T) {
+	topo, err := topology.FromJSONFile("testdata/topology-core.json")
+	require.NoError(t, err)
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+	g := graph.NewDefaultGraph(mctrl)
+
+	intfs := testInterfaces(topo)
+	intfs.Get(localIF).SetShutdown(true)
+	handler := beaconing.Handler{
+		LocalIA:    localIA,
+		Inserter:   mock_beaconing.NewMockBeaconInserter(mctrl),
+		Interfaces: intfs,
+		Verifier:   mock_infra.NewMockVerifier(mctrl),
+	}
+	b := beacon.Beacon{
+		Segment: testSegment(g, []uint16{graph.If_220_X_120_B, graph.If_120_A_110_X}),
+		InIfID:  localIF,
+	}
+	err = handler.HandleBeacon(context.Background(), b, &snet.UDPAddr{Path: path.SCION{}})
+	assert.Error(t, err)
+}
+
 func testSegment(g *graph.Graph, ifIDs []uint16) *seg.PathSegment {
 	pseg := g.Beacon(ifIDs)
 	pseg.ASEntries = pseg.ASEntries[:len(pseg.ASEntries)-1]
@@ -169,7 +169,7 @@ func (p *Propagator) beaconsPerInterface(
 	}
 	var beacons []beacon.Beacon
 	for _, b := range allBeacons {
-		if p.AllInterfaces.Get(b.InIfID) == nil {
+		if intf := p.AllInterfaces.Get(b.InIfID); intf == nil || intf.Shutdown() {
 			continue
 		}
 		beacons = append(beacons, b)
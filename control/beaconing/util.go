@@ -34,7 +34,7 @@ func sortedIntfs(intfs *ifstate.Interfaces, linkType topology.LinkType) []uint16
 	var result []uint16
 	for ifID, intf := range intfs.All() {
 		topoInfo := intf.TopoInfo()
-		if topoInfo.LinkType != linkType {
+		if topoInfo.LinkType != linkType || intf.Shutdown() {
 			continue
 		}
 		result = append(result, ifID)
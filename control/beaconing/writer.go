@@ -120,6 +120,7 @@ func (r *WriteScheduler) run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	segments = r.withoutShutdown(segments)
 	peers := sortedIntfs(r.Intfs, topology.Peer)
 	stats, err := r.Writer.Write(ctx, segments, peers)
 	if err != nil {
@@ -132,6 +133,19 @@ func (r *WriteScheduler) run(ctx context.Context) error {
 	return err
 }
 
+// withoutShutdown removes the segments that were received on an interface that
+// is administratively shut down.
+func (r *WriteScheduler) withoutShutdown(segments []beacon.Beacon) []beacon.Beacon {
+	filtered := make([]beacon.Beacon, 0, len(segments))
+	for _, b := range segments {
+		if intf := r.Intfs.Get(b.InIfID); intf != nil && intf.Shutdown() {
+			continue
+		}
+		filtered = append(filtered, b)
+	}
+	return filtered
+}
+
 // RemoteWriter writes segments via an RPC to the source AS of a segment.
 type RemoteWriter struct {
 	// InternalErrors counts errors that happened before being able to send a
@@ -323,6 +323,46 @@ func TestRegistrarRun(t *testing.T) {
 			})
 		r.Run(context.Background())
 	})
+	t.Run("Beacons received on shut down interfaces are not sent", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		defer mctrl.Finish()
+
+		topo, err := topology.FromJSONFile(topoNonCore)
+		require.NoError(t, err)
+		intfs := ifstate.NewInterfaces(interfaceInfos(topo), ifstate.Config{})
+		intfs.Get(graph.If_111_B_120_X).SetShutdown(true)
+		segProvider := mock_beaconing.NewMockSegmentProvider(mctrl)
+		writer := &recordingWriter{}
+
+		r := beaconing.WriteScheduler{
+			Writer:   writer,
+			Intfs:    intfs,
+			Tick:     beaconing.NewTick(time.Hour),
+			Provider: segProvider,
+			Type:     seg.TypeDown,
+		}
+
+		g := graph.NewDefaultGraph(mctrl)
+		segProvider.EXPECT().SegmentsToRegister(gomock.Any(), seg.TypeDown).Return(
+			[]beacon.Beacon{testBeacon(g, []uint16{graph.If_120_X_111_B})}, nil,
+		)
+		r.Run(context.Background())
+		assert.Empty(t, writer.segs)
+	})
+}
+
+type recordingWriter struct {
+	segs []beacon.Beacon
+}
+
+func (w *recordingWriter) Write(
+	_ context.Context,
+	segs []beacon.Beacon,
+	_ []uint16,
+) (beaconing.WriteStats, error) {
+
+	w.segs = append(w.segs, segs...)
+	return beaconing.WriteStats{}, nil
 }
 
 func testBeacon(g *graph.Graph, desc []uint16) beacon.Beacon {
@@ -28,7 +28,6 @@ import (
 	"time"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/cors"
 	promgrpc "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
@@ -75,6 +74,7 @@ import (
 	"github.com/scionproto/scion/private/discovery"
 	"github.com/scionproto/scion/private/drkey/drkeyutil"
 	"github.com/scionproto/scion/private/keyconf"
+	"github.com/scionproto/scion/private/mgmtapi"
 	cppkiapi "github.com/scionproto/scion/private/mgmtapi/cppki/api"
 	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
 	segapi "github.com/scionproto/scion/private/mgmtapi/segments/api"
@@ -154,7 +154,7 @@ func realMain(ctx context.Context) error {
 		return serrors.Wrap("initializing path storage", err)
 	}
 	pathDB = pathstoragemetrics.WrapDB(pathDB, pathstoragemetrics.Config{
-		Driver:       string(storage.BackendSqlite),
+		Driver:       string(globalCfg.PathDB.Backend),
 		QueriesTotal: libmetrics.NewPromCounter(metrics.PathDBQueriesTotal),
 	})
 	defer pathDB.Close()
@@ -191,7 +191,7 @@ func realMain(ctx context.Context) error {
 		},
 	)
 	trustDB = truststoragemetrics.WrapDB(trustDB, truststoragemetrics.Config{
-		Driver:       string(storage.BackendSqlite),
+		Driver:       string(globalCfg.TrustDB.Backend),
 		QueriesTotal: libmetrics.NewPromCounter(metrics.TrustDBQueriesTotal),
 	})
 	if err := cs.LoadTrustMaterial(ctx, globalCfg.General.ConfigDir, trustDB); err != nil {
@@ -244,7 +244,7 @@ func realMain(ctx context.Context) error {
 	}
 	defer beaconDB.Close()
 	beaconDB = beaconstoragemetrics.WrapDB(beaconDB, beaconstoragemetrics.Config{
-		Driver:       string(storage.BackendSqlite),
+		Driver:       string(globalCfg.BeaconDB.Backend),
 		QueriesTotal: libmetrics.NewPromCounter(metrics.BeaconDBQueriesTotal),
 	})
 
@@ -686,8 +686,10 @@ func realMain(ctx context.Context) error {
 
 	if globalCfg.API.Addr != "" {
 		r := chi.NewRouter()
-		r.Use(cors.Handler(cors.Options{
-			AllowedOrigins: []string{"*"},
+		// Browsers must not change the administrative state of interfaces on
+		// behalf of web pages of other origins.
+		r.Use(mgmtapi.CORS(func(r *http.Request) bool {
+			return strings.HasSuffix(r.URL.Path, "/shutdown")
 		}))
 		r.Get("/", api.ServeSpecInteractive)
 		r.Get("/openapi.json", api.ServeSpecJSON)
@@ -698,13 +700,16 @@ func realMain(ctx context.Context) error {
 			CPPKIServer: cppkiapi.Server{
 				TrustDB: trustDB,
 			},
-			Beacons:  beaconDB,
-			CA:       chainBuilder,
-			Config:   service.NewConfigStatusPage(globalCfg).Handler,
-			Info:     service.NewInfoStatusPage().Handler,
-			LogLevel: service.NewLogLevelStatusPage().Handler,
-			Signer:   signer,
-			Topology: topo.HandleHTTP,
+			Beacons:    beaconDB,
+			CA:         chainBuilder,
+			Config:     service.NewConfigStatusPage(globalCfg).Handler,
+			Info:       service.NewInfoStatusPage().Handler,
+			LogLevel:   service.NewLogLevelStatusPage().Handler,
+			Signer:     signer,
+			Topology:   topo.HandleHTTP,
+			IA:         topo.IA(),
+			Interfaces: intfs,
+			RevCache:   revCache,
 			Healther: &healther{
 				Signer:   signer,
 				TrustDB:  trustDB,
@@ -712,6 +717,13 @@ func realMain(ctx context.Context) error {
 				CAHealth: caHealthCached,
 			},
 		}
+		if secret := globalCfg.BS.ShutdownSecret; secret != "" {
+			verifier := &jwtauth.HTTPVerifier{
+				Generator: caconfig.NewPEMSymmetricKey(secret).Get,
+				Logger:    log.New("component", "interface_shutdown"),
+			}
+			server.Authorize = verifier.AddAuthorization
+		}
 		log.Info("Exposing API", "addr", globalCfg.API.Addr)
 		s := http.Server{
 			Addr:    globalCfg.API.Addr,
@@ -746,17 +758,20 @@ func realMain(ctx context.Context) error {
 	if topo.Core() {
 		propagationFilter = func(intf *ifstate.Interface) bool {
 			topoInfo := intf.TopoInfo()
-			return topoInfo.LinkType == topology.Core
+			return topoInfo.LinkType == topology.Core && !intf.Shutdown()
 		}
 	} else {
 		propagationFilter = func(intf *ifstate.Interface) bool {
 			topoInfo := intf.TopoInfo()
-			return topoInfo.LinkType == topology.Child
+			return topoInfo.LinkType == topology.Child && !intf.Shutdown()
 		}
 	}
 
 	originationFilter := func(intf *ifstate.Interface) bool {
 		topoInfo := intf.TopoInfo()
+		if intf.Shutdown() {
+			return false
+		}
 		return topoInfo.LinkType == topology.Core || topoInfo.LinkType == topology.Child
 	}
 
@@ -26,6 +26,12 @@ registration_interval = "5s"
 
 # Add EPIC authenticators to the beacons. (default false)
 epic = false
+
+# The PEM-encoded shared secret that is used to verify the JWT bearer tokens
+# (HS256) of administrative interface shutdowns through the management API. If
+# not set, interfaces cannot be shut down through the management API.
+# (default "")
+shutdown_secret = ""
 `
 
 const policiesSample = `
@@ -157,6 +157,10 @@ type BSConfig struct {
 	Policies Policies `toml:"policies,omitempty"`
 	// EPIC specifies whether the EPIC authenticators should be added to the beacons.
 	EPIC bool `toml:"epic,omitempty"`
+	// ShutdownSecret is the path to the PEM-encoded shared secret that is used to verify the JWT
+	// tokens of administrative interface shutdowns through the management API. If it is empty,
+	// interfaces cannot be shut down through the management API.
+	ShutdownSecret string `toml:"shutdown_secret,omitempty"`
 }
 
 // InitDefaults the default values for the durations that are equal to zero.
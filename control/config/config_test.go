@@ -76,6 +76,7 @@ func CheckTestBSConfig(t *testing.T, cfg *BSConfig) {
 	assert.Equal(t, DefaultPropagationInterval, cfg.PropagationInterval.Duration)
 	assert.Equal(t, DefaultRegistrationInterval, cfg.RegistrationInterval.Duration)
 	assert.False(t, cfg.EPIC)
+	assert.Empty(t, cfg.ShutdownSecret)
 	CheckTestPolicies(t, &cfg.Policies)
 }
 
@@ -147,6 +147,7 @@ type Interface struct {
 	topoInfo      InterfaceInfo
 	lastOriginate time.Time
 	lastPropagate time.Time
+	shutdown      bool
 	cfg           Config
 }
 
@@ -195,6 +196,22 @@ func (intf *Interface) LastPropagate() time.Time {
 	return intf.lastPropagate
 }
 
+// SetShutdown sets whether the interface is administratively shut down. No
+// beacons are originated, propagated or accepted on a shut down interface.
+// The state is kept across topology updates and resets.
+func (intf *Interface) SetShutdown(shutdown bool) {
+	intf.mu.Lock()
+	defer intf.mu.Unlock()
+	intf.shutdown = shutdown
+}
+
+// Shutdown indicates whether the interface is administratively shut down.
+func (intf *Interface) Shutdown() bool {
+	intf.mu.RLock()
+	defer intf.mu.RUnlock()
+	return intf.shutdown
+}
+
 func (intf *Interface) reset() {
 	intf.mu.Lock()
 	defer intf.mu.Unlock()
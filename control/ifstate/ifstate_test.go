@@ -16,6 +16,7 @@ package ifstate_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -36,6 +37,9 @@ func TestInterfacesUpdate(t *testing.T) {
 		// The remote ifID should be kept
 		assert.EqualValues(t, 11, intfs.Get(1).TopoInfo().RemoteID)
 		assert.EqualValues(t, 22, intfs.Get(2).TopoInfo().RemoteID)
+		// The shutdown state should be kept.
+		assert.True(t, intfs.Get(1).Shutdown())
+		assert.False(t, intfs.Get(2).Shutdown())
 	})
 	t.Run("The update adds new interfaces and removes missing", func(t *testing.T) {
 		intfs := testInterfaces(t)
@@ -51,7 +55,11 @@ func TestInterfacesUpdate(t *testing.T) {
 
 func TestInterfacesReset(t *testing.T) {
 	intfs := testInterfaces(t)
+	intfs.Get(1).Originate(time.Now())
 	intfs.Reset()
+	assert.True(t, intfs.Get(1).LastOriginate().IsZero())
+	// The shutdown state should remain.
+	assert.True(t, intfs.Get(1).Shutdown())
 	// The topo info should remain.
 	assert.Equal(t, uint16(1301), intfs.Get(1).TopoInfo().MTU)
 	assert.Equal(t, uint16(1302), intfs.Get(2).TopoInfo().MTU)
@@ -76,5 +84,6 @@ func testInterfaces(t *testing.T) *ifstate.Interfaces {
 	intfs := ifstate.NewInterfaces(topoMap, ifstate.Config{})
 	intfs.Get(1).TopoInfoRef().RemoteID = 11
 	intfs.Get(2).TopoInfoRef().RemoteID = 22
+	intfs.Get(1).SetShutdown(true)
 	return intfs
 }
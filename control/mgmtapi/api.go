@@ -21,23 +21,29 @@ import (
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
+	"math"
 	"net/http"
 	"sort"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/proto"
 
 	"github.com/scionproto/scion/control/beacon"
+	"github.com/scionproto/scion/control/ifstate"
 	cstrust "github.com/scionproto/scion/control/trust"
 	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
 	seg "github.com/scionproto/scion/pkg/segment"
+	"github.com/scionproto/scion/pkg/segment/iface"
 	"github.com/scionproto/scion/private/ca/renewal"
 	api "github.com/scionproto/scion/private/mgmtapi"
 	cppkiapi "github.com/scionproto/scion/private/mgmtapi/cppki/api"
 	healthapi "github.com/scionproto/scion/private/mgmtapi/health/api"
 	segapi "github.com/scionproto/scion/private/mgmtapi/segments/api"
+	"github.com/scionproto/scion/private/revcache"
 	"github.com/scionproto/scion/private/storage"
 	beaconstorage "github.com/scionproto/scion/private/storage/beacon"
 	"github.com/scionproto/scion/private/trust"
@@ -91,6 +97,19 @@ type Server struct {
 	Topology       http.HandlerFunc
 	TrustDB        storage.TrustDB
 	Healther       Healther
+	// IA is the local ISD-AS. It is used to look up the revocations of the
+	// interfaces.
+	IA addr.IA
+	// Interfaces is used to list and shut down the interfaces. If nil, the
+	// interface endpoints are not supported.
+	Interfaces *ifstate.Interfaces
+	// RevCache is used to look up the revocations of the interfaces. If nil,
+	// revocations are not reported.
+	RevCache revcache.RevCache
+	// Authorize wraps the handlers of requests that shut down interfaces or
+	// bring them back up, and only calls them for authorized requests. If it
+	// is nil, the administrative state of the interfaces cannot be changed.
+	Authorize func(http.Handler) http.Handler
 
 	// nowProvider can be set during tests to control the current time.
 	nowProvider func() time.Time
@@ -841,6 +860,186 @@ func (s *Server) GetHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetInterfaces lists the interfaces of the AS.
+func (s *Server) GetInterfaces(w http.ResponseWriter, r *http.Request) {
+	if s.Interfaces == nil {
+		interfacesNotSupported(w)
+		return
+	}
+	all := s.Interfaces.All()
+	ifIDs := make([]uint16, 0, len(all))
+	for ifID := range all {
+		ifIDs = append(ifIDs, ifID)
+	}
+	sort.Slice(ifIDs, func(i, j int) bool { return ifIDs[i] < ifIDs[j] })
+
+	rep := InterfacesResponse{Interfaces: make([]Interface, 0, len(ifIDs))}
+	for _, ifID := range ifIDs {
+		intf, err := s.interfaceInfo(r.Context(), all[ifID])
+		if err != nil {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusInternalServerError,
+				Title:  "error getting interface state",
+				Type:   api.StringRef(api.InternalError),
+			})
+			return
+		}
+		rep.Interfaces = append(rep.Interfaces, intf)
+	}
+	writeJSON(w, rep)
+}
+
+// GetInterface gets the interface with the given ID.
+func (s *Server) GetInterface(w http.ResponseWriter, r *http.Request, interfaceId int) {
+	s.serveInterface(w, r, interfaceId, nil)
+}
+
+// ShutdownInterface administratively shuts down the interface for beaconing.
+func (s *Server) ShutdownInterface(w http.ResponseWriter, r *http.Request, interfaceId int) {
+	s.setShutdown(w, r, interfaceId, true)
+}
+
+// UnshutInterface reverts the administrative shut down of the interface.
+func (s *Server) UnshutInterface(w http.ResponseWriter, r *http.Request, interfaceId int) {
+	s.setShutdown(w, r, interfaceId, false)
+}
+
+// setShutdown changes the administrative state of the interface for
+// authorized requests.
+func (s *Server) setShutdown(
+	w http.ResponseWriter,
+	r *http.Request,
+	interfaceId int,
+	shutdown bool,
+) {
+
+	if s.Authorize == nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef("no shared secret for interface shutdowns configured"),
+			Status: http.StatusForbidden,
+			Title:  "interface shutdown disabled",
+			Type:   api.StringRef(api.Forbidden),
+		})
+		return
+	}
+	s.Authorize(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.serveInterface(w, r, interfaceId, func(intf *ifstate.Interface) {
+			intf.SetShutdown(shutdown)
+			msg := "Interface brought up through management API"
+			if shutdown {
+				msg = "Interface shut down through management API"
+			}
+			log.FromCtx(r.Context()).Info(msg, "interface_id", interfaceId)
+		})
+	})).ServeHTTP(w, r)
+}
+
+// serveInterface looks up the interface, applies the optional update to it,
+// and writes its state.
+func (s *Server) serveInterface(
+	w http.ResponseWriter,
+	r *http.Request,
+	interfaceId int,
+	update func(*ifstate.Interface),
+) {
+
+	if s.Interfaces == nil {
+		interfacesNotSupported(w)
+		return
+	}
+	var intf *ifstate.Interface
+	if interfaceId > 0 && interfaceId <= math.MaxUint16 {
+		intf = s.Interfaces.Get(uint16(interfaceId))
+	}
+	if intf == nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(fmt.Sprintf("interface %d does not exist", interfaceId)),
+			Status: http.StatusNotFound,
+			Title:  "interface not found",
+			Type:   api.StringRef(api.NotFound),
+		})
+		return
+	}
+	if update != nil {
+		update(intf)
+	}
+	rep, err := s.interfaceInfo(r.Context(), intf)
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "error getting interface state",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	writeJSON(w, rep)
+}
+
+func (s *Server) interfaceInfo(ctx context.Context, intf *ifstate.Interface) (Interface, error) {
+	info := intf.TopoInfo()
+	rep := Interface{
+		InterfaceId: int(info.ID),
+		Neighbor: InterfaceNeighbor{
+			InterfaceId: int(info.RemoteID),
+			IsdAs:       info.IA.String(),
+		},
+		Relationship:      LinkRelationship(strings.ToUpper(info.LinkType.String())),
+		ScionMtu:          int(info.MTU),
+		InternalInterface: info.InternalAddr.String(),
+		Shutdown:          intf.Shutdown(),
+	}
+	if t := intf.LastOriginate(); !t.IsZero() {
+		t = t.UTC()
+		rep.LastOriginate = &t
+	}
+	if t := intf.LastPropagate(); !t.IsZero() {
+		t = t.UTC()
+		rep.LastPropagate = &t
+	}
+	if s.RevCache == nil {
+		return rep, nil
+	}
+	rev, err := s.RevCache.Get(ctx, revcache.NewKey(s.IA, iface.ID(info.ID)))
+	if err != nil {
+		return Interface{}, serrors.Wrap("looking up revocation", err, "interface_id", info.ID)
+	}
+	if rev != nil {
+		rep.Revocation = &Revocation{
+			Timestamp:  rev.Timestamp().UTC(),
+			Expiration: rev.Expiration().UTC(),
+		}
+	}
+	return rep, nil
+}
+
+func interfacesNotSupported(w http.ResponseWriter) {
+	ErrorResponse(w, Problem{
+		Status: http.StatusNotImplemented,
+		Title:  "interfaces not supported",
+		Type:   api.StringRef(api.NotImplemented),
+	})
+}
+
+// writeJSON writes the indented JSON response.
+func writeJSON(w http.ResponseWriter, rep any) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(buf.Bytes())
+}
+
 func (s *Server) now() time.Time {
 	if s.nowProvider != nil {
 		return s.nowProvider()
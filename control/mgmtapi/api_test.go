@@ -15,12 +15,14 @@
 package mgmtapi_test
 
 import (
+	"context"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
 	"os"
 	"path/filepath"
 	"strings"
@@ -32,11 +34,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	beaconlib "github.com/scionproto/scion/control/beacon"
+	"github.com/scionproto/scion/control/ifstate"
 	api "github.com/scionproto/scion/control/mgmtapi"
 	"github.com/scionproto/scion/control/mgmtapi/mock_mgmtapi"
 	cstrust "github.com/scionproto/scion/control/trust"
 	"github.com/scionproto/scion/control/trust/mock_trust"
 	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/private/xtest"
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
@@ -44,7 +48,11 @@ import (
 	seg "github.com/scionproto/scion/pkg/segment"
 	"github.com/scionproto/scion/private/ca/renewal"
 	"github.com/scionproto/scion/private/ca/renewal/mock_renewal"
+	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
+	"github.com/scionproto/scion/private/revcache"
+	"github.com/scionproto/scion/private/revcache/mock_revcache"
 	"github.com/scionproto/scion/private/storage/beacon"
+	"github.com/scionproto/scion/private/topology"
 	"github.com/scionproto/scion/private/trust"
 )
 
@@ -878,6 +886,41 @@ func TestAPI(t *testing.T) {
 			TimestampOffset: 10 * time.Hour,
 			Status:          200,
 		},
+		"interfaces": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(interfaceServer(ctrl, nil))
+			},
+			RequestURL: "/interfaces",
+			Status:     200,
+		},
+		"interfaces revocation error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(interfaceServer(ctrl, serrors.New("internal")))
+			},
+			RequestURL: "/interfaces",
+			Status:     500,
+		},
+		"interfaces not supported": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{})
+			},
+			RequestURL: "/interfaces",
+			Status:     501,
+		},
+		"interface": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(interfaceServer(ctrl, nil))
+			},
+			RequestURL: "/interfaces/2",
+			Status:     200,
+		},
+		"interface not found": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(interfaceServer(ctrl, nil))
+			},
+			RequestURL: "/interfaces/42",
+			Status:     404,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -912,6 +955,94 @@ func TestAPI(t *testing.T) {
 	}
 }
 
+func TestInterfaceShutdown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	secret := func() ([]byte, error) {
+		return []byte("0123456789abcdef0123456789abcdef"), nil
+	}
+	token, err := (&jwtauth.JWTTokenSource{Subject: "operator", Generator: secret}).Token()
+	require.NoError(t, err)
+	s := interfaceServer(ctrl, nil)
+	authorized := true
+	serve := func(method, url string) int {
+		req, err := http.NewRequest(method, url, nil)
+		require.NoError(t, err)
+		if authorized {
+			req.Header.Set("Authorization", "Bearer "+token.String())
+		}
+		rr := httptest.NewRecorder()
+		api.Handler(s).ServeHTTP(rr, req)
+		return rr.Result().StatusCode
+	}
+
+	// Without a shared secret, the state cannot be changed.
+	assert.Equal(t, http.StatusForbidden, serve("PUT", "/interfaces/1/shutdown"))
+	assert.False(t, s.Interfaces.Get(1).Shutdown())
+
+	s.Authorize = (&jwtauth.HTTPVerifier{Generator: secret}).AddAuthorization
+	authorized = false
+	assert.Equal(t, http.StatusInternalServerError, serve("PUT", "/interfaces/1/shutdown"))
+	assert.False(t, s.Interfaces.Get(1).Shutdown())
+
+	authorized = true
+	assert.Equal(t, http.StatusOK, serve("PUT", "/interfaces/1/shutdown"))
+	assert.True(t, s.Interfaces.Get(1).Shutdown())
+	assert.True(t, s.Interfaces.Get(2).Shutdown())
+	assert.Equal(t, http.StatusOK, serve("DELETE", "/interfaces/2/shutdown"))
+	assert.True(t, s.Interfaces.Get(1).Shutdown())
+	assert.False(t, s.Interfaces.Get(2).Shutdown())
+	assert.Equal(t, http.StatusNotFound, serve("PUT", "/interfaces/42/shutdown"))
+	assert.Equal(t, http.StatusNotFound, serve("DELETE", "/interfaces/0/shutdown"))
+}
+
+func interfaceServer(ctrl *gomock.Controller, revErr error) *api.Server {
+	revCache := mock_revcache.NewMockRevCache(ctrl)
+	revCache.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, key revcache.Key) (*path_mgmt.RevInfo, error) {
+			if revErr != nil || key.IfID != 2 {
+				return nil, revErr
+			}
+			return &path_mgmt.RevInfo{
+				IfID:         2,
+				RawIsdas:     key.IA,
+				RawTimestamp: 1609488000,
+				RawTTL:       10,
+			}, nil
+		},
+	).AnyTimes()
+	return &api.Server{
+		IA:         addr.MustParseIA("1-ff00:0:110"),
+		Interfaces: createInterfaces(),
+		RevCache:   revCache,
+	}
+}
+
+func createInterfaces() *ifstate.Interfaces {
+	intfs := ifstate.NewInterfaces(map[uint16]ifstate.InterfaceInfo{
+		1: {
+			ID:           1,
+			IA:           addr.MustParseIA("1-ff00:0:111"),
+			LinkType:     topology.Child,
+			InternalAddr: netip.MustParseAddrPort("192.168.0.1:30042"),
+			RemoteID:     5,
+			MTU:          1472,
+		},
+		2: {
+			ID:           2,
+			IA:           addr.MustParseIA("1-ff00:0:120"),
+			LinkType:     topology.Core,
+			InternalAddr: netip.MustParseAddrPort("192.168.0.2:30042"),
+			RemoteID:     7,
+			MTU:          1400,
+		},
+	}, ifstate.Config{})
+	intfs.Get(1).Originate(time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC))
+	intfs.Get(2).Propagate(time.Date(2021, 1, 1, 8, 0, 1, 0, time.UTC))
+	intfs.Get(2).SetShutdown(true)
+	return intfs
+}
+
 func createBeacons(t *testing.T) []beacon.Beacon {
 	return []beacon.Beacon{
 		{
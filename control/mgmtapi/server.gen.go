@@ -4,6 +4,7 @@
 package mgmtapi
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -46,6 +47,18 @@ type ServerInterface interface {
 	// Basic information page about the control service process.
 	// (GET /info)
 	GetInfo(w http.ResponseWriter, r *http.Request)
+	// List the SCION interfaces
+	// (GET /interfaces)
+	GetInterfaces(w http.ResponseWriter, r *http.Request)
+	// Get the SCION interface
+	// (GET /interfaces/{interface-id})
+	GetInterface(w http.ResponseWriter, r *http.Request, interfaceId int)
+	// Bring the SCION interface back up
+	// (DELETE /interfaces/{interface-id}/shutdown)
+	UnshutInterface(w http.ResponseWriter, r *http.Request, interfaceId int)
+	// Shut down the SCION interface
+	// (PUT /interfaces/{interface-id}/shutdown)
+	ShutdownInterface(w http.ResponseWriter, r *http.Request, interfaceId int)
 	// Get logging level
 	// (GET /log/level)
 	GetLogLevel(w http.ResponseWriter, r *http.Request)
@@ -154,6 +167,30 @@ func (_ Unimplemented) GetInfo(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List the SCION interfaces
+// (GET /interfaces)
+func (_ Unimplemented) GetInterfaces(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the SCION interface
+// (GET /interfaces/{interface-id})
+func (_ Unimplemented) GetInterface(w http.ResponseWriter, r *http.Request, interfaceId int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Bring the SCION interface back up
+// (DELETE /interfaces/{interface-id}/shutdown)
+func (_ Unimplemented) UnshutInterface(w http.ResponseWriter, r *http.Request, interfaceId int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Shut down the SCION interface
+// (PUT /interfaces/{interface-id}/shutdown)
+func (_ Unimplemented) ShutdownInterface(w http.ResponseWriter, r *http.Request, interfaceId int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get logging level
 // (GET /log/level)
 func (_ Unimplemented) GetLogLevel(w http.ResponseWriter, r *http.Request) {
@@ -545,6 +582,103 @@ func (siw *ServerInterfaceWrapper) GetInfo(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetInterfaces operation middleware
+func (siw *ServerInterfaceWrapper) GetInterfaces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInterfaces(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetInterface operation middleware
+func (siw *ServerInterfaceWrapper) GetInterface(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "interface-id" -------------
+	var interfaceId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "interface-id", chi.URLParam(r, "interface-id"), &interfaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "interface-id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInterface(w, r, interfaceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UnshutInterface operation middleware
+func (siw *ServerInterfaceWrapper) UnshutInterface(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "interface-id" -------------
+	var interfaceId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "interface-id", chi.URLParam(r, "interface-id"), &interfaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "interface-id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnshutInterface(w, r, interfaceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ShutdownInterface operation middleware
+func (siw *ServerInterfaceWrapper) ShutdownInterface(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "interface-id" -------------
+	var interfaceId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "interface-id", chi.URLParam(r, "interface-id"), &interfaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "interface-id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ShutdownInterface(w, r, interfaceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetLogLevel operation middleware
 func (siw *ServerInterfaceWrapper) GetLogLevel(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1004,6 +1138,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/info", wrapper.GetInfo)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/interfaces", wrapper.GetInterfaces)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/interfaces/{interface-id}", wrapper.GetInterface)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/interfaces/{interface-id}/shutdown", wrapper.UnshutInterface)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/interfaces/{interface-id}/shutdown", wrapper.ShutdownInterface)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/log/level", wrapper.GetLogLevel)
 	})
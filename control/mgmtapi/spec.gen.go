@@ -18,85 +18,102 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x8bXPbNrbwX8Fw90M7K8myY29rzTwfFNlp9WyTeGx1d6ZNrgORRyIaCGAB0Laur/77",
-	"HbyQAklQouwkze5tJh8sCjw472840GMU81XGGTAlo9FjJEBmnEkwH17i5Bp+z0Eq/SnmTAEzf+IsoyTG",
-	"inB29JvkTD+TcQorrP/6q4BFNIr+crQFfWS/lUc3CrMEi+RSCC6izWbTixKQsSCZBhaN9J5IuE03vWjK",
-	"FAiG6ZdDoNgR3YC4A4GKhT23geUM4Njuiil9u4hGv+7ZFZYrjfqm9xhlgmcgFLE8JmwpQMpbordd4Bj0",
-	"wzpGZgkqlyC+QCoFNDdYDKJepNYZRKNIr1iC0IzLJV7aHXbhZen42a7VNGrWEwFJNPq1ANEL4Pi+3JLP",
-	"f4NYRRv9hCiqH91Mpm/foAyrtC8t3SjmTCqRx5oih7ZG0m7/A6hrp3b/38myyqN5ye39tDSocC83MS62",
-	"N9Rr4MDylaE7uxWwJFIJo2BRL0r4Pas/i7mA+jONNl7aTx5DxpTye0iQ3Q8ZvnpSk0oQtqwhZJVDweoQ",
-	"GWoYxaY/Eam0omC3+dzbXHq7YyHwOupFOSO/5zC1OyqRw6YXTcZNYcQg1O0dpiQhar0Pt38W6za9KOOU",
-	"xHvfuLKrtLnlVlD7DDov5eneuP0I61uSdHzxH7CeXjS0pti8AbSko1fjREjBJpptC+2ooMnIhEhF2DIn",
-	"MoXkluGVWdPQCSKTW7xXCaYyGcs6DzBdcqPYD3iVGaW4nFzcjEOa9xzW9aLD1aHG7gAvSso98AHyGqh7",
-	"duexH/kuNSSpFJOA5yFS5iD2keWLubviVt5qVT+HQQtVsUa7E20vBdGoNAjcK2vzthVzN27UVbHz+mdr",
-	"kTHPBus8wB4XDT9Q/CReTi+qVrXAZy/w8BRHvWjBxQqraBSl8NB35rVLdNMEmH5k8GxY5SSF+GPAc2CF",
-	"94sN4o8XeqHJcBQmtJlZjJOE6D8xRYRZ1IlNKLbEhfAqnFUV2hu8MqlJCpiqFMUagyosIwgkyZKBQPgO",
-	"E4rnFEI7CMAuFajucW2eowUXFj5aYEJzAftxlgqrXHZID/WqumY5j+Rg9KwEPG360ZI8KUgO6E0hDp0z",
-	"lmy/8uSqY+4W4isBoMlcoe1qpLc1tOvsr87mxp4WqUAE12/IJm+LjMEHbDKFTmmI1dVNLa94LuNLjjuk",
-	"/TQzX62wWHsY28UIs8RDvoUtRcbZZE9asm0Xvo65dXzdyz6aIO5IXIqrZmdN7HgW8NJ+cVCq+elJKPE/",
-	"KF+oO9Ai4lYzfUfJFdY8dhl9yrMQ+hZuxTse9xeL4XA0HB0fD3X6hJUusKJR9F/v3iV/63/zK+4vhv3z",
-	"94/HvdPN6NvHk0310bf/o9f91XOj05uL/vhmj+/8iS9/gjugTW7S4nFN/flySdgS2a97ZTmQwDxfGp4s",
-	"dLoBph5877sb900NhRpvLdhQlnhVJsZ1O8WE3VKyAEVWVdFH352kw9VQ7t21BiO4veBzCqtAmGmLGijN",
-	"V5ghATjR/hvBQ0YxMzqNZAaxDnFIcaRSIhGP41wIYNuyNbMbIpVihYhEKdBskVP9BuUmNvqrtDUvyR0g",
-	"nBg74gyl/F4vzgSPAZIB+pcgSgFDhKFLtqREpuatEj/tMYEtCQMQsodymWNK14hxhWROFCRmBeMMKYhT",
-	"RmJMtS/5CCmnCQjrUfRqjR4l/w1JNdxMOGNga1vFjZOeYwlIczxBPFch9SRMKsxC5f4Y/Xw9RQIWYLlm",
-	"2VToujTMKbncyt0egsFygOZrEz/YEmG0ENjabglMIC6QzOd9XaxbiXniWWcwQK/xGs0B5RKSmoAE58pu",
-	"SmT5EmEWP56LGFDMk1pkPnILj+KSZ31jUX9R/COwvjalvhZc33Cvb7lXZlW5IP2SM7ujfJWpsxTQj7PZ",
-	"VREjNGZoCQwE1vKfrw3aXJAlYUjazo8NtLtUuELb2fBFL1rhB7LSfuPs/LwXrQizn46Hw5Cvdg6tqQEy",
-	"5UIrZxnhmoL5o5W+iGs/s52JnH2gKVzgnGoZ4jnP1WhOMfsY9brovu1M0HXdCHx+IM70Aqt9plH4oDy+",
-	"3ZEEEjS+mg7Q2yzjTpl9S7LeizB0/WrS/+774Xc9RIx3YkBUCgIJiPlqBSyx7851SVkgahiu+ZVxwpT+",
-	"Glsf2S/FkfA418Zn92FcoCXlcyMSS1+Z11XE3M14DjCRtvzKqmIoPhS9y0Z8gIeMuNbX6HGLQIIVGOsN",
-	"qUPKs+6dLZ0LBRLKDv0Ji7KtWimW6jbPNFpJd0T1c6nwKuv6SqgW3QLp+dyq4eS4EuyglvnWnrrUUdxS",
-	"5QNLbg/sIx3KZGBLmzTXkirzvLBER0xFq49DjlEqLNTts1LZJKqB6flsKDFutASezPtGV2B+epacniZ7",
-	"uwLu/T357I2pmpuyxfI2rrYZD2hVVU24Kjq7IdouQWRlXed87boX2uXNrieoaLBU3dXJ8OSkPzzuD09n",
-	"w/PR2fnoxYtffGbstj8Rd2hEzq4nVv/Mcna7FDiG2wwE4UkgCbie2EQGS6RELpXNYYjUft+8iuyrPUOZ",
-	"1liKFUhliIwxY1y9Y0U2VAEyeOepxpxzCrh5FFFxATW5lRSHafH7f5wpwSnSOTcUzRSvrAyqaOXUq+kf",
-	"isdVfpnVaAXSnC3s83hlYRTa3SVlRU2VYSmtESSwFDgxXnCBCdUPK7XVdmWt1+ISudKzmGwkeKpys+1D",
-	"1ru7zy6Vg+T63fGKS/j+HL08R6fnaHKCTl7p/+cTdHGBhhfoZIzOvkPjc3Rxib6/NF+doVcv0PAcHQ/R",
-	"xbFvODLDMST9qjOpUz27ngScRa5SLojOQu7gFssDjpnKyFAPx+Yg7NOAqqhf6Cyku0P4NM1k7+RhS2Yv",
-	"xMYq8p65atexJ4DMridPbs87gpvINwJbN0SsytbOX7GEW5av5jb+7A7dRCYdulQSBME0BPRFc3nT9KJe",
-	"Bak6vBr7Q4HVI5pnnPLlem9ntv7iPz0VqzKMcXWLF6pG2fMCooY5hwUX0AB6/ESg9Sb3doeeR4LHzIJi",
-	"Fyab3NxsXJ+sWdNeTcsKx6ZYRRxzhWTUjHBFiTm+mmpbBCEtrOFgODjWPOEZMJyRaBS9GAwHJ7a7mBoR",
-	"HNnzbvP3ElRLt3uLjVtuK04sAH1k/J4VVWLsMCrCDJqlgATInCqpEwNdDi4IVSC2zQSTfKLxTQ+RxgCH",
-	"Ti/MSXxtlAO9XCNXKfcQphTlzCQN5fm9NLgJULlgkGg8iERzSPEd4aLAJE4xW0KC7olKDfQPmNIPZtMP",
-	"xqPdYvUBZVjgFSgQpk2u1dekD9MkGkU/gHrp+Kd5Wiw0cy61LNFQ6TqyfFGgaTmEk8QQrvEiLKZ5Auie",
-	"0CTGIpHom+G3aM5VWurF9ObCIDm+8VpU1Zyy1kwmGoXfcxDaQ9tTqXrS320sqAzydfpe2xZOOUZh5ycK",
-	"sRWC2JL9ltF1U5mKt3XOTKl51QFyLQuqtfGeUKrlV4rXJ73bXMr7ME/KUZ5u3KiPBe2fSCJVZE/CaDQH",
-	"iXyMyt7Z38/OXpx53bNhKCQ0kvui1kZYofuUxGlDOkYUxgAGaLpAOZNgXIDrGpken0LaYWp/qesCneg7",
-	"IzMNphRLhBmCxQJihcjCWNb/W2Aq4UOj+DnuHx/3T85mxyejk+HobDg4O/mlRWcLq6zwo5sLb8rG2llB",
-	"s4AlFgnV4uILv5ozx2QC7AcNfdCCHKa0glfZyjN0h6qeOk7/SsH00BRHArQfB9clFgpxkYBA32AZAzON",
-	"6nnpAr9tw0hDfyZKY6UEmecK9H6Fulh/rrVEo2ZFbzQmB/TB9ysfbI9SFvHB+T+/sW4dxIIIaQ7LqtpR",
-	"qQSDTowLFaaw3jsqSqoKSL/xVPOHtdd3zfaVSva+Vx0MPRkODxrIDI3zHTrg1qwXNsH0I3ymvcIqTrV2",
-	"VaL9QAM9tcSEMCiJPvJGYc0oqO3Mt6YRWgR4Kf35Q/1akZQcPbrWUp8kGytdCipwEnBhnjfgbyP7ktwB",
-	"KxtV04tmKLcgHA/3BPPZtkeHphfV3KRs3mnXqR8TluXKGQeR9shC23iKGcIemKKRrgknicmQMMoELMiD",
-	"8UE6IJbi8T21ZUrhf603XuTUpAvmO/8FXQ4kiBtXTgSi7jBXb2+tm9hDg+MTNF8rKBBwJOJY5Zj6fDT9",
-	"HHjIKE+gdCvGUnWK6RlqKcjIz6ZtydBxJNlvpEq1Nh5CEuMqAqZ32lQTN1jqGIZkHscg5SKndP00Fe9F",
-	"Z11eKaezqzbRorUho+iFk/MfbGD261Uzy7o9+PQB78hf/yCNn+fK6nR5VOVrW3VDeMCxomvEWbFxr0hK",
-	"TG5vUCGylhV+hYo5/GRD+uG58IB7rzjFymjNsx17oYKVLWr9k64u/mhO+by1Eg3upN/QxcHV5WsELOY6",
-	"Ndqh5y/1Bg1d/7dTk4d+Bqv+gtBak6Ov/728/GH6Bl2NZz+im8sfXl++mZnH75hhnOXDYDB4x8zjyzcX",
-	"obXRHiUykvo8yjO3MgpqTYw99WjIeIKjz2htk3HQtMoggt4W+DyfMdOtjSIzCGDYNBkPPMbEWfaRFHzZ",
-	"HpF0aOW4Eo6udUAnd9Cc721p8LxjOzo8oQaPTfgH6FUudGWz4gJ675h24XpxhqXUSQ4WisQ5xcINBhBb",
-	"aG0rVIP0Fsd3zCFZFqoISxt2BmiMXDlT4FPONZiySgcHnUu9Yz7PerX6z2ZHtn2nP99h4o7uTMLT1Dyf",
-	"/w3/Eqzxn9x4+eSFcZditlEpPjeudRyWLUfym2VNaxHT1GbPIFsQdCMjfzvMJRQzgcH7cVYxxe5yKIDr",
-	"fgs/ejRLi6poZ7Rsju7rugC7isjN6e/X6halrgbJAqsnh8jyEsVnTZvsJZaAzBr3Dr46vWmV6mFa0y3R",
-	"aqqOybDsmb5OuHSFKG0K9iSlCmdjX5NidUi0JpfXs+mr6WQ8u3S50/jGV6RqqtVcvRPUZHwIqKiDStcz",
-	"t69cr+vZYEW5OVuQ5c6E0K7YK3IFD+ooo+5uWyPqlcHyC2V/V4IwZSvi2dvXPyFLaO4a0lobK3kgX63K",
-	"BHl7KyNo2lcCpC6pvYsx1ckQhClny23jDB4gzhUkzdsuDWa7qx6f0XHXrqSE5LHjFsknSMoTUo51y8pO",
-	"vjyKuy1GHsUpb5uGTu2diH8v/XyJJYl95qIML8ErVGpVgr2CIGWr1lK+PCqvm7Sxqryp8hk1rNzji/FS",
-	"ez5au1LT4FEvyvIAU25qTDHwX/Jk/UX4UVwE8vffRubNf5SUbrpISWuy6xN1nqfwB2jbpioOn6bALEFg",
-	"JwRqI8VoymQGsXKN2oTckcRr6UuXyOlCHdmLTZCgOwL3QZd/U1B74PRDaMD5y88szECsCMMU7UDqpEDq",
-	"pBWpyrj0YSh9kSK6MvN+QBldOwusaOrg662oA9h6xuoe1az16QeNlQH4g48bizsjTzp98bf+vKeNVSfV",
-	"+cyx+tr/6ZPH4G0Jw8KvwZDKY8wvh0Hrj0e1n4763Ata9DMPSSv2tCPa/QecHx34W2DFr3K1nQlV9Lql",
-	"5vq6+gz7by91jxeHnFpWdmztpu3Svj9PMK+wSou7YE8/x6xI4qvuibXh26qk5Q24tkra3ZH7nC7D7vCl",
-	"O2YkeGw6vkF+G7S4o6/55Hcr+vammLvH1XbSarn71Aa66ZVX7b6lTW45OHG9/T9b1p9u2OCgHrPybr20",
-	"mVN5M+YzGlS5xx/RhHYUlOP84xtU8GV3N1qJuEMnxF0etX5uZu6KXnOu0MRve9vOBOA4NfcfDr5/0jKd",
-	"MEBvM3uTia7tVZLZ9aTsrjjHbG4kSAXYzAKYCXcPb84g3BCfaeq7hermcECll1DW+YHfxmj8jJQNy9oR",
-	"Rl/36X55n++ApoTbdk7BCOpTTiZreG1eQMTyiMjkkchk058/6lp205eP9jrdpmPy16baLRFgJuJOh6NW",
-	"Wdozup1XDEsNqcLUBHYDetwZpmVWN6ih242fs8SZXU9CWje7nnzCEUm9yZP065AKo03JiiqjSD5Mj8UU",
-	"G63a1/l4/k8NfGIiNrueuDzol9/G929/G//99ezyflrLmraroqCKfuL8qIQY0FV7Jfmu0IVc0GgUpUpl",
-	"o6Ojx5RLtRk9ZlyojbkULoh21PbXArlUtfs5PMbUPDY/eixqX78Ynp6daJt8X6LR+N2FOxBrZTqUAqj5",
-	"gSrFw93qehUcNU8DdkGbXF39Y4pWWBkF8sBZxjSBTUwWhMZXUwQP5a+BWGAuOfGxcklTACmWmJFI6ePk",
-	"Hd5vf90hANUdRG/eb/43AAD//y7RBnW/XgAA",
+	"H4sIAAAAAAAC/+w9a3PbuLV/BcP2w+5UkmUn7m58535QZGdX7Sbx2Ep3pptcByKPJKwpgAVAx7q++u93",
+	"DgBSIAlKlPNo2mZnP8Q0HueN8wL8EMVilQkOXKvo7CGSoDLBFZgfntPkCv6Rg9L4Uyy4Bm7+SbMsZTHV",
+	"TPCj35Xg+E3FS1hR/NcfJcyjs+gPR9ulj+xv1dG1pjyhMrmQUshos9n0ogRULFmGi0VnuCeRbtNNL5pw",
+	"DZLT9MsBUOxIrkHegSTFwJ7bwFIGaGx3pWn6eh6d/bZnV1isEPRN7yHKpMhAamZpzPhCglI3DLed0xjw",
+	"Yx0iM4SUQ4iYE70EMjNQDKJepNcZRGcRjliARMLlii7sDrvgsni8sWMRRyQ9k5BEZ78VS/QCML4rtxSz",
+	"3yHW0Qa/MJ3ip+vx5PUrklG97CuLN4kFV1rmMWLkwEYg7fY/gb5yYvcXx8sqjWYltffj0sDCTW5C3Is8",
+	"7HFx4PnK4J3dSFgwpaURsKgXJeIDr3+LhYT6NwSbLuxPHkFGaSo+QELsfsTQ1eOa0pLxRQ0gKxwaVofw",
+	"MNpsN/2FKY2CQt3mM29z5e1OpaTrqBflnP0jh4ndUcscNr1oPGoyIwapb+5oyhKm1/tg+1sxbtOLMpGy",
+	"eO+MSzsK1S23jNqn0HnJTzfj5hbWNyzpOPGvsJ6cN6Sm2LyxaIlHr0aJkICNkWxzNFTQJGTClGZ8kTO1",
+	"hOSG05UZ05AJppIbulcIJioZqToNaLoQOBHu6SozQnExPr8ehSTvY0jXiw4Xhxq5A7QoMfeWD6DXAN3T",
+	"O4/8xDepIU4tKQtYHqZUDnIfWj6buwtuZVar+DkIWrCKEexOuD2XDOYBBPfy2sy2bO5Gjboodh7/0VJk",
+	"1LNBOm9hj4qGHiR+FC0n51WtmtPTJ3T4lEa9aC7kiuroLFrCfd+p1y7WTRLg+AnkdretVo6XEN8GLAfV",
+	"dD/bIL49x4HGw9GUpU3PYpQkDP9JU8K4BZ1Zh2KLXAiuwlhVV3tFV8Y1WQJN9ZLECEF1LcMIotiCgyT0",
+	"jrKUzlII7SCBOleguseV+U7mQtr1yZyyNJewH2alqc5VB/cQR9Uly1kkt0bPcsCTpp8tyuMC5YDcFOxA",
+	"n7Ek+6XHVzxztyu+kACI5opsRxPc1uCO3l+dzI09LVCBExxnqCZtC4/BX9h4Cp3cECurm5pf8bGELynu",
+	"gPbdzHy1onLtQWwHE8oTD/gWshQeZ5M8y5Jsu+B1xK3D6yb7YIK8Y3HJrpqeNaETWcBK+8FBKeZPT0KO",
+	"/0H+Qt2AFidu1dN3mFxSpLHz6JciC4E/8SFtQcI5GFXhs0FDOYaw0jBWVPtJEGUXsu0KoqZLIMU4VCkT",
+	"Ubk4SopcAyoV1UR84IroYjCuVdk/On52Mjj+84+Dk8HJ2ZPj4XAYsjUpVfpGSLZgnOoWYDRbFRGcIh9A",
+	"AsFZpJyVEMFrgJCJJkwRLjRRoAmbEy6qS3izFeMxmAUwbpYiJcpJotJUakgG/nGVUA19BKkVnSK2OQyd",
+	"ctaj0PFmf0J0OLDFcib2OzIFpK+KCUZdUqO8asmyfQv8wvjtlT/ezL8TNnGxb/bVdqRJPjDBb1Y632tI",
+	"ceDL6RszaZlrjF2bLPt1CXoJssoR5AZNVoy7mPYO0jXBNQguYg4eyx7GFxWtmNNUQUnpmRAp0GYkXjEA",
+	"HhtqRPVxDeq2h1cj69DIkYyuBzvt1CtPGD6NvSLMCnqBH+MLB0VJr9PPb7hrodArD5g66MCTTDCuC5Kl",
+	"jN/uJppqPz3LZbsnMMplm95DmwRVHIECGJLaKNIgUUMy7ApYYlZ8+eP+fD4cng3Pjo/RtGdUo/xFZ9H/",
+	"vH2b/Kn/3W+0Px/2n717OO493Zx9/3CyqX76/v9w3B89p39yfd4fXe/x9BumwstHjV9fXUS9aPzz5Jfz",
+	"qBddjq4uXk3xHxcXV0iHLfDFkObyYvEL3EHa5FdafK75gmKxQFLaX/dKWBKY5QsjZ3OBn01ytAKD+00N",
+	"hBoj7bKhlMllmSWqO62U8ZuUzcGY9QrPfjhZDldDtXfX2hrB7aWYpbAKxFxtIRRZ5ivKiQSaYDBD4D5L",
+	"KTeMJCqDGOM9ogXRS6aIiONcSuBb+5TZDa3zwRRZQprN8xRnpMIEiv4odG0X7A4ITczZJzhZig84OJMi",
+	"BkgG5FfJtAaOVuiCL1KmlmZWCR9aceALxgGk6pFc5TRN1/YUzhketTiC42kN8ZKzmKZ4xN7CUqQJSOte",
+	"42gEL2X/a09eTwYF52ATvVqYiGVGlfUPEiJyHRJPxpWmPOS2jcibqwmRMAdLNUumQpWsp1ZSuZW6PQKD",
+	"xYDM1iaY4gtCyVxS68h6hltIovJZHzPXlmMee9YZDMhLuiYzILmCpMYgKYSzn0yVk9xBoEQuY/Rbkpov",
+	"eeQGHsUlzfpGo/6gxS3wPqpSHxlnXJmkb6lXOjm5ZP2SMrtD3qa/9vN0elkETAgZWQAHaVyt2dqAbX1J",
+	"42aBdFHnLhGunnDDJ71oRe/ZCu3G6bNnvQj9CvPT8XAYOv+cvWxKgFoKicJZhntNxvyzhb4I8t7wnVkN",
+	"+wExnNM8RR7Smcj12Syl/DbqdZF9m6ZP13Ul8OlBBE/XhfSZqtm99uh2xxJIyOhyMiCvs0w4YfY1yVov",
+	"xsnVi3H/hx+HP/QIs746MOM2SojFagU8sXNnQBIoADUER3pZp0ILQq2N7JfsSESco/LZfbiQZJGKmWGJ",
+	"xa9MclTY3E15DlCRtmSDFcXQ+XBVcd+rRwTcZ0yWv6upHIZHVJMPSxYvrcUoVyJmonVRukUw+F1pusoO",
+	"3OgDVcRkRTtHSzUKbTfu+ehWnLFyNzEPeWJBR6wMWoK2ytkRoiXlasWUwtVzjiLJyWytQRl5KeqO8S1o",
+	"NSBTtFYSMgkKuC61RItYuEyaWeK7y/M331dhTekapBFhpkpbEdM0zlNjIKkqQbpAdeCgSUbXqaAJ6ZPJ",
+	"JfkZaAKS9Mmb8+KHivAeP/0hmLspysR7RKubkCxF1t0Hx7RTIHfXoRRkQbYFApMoyDMEK+kOaEWaHyGU",
+	"JppskcwaTI4qwWJ1mdraUwJwGLcUVIAnNweW7A4lMvCFzU/WXHbzvZBkh0xV7EIyZ9InNx8VfCZRbZme",
+	"T4YS4kb15dG0bxRgZk9Pk6dPk70FGDd/TzB2bQoUTd5SdRNXK7oHVAV3nQ52Q7IdQtjKHsyztSsUoTWa",
+	"Xo1JUcuqHoYnw5OT/vC4P3w6HT47O3129uTJ37ufJjLuUPOdXo0n5+VwfrOQmGXIQDIRSI8gqMZNpopo",
+	"mSttPWSm0GSbqcRO7RnMTNqBalDaIBlTzoV+y2cQWGTwlkd7c00VE1DjW4lxGBe/1OpSjRjRQVG38jL4",
+	"QRGtNBg17UPxuUovM5qsQJk2jn0Wrwy7Q7s7l7+I2DOqlFWCBBaSJsYKYtUMP1Yi9+3IWlnLhQmlZTG+",
+	"brCB5Xpb8q0X0j86uRVE129EqJiEH5+R58/I02dkfEJOXuD/z8bk/JwMz8nJiJz+QEbPyPkF+fHC/OqU",
+	"vHhChs/I8ZCcH/uKozIaQ9KvGpM61tOrccBY5HopJNMmj3pD1SEJseJkqB/Hpufo0yxVEb9Q20l3g/Bp",
+	"6vblKj6avRAZq8B76oqmY88BMr0aP7oTwiHcBL5xsHUDZHLehAJzJTc8X81AVuT5uCVj3KEgqEAymoYW",
+	"DRTTmqoX9SpA1derkT90sHpIi0ykYrHeWwSvT/ybJ2JVgnGhb+hc1zD7uAMR15zBXEhoLHr8yEVrdPV2",
+	"6HkoeMQsMHbHZJOahrNxLpleX6OAQtGYKkGOcusgzsxPLwr4/vLrNHJtrObsNL/drrzUOrMdsSaH28zE",
+	"XE7KuNy6bsX56NIfUfPkdL/BbAPqOEhl1xoOhoNjpLXIgNOMRWfRk8FwcGJT7kuDy5GrBeK/F6BbGha2",
+	"0LjhNk9CJZBbjmUrLco8iFc2xPgQiASVp1qhw4FJjDlLNchtCsw4tWR03SOs0YOLbotppqx145Lna+Ly",
+	"Oz1sviQ5t/F9UgKIsEnQueSQuDh1Bkt6x4QsIImXlC8gIR+YtlH8e5qm782m742lvKH6PcmopCvQIE3u",
+	"ANXCuCWTJDqLfgL93NGvF20HmlblmvdpsHRlCjEvwLQUKkrlCBfjcZonQD6wNImpTBT5bvg9mQm9LOVi",
+	"cn1ugBxdt1Xw6xUWhiD8IweJlt82FtWDiW6d3aXzUMfvpU08lp2whmvb4r9jxBbt15g9awhTMRt98TQ1",
+	"U91CLtGG1SckTUpm21UrqHdrLX4XpknZjd2NGvXO7v1N5awK7EkYjGYvuA9RmfH98+npk1Mv5zsMHTWh",
+	"3JWJ4bcJrDp3DCuMAgzIZE5yrsCYAJfrNJlpjdkdU+Kx+ZtSyUxadEkVoZzAfA6xaTpAzfpvU8F+3wiq",
+	"jvvHx/2T0+nxydnJ8Ox0ODg9+XuLzBZaWaFHt6OhyRurZwXOEhZUJqnrWPGiRNPpJMH+gKsPWoCjaVqB",
+	"q0xAt1buWxsGBCYUQSpwtQ2piZCY7fqOqhi4Ka/MShP4fRtEuPpHgjTSWrJZrgH3K8TF2nMqLWiW9UZi",
+	"ciDvfbvy3mbWVXE+OPvnl4OsgZgzqUy/U1U6KhFm0IgJqcMY1nNSRahWWdJPaNXsYW36rusZpZC961Xv",
+	"9pwMhwfdqQndyDj0jkKwvl93aza90Ckv5mRFdbzc9i8U5hoXfToctkFQIn3k3WbamO5kU09qdSOQBXSh",
+	"/CskOK1wSo4eXMqqz5KN5W4Koeaoc/O9sf72ZMd6Li8TYJPz5lFul3A03HOYT7e5PzI5r/omxS+M6dSm",
+	"8yfLtVMOpmyhzTbCcUK9ZYryDyLOEuMhUZJJmLN7Y4PwQCzZ41tqS5TC/lprjNVtdBfM7/wJGGYUbWJM",
+	"ktS1IOD2VruZTeIfn5ikfwGAQ5HGOqepB7TNE2E5XiTbHiWjqehieopaMjLyvXQbinS8VeYnaJVeGwuh",
+	"mDEVAdV72hQTy92CYETlcQxKzfM0XT9OxHvRaZcp5QW7qk60SG1IKXph5/wnezD7cTCyim7L9f7CO/zX",
+	"f5LEz3JtZbossPrSVt0Q7mms0zURvNi4VzglTLkvhKnSmxl8rYI5/GT3LMNX+wLmvWIUK93RH23YCxGs",
+	"bFHLy3Q18UezVMxaI9HgTjgDg4PLi5cEeCwS1zbZIufPcYOGrP/Licl9P4NVf87SWvKkj/89v/hp8opc",
+	"jqY/k+uLn15evJqaz2+5IZylw2AweMvN54tX56Gx0R4hMpz6PMIzszwKSk1MPfFo8HhMo8+obeNRULXK",
+	"Q4S8LuD5eMJMtjpKTPuKIdN4NPAIE2fZLSvosi29dEjluBAuXeOBjp1ujStaLQmet3xHhieU4LEO/4C8",
+	"yCVGNishofeWownHwRlVCp0cKjXDur907SzMBlrVFgsPxrfcAVkGqli9MsfOgIyIC2cKeMpuHC3c4YC+",
+	"1Fvu06xXi/+sd2TTgvgzNhzZkqBxeJqS59O/YV+CMf6jEy+fPDDuEsw2IsWPPdc63ncqb1U2w5rWIKYp",
+	"zZ5CtgDoGp3+dJhJKDpZg08cWMGUu8OhAKz7NfzowQwtoqKdp2VjAxMXUBcRuauW+6W6Rairh2QB1aOP",
+	"yPIe7Gd1m8wuIZ41ro5+dXLTytXDpKabo9UUHeNh2V4BdLhsWxiu9TihCntjX5NgdXC0xhdX08mLyXg0",
+	"vXC+0+jaF6Sqq9UcvXOp8eiQpaIOIl333L5yua57gxXhFnzOFjsdQjtiL8s13OujLHXPEzROvfKw/ELe",
+	"36VkRTPl9PXLX4hFNLfLo38FFT9QrFalg7y9WBtU7UvbqOnfba52nBCaCr7YJs7gHuJcQ9K8sNwgtrut",
+	"+xkNd+1WcYgfOy4CfwKnPGHlZQRV2cnnR3E92fCjqPK2SSg6+v9y8vmcKhb7xCUZ1mi3gUr9+qi5OKNU",
+	"q9RWL7R1qUNvZ2wvIeKxtKsc3duKtdJUFxddFJGQwh3lunn7MsCvEtLPKOiBS4A7/N3mNbxtYvJLmXf3",
+	"kJdpmhvszv0zn4SFOHh1lZpEHD2U/+7k8FrWhrKglfb4ds7uLeV3vkUf8GR8XHZ6M61V3kCX0mf1lrd0",
+	"aU0seoQ1tuXpl5S8aeV2dSLA3naHe6b016YJ1XyXX94/WA+OqvfOw3WxK6wi2y2rd869G+ctN0jcQ2pY",
+	"HKo/KmDyPStQW3PKzeUn9y5CUTT3HmobkCsr6Jju+cuvU2Lbooi5gvdf2CAQOjWwi4ALopYUs0kKYgnW",
+	"RDNdukSQmCpA4VZaa45VTkiaWv6GI9rfFP0Rij5tioh9V4KgvwhyK1DOBjz5kjo3xk6u4lZ6XdLNecAU",
+	"SZjCi3HJNyMVNFKuz9Hogd/h+Nu7zTvfhj2XLdf/yYzGtyTPWqxZL8pyHXoeq/UtjNAeVQ+NvBKVUrj/",
+	"sIvc9S5KeddSaEI5FzmPbVojAzD4eSOLuxPbTqEY2J1dlNkceXFlk8YxZLgfr20viW0/A4mF+ot794iC",
+	"fy1HuSuKhLr7cDa3XorvLWQmM76ClZBr22flrs+lArviygatmhmVYFpb1FdihK/dufXNDH86M/zN+P6H",
+	"GN/rXcZxhxuZisVR+QpJWy6ifMDkM0p3uccXS1agz53WXlppJCHKw6lmq2pEMes/F8n6i9CjeB/G339r",
+	"vDb/Vly67sIllOTitOyaKKoeseFrC4dfV8CDF2wLfu0uMJlwlUGsnb+RsDuWeD1zylVKVsJ07mnKUkjI",
+	"HYMPwaTEdYHtgdcLQjeTv/ylgCnIFeM0JTuAOimAOmkFqnLP+TCQvkiVunJZ/YA6da3ZtiKpg6+3ZB2A",
+	"1lNW96mmrY/v5PX3Obyf17Hmce2N/taft523aqQ6N/VWp/1Ht/YGnzkwJPwaFOmLu5g7/sBGe/uxT72g",
+	"Rn9kF3JFn3acdv8GDZoH/r0Uh3drgr0i1y1Fza+rkL//2ZHu58UhbcGVHVvbVXZJ37cWYXyD2kFCHt8o",
+	"XOHEV9100gZvq5CWT9e0RdLucZvPaTLsDl+6JYUF+5JH18TvMyqebkQ6+TnFvn3ixT3A0tbKbKn72A41",
+	"nFbT+5Y+NEvBsWue+9YT9um6+Q9q4tLecxVt6lQ+afEZFarc45/R5eUw8HtZCrrsbvfSMu6QCXGvPlk7",
+	"NzWPPF0JocnY7yuzmQmg8dI8MHDwAw8t7f/4/qV9giRd27caplfjMrviDLO58q80UNNsb0obHtyCQ7jj",
+	"bIrYdzuqm933US8U5weeTG38qQ17LKMhjL7u9vnyIZ4DkhJuW3xBFBn1Ka/+4nptVkDG6oip5IGpZNOf",
+	"PWAsu+mrB/sOzqaj89cm2i0nwFTGnbqPrbC0e3Q73wba9IJrIoLdFj3uvKYlVrdVn3zhqhY+3xUqzVyN",
+	"P+EdRNzkUfJ1SITRJmRFlFE4HybHYoKNVunr3P/+TQIf6YhNr8bOD/r776MPr38f/fnl9OLDpOY1bUdF",
+	"QRH9xP5RuWJAVnGCSdlYWchl6h6NOjs6elgKpTdnD5mQemNec5MMDbUhFf6u9gAGPqNtPps/DClrv34y",
+	"fHp6gjr5rgSj8WDiHci1NhlK89dDrFsfzFbXo+Bo0ztktfHl5V8nmA81AuQtZwnTXGxsvCB88grbJopn",
+	"PO1izjnxoXJOUwAo93c5lA+T1x2/fZYxsKod0xnV3d3Lg0DTQrR5t/n/AQA/8ayzPngAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -7,6 +7,10 @@ import (
 	"time"
 )
 
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
 // Defines values for BeaconUsage.
 const (
 	CoreRegistration BeaconUsage = "core_registration"
@@ -15,6 +19,14 @@ const (
 	UpRegistration   BeaconUsage = "up_registration"
 )
 
+// Defines values for LinkRelationship.
+const (
+	CHILD  LinkRelationship = "CHILD"
+	CORE   LinkRelationship = "CORE"
+	PARENT LinkRelationship = "PARENT"
+	PEER   LinkRelationship = "PEER"
+)
+
 // Defines values for LogLevelLevel.
 const (
 	Debug LogLevelLevel = "debug"
@@ -132,9 +144,48 @@ type Hop struct {
 	IsdAs     IsdAs `json:"isd_as"`
 }
 
+// Interface defines model for Interface.
+type Interface struct {
+	// InterfaceId SCION interface identifier.
+	InterfaceId int `json:"interface_id"`
+
+	// InternalInterface The internal address of the router that owns the interface.
+	InternalInterface string `json:"internal_interface"`
+
+	// LastOriginate The time beacons were last originated on the interface. It is not set if no beacons were originated since the control service started.
+	LastOriginate *time.Time `json:"last_originate,omitempty"`
+
+	// LastPropagate The time beacons were last propagated on the interface. It is not set if no beacons were propagated since the control service started.
+	LastPropagate *time.Time        `json:"last_propagate,omitempty"`
+	Neighbor      InterfaceNeighbor `json:"neighbor"`
+	Relationship  LinkRelationship  `json:"relationship"`
+	Revocation    *Revocation       `json:"revocation,omitempty"`
+
+	// ScionMtu The maximum transmission unit in bytes for SCION packets. This represents the protocol data unit (PDU) of the SCION layer and is usually calculated as maximum Ethernet payload - IP Header - UDP Header.
+	ScionMtu ScionMTU `json:"scion_mtu"`
+
+	// Shutdown Whether the interface is administratively shut down for beaconing.
+	Shutdown bool `json:"shutdown"`
+}
+
+// InterfaceNeighbor defines model for InterfaceNeighbor.
+type InterfaceNeighbor struct {
+	// InterfaceId SCION interface identifier in the neighboring AS.
+	InterfaceId int   `json:"interface_id"`
+	IsdAs       IsdAs `json:"isd_as"`
+}
+
+// InterfacesResponse defines model for InterfacesResponse.
+type InterfacesResponse struct {
+	Interfaces []Interface `json:"interfaces"`
+}
+
 // IsdAs defines model for IsdAs.
 type IsdAs = string
 
+// LinkRelationship defines model for LinkRelationship.
+type LinkRelationship string
+
 // LogLevel defines model for LogLevel.
 type LogLevel struct {
 	// Level Logging level
@@ -167,6 +218,18 @@ type Problem struct {
 	Type *string `json:"type,omitempty"`
 }
 
+// Revocation defines model for Revocation.
+type Revocation struct {
+	// Expiration Time at which the revocation expires.
+	Expiration time.Time `json:"expiration"`
+
+	// Timestamp Time at which the revocation was issued.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ScionMTU The maximum transmission unit in bytes for SCION packets. This represents the protocol data unit (PDU) of the SCION layer and is usually calculated as maximum Ethernet payload - IP Header - UDP Header.
+type ScionMTU = int
+
 // Segment defines model for Segment.
 type Segment struct {
 	Expiration  time.Time `json:"expiration"`
@@ -39,6 +39,7 @@ import (
 	"github.com/scionproto/scion/daemon/fetcher"
 	api "github.com/scionproto/scion/daemon/mgmtapi"
 	"github.com/scionproto/scion/pkg/addr"
+	sdclient "github.com/scionproto/scion/pkg/daemon"
 	"github.com/scionproto/scion/pkg/experimental/hiddenpath"
 	hpgrpc "github.com/scionproto/scion/pkg/experimental/hiddenpath/grpc"
 	libgrpc "github.com/scionproto/scion/pkg/grpc"
@@ -110,7 +111,7 @@ func realMain(ctx context.Context) error {
 		return serrors.Wrap("initializing path storage", err)
 	}
 	pathDB = pathstoragemetrics.WrapDB(pathDB, pathstoragemetrics.Config{
-		Driver: string(storage.BackendSqlite),
+		Driver: string(globalCfg.PathDB.Backend),
 	})
 	defer pathDB.Close()
 	defer revCache.Close()
@@ -140,7 +141,7 @@ func realMain(ctx context.Context) error {
 	}
 	defer trustDB.Close()
 	trustDB = truststoragemetrics.WrapDB(trustDB, truststoragemetrics.Config{
-		Driver: string(storage.BackendSqlite),
+		Driver: string(globalCfg.TrustDB.Backend),
 		QueriesTotal: metrics.NewPromCounterFrom(
 			prometheus.CounterOpts{
 				Name: "trustengine_db_queries_total",
@@ -297,6 +298,15 @@ func realMain(ctx context.Context) error {
 	cleanup.Add(func() error { server.GracefulStop(); return nil })
 
 	if globalCfg.API.Addr != "" {
+		// The management API queries paths through the gRPC API, such that it
+		// returns the paths exactly as applications see them.
+		connCtx, cancel := context.WithTimeout(errCtx, 10*time.Second)
+		conn, err := sdclient.Service{Address: listener.Addr().String()}.Connect(connCtx)
+		cancel()
+		if err != nil {
+			return serrors.Wrap("connecting to gRPC API", err, "addr", listener.Addr())
+		}
+		cleanup.Add(conn.Close)
 		r := chi.NewRouter()
 		r.Use(cors.Handler(cors.Options{
 			AllowedOrigins: []string{"*"},
@@ -313,6 +323,7 @@ func realMain(ctx context.Context) error {
 			Config:   service.NewConfigStatusPage(globalCfg).Handler,
 			Info:     service.NewInfoStatusPage().Handler,
 			LogLevel: service.NewLogLevelStatusPage().Handler,
+			Daemon:   conn,
 		}
 		log.Info("Exposing API", "addr", globalCfg.API.Addr)
 		h := api.HandlerFromMuxWithBaseURL(&server, r, "/api/v1")
@@ -62,11 +62,17 @@ type DaemonServer struct {
 	RevCache    revcache.RevCache
 	ASInspector trust.Inspector
 	DRKeyClient *drkey_daemon.ClientEngine
+	// WatchPathsInterval is the interval in which watched path sets are
+	// re-evaluated. If zero, DefaultWatchPathsInterval is used.
+	WatchPathsInterval time.Duration
 
 	Metrics Metrics
 
 	foregroundPathDedupe singleflight.Group
 	backgroundPathDedupe singleflight.Group
+	// pathsChanged notifies path watchers about changes of the set of paths to
+	// their destination.
+	pathsChanged changeNotifier
 }
 
 // Paths serves the paths request.
@@ -124,6 +130,10 @@ func (s *DaemonServer) fetchPaths(
 	// just cast to the correct type, ignore the "ok", since that can only be
 	// false in case of a nil result.
 	paths, _ := r.([]snet.Path)
+	if err == nil {
+		// Fetching might have added new segments to the path database.
+		s.pathsChanged.update(pathSetKey{src: src, dst: dst}, paths)
+	}
 	return paths, err
 }
 
@@ -342,7 +352,7 @@ func (s *DaemonServer) notifyInterfaceDown(ctx context.Context,
 		RawTTL:       10,
 		RawTimestamp: util.TimeToSecs(time.Now()),
 	}
-	_, err := s.RevCache.Insert(ctx, revInfo)
+	inserted, err := s.RevCache.Insert(ctx, revInfo)
 	if err != nil {
 		log.FromCtx(ctx).Error("Inserting revocation", "err", err, "req", req)
 		return nil, metricsError{
@@ -350,6 +360,9 @@ func (s *DaemonServer) notifyInterfaceDown(ctx context.Context,
 			result: prom.ErrDB,
 		}
 	}
+	if inserted {
+		s.pathsChanged.notifyAll()
+	}
 	return &sdpb.NotifyInterfaceDownResponse{}, nil
 }
 
@@ -0,0 +1,222 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	sdpb "github.com/scionproto/scion/pkg/proto/daemon"
+	"github.com/scionproto/scion/pkg/snet"
+)
+
+// DefaultWatchPathsInterval is the default interval in which the path set of
+// a watched destination is re-evaluated, if no other event triggers it.
+const DefaultWatchPathsInterval = 10 * time.Second
+
+// WatchPaths serves the watch paths request. It sends the current set of paths
+// and an update every time the set changes. The set is re-evaluated whenever
+// a paths request yielded a different set of paths for the destination or an
+// interface was revoked, when the first path in the set expires, and in
+// regular intervals to pick up new segments.
+func (s *DaemonServer) WatchPaths(
+	req *sdpb.WatchPathsRequest,
+	stream sdpb.DaemonService_WatchPathsServer,
+) error {
+
+	ctx := stream.Context()
+	srcIA, dstIA := addr.IA(req.SourceIsdAs), addr.IA(req.DestinationIsdAs)
+	logger := log.FromCtx(ctx).New("src", srcIA, "dst", dstIA)
+
+	key := pathSetKey{src: srcIA, dst: dstIA}
+	defer s.pathsChanged.subscribe(key)()
+	var last map[snet.PathFingerprint]time.Time
+	for {
+		// Wait for changes before fetching, such that no change is missed.
+		changed := s.pathsChanged.wait(key)
+		paths, err := s.watchedPaths(ctx, srcIA, dstIA)
+		if err == nil {
+			s.pathsChanged.update(key, paths)
+		}
+		switch {
+		case err != nil && last == nil:
+			return serrors.Wrap("fetching paths", err, "src", srcIA, "dst", dstIA)
+		case err != nil:
+			logger.Debug("Fetching watched paths", "err", err)
+		case last == nil || !samePathSet(last, paths):
+			reply := &sdpb.WatchPathsResponse{}
+			for _, p := range paths {
+				reply.Paths = append(reply.Paths, pathToPB(p))
+			}
+			if err := stream.Send(reply); err != nil {
+				return err
+			}
+			last = pathSet(paths)
+		}
+
+		timer := time.NewTimer(s.watchPathsWait(paths))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-changed:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+func (s *DaemonServer) watchedPaths(
+	ctx context.Context,
+	src, dst addr.IA,
+) ([]snet.Path, error) {
+
+	ctx, cancelF := context.WithTimeout(ctx, 10*time.Second)
+	defer cancelF()
+	return s.Fetcher.GetPaths(ctx, src, dst, false)
+}
+
+// watchPathsWait returns the time until the path set must be re-evaluated,
+// i.e., the minimum of the watch interval and the time until the first path
+// expires.
+func (s *DaemonServer) watchPathsWait(paths []snet.Path) time.Duration {
+	wait := s.WatchPathsInterval
+	if wait == 0 {
+		wait = DefaultWatchPathsInterval
+	}
+	for _, p := range paths {
+		expiry := p.Metadata().Expiry
+		if expiry.IsZero() {
+			continue
+		}
+		if untilExpiry := time.Until(expiry); untilExpiry > 0 && untilExpiry < wait {
+			wait = untilExpiry
+		}
+	}
+	return wait
+}
+
+func pathSet(paths []snet.Path) map[snet.PathFingerprint]time.Time {
+	set := make(map[snet.PathFingerprint]time.Time, len(paths))
+	for _, p := range paths {
+		set[snet.Fingerprint(p)] = p.Metadata().Expiry
+	}
+	return set
+}
+
+// samePathSet checks whether the paths are the same as in the set, including
+// their expiration time.
+func samePathSet(set map[snet.PathFingerprint]time.Time, paths []snet.Path) bool {
+	if len(set) != len(paths) {
+		return false
+	}
+	for _, p := range paths {
+		expiry, ok := set[snet.Fingerprint(p)]
+		if !ok || !expiry.Equal(p.Metadata().Expiry) {
+			return false
+		}
+	}
+	return true
+}
+
+// pathSetKey identifies the set of paths between a source and a destination.
+type pathSetKey struct {
+	src, dst addr.IA
+}
+
+// watchedPathSet is the last known set of paths to a watched destination.
+type watchedPathSet struct {
+	watchers int
+	paths    map[snet.PathFingerprint]time.Time
+	ch       chan struct{}
+}
+
+// changeNotifier wakes up the path watchers of a destination when its set of
+// paths changed. Only the path sets of destinations that are being watched are
+// tracked. The zero value is ready to use.
+type changeNotifier struct {
+	mu   sync.Mutex
+	sets map[pathSetKey]*watchedPathSet
+}
+
+// subscribe starts tracking the path set of the destination. It returns a
+// function that must be called once the destination is no longer watched.
+func (n *changeNotifier) subscribe(key pathSetKey) func() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.sets == nil {
+		n.sets = make(map[pathSetKey]*watchedPathSet)
+	}
+	set, ok := n.sets[key]
+	if !ok {
+		set = &watchedPathSet{}
+		n.sets[key] = set
+	}
+	set.watchers++
+	return func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		if set.watchers--; set.watchers == 0 {
+			delete(n.sets, key)
+		}
+	}
+}
+
+// wait returns a channel that is closed on the next change of the path set of
+// the destination. The destination must be subscribed.
+func (n *changeNotifier) wait(key pathSetKey) <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	set := n.sets[key]
+	if set.ch == nil {
+		set.ch = make(chan struct{})
+	}
+	return set.ch
+}
+
+// update records the paths to the destination and wakes up its waiters if the
+// set of paths is different from the last recorded one. Paths to destinations
+// that are not watched are ignored.
+func (n *changeNotifier) update(key pathSetKey, paths []snet.Path) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	set, ok := n.sets[key]
+	if !ok || (set.paths != nil && samePathSet(set.paths, paths)) {
+		return
+	}
+	set.paths = pathSet(paths)
+	set.wake()
+}
+
+// notifyAll wakes up the waiters of all destinations, e.g., after a revocation
+// that might affect any of the path sets.
+func (n *changeNotifier) notifyAll() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, set := range n.sets {
+		set.wake()
+	}
+}
+
+func (s *watchedPathSet) wake() {
+	if s.ch != nil {
+		close(s.ch)
+		s.ch = nil
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servers
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/scionproto/scion/daemon/fetcher/mock_fetcher"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	sdpb "github.com/scionproto/scion/pkg/proto/daemon"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+)
+
+func TestWatchPaths(t *testing.T) {
+	src, dst := addr.MustParseIA("1-ff00:0:110"), addr.MustParseIA("1-ff00:0:120")
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	path1 := testPath(src, dst, 1, expiry)
+	path2 := testPath(src, dst, 2, expiry)
+	req := &sdpb.WatchPathsRequest{SourceIsdAs: uint64(src), DestinationIsdAs: uint64(dst)}
+
+	t.Run("updates", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var mu sync.Mutex
+		current := []snet.Path{path1}
+		f := mock_fetcher.NewMockFetcher(ctrl)
+		f.EXPECT().GetPaths(gomock.Any(), src, dst, false).DoAndReturn(
+			func(context.Context, addr.IA, addr.IA, bool) ([]snet.Path, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				return current, nil
+			},
+		).AnyTimes()
+		s := &DaemonServer{Fetcher: f, WatchPathsInterval: time.Hour}
+
+		ctx, cancelF := context.WithCancel(context.Background())
+		defer cancelF()
+		stream := newFakeWatchStream(ctx)
+		done := make(chan error)
+		go func() { done <- s.WatchPaths(req, stream) }()
+
+		assertPaths(t, stream, path1)
+
+		// A paths request without change does not result in an update.
+		_, err := s.fetchPaths(ctx, &s.foregroundPathDedupe, src, dst, false)
+		require.NoError(t, err)
+		select {
+		case resp := <-stream.sent:
+			t.Fatalf("unexpected update: %v", resp)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		// A paths request with a changed path set results in an update.
+		mu.Lock()
+		current = []snet.Path{path1, path2}
+		mu.Unlock()
+		_, err = s.fetchPaths(ctx, &s.foregroundPathDedupe, src, dst, false)
+		require.NoError(t, err)
+		assertPaths(t, stream, path1, path2)
+
+		cancelF()
+		assert.NoError(t, <-done)
+	})
+	t.Run("re-evaluates periodically", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		f := mock_fetcher.NewMockFetcher(ctrl)
+		gomock.InOrder(
+			f.EXPECT().GetPaths(gomock.Any(), src, dst, false).Return([]snet.Path{path1}, nil),
+			f.EXPECT().GetPaths(gomock.Any(), src, dst, false).
+				Return([]snet.Path{path2}, nil).AnyTimes(),
+		)
+		s := &DaemonServer{Fetcher: f, WatchPathsInterval: 10 * time.Millisecond}
+
+		ctx, cancelF := context.WithCancel(context.Background())
+		defer cancelF()
+		stream := newFakeWatchStream(ctx)
+		done := make(chan error)
+		go func() { done <- s.WatchPaths(req, stream) }()
+
+		assertPaths(t, stream, path1)
+		assertPaths(t, stream, path2)
+
+		cancelF()
+		assert.NoError(t, <-done)
+	})
+	t.Run("initial error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		f := mock_fetcher.NewMockFetcher(ctrl)
+		f.EXPECT().GetPaths(gomock.Any(), src, dst, false).Return(nil, serrors.New("test"))
+		s := &DaemonServer{Fetcher: f}
+
+		err := s.WatchPaths(req, newFakeWatchStream(context.Background()))
+		assert.Error(t, err)
+	})
+}
+
+func TestChangeNotifier(t *testing.T) {
+	src := addr.MustParseIA("1-ff00:0:110")
+	dst1, dst2 := addr.MustParseIA("1-ff00:0:120"), addr.MustParseIA("1-ff00:0:130")
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	key1, key2 := pathSetKey{src: src, dst: dst1}, pathSetKey{src: src, dst: dst2}
+	closed := func(ch <-chan struct{}) bool {
+		select {
+		case <-ch:
+			return true
+		default:
+			return false
+		}
+	}
+
+	var n changeNotifier
+	unsubscribe1 := n.subscribe(key1)
+	unsubscribe2 := n.subscribe(key2)
+	n.update(key1, []snet.Path{testPath(src, dst1, 1, expiry)})
+	n.update(key2, []snet.Path{testPath(src, dst2, 1, expiry)})
+
+	ch1, ch2 := n.wait(key1), n.wait(key2)
+	n.update(key1, []snet.Path{testPath(src, dst1, 1, expiry)})
+	assert.False(t, closed(ch1), "unchanged set")
+	n.update(key1, []snet.Path{testPath(src, dst1, 2, expiry)})
+	assert.True(t, closed(ch1), "changed set")
+	assert.False(t, closed(ch2), "other destination")
+
+	ch1 = n.wait(key1)
+	n.notifyAll()
+	assert.True(t, closed(ch1))
+	assert.True(t, closed(ch2))
+
+	unsubscribe1()
+	unsubscribe2()
+	assert.Empty(t, n.sets)
+	// Destinations that are not watched are not tracked.
+	n.update(key1, []snet.Path{testPath(src, dst1, 1, expiry)})
+	assert.Empty(t, n.sets)
+}
+
+func assertPaths(t *testing.T, stream *fakeWatchStream, expected ...snet.Path) {
+	t.Helper()
+	select {
+	case resp := <-stream.sent:
+		require.Len(t, resp.Paths, len(expected))
+		for i, p := range expected {
+			assert.Equal(t, pathToPB(p), resp.Paths[i])
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for update")
+	}
+}
+
+func testPath(src, dst addr.IA, ifID uint64, expiry time.Time) snet.Path {
+	return snetpath.Path{
+		Src: src,
+		Dst: dst,
+		Meta: snet.PathMetadata{
+			Interfaces: []snet.PathInterface{
+				{IA: src, ID: iface.ID(ifID)},
+				{IA: dst, ID: iface.ID(ifID)},
+			},
+			Expiry: expiry,
+		},
+		DataplanePath: snetpath.SCION{Raw: []byte{byte(ifID)}},
+	}
+}
+
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *sdpb.WatchPathsResponse
+}
+
+func newFakeWatchStream(ctx context.Context) *fakeWatchStream {
+	return &fakeWatchStream{
+		ctx:  ctx,
+		sent: make(chan *sdpb.WatchPathsResponse, 10),
+	}
+}
+
+func (s *fakeWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchStream) Send(resp *sdpb.WatchPathsResponse) error {
+	s.sent <- resp
+	return nil
+}
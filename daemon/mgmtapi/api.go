@@ -17,6 +17,7 @@ package mgmtapi
 import (
 	"net/http"
 
+	"github.com/scionproto/scion/pkg/daemon"
 	cppkiapi "github.com/scionproto/scion/private/mgmtapi/cppki/api"
 	segapi "github.com/scionproto/scion/private/mgmtapi/segments/api"
 )
@@ -28,6 +29,9 @@ type Server struct {
 	Config         http.HandlerFunc
 	Info           http.HandlerFunc
 	LogLevel       http.HandlerFunc
+	// Daemon is used to query the paths as they are returned to applications,
+	// and as topology for probing them.
+	Daemon daemon.Connector
 }
 
 // GetConfig is an indirection to the http handler.
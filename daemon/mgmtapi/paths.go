@@ -0,0 +1,222 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/daemon"
+	"github.com/scionproto/scion/pkg/snet"
+	"github.com/scionproto/scion/private/app/path/pathprobe"
+	api "github.com/scionproto/scion/private/mgmtapi"
+	"github.com/scionproto/scion/private/path/pathpol"
+)
+
+// probeTimeout is the time the paths are probed for.
+const probeTimeout = 5 * time.Second
+
+// GetPaths lists the paths to the ISD-AS, as they are returned to
+// applications.
+func (s *Server) GetPaths(
+	w http.ResponseWriter,
+	r *http.Request,
+	isdAs IsdAs,
+	params GetPathsParams,
+) {
+
+	dst, err := addr.ParseIA(isdAs)
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "malformed ISD-AS",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	var seq *pathpol.Sequence
+	if params.Sequence != nil {
+		if seq, err = pathpol.NewSequence(*params.Sequence); err != nil {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusBadRequest,
+				Title:  "malformed sequence",
+				Type:   api.StringRef(api.BadRequest),
+			})
+			return
+		}
+	}
+	epic := params.Epic != nil && *params.Epic
+
+	ctx := r.Context()
+	localIA, err := s.Daemon.LocalIA(ctx)
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "error determining local ISD-AS",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	paths, err := s.Daemon.Paths(ctx, dst, 0, daemon.PathReqFlags{
+		Refresh: params.Refresh != nil && *params.Refresh,
+		Hidden:  params.Hidden != nil && *params.Hidden,
+	})
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "error getting paths",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	if seq != nil {
+		paths = seq.Eval(paths)
+	}
+	if epic {
+		epicPaths := make([]snet.Path, 0, len(paths))
+		for _, p := range paths {
+			if p.Metadata().EpicAuths.SupportsEpic() {
+				epicPaths = append(epicPaths, p)
+			}
+		}
+		paths = epicPaths
+	}
+
+	var statuses map[string]pathprobe.Status
+	if params.Probe != nil && *params.Probe {
+		probeCtx, cancel := context.WithTimeout(ctx, probeTimeout)
+		defer cancel()
+		statuses, err = pathprobe.Prober{
+			DstIA:    dst,
+			LocalIA:  localIA,
+			Topology: s.Daemon,
+		}.GetStatuses(probeCtx, pathprobe.FilterEmptyPaths(paths), pathprobe.WithEPIC(epic))
+		if err != nil {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusInternalServerError,
+				Title:  "error probing paths",
+				Type:   api.StringRef(api.InternalError),
+			})
+			return
+		}
+	}
+
+	(&pathpol.Ordering{}).Sort(paths, nil)
+	rep := Paths{
+		LocalIsdAs:  localIA.String(),
+		Destination: dst.String(),
+		Paths:       make([]Path, 0, len(paths)),
+	}
+	for _, p := range paths {
+		entry := pathEntry(p)
+		if status, ok := statuses[pathprobe.PathKey(p)]; ok {
+			st := PathStatus(strings.ToLower(string(status.Status)))
+			entry.Status = &st
+			if status.AdditionalInfo != "" {
+				entry.StatusInfo = api.StringRef(status.AdditionalInfo)
+			}
+			if status.LocalIP.IsValid() {
+				entry.LocalIp = api.StringRef(status.LocalIP.String())
+			}
+		}
+		rep.Paths = append(rep.Paths, entry)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "    ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(buf.Bytes())
+}
+
+// pathEntry converts the path and its metadata.
+func pathEntry(p snet.Path) Path {
+	meta := p.Metadata()
+	if meta == nil {
+		meta = &snet.PathMetadata{}
+	}
+	entry := Path{
+		Fingerprint:  snet.Fingerprint(p).String(),
+		Hops:         make([]Hop, 0, len(meta.Interfaces)),
+		Expiry:       meta.Expiry,
+		Mtu:          int(meta.MTU),
+		Latency:      make([]int64, 0, len(meta.Latency)),
+		Bandwidth:    make([]int64, 0, len(meta.Bandwidth)),
+		Geo:          make([]GeoCoordinates, 0, len(meta.Geo)),
+		LinkType:     make([]string, 0, len(meta.LinkType)),
+		InternalHops: make([]int, 0, len(meta.InternalHops)),
+		Notes:        append([]string{}, meta.Notes...),
+		SupportsEpic: meta.EpicAuths.SupportsEpic(),
+	}
+	for _, intf := range meta.Interfaces {
+		entry.Hops = append(entry.Hops, Hop{IsdAs: intf.IA.String(), Interface: int(intf.ID)})
+	}
+	if nh := p.UnderlayNextHop(); nh != nil {
+		entry.NextHop = nh.String()
+	}
+	if seq, err := pathpol.GetSequence(p); err == nil {
+		entry.Sequence = seq
+	}
+	for _, l := range meta.Latency {
+		entry.Latency = append(entry.Latency, int64(l))
+	}
+	for _, b := range meta.Bandwidth {
+		entry.Bandwidth = append(entry.Bandwidth, int64(b))
+	}
+	for _, g := range meta.Geo {
+		entry.Geo = append(entry.Geo, GeoCoordinates{
+			Latitude:  g.Latitude,
+			Longitude: g.Longitude,
+			Address:   g.Address,
+		})
+	}
+	for _, lt := range meta.LinkType {
+		entry.LinkType = append(entry.LinkType, lt.String())
+	}
+	for _, h := range meta.InternalHops {
+		entry.InternalHops = append(entry.InternalHops, int(h))
+	}
+	return entry
+}
+
+// ErrorResponse writes the problem as JSON to the response writer.
+func ErrorResponse(w http.ResponseWriter, p Problem) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(p.Status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	// no point in catching error here, there is nothing we can do about it anymore.
+	_ = enc.Encode(p)
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/daemon"
+	"github.com/scionproto/scion/pkg/daemon/mock_daemon"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/private/xtest"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+)
+
+var update = xtest.UpdateGoldenFiles()
+
+func TestGetPaths(t *testing.T) {
+	local := addr.MustParseIA("1-ff00:0:110")
+	remote := addr.MustParseIA("1-ff00:0:111")
+	expiry := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	direct := snetpath.Path{
+		Src:           local,
+		Dst:           remote,
+		DataplanePath: snetpath.SCION{Raw: []byte{1, 2, 3, 4}},
+		NextHop:       &net.UDPAddr{IP: net.ParseIP("10.0.0.1"), Port: 30042},
+		Meta: snet.PathMetadata{
+			Interfaces: []snet.PathInterface{
+				{IA: local, ID: 1},
+				{IA: remote, ID: 2},
+			},
+			MTU:          1400,
+			Expiry:       expiry,
+			Latency:      []time.Duration{5 * time.Millisecond},
+			Bandwidth:    []uint64{1000},
+			Geo:          []snet.GeoCoordinates{{Latitude: 47.3, Longitude: 8.5, Address: "Zürich"}},
+			LinkType:     []snet.LinkType{snet.LinkTypeDirect},
+			InternalHops: []uint32{},
+			Notes:        []string{"<direct>"},
+			EpicAuths: snet.EpicAuths{
+				AuthPHVF: make([]byte, 16),
+				AuthLHVF: make([]byte, 16),
+			},
+		},
+	}
+	indirect := snetpath.Path{
+		Src:           local,
+		Dst:           remote,
+		DataplanePath: snetpath.SCION{Raw: []byte{5, 6, 7, 8}},
+		NextHop:       &net.UDPAddr{IP: net.ParseIP("10.0.0.2"), Port: 30042},
+		Meta: snet.PathMetadata{
+			Interfaces: []snet.PathInterface{
+				{IA: local, ID: 3},
+				{IA: addr.MustParseIA("1-ff00:0:112"), ID: 4},
+				{IA: addr.MustParseIA("1-ff00:0:112"), ID: 5},
+				{IA: remote, ID: 6},
+			},
+			MTU:    1280,
+			Expiry: expiry,
+		},
+	}
+
+	testCases := map[string]struct {
+		RequestURL   string
+		Flags        daemon.PathReqFlags
+		Paths        []snet.Path
+		PathsErr     error
+		ResponseFile string
+		Status       int
+	}{
+		"paths": {
+			RequestURL:   "/paths/1-ff00:0:111",
+			Paths:        []snet.Path{indirect, direct},
+			ResponseFile: "testdata/paths.json",
+			Status:       http.StatusOK,
+		},
+		"paths refresh hidden": {
+			RequestURL:   "/paths/1-ff00:0:111?refresh=true&hidden=true",
+			Flags:        daemon.PathReqFlags{Refresh: true, Hidden: true},
+			Paths:        []snet.Path{indirect, direct},
+			ResponseFile: "testdata/paths.json",
+			Status:       http.StatusOK,
+		},
+		"paths sequence": {
+			RequestURL:   "/paths/1-ff00:0:111?sequence=0*+1-ff00:0:112+0*",
+			Paths:        []snet.Path{indirect, direct},
+			ResponseFile: "testdata/paths-sequence.json",
+			Status:       http.StatusOK,
+		},
+		"paths epic": {
+			RequestURL:   "/paths/1-ff00:0:111?epic=true",
+			Paths:        []snet.Path{indirect, direct},
+			ResponseFile: "testdata/paths-epic.json",
+			Status:       http.StatusOK,
+		},
+		"no paths": {
+			RequestURL:   "/paths/1-ff00:0:111",
+			ResponseFile: "testdata/paths-empty.json",
+			Status:       http.StatusOK,
+		},
+		"malformed isd-as": {
+			RequestURL:   "/paths/1-ff00:0:11x",
+			ResponseFile: "testdata/paths-malformed-isd-as.json",
+			Status:       http.StatusBadRequest,
+		},
+		"malformed sequence": {
+			RequestURL:   "/paths/1-ff00:0:111?sequence=1-ff00:0:11x",
+			ResponseFile: "testdata/paths-malformed-sequence.json",
+			Status:       http.StatusBadRequest,
+		},
+		"daemon error": {
+			RequestURL:   "/paths/1-ff00:0:111",
+			PathsErr:     serrors.New("no connection"),
+			ResponseFile: "testdata/paths-error.json",
+			Status:       http.StatusInternalServerError,
+		},
+	}
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			conn := mock_daemon.NewMockConnector(ctrl)
+			conn.EXPECT().LocalIA(gomock.Any()).Return(local, nil).AnyTimes()
+			conn.EXPECT().Paths(gomock.Any(), remote, addr.IA(0), tc.Flags).
+				Return(tc.Paths, tc.PathsErr).AnyTimes()
+
+			req, err := http.NewRequest("GET", tc.RequestURL, nil)
+			require.NoError(t, err)
+
+			rr := httptest.NewRecorder()
+			Handler(&Server{Daemon: conn}).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.Status, rr.Result().StatusCode)
+			if *update {
+				require.NoError(t, os.WriteFile(tc.ResponseFile, rr.Body.Bytes(), 0666))
+			}
+			golden, err := os.ReadFile(tc.ResponseFile)
+			require.NoError(t, err)
+			assert.Equal(t, string(golden), rr.Body.String())
+		})
+	}
+}
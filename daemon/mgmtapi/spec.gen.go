@@ -18,59 +18,77 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaWXPjNhL+K11MHjYV6vKxifWmkTUTVeZwWUq2KrHXBZEtETMkwACgbK1X/30LAEnx",
-	"kkV5JrPO1k7Ng0UCjcaHrxt98NHxeBRzhkxJZ/joCJQxZxLNj1fEv8Y/EpRK//I4U8jMnySOQ+oRRTnr",
-	"fZSc6WfSCzAi+q9vBS6dofNNbye6Z9/K3kwR5hPhT4Tgwtlut67jo/QEjbUwZ6jXBJEuqt+mE7XcMQpF",
-	"l3pd1D9jwWP9xOrqU6koWyVUBujfMRKZMWoTozN0pBKUrZyt61Dp31lpT2k5lf5I6uEyWXxET919ws0d",
-	"CVdcT8QHEsWhFjsZX85GjltfpTiN+gcxsaN/xs30Us9ek5D6VG0Ozfs1G6dx0phRgb4z/L0Ji3znBfEN",
-	"26upfus6iiqz2wL8UDyzfP/czNQ7GAeEsvoZUSkTFIe2VTzmHZZHzargkYlwMw327MrTarfa2ytBtSq1",
-	"DR48azPbHnM7NKpUbD3+s1lE/R0ddtAVBBdQNHiA9ywsp5dlq1qS81PSPyOO6yy5iIhyhk6AD53UvJ46",
-	"uqmPTD8yetas8iceNxwZUyiWxMOSEmcn+Xw9YIXiaOdRRTMzv92CBfyuiApA4ipCpiDgcRNYVm4JqkFn",
-	"uez3h/3hYNB3XCcmSqHQXvSfNzf+952//U46y37n4vZx4J5th989nmzLj777tx73bQHT6eyyM5odAPIt",
-	"X73FNYZ1NMPscdmpv+WrFWUrsK9dB1kSGUeFi2RlMFlq34PmUrh1CztM31RUqGBrxd42YHYl+CLEqOG6",
-	"QEVog6YjCJKIMBBIfLIIEfAhDgkzVx3IGD1NOFAcVEAlcM9LhEDmIfAlqAAhtguCCogCKiHAMF4moZ4R",
-	"csPU4ijCfFjRNQLx11QLYRDwez04FtxD9LvwD0GVQgaUwYStQioDMyvXb8kFIFtRhiikC4lMSBhugHEF",
-	"MqEKfTOCcQYKvYBRj4QgFfmEAQ99FNJI06O1eiH9F/pdp3gAY84Yemb7ioNPFFkQiaBohD7wRDXxgzKp",
-	"CLMmVYX3l+spCFyiRc3ClJFNGnBylPei6wJ2V11YbID4vuYVgaUg1nhyYQK4AJksOrG2LXNihePZxNiF",
-	"d2QDC4REol85IMG5sotSmU+izOrHE+EheNzHMlS9dGDPyzHrGEp/o/gnZB3N5Y4+uI5Br2PRy31cImgn",
-	"R6YxqlBEJbIO6jxA+Gk+vwI7wGgGK2QoiD7/xcaozQVdUQYSxRqFIcXTFC7t7bx/6joReaCRNtzziwvX",
-	"iSizvwb9fpOzTD1KnQEy4EKTM4qI2NTsxhzMf5v0MxTGHn9hZE1oqNdsOhD7QO9wSZJQnyFZ8EQNFyFh",
-	"nxy3DfcTRv9IMNxUjaCIB3CmB1j2mSj8QRVwW1MffRhdTbvwIY55SuaiJVnvRRlcvx53fvix/4ML1Hgn",
-	"hlQFKECgx6MImW/nLnSAlylqANd4xZwypV8T6yM7+XH43Eu08dl1GBewCvnCHIndX0q3yjG3M54jTKQa",
-	"9ll7yajYdD/M7JVbvx/wIaaC2JN73CngE4XGepvoEPDYhhQKo4NRgg5Gcgo5RAiyMa7zcLZgVbYxZEik",
-	"uktirZbfXlH9XCoSxW2nNEWGOyFuEa2KTikqhVBnNp5+eA9xMeA5ECWmO94TcyPz747M6o4FGdlKBQ1R",
-	"jXmeWWK6mRKrB02OUSoi1N1nxZImMi+KcYsw5BrXAvRnY1+L0Rdn5/7ZmX8wRk/nHwgoy1WB+hFnj8v4",
-	"m9EQoZRkdZi0eXBZ32Mx/y5t88cLeHUBZxcwPoGT1/r/xRguL6F/CScjOP8BRhdwOYEfJ+bVObw+hf4F",
-	"DPpwOSgiI2Piod8pA1TFYH49ru+cJCrggmrPusY7ktZlWjmYnO1VF+Nx8aVElc6jqdpy0NDm1+MvVPQw",
-	"RlGobey26TbBWFa+YCnz6/Eho5hfj59dAEg3XFe+ZqztFLGULWuhI/Q7lkQLW1542h1R6bdIfSUKSsIm",
-	"oaf14fXU13FLSlXlVeBvcha7Tf9aYEp534yrO7JUFQWdk/7JSac/6PTP5v2L4fnF8PT0t6J5PnlXapkL",
-	"XHKBNaGDZwqtwFNYwS1soYBJtmOIUVDu10HZbtMcuuYjs0h2dDXNgzB7C1wSjCyrShezfazHa3NCIa2c",
-	"frffHWg8eIyMxNQZOqfdfvfEVh0CA3+vUP8xD1aoGm5NKpWNZE3eocINEE/bZb18JG2MTATCJ8bvWRrX",
-	"3jAdBAsemmSGetgFnQIJlEmowCNMB7BLGioUNv2xRY0uvE6EDncjLtC9YZyhGRwTKYFATISiXhISkUa6",
-	"OuCmEQJRcB9QL7BK73S8YamSWj/jeIBIoCxOVBdGsOA8RMIyffJAXXEQqBLBgIThDSti5oLAFRF+iFKm",
-	"YQUV6aHr3zoXMUTo3uiD09Q3QdfUd4bOG1TjIv76YASJUKGQzvD3R4dq9P9IUGjvaAvku6pUu+p9Ho40",
-	"SzMg3BFVktfOIpoFkjAsyUqnpdA62+2tW+5YnPT7R7UqWl1/hYpv7Q6sNzAMv3lDMdTcoGdPKpjmQN8f",
-	"11PJilwNykyZJWapo2Iz75Ip1nV1HUVWmjiOF8efqHOrp5YsvPdohnaov91r7G9wzwLGGRFT/GKQloEP",
-	"s3oPqbUH2pEm08opulklEmzL8rxG/9n0OrhK05nVytovjjd7T/U41vQWIV88gzrIPK49KZFwNXkHi41C",
-	"CVrW80j1Smvxoon10Ikx6ixpWIlBOvrfq8mb6XsYT67n09fT8Wg+MU9v2GhWJFK3271h5s3k/WXD6CdF",
-	"jUfHiHJaUNoc11+H11bdPeTmbElXBRrXuWZHHDxyhQ+qF4dp67R26+WXZW1Xs8TzUMplEsKHbPECuE1Y",
-	"5ar0Ck3+MhpXgjJlq5HzD+/egt1oYsXr+MpU8HJIeKTDSYtJFovuQ2Rquzp/LTxeEUk90FvTAY3GICYr",
-	"BFPyzUuzhajU9nCk3ItSyFe9vGG2D6q81/YnXkX5Gl8NS21pYaUpWMPIdeKkAZRZBRQj/xX3N18Fj6yV",
-	"WVx/dxNs/6dOadbmlDST02Jii6SvXoHck+Q15XayKbkzYxURyjQpkPkwmlVrsjBlMkbPqkCZT9fUT0i4",
-	"U8EGDjoxBNsZRh/WFO+7TbHDLNttLWioHIrRKu2o82Vjhbjawm/KgiqV3qNTtUq3EEVEGQnhCaVOMqVO",
-	"9ipVqjcfp9JXSdpKTYMj0raIKC/QhG9gavflZnAN2haMNX1UsdbeY/pXlsL5GKJq6Nlemud71oF7qmxt",
-	"xMbd2ePpZd14rKCs6XbAfOY7A4bpZd7wLCzdhekyNek4UbAmYYJApe0wo9COhQEpCMn6nh5nkvrGgxCI",
-	"BS7pg/EeJAx3BCg7KWJcg1bf1y6JSi0nkahdrvYe5l192oJI9IGztJaTeVOtii0qURthDU5MHpMpk26W",
-	"eKrgpiDLZvAhDrmPznBJQoluU66yO9lnZyulHphUG+MZJDUuosGGzxqKj03tJgPhSzAk1zn/2hooFNrz",
-	"zuw3GNk3sEWDftLUGi3afTp9LjzVrCK7z2vq8p+67erW+iJZ+OXCrWzfTdFWndeFtKD7YvPaw+3f9vdF",
-	"u+JNw4p7qzdPsa+5RvOXY2CLQs7VaP4TzCZv3k3ez9OCigHxiqgga6aXKzANM5xWnH3RNZh9+u4jqRJe",
-	"i/QjJAqlSoXPRSIVXHOuYFysbdh0AIkX6OB9T3pyfAuqCx+MPiQMN64JNebX4zylSdFAHyiTColp+JgP",
-	"vwp6c4ay0Uzmevft7KPeASoF8Hlw3fBFX6X7n9mC9nzOy27h5B37IzKBdNlFiOagup+fT+c01PL2lBM1",
-	"j3tU+o9U+tvO4lEHkNuOfLQN821Lj7uP2nuq4XPhtaqAW7Lsd6NPfkSQM6QsU2+wndBBa5kWrHZSm75f",
-	"+DPjivn1uIl18+tx98vU1VKCPY9fx1zr+0iWXe3ZTW8SG3PD72Vf6x7M/xn4zLhifj1Og4PfPo7uP3wc",
-	"/f3dfHI/rcQSu1FOI0WrMcPn03Rva8V+dLTOuJCI0Bk6gVLxsNd7DLhU2+FjzIXa9khMe+uB+fpLUO2v",
-	"DWJ6SPnjbPOxt3m8dR09tfz6dDA4P9GmeZtrU+X/2BQ9zcc0+GA/tV5sUmtIAwFzP2cNQ1sjrdfgJmsU",
-	"G2WqDAJD85W+4s0Vp2oke6S08dXVz1OIiDJ8LOpmcN7ebv8TAAD//yDXdgQCOgAA",
+	"H4sIAAAAAAAC/+w7a3PbtpZ/BcPeD3v3Ui8/4ljfFNnJ1TQPj6XeO9Mm64GIIwkNCbAA6ETr1X/fOQBJ",
+	"8QFZkp226c52+iEmwYPzfushiGSSSgHC6GD4ECjQqRQa7B+vKLuF3zLQBv+KpDAg7D9pmsY8ooZL0ftV",
+	"S4HPdLSChOK//qZgEQyDH3pb0D33VvemhgpGFbtWSqpgs9mEAQMdKZ4isGCIdxKVX4pv8w8R7hiU4Qu8",
+	"F/DPVMkUnzhcGdeGi2XG9QrYnaCJPWPWKQTDQBvFxTLYhAHX7I7qfVhONBtpPK6z+a8QmbvPsL6j8VLi",
+	"h/CVJmmMYK/HV9NRELZvqX7G2V6euNM/wnpyhV/f05gzbtb7vvtXcQ75hDzjClgw/MXHi5LyCngPeS3U",
+	"P4WB4cZSW2E/qcqspF/aL5GC8Ypy0ZYR1zoDtY+sqpi3vDzqqwY/ChBhgcEOqiJE+yDaXikOCw+Be2Vt",
+	"v3ZiPowbTVU8+PyztYizIGyzrgK4wkXLDxI9iZeTq7pVLej5Ke2f0SAMFlIl1ATDYAVfO7l5PSa6CQOB",
+	"j0Btb9ta5RuQYykV44Ia0G3pUcYUaF3H5udM8WjlAxdTw03GoHb+7KJ7evHisoL6IpbUbL8XWTIHZb+X",
+	"YtkG8LJ7fja42P99Q1glMlW4YUlSRVRvQC4VTVc8ojFJpeYoHCIXhArChQG1oBF0fdL6p0w9Kl98UmfD",
+	"Sfk9Hlg6io9yvk1tLNzX9sIKUTfUrIiGZQLCkJVMfeg7uDXhDjqLRb8/7A8Hg34QBik1BpQIhsF/ffzI",
+	"/tH5j19oZ9HvXH56GIRnm+HfH0429Ud//x8897eKTk6mV53RdI8ivpXLt3APcZubcfG4HhTfyuWSiyVx",
+	"r8MARJZYRw/zbGl5spD42AbVT2GFwvxNA4Wm8liwnzw8Q762sZxTwb5wZlZtTF8VrzSZg/kCIIhZwVax",
+	"NJHuSYoS44L8OOemp7vkZ1CScMGsOWtiVtTYc+Vd5AvVREhDqBAyExEwVFJuILE4lfbChXlxFvjUL39C",
+	"laJr/Bu+plyt2zTMeAKEGvJlxaPVFll7HHS36pcYNdAx3AbYlpgXXCxBpYoL077k9fYlGl9xS0h4F7oh",
+	"oYTxJWj7jhtdYWA3qMr3JBrMT+FswE7nUX9+yi5P5y/mi5fn85PF5cWCwkXUn0dn8DIaQB+i/tmLF/Tl",
+	"YjB/OT+NLhd9euHDewmyja/XbegCdb98awJ6zOQbntkjq5VMdRupyfOuRYfmucsSI2h857/0vfXASPlo",
+	"Soqz6HRKboymjyC0Xy9jakBEHsV8a19wONS2BBVSQyQF010yIgKW1PB7IPc0zsBnbPnN397UYi4+37mH",
+	"LWtbp1ByDs/VqXuUl1s7YFy5NKWlzS1UZETjO576vCzq9uSG5FFzy0vkR6rkHBhZKJnUTXBwedLtd0+6",
+	"g37fd39isvZV7+hXnmQJMYoKnXCtMQZngtd8AYpwvjYNkx+cXXiDq4CvBjW2fdlPgoGK6bqkK79jwZU2",
+	"RMnMgGqy2EPe8HTQ75/4SBQyz6cahoKPtzpE5uuDrWO3+DTWhiLyKVKFbSULUxnzaE2Kr4heC0O/Niis",
+	"ZAE/DEjlz8EPXnq1oSbzEDy1z6si7JIPIsbrDeELnz51K9E8E5+F/GITZZ6AzEwQBjTm9xCEgY6SNPi0",
+	"E5c7G+dbCI0Ys36axoQLZ7Y22XMMcp92/QVsmkpl9B2kPGrD/fcKzArUlqDiPLm+mYwrEOdSxkBFK+Oo",
+	"hsbcuVdEW1HmMko7Q9o6x7CShbiQVfUyTSde6GiTskoKOR1PPrzPxcNRi4wmCRjKqKHdYEdu5KkiGGiD",
+	"cYxLsS/+lLVa7pOO602kxf0HxTrEtm1PzUSwikdYI6W4r5F0a2IkJivbk8SlwH6WKTmPIfExzVDuSXxH",
+	"ZJUlVBAFlNF5DJiExTS/SKcQYf2HKJgV10RGUaaUtfPCBt2FLsJxTVYQp4ssxi+QVgO1U1QwssQASdk9",
+	"RyCCrOQXPJwqGQGwLvm34sYAVkrkWixjrlf2qxK/hVQExJILAKVDkumMxvHaBlOdcYMBRCoi0AIhWgmb",
+	"TWlDP8NKxgyUttDwtBUF/29gdV81lkJAZMk3kqBuzqkGgg6DEecy2j0voQ31uswR+el2QhQswHHNsamo",
+	"XVwALLm8k7shge6yi+6dMoZlCiULRV0tVgJTRCqis3nHWpiRVQAEUe6Sd3RN5kAyDawhICVlHhm5Lj/K",
+	"3byWmYqARJJBnVW9/GAvKnnWsRXSD0Z+BtFBj9hBwdkEnnUc98rkJlO8U3LmmCiAceifs9lN7l8tZmQJ",
+	"AhQ12ygoFV9yQTSoe1BWKR5X4Rpt5/3TMEhcDhEMzy8vwyDhwv016Pe9GZkz2rYG6JVUqJxJQtW6ZTdW",
+	"MH+20k9BWXv8SdB7ymO8c3emhxQuaBajDOlcZmY4j6n4HISH6H4m+G8ZxOumEVT5QSSG9Fz7bFP8q6nw",
+	"7Z4zYGR0M+mSD2kqc2WuWpLzXlyQ29fjzsXL/kVIuPVOArgNqwoimSQgmPt2DoRBgahlOPIrlVg6Wu9r",
+	"fWSnFAeTUYbG5+4RUpFlLOdWJI6+XN0aYj7MeI4wkWYX1tlLoYq+dsPUdXDa8cEmAWVMPaz8Lqq3Z5WC",
+	"+5v3DmXX0o2pNndZimixwxHF59rQJD30E1+jdgskrHKrgVPOFX/aU/TP9jRtc4p3tMBBsGMTmWOZDGLp",
+	"az29tc8LS8yJqZdOPseoDVXm7lmtSRY0wIRVNpQYt/rlT+Z9q2U+PztnZ2dsb8s8/35Pf7I+pGuLuHhc",
+	"5789TRLQmi73K23Zq2zTWB2H1ch8eUleXZKzSzI+ISev8f/LMbm6Iv0rcjIi5xdkdEmursnLa/vqnLw+",
+	"Jf1LMuiTq0GVMzqlEbBOnUFNHsxux23KaWZWUnFj2yh3VMPhDqbU9qaLiaT6VqBq8vANP/ca2ux2/I1m",
+	"kNYoKqPGLZmhj4115CuWMrsd7zOK2e34yfO4nOA28i1jPQyRyVUbC8zQ7/K5zfBhjzvimh0wSdGgOI19",
+	"QE/bx9uTlCCsIdWE12C/z1lsif5XRVPqdAtp7ujCNBAMTvonJ53+oNM/m/Uvh+eXw9PTnw/uqSPMOSyk",
+	"ghbQwROBNthTuSGskFDhSUExSUFxydpM2WzykUy7Q5RnsqObSZmEuShwRSFxWlULzO4xnkdzAqUdnH63",
+	"3x0gP2QKgqY8GAan2KULKm2BXmUcax8swTOHeMu16/26usPEa0Ij2yVuTXPzRjFVQGyjKs9rPwpMgpWM",
+	"bTHDI+gSLIEU6Cw2JKICE9gFjw0oV/7kDQLyOlOY7iZSQfhRSAH2cEq1JpSkVBkeZTFVeaaLCXdrMFPB",
+	"8aPIkUT8rOMhVBMu0sxg8zvvRBX4lIm6kUSByZQgNI4/iirPQqJgSRWLtz1TrnKh499Yi1hF6H5EwaHq",
+	"26Rrwuy8xIyr/EfBKJqAAaWD4S8PAUfu/5aB7W25fZXtkPOwZZoyHfFDs0y4s8PjLbzDLMIPkMZxDVar",
+	"yfcprC8QnfT7R20OHRT+KgsY7X7WJvTpt/TsJtgIevYognkN9I/jVpyKJpcHmYlwillbcHKVd80U27iG",
+	"gaFLVJwgStPPPPiEn9YsvPdgj3Y42+w09jew4wLrjKhtfgmSb2Xs1+odSo0eaKs0BVZB1c0alcGhWl6u",
+	"zDxbvfbe4pNZa8vku9ObnVI9Tmt681jOn6A6ILDDZb3tzfU7N7EiCOtpSvUKsfiuFetrJ4Wks+BxIwfp",
+	"4H+vrt9M3pPx9e1s8noyHs2u7dOPYjStKlK32/0o7Jvr91ee04+CGo+OARUcoNJWXH8dvXbo7lBuKRZ8",
+	"WVHjtq65E3tFjn29Xhrnm4ytqFcGyxZV0yyKQGucM3woLq8w18erEpVeZee2zo0bxYVx3cjZh3dviSM0",
+	"c+Axv4JulSUySbCQsjwpctFdHJkIuyT01+LHK6p5VBtrpnQJxLZ8y9ZsJSt1Mxytd3IplsteuX+1i1Xl",
+	"6tbvGIrKO/4wXqKlxY0dsxaPwiDNPEyZNphi4b+SbP2H8KPYjKvev40Em/9TUpoeIiXUZFsC9h64Zh2q",
+	"N4+WftslF12MKdqzXDcQd+VPMRIPMd6bFaxtveVKKFdOVViqcQbiFhDidVi5iqpKSWihU/++hp10lJsS",
+	"LXN0M/hWtlCn88o7nPZkFI5hT84nKuVYY9cPTLQiCwV6Vev2YnmqDVBbTWYa5RrRaIWTXAG6RLJRhSmw",
+	"kGqVWDnyWtBYg2/9oolTrmJkxRkD4cSy60Z35pkX2kWYGEucXNuwl5BvY9i1kXArbNSUpNQ6+9YxrhhU",
+	"+bC0Cx3Pw3Ga0giIBlQnA8yhKxe43EdSBdt9uUKPk0wbklATrcJiIvi0taM+6f9ndfPo5If+DjIrWzK7",
+	"Y3CLspuCrQ7xYs1Ug2CECjIdv7shRtEI7DpYkZAVLgFnSJYJckHgHtQ631V1U2ArwfpCkQ9xK9njBPR7",
+	"FnzOdXic983jzrD75+fJFoXzPxqFfNHVDm66u1oXZSShBb8qQQpf5iGq8ID7+5LtIdmOPqSv/ah9/cdc",
+	"VZWx+osWMJo2x4ZkInQKkUMBN2XvOctoXLzXeW2bSAXELS8BI/ccvnjD1LSgdk+kmlqs8qArF94hZvNH",
+	"C14fUR9GPjN8zUAlHEX/CFInBVInO5GqjUSPQ+kP6SvW5tpHdBat+8fY7dHU7vfbZPRgWzHV/FHDWnsP",
+	"+b+KLiODGIxnrejKPt9xzzawu9ZQ8Xhy1TYeBygXzT7zmW0NmEyuaqvUW7te5CadZqZYhNduCcoutFJB",
+	"aAVIsZoTSaE5sx6EYiqw4F+t96BxvFWAupOi1jUg+gxdEtcIJ9OAVQF6D/uu/RkO5li+ostVmfAjKm7u",
+	"wV0CMjixrbYCmZxYGpmKmyJFww3XJiWDMtJ6kt+tZJ+cANfWNLRZW8+guXURHhs+88zHfBsRloXfgyH9",
+	"eUF36tYEi19NVw36UVPzWnT4eIe38tT+LHC7AdqG/1i0a1vrd6mF3y6jLOj2NQTael3pXHW/29br/g2l",
+	"w+PFYfMFz407BwyPaZ9/jPCX08ADZg03o9k/yfT6zbvr97O852+ZiHVMjkljSOD5IjhIZ7/rMcEufHcp",
+	"qVHRAeVHTA1okwOfKaz3b6U0ZFxtv7tyAGi0wuR9R3ly/JZEvXuGqcbsdlyWNDk3gFW7SHY3uYJ30Uhq",
+	"mckMqT/MPtpLCkHoS673/wywsAX0fMH3vWVQLpUdUQnk1+IONgqq+/yWb6mGCG/HxAv1uMc1w37vpjN/",
+	"wARy09EPbqdrc6DH3aXaOwa2MxUdNKR1yrLbjT6657YJvTCRwMOADg6G6Zh1GFTfit3vmVfgKqpH62a3",
+	"4+63Gf3kCvY0/TomrO9SsiK0F5HeFjY2wu/UvoPXBP5fA5+YV8xux3ly8POvoy8ffh29eDe7/jJp5BLb",
+	"U4FXRZs5w/PVdOf0f2P3WO8LXchUHAyDlTHpsNd7WEltNsMH7FVvejTlvfuBXVBWHP215Rgeqf9+yP4e",
+	"yT7G6adUjdeng8H5CZrmpxKbpv6PZZLvb+JPF+2vgebr3BryREB3t0qQj/HaPbhr7Lob22VQENvhhJH+",
+	"jlMzkz0S2vjm5scJ9jSsPlZxs3w+HrUqSqn9Feqnzf8OABoIMTt3TAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -14,6 +14,14 @@ const (
 	Info  LogLevelLevel = "info"
 )
 
+// Defines values for PathStatus.
+const (
+	Alive   PathStatus = "alive"
+	Scmp    PathStatus = "scmp"
+	Timeout PathStatus = "timeout"
+	Unknown PathStatus = "unknown"
+)
+
 // Certificate defines model for Certificate.
 type Certificate struct {
 	DistinguishedName string       `json:"distinguished_name"`
@@ -40,6 +48,13 @@ type ChainBrief struct {
 // ChainID defines model for ChainID.
 type ChainID = string
 
+// GeoCoordinates defines model for GeoCoordinates.
+type GeoCoordinates struct {
+	Address   string  `json:"address"`
+	Latitude  float32 `json:"latitude"`
+	Longitude float32 `json:"longitude"`
+}
+
 // Hop defines model for Hop.
 type Hop struct {
 	Interface int   `json:"interface"`
@@ -58,6 +73,67 @@ type LogLevel struct {
 // LogLevelLevel Logging level
 type LogLevelLevel string
 
+// Path defines model for Path.
+type Path struct {
+	// Bandwidth Bandwidths between the interfaces on the path in Kbit/s. Zero indicates that the bandwidth was not announced.
+	Bandwidth []int64 `json:"bandwidth"`
+
+	// Expiry Time at which the path expires.
+	Expiry time.Time `json:"expiry"`
+
+	// Fingerprint Fingerprint of the path, i.e., a digest of its interfaces.
+	Fingerprint string `json:"fingerprint"`
+
+	// Geo Geographical positions of the interfaces on the path.
+	Geo []GeoCoordinates `json:"geo"`
+
+	// Hops Interfaces on the path.
+	Hops []Hop `json:"hops"`
+
+	// InternalHops Number of AS internal hops of the ASes on the path.
+	InternalHops []int `json:"internal_hops"`
+
+	// Latency Latencies between the interfaces on the path in nanoseconds. A negative value indicates that the latency was not announced.
+	Latency []int64 `json:"latency"`
+
+	// LinkType Types of the links between the ASes on the path.
+	LinkType []string `json:"link_type"`
+
+	// LocalIp Local IP address the path was probed from.
+	LocalIp *string `json:"local_ip,omitempty"`
+
+	// Mtu Maximum transmission unit of the path in bytes.
+	Mtu int `json:"mtu"`
+
+	// NextHop Underlay address of the first router on the path.
+	NextHop string `json:"next_hop"`
+
+	// Notes Notes announced by the ASes on the path.
+	Notes []string `json:"notes"`
+
+	// Sequence The path in the path policy sequence syntax.
+	Sequence string `json:"sequence"`
+
+	// Status Status of the path. Only set if the path was probed.
+	Status *PathStatus `json:"status,omitempty"`
+
+	// StatusInfo Additional information on the status.
+	StatusInfo *string `json:"status_info,omitempty"`
+
+	// SupportsEpic Whether the path supports EPIC.
+	SupportsEpic bool `json:"supports_epic"`
+}
+
+// PathStatus Status of the path. Only set if the path was probed.
+type PathStatus string
+
+// Paths defines model for Paths.
+type Paths struct {
+	Destination IsdAs  `json:"destination"`
+	LocalIsdAs  IsdAs  `json:"local_isd_as"`
+	Paths       []Path `json:"paths"`
+}
+
 // Problem defines model for Problem.
 type Problem struct {
 	// Detail A human readable explanation specific to this occurrence of the problem that is helpful to locate the problem and give advice on how to proceed. Written in English and readable for engineers, usually not suited for non technical stakeholders and not localized.
@@ -144,6 +220,24 @@ type GetCertificatesParams struct {
 	All     *bool      `form:"all,omitempty" json:"all,omitempty"`
 }
 
+// GetPathsParams defines parameters for GetPaths.
+type GetPathsParams struct {
+	// Refresh Fetch fresh path segments instead of using cached ones.
+	Refresh *bool `form:"refresh,omitempty" json:"refresh,omitempty"`
+
+	// Hidden Request hidden paths.
+	Hidden *bool `form:"hidden,omitempty" json:"hidden,omitempty"`
+
+	// Epic Only list paths that support EPIC, and probe them with the EPIC path type.
+	Epic *bool `form:"epic,omitempty" json:"epic,omitempty"`
+
+	// Sequence Space separated list of hop predicates the paths must match, in the path policy sequence syntax.
+	Sequence *string `form:"sequence,omitempty" json:"sequence,omitempty"`
+
+	// Probe Probe the paths, i.e., send an SCMP traceroute request to the last hop of every path, and report the status.
+	Probe *bool `form:"probe,omitempty" json:"probe,omitempty"`
+}
+
 // GetSegmentsParams defines parameters for GetSegments.
 type GetSegmentsParams struct {
 	// StartIsdAs Start ISD-AS of segment.
@@ -21,9 +21,9 @@ import (
 	"net/http"
 	_ "net/http/pprof"
 	"net/netip"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/cors"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/scionproto/scion/gateway"
@@ -36,6 +36,9 @@ import (
 	"github.com/scionproto/scion/pkg/snet/addrutil"
 	"github.com/scionproto/scion/private/app"
 	"github.com/scionproto/scion/private/app/launcher"
+	caconfig "github.com/scionproto/scion/private/ca/config"
+	"github.com/scionproto/scion/private/mgmtapi"
+	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
 	"github.com/scionproto/scion/private/service"
 )
 
@@ -94,38 +97,6 @@ func realMain(ctx context.Context) error {
 		probeAddress.IP = controlAddress.IP
 		probeAddress.Zone = controlAddress.Zone
 	}
-	var cleanup app.Cleanup
-	g, errCtx := errgroup.WithContext(ctx)
-	if globalCfg.API.Addr != "" {
-		r := chi.NewRouter()
-		r.Use(cors.Handler(cors.Options{
-			AllowedOrigins: []string{"*"},
-		}))
-		r.Get("/", api.ServeSpecInteractive)
-		r.Get("/openapi.json", api.ServeSpecJSON)
-		server := api.Server{
-			Config:   service.NewConfigStatusPage(globalCfg).Handler,
-			Info:     service.NewInfoStatusPage().Handler,
-			LogLevel: service.NewLogLevelStatusPage().Handler,
-		}
-		log.Info("Exposing API", "addr", globalCfg.API.Addr)
-		h := api.HandlerFromMuxWithBaseURL(&server, r, "/api/v1")
-		mgmtServer := &http.Server{
-			Addr:    globalCfg.API.Addr,
-			Handler: h,
-		}
-		defer mgmtServer.Close()
-		g.Go(func() error {
-			defer log.HandlePanic()
-			err := mgmtServer.ListenAndServe()
-			if err != nil && !errors.Is(err, http.ErrServerClosed) {
-				return serrors.Wrap("serving service management API", err)
-			}
-			return nil
-		})
-		cleanup.Add(mgmtServer.Close)
-	}
-
 	httpPages := service.StatusPages{
 		"info":      service.NewInfoStatusPage(),
 		"config":    service.NewConfigStatusPage(globalCfg),
@@ -144,6 +115,7 @@ func realMain(ctx context.Context) error {
 		ProbeClientIP:            controlAddress.IP,
 		DataServerAddr:           dataAddress,
 		DataClientIP:             dataAddress.IP,
+		FrameEncryption:          globalCfg.Gateway.FrameEncryption,
 		Daemon:                   daemon,
 		RouteSourceIPv4:          globalCfg.Tunnel.SrcIPv4,
 		RouteSourceIPv6:          globalCfg.Tunnel.SrcIPv6,
@@ -156,6 +128,48 @@ func realMain(ctx context.Context) error {
 		Metrics:                  gateway.NewMetrics(localIA),
 	}
 
+	var cleanup app.Cleanup
+	g, errCtx := errgroup.WithContext(ctx)
+	if globalCfg.API.Addr != "" {
+		r := chi.NewRouter()
+		// Browsers must not replace the traffic and routing policies on behalf
+		// of web pages of other origins.
+		r.Use(mgmtapi.CORS(func(r *http.Request) bool {
+			return strings.HasPrefix(r.URL.Path, "/api/v1/policies/")
+		}))
+		r.Get("/", api.ServeSpecInteractive)
+		r.Get("/openapi.json", api.ServeSpecJSON)
+		server := api.Server{
+			Config:   service.NewConfigStatusPage(globalCfg).Handler,
+			Info:     service.NewInfoStatusPage().Handler,
+			LogLevel: service.NewLogLevelStatusPage().Handler,
+			Gateway:  gw,
+		}
+		if secret := globalCfg.Gateway.PolicyUpdateSecret; secret != "" {
+			verifier := &jwtauth.HTTPVerifier{
+				Generator: caconfig.NewPEMSymmetricKey(secret).Get,
+				Logger:    log.New("component", "policy_update"),
+			}
+			server.Authorize = verifier.AddAuthorization
+		}
+		log.Info("Exposing API", "addr", globalCfg.API.Addr)
+		h := api.HandlerFromMuxWithBaseURL(&server, r, "/api/v1")
+		mgmtServer := &http.Server{
+			Addr:    globalCfg.API.Addr,
+			Handler: h,
+		}
+		defer mgmtServer.Close()
+		g.Go(func() error {
+			defer log.HandlePanic()
+			err := mgmtServer.ListenAndServe()
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return serrors.Wrap("serving service management API", err)
+			}
+			return nil
+		})
+		cleanup.Add(mgmtServer.Close)
+	}
+
 	g.Go(func() error {
 		defer log.HandlePanic()
 		return globalCfg.Metrics.ServePrometheus(errCtx)
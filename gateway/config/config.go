@@ -107,6 +107,15 @@ type Gateway struct {
 	DataAddr string `toml:"data_addr,omitempty"`
 	// Probe address, for probing paths.
 	ProbeAddr string `toml:"probe_addr,omitempty"`
+	// FrameEncryption enables the encryption of the frames exchanged with the
+	// remote gateways that have it enabled as well. Frames exchanged with other
+	// remote gateways are not encrypted.
+	FrameEncryption bool `toml:"frame_encryption,omitempty"`
+	// PolicyUpdateSecret is the path to the PEM-encoded shared secret that is
+	// used to verify the JWT tokens of policy updates through the management
+	// API. If it is empty, policy updates through the management API are
+	// disabled.
+	PolicyUpdateSecret string `toml:"policy_update_secret,omitempty"`
 }
 
 func (cfg *Gateway) Validate() error {
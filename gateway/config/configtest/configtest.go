@@ -31,6 +31,8 @@ func CheckGateway(t *testing.T, cfg *config.Gateway) {
 	assert.Equal(t, config.DefaultCtrlAddr, cfg.CtrlAddr)
 	assert.Equal(t, config.DefaultDataAddr, cfg.DataAddr)
 	assert.Equal(t, config.DefaultProbeAddr, cfg.ProbeAddr)
+	assert.False(t, cfg.FrameEncryption)
+	assert.Empty(t, cfg.PolicyUpdateSecret)
 }
 
 func InitTunnel(cfg *config.Tunnel) {}
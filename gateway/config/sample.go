@@ -57,6 +57,21 @@ data_addr = ":30056"
 #
 # (default ":30856")
 probe_addr = ":30856"
+
+# Whether frames exchanged with remote gateways are encrypted and
+# authenticated. Encryption is negotiated per remote gateway: frames are only
+# encrypted if the remote gateway has this setting enabled as well, frames
+# exchanged with all other remote gateways stay unencrypted. The keys are DRKeys
+# obtained from the SCION Daemon, so DRKey must be enabled in the local and the
+# remote AS.
+# (default false)
+frame_encryption = false
+
+# The PEM-encoded shared secret that is used to verify the JWT bearer tokens
+# (HS256) of traffic policy and IP routing policy updates through the management
+# API. If not set, the policies cannot be updated through the management API.
+# (default "")
+policy_update_secret = ""
 `
 
 const tunnelSample = `
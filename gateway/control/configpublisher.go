@@ -121,6 +121,18 @@ func (n *ConfigPublisher) SubscribeRemoteIAs() <-chan []addr.IA {
 	return c
 }
 
+// SessionPolicies returns the last session policies that were published.
+// The returned object is a deep-copy, and can be edited by the caller.
+func (n *ConfigPublisher) SessionPolicies() SessionPolicies {
+	n.mtx.RLock()
+	defer n.mtx.RUnlock()
+
+	if n.sessionPolicies == nil {
+		return nil
+	}
+	return n.sessionPolicies.Copy()
+}
+
 // RoutingPolicy returns the last routing policy that was published.
 // The returned object is a deep-copy, and can be edited by the caller.
 func (n *ConfigPublisher) RoutingPolicy() *routing.Policy {
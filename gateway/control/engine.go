@@ -154,88 +154,93 @@ func (e *Engine) DiagnosticsWrite(w io.Writer) {
 	}
 }
 
-// Status prints the status page to the writer.
-func (e *Engine) Status(w io.Writer) {
+// SessionInfo describes the state of a session of the engine.
+type SessionInfo struct {
+	// ID is the ID of the session.
+	ID uint8
+	// RemoteIA is the ISD-AS of the remote gateway.
+	RemoteIA addr.IA
+	// PolicyID is the ID of the session policy the session was created for.
+	PolicyID int
+	// ProbeAddr is the probe address of the remote gateway.
+	ProbeAddr *net.UDPAddr
+	// Healthy indicates whether the remote gateway answers probes.
+	Healthy bool
+	// PathInfo describes the paths considered by the session. The paths in use
+	// are marked as current.
+	PathInfo pathhealth.PathInfo
+}
+
+// Sessions returns the state of the sessions, sorted by remote ISD-AS and
+// session ID.
+func (e *Engine) Sessions() []SessionInfo {
 	e.stateMtx.RLock()
 	defer e.stateMtx.RUnlock()
 
-	type session struct {
-		ID       uint8
-		PolicyID int
-
-		ProbeAddr *net.UDPAddr
-		Healthy   bool
-		PathInfo  pathhealth.PathInfo
+	type key struct {
+		ia addr.IA
+		id uint8
 	}
-	sessions := make(map[addr.IA]map[uint8]*session)
-	for _, sm := range e.sessionMonitors {
-		iaSessions, ok := sessions[sm.RemoteIA]
+	sessions := make(map[key]*SessionInfo)
+	entry := func(ia addr.IA, id uint8) *SessionInfo {
+		s, ok := sessions[key{ia: ia, id: id}]
 		if !ok {
-			iaSessions = make(map[uint8]*session)
-			sessions[sm.RemoteIA] = iaSessions
-		}
-		iaSessions[sm.ID] = &session{
-			ID:        sm.ID,
-			ProbeAddr: sm.ProbeAddr,
-			Healthy:   sm.sessionState().Healthy,
+			s = &SessionInfo{ID: id, RemoteIA: ia}
+			sessions[key{ia: ia, id: id}] = s
 		}
+		return s
+	}
+	for _, sm := range e.sessionMonitors {
+		s := entry(sm.RemoteIA, sm.ID)
+		s.ProbeAddr = sm.ProbeAddr
+		s.Healthy = sm.sessionState().Healthy
 	}
 	for _, s := range e.sessions {
-		iaSessions, ok := sessions[s.RemoteIA]
-		if !ok {
-			// should never be nil, but better be safe here.
-			iaSessions = make(map[uint8]*session)
-			sessions[s.RemoteIA] = iaSessions
-		}
-		entry := iaSessions[s.ID]
-		if entry == nil {
-			// should never be nil, but better be safe here.
-			entry = &session{ID: s.ID}
-			iaSessions[s.ID] = entry
-		}
-		entry.PathInfo = s.pathResult.PathInfo
+		entry(s.RemoteIA, s.ID).PathInfo = s.sessionPaths().PathInfo
 	}
 	for _, sc := range e.SessionConfigs {
-		iaSessions, ok := sessions[sc.IA]
-		if !ok {
-			// should never be nil, but better be safe here.
-			iaSessions = make(map[uint8]*session)
-			sessions[sc.IA] = iaSessions
-		}
-		entry := iaSessions[sc.ID]
-		if entry == nil {
-			// should never be nil, but better be safe here.
-			entry = &session{ID: sc.ID}
-			iaSessions[sc.ID] = entry
-		}
-		entry.PolicyID = sc.PolicyID
+		entry(sc.IA, sc.ID).PolicyID = sc.PolicyID
 	}
-	sortedIAs := make([]addr.IA, 0, len(sessions))
-	for ia := range sessions {
-		sortedIAs = append(sortedIAs, ia)
+	result := make([]SessionInfo, 0, len(sessions))
+	for _, s := range sessions {
+		result = append(result, *s)
 	}
-	sort.Slice(sortedIAs, func(i, j int) bool {
-		return sortedIAs[i] < sortedIAs[j]
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].RemoteIA != result[j].RemoteIA {
+			return result[i].RemoteIA < result[j].RemoteIA
+		}
+		return result[i].ID < result[j].ID
 	})
-	printSessions := func(m map[uint8]*session) {
-		iaSessions := make([]*session, 0, len(m))
-		for _, s := range m {
-			iaSessions = append(iaSessions, s)
+	return result
+}
+
+// activeSessions returns the session in use per routing table index.
+func (e *Engine) activeSessions() map[int]uint8 {
+	e.stateMtx.RLock()
+	defer e.stateMtx.RUnlock()
+
+	if e.router == nil {
+		return nil
+	}
+	return e.router.ActiveSessions()
+}
+
+// Status prints the status page to the writer.
+func (e *Engine) Status(w io.Writer) {
+	sessions := e.Sessions()
+	for i, s := range sessions {
+		if i == 0 || s.RemoteIA != sessions[i-1].RemoteIA {
+			fmt.Fprintf(w, "ISD-AS %s\n", s.RemoteIA)
 		}
-		sort.Slice(iaSessions, func(i, j int) bool { return iaSessions[i].ID < iaSessions[j].ID })
-		for _, s := range iaSessions {
-			fmt.Fprintf(w, "  SESSION %d, POLICY_ID %d, REMOTE: %s, HEALTHY %t\n",
-				s.ID, s.PolicyID, s.ProbeAddr, s.Healthy)
-			fmt.Fprint(w, "    PATHS:\n")
-			renderPathInfo(s.PathInfo, w, 2)
+		fmt.Fprintf(w, "  SESSION %d, POLICY_ID %d, REMOTE: %s, HEALTHY %t\n",
+			s.ID, s.PolicyID, s.ProbeAddr, s.Healthy)
+		fmt.Fprint(w, "    PATHS:\n")
+		renderPathInfo(s.PathInfo, w, 2)
+		fmt.Fprint(w, "\n")
+		if i == len(sessions)-1 || s.RemoteIA != sessions[i+1].RemoteIA {
 			fmt.Fprint(w, "\n")
 		}
 	}
-	for _, ia := range sortedIAs {
-		fmt.Fprintf(w, "ISD-AS %s\n", ia)
-		printSessions(sessions[ia])
-		fmt.Fprint(w, "\n")
-	}
 
 	if dw, ok := e.RoutingTable.(DiagnosticsWriter); ok {
 		fmt.Fprint(w, "\nROUTING TABLE:\n")
@@ -255,6 +260,9 @@ func renderPathInfo(p pathhealth.PathInfo, w io.Writer, indent int) {
 				strings.Repeat(" ", indent),
 				state,
 				fmt.Sprintf("%v", path.Revoked),
+				renderDuration(path.Latency),
+				renderDuration(path.Jitter),
+				fmt.Sprintf("%.0f%%", path.DropRate*100),
 				path.Path,
 			})
 		} else {
@@ -262,6 +270,9 @@ func renderPathInfo(p pathhealth.PathInfo, w io.Writer, indent int) {
 				strings.Repeat(" ", indent),
 				path.RejectReason,
 				"",
+				"",
+				"",
+				"",
 				path.Path,
 			})
 		}
@@ -275,12 +286,21 @@ func renderPathInfo(p pathhealth.PathInfo, w io.Writer, indent int) {
 	table.SetRowSeparator("")
 	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
 	table.SetAlignment(tablewriter.ALIGN_LEFT)
-	table.SetHeader([]string{"", "STATE", "REVOKED", "PATH"})
+	table.SetHeader([]string{"", "STATE", "REVOKED", "LATENCY", "JITTER", "LOSS", "PATH"})
 	table.AppendBulk(paths)
 	table.Render()
 
 }
 
+// renderDuration renders a measured duration, where zero means that no
+// measurement is available.
+func renderDuration(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	return d.Round(10 * time.Microsecond).String()
+}
+
 func (e *Engine) setup(ctx context.Context) error {
 	if err := e.validate(); err != nil {
 		return err
@@ -322,7 +342,8 @@ func (e *Engine) initWorkers(ctx context.Context) error {
 			config.ID,
 			config.PolicyID,
 			config.IA,
-			config.Gateway.Data,
+			config.Gateway,
+			config.LoadBalancing,
 		)
 		remoteIA := config.IA
 		pathMonitorRegistration := e.PathMonitor.Register(
@@ -496,7 +517,8 @@ type PktWriter interface {
 // DataplaneSessionFactory is used to construct a data-plane session with a specific ID towards a
 // remote.
 type DataplaneSessionFactory interface {
-	New(sessID uint8, policyID int, remoteIA addr.IA, remoteAddr net.Addr) DataplaneSession
+	New(sessID uint8, policyID int, remoteIA addr.IA, remote Gateway,
+		lb LoadBalancing) DataplaneSession
 }
 
 // PathMonitor is used to construct registrations for path discovery.
@@ -106,6 +106,11 @@ type EngineController struct {
 	// startup before the first configuration update arrives), it means no forwarding is currently
 	// in effect.
 	engine Worker
+	// routingChains are the routing chains of the engine currently in use.
+	routingChains []*RoutingChain
+	// routingTableIndices maps the routing table indices of the engine
+	// currently in use to the priority-ordered list of eligible sessions.
+	routingTableIndices map[int][]uint8
 
 	workerBase worker.Base
 }
@@ -134,6 +139,75 @@ func (c *EngineController) Status(w io.Writer) {
 	}
 }
 
+// Sessions returns the state of the sessions of the engine currently in use.
+func (c *EngineController) Sessions() []SessionInfo {
+	c.stateMtx.RLock()
+	defer c.stateMtx.RUnlock()
+	if sl, ok := c.engine.(interface{ Sessions() []SessionInfo }); ok {
+		return sl.Sessions()
+	}
+	return nil
+}
+
+// RoutingChainInfo describes a routing chain of the routing table in use.
+type RoutingChainInfo struct {
+	// RemoteIA is the remote ISD-AS to which the routing chain routes.
+	RemoteIA addr.IA
+	// Prefixes are the prefixes routed by the routing chain.
+	Prefixes []*net.IPNet
+	// TrafficClasses are the traffic classes of the routing chain, in the
+	// order in which they are evaluated.
+	TrafficClasses []TrafficClassInfo
+}
+
+// TrafficClassInfo describes a traffic class of a routing chain.
+type TrafficClassInfo struct {
+	// ID is the routing table index of the traffic class.
+	ID int
+	// Matcher is the condition the IP traffic must satisfy.
+	Matcher pktcls.Cond
+	// Sessions are the IDs of the sessions eligible for the traffic class,
+	// sorted by priority.
+	Sessions []uint8
+	// Active indicates whether a session is in use for the traffic class. If
+	// false, the traffic is dropped.
+	Active bool
+	// ActiveSession is the ID of the session in use. It is only valid if
+	// Active is true.
+	ActiveSession uint8
+}
+
+// RoutingChains returns the routing chains of the routing table in use.
+func (c *EngineController) RoutingChains() []RoutingChainInfo {
+	c.stateMtx.RLock()
+	defer c.stateMtx.RUnlock()
+
+	var active map[int]uint8
+	if e, ok := c.engine.(interface{ activeSessions() map[int]uint8 }); ok {
+		active = e.activeSessions()
+	}
+	chains := make([]RoutingChainInfo, 0, len(c.routingChains))
+	for _, rc := range c.routingChains {
+		chain := RoutingChainInfo{
+			RemoteIA:       rc.RemoteIA,
+			Prefixes:       rc.Prefixes,
+			TrafficClasses: make([]TrafficClassInfo, 0, len(rc.TrafficMatchers)),
+		}
+		for _, tm := range rc.TrafficMatchers {
+			sessID, ok := active[tm.ID]
+			chain.TrafficClasses = append(chain.TrafficClasses, TrafficClassInfo{
+				ID:            tm.ID,
+				Matcher:       tm.Matcher,
+				Sessions:      c.routingTableIndices[tm.ID],
+				Active:        ok,
+				ActiveSession: sessID,
+			})
+		}
+		chains = append(chains, chain)
+	}
+	return chains
+}
+
 func (c *EngineController) validate(ctx context.Context) error {
 	if c.ConfigurationUpdates == nil {
 		return serrors.New("configuration update channel must not be nil")
@@ -194,6 +268,8 @@ func (c *EngineController) run(ctx context.Context) error {
 
 		c.stateMtx.Lock()
 		c.engine = newEngine
+		c.routingChains = rcs
+		c.routingTableIndices = rcMapping
 		c.stateMtx.Unlock()
 	}
 	return nil
@@ -137,6 +137,65 @@ func TestEngineControllerRun(t *testing.T) {
 	})
 }
 
+func TestEngineControllerRoutingChains(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	configurationUpdates := make(chan []*control.SessionConfig)
+	routingTableSwapper := mock_control.NewMockRoutingTableSwapper(ctrl)
+	routingTableFactory := mock_control.NewMockRoutingTableFactory(ctrl)
+	engineFactory := mock_control.NewMockEngineFactory(ctrl)
+	publisherFactory := mock_control.NewMockPublisherFactory(ctrl)
+	engine := mock_control.NewMockWorker(ctrl)
+
+	routingTableFactory.EXPECT().New(gomock.Any()).Return(
+		mock_control.NewMockRoutingTable(ctrl), nil)
+	publisherFactory.EXPECT().NewPublisher().Return(mock_control.NewMockPublisher(ctrl))
+	engineFactory.EXPECT().New(gomock.Any(), gomock.Any(), gomock.Any()).Return(engine)
+	engine.EXPECT().Run(gomock.Any())
+	routingTableSwapper.EXPECT().SetRoutingTable(gomock.Any())
+
+	engineController := &control.EngineController{
+		ConfigurationUpdates:  configurationUpdates,
+		RoutingTableSwapper:   routingTableSwapper,
+		RoutingTableFactory:   routingTableFactory,
+		EngineFactory:         engineFactory,
+		RoutePublisherFactory: publisherFactory,
+	}
+	assert.Empty(t, engineController.RoutingChains())
+
+	var bg errgroup.Group
+	bg.Go(func() error {
+		return engineController.Run(context.Background())
+	})
+	configurationUpdates <- []*control.SessionConfig{
+		{
+			ID:             23,
+			IA:             addr.MustParseIA("1-ff00:0:110"),
+			TrafficMatcher: pktcls.CondTrue,
+			Gateway: control.Gateway{
+				Control: xtest.MustParseUDPAddr(t, "10.1.0.1:30256"),
+			},
+			Prefixes: xtest.MustParseCIDRs(t, "10.99.0.0/16"),
+		},
+	}
+	close(configurationUpdates)
+	assert.NoError(t, bg.Wait())
+
+	expected := []control.RoutingChainInfo{
+		{
+			RemoteIA: addr.MustParseIA("1-ff00:0:110"),
+			Prefixes: xtest.MustParseCIDRs(t, "10.99.0.0/16"),
+			TrafficClasses: []control.TrafficClassInfo{
+				{ID: 1, Matcher: pktcls.CondTrue, Sessions: []uint8{23}},
+			},
+		},
+	}
+	assert.Equal(t, expected, engineController.RoutingChains())
+	// The mocked engine does not report sessions.
+	assert.Empty(t, engineController.Sessions())
+}
+
 func TestBuildRoutingChains(t *testing.T) {
 	testCases := map[string]struct {
 		Input          []*control.SessionConfig
@@ -18,6 +18,9 @@ import (
 	"context"
 	"net"
 
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
 	"github.com/scionproto/scion/gateway/control"
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/grpc"
@@ -33,10 +36,14 @@ type PrefixFetcher struct {
 	Dialer grpc.Dialer
 }
 
-func (f PrefixFetcher) Prefixes(ctx context.Context, gateway *net.UDPAddr) ([]*net.IPNet, error) {
+// Prefixes fetches the IP prefixes from the remote gateway. It also reports
+// whether the remote gateway advertised that it accepts encrypted frames.
+func (f PrefixFetcher) Prefixes(ctx context.Context,
+	gateway *net.UDPAddr) ([]*net.IPNet, bool, error) {
+
 	paths := f.Pather.Get().Paths
 	if len(paths) == 0 {
-		return nil, serrors.New("no path available")
+		return nil, false, serrors.New("no path available")
 	}
 	conn, err := f.Dialer.Dial(ctx, &snet.UDPAddr{
 		IA:      f.Remote,
@@ -45,13 +52,15 @@ func (f PrefixFetcher) Prefixes(ctx context.Context, gateway *net.UDPAddr) ([]*n
 		Host:    gateway,
 	})
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	defer conn.Close()
 	client := gpb.NewIPPrefixesServiceClient(conn)
-	rep, err := client.Prefixes(ctx, &gpb.PrefixesRequest{}, grpc.RetryProfile...)
+	var header metadata.MD
+	opts := append([]ggrpc.CallOption{ggrpc.Header(&header)}, grpc.RetryProfile...)
+	rep, err := client.Prefixes(ctx, &gpb.PrefixesRequest{}, opts...)
 	if err != nil {
-		return nil, serrors.Wrap("receiving IP prefixes", err)
+		return nil, false, serrors.Wrap("receiving IP prefixes", err)
 	}
 	prefixes := make([]*net.IPNet, 0, len(rep.Prefixes))
 	for _, pb := range rep.Prefixes {
@@ -64,5 +73,5 @@ func (f PrefixFetcher) Prefixes(ctx context.Context, gateway *net.UDPAddr) ([]*n
 			Mask: mask,
 		})
 	}
-	return prefixes, nil
+	return prefixes, frameEncryptionAdvertised(header), nil
 }
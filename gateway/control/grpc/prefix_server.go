@@ -18,7 +18,9 @@ import (
 	"context"
 	"net/netip"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
@@ -29,6 +31,19 @@ import (
 )
 
 // Advertiser returns a list of IP prefixes to advertise.
+// FrameEncryptionHeader is the gRPC header with which a gateway advertises in
+// the reply to a prefix request that it accepts encrypted frames. Gateways that
+// do not support encrypted frames do not set it, so they keep receiving plain
+// frames.
+const FrameEncryptionHeader = "x-scion-gateway-frame-encryption"
+
+// frameEncryptionAdvertised checks whether the header advertises that encrypted
+// frames are accepted.
+func frameEncryptionAdvertised(header metadata.MD) bool {
+	values := header.Get(FrameEncryptionHeader)
+	return len(values) > 0 && values[0] == "1"
+}
+
 type Advertiser interface {
 	AdvertiseList(from, to addr.IA) ([]netip.Prefix, error)
 }
@@ -42,6 +57,9 @@ type IPPrefixServer struct {
 	// PrefixesAdvertised reports the number of IP prefixes advertised. If nil, no  metrics are
 	// reported.
 	PrefixesAdvertised metrics.Gauge
+	// FrameEncryption advertises to the remote gateways that encrypted frames
+	// are accepted.
+	FrameEncryption bool
 }
 
 func (s IPPrefixServer) Prefixes(ctx context.Context,
@@ -59,6 +77,12 @@ func (s IPPrefixServer) Prefixes(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if s.FrameEncryption {
+		header := metadata.Pairs(FrameEncryptionHeader, "1")
+		if err := grpc.SetHeader(ctx, header); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
 	metrics.GaugeSet(metrics.GaugeWith(s.PrefixesAdvertised,
 		"remote_isd_as", udp.IA.String()), float64(len(prefixes)))
 
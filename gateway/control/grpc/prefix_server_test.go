@@ -23,6 +23,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 
 	"github.com/scionproto/scion/gateway/control/grpc"
@@ -134,3 +136,51 @@ func networksList(t *testing.T, networks string) []*net.IPNet {
 	}
 	return prefixes
 }
+
+func TestIPPrefixServerFrameEncryption(t *testing.T) {
+	local := addr.MustParseIA("1-ff00:0:110")
+	remote := addr.MustParseIA("1-ff00:0:111")
+
+	for name, enabled := range map[string]bool{"enabled": true, "disabled": false} {
+		enabled := enabled
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			a := mock_grpc.NewMockAdvertiser(ctrl)
+			a.EXPECT().AdvertiseList(local, remote).Return(nil, nil)
+			s := grpc.IPPrefixServer{
+				LocalIA:         local,
+				Advertiser:      a,
+				FrameEncryption: enabled,
+			}
+			stream := &headerStream{}
+			ctx := ggrpc.NewContextWithServerTransportStream(
+				peer.NewContext(context.Background(), &peer.Peer{Addr: &snet.UDPAddr{IA: remote}}),
+				stream,
+			)
+			_, err := s.Prefixes(ctx, &gpb.PrefixesRequest{})
+			require.NoError(t, err)
+			if enabled {
+				assert.Equal(t, []string{"1"}, stream.header.Get(grpc.FrameEncryptionHeader))
+			} else {
+				assert.Empty(t, stream.header.Get(grpc.FrameEncryptionHeader))
+			}
+		})
+	}
+}
+
+// headerStream records the header set by a gRPC server handler.
+type headerStream struct {
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string { return "Prefixes" }
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *headerStream) SetTrailer(metadata.MD) error { return nil }
@@ -57,17 +57,17 @@ func (mr *MockDataplaneSessionMockRecorder) Close() *gomock.Call {
 }
 
 // SetPaths mocks base method.
-func (m *MockDataplaneSession) SetPaths(arg0 []snet.Path) error {
+func (m *MockDataplaneSession) SetPaths(arg0 []snet.Path, arg1 []float64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetPaths", arg0)
+	ret := m.ctrl.Call(m, "SetPaths", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetPaths indicates an expected call of SetPaths.
-func (mr *MockDataplaneSessionMockRecorder) SetPaths(arg0 interface{}) *gomock.Call {
+func (mr *MockDataplaneSessionMockRecorder) SetPaths(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPaths", reflect.TypeOf((*MockDataplaneSession)(nil).SetPaths), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPaths", reflect.TypeOf((*MockDataplaneSession)(nil).SetPaths), arg0, arg1)
 }
 
 // Write mocks base method.
@@ -524,12 +524,13 @@ func (mr *MockPrefixFetcherMockRecorder) Close() *gomock.Call {
 }
 
 // Prefixes mocks base method.
-func (m *MockPrefixFetcher) Prefixes(arg0 context.Context, arg1 *net.UDPAddr) ([]*net.IPNet, error) {
+func (m *MockPrefixFetcher) Prefixes(arg0 context.Context, arg1 *net.UDPAddr) ([]*net.IPNet, bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Prefixes", arg0, arg1)
 	ret0, _ := ret[0].([]*net.IPNet)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // Prefixes indicates an expected call of Prefixes.
@@ -599,17 +600,17 @@ func (m *MockDataplaneSessionFactory) EXPECT() *MockDataplaneSessionFactoryMockR
 }
 
 // New mocks base method.
-func (m *MockDataplaneSessionFactory) New(arg0 byte, arg1 int, arg2 addr.IA, arg3 net.Addr) control.DataplaneSession {
+func (m *MockDataplaneSessionFactory) New(arg0 byte, arg1 int, arg2 addr.IA, arg3 control.Gateway, arg4 control.LoadBalancing) control.DataplaneSession {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "New", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "New", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(control.DataplaneSession)
 	return ret0
 }
 
 // New indicates an expected call of New.
-func (mr *MockDataplaneSessionFactoryMockRecorder) New(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockDataplaneSessionFactoryMockRecorder) New(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "New", reflect.TypeOf((*MockDataplaneSessionFactory)(nil).New), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "New", reflect.TypeOf((*MockDataplaneSessionFactory)(nil).New), arg0, arg1, arg2, arg3, arg4)
 }
 
 // MockPktWriter is a mock of PktWriter interface.
@@ -0,0 +1,387 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+
+	"github.com/scionproto/scion/gateway/pathhealth/policies"
+	"github.com/scionproto/scion/gateway/routing"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+// ErrInvalidPolicy indicates that a policy update was rejected, because the
+// new policy is invalid.
+var ErrInvalidPolicy = serrors.New("invalid policy")
+
+// PolicyUpdate describes the outcome of a policy update.
+type PolicyUpdate struct {
+	// DryRun indicates that the policy was only validated, but not applied.
+	DryRun bool
+	// Changed indicates that the new policy differs from the current one.
+	Changed bool
+	// Persisted indicates that the new policy was written to the policy file.
+	Persisted bool
+	// Diff is the line-based difference between the current and the new
+	// policy. Removed lines are prefixed with "-", added lines with "+", and
+	// unchanged lines with " ". It is empty if the policy did not change.
+	Diff string
+}
+
+// PolicyUpdater applies policies that are changed at runtime, e.g., through
+// the management API. A new policy is validated, written to the policy file,
+// and only then published. Updates are serialized, such that concurrent
+// updates do not overwrite each other.
+type PolicyUpdater struct {
+	// SessionPoliciesFile is the file name of the session policies. Must be
+	// set.
+	SessionPoliciesFile string
+	// RoutingPolicyFile is the file name of the routing policy. If it is
+	// empty, the routing policy is applied without being persisted.
+	RoutingPolicyFile string
+	// SessionPolicyParser is used to parse session policies. Must be set.
+	SessionPolicyParser SessionPolicyParser
+	// Publisher is used to publish the new policies. Must be set.
+	Publisher *ConfigPublisher
+
+	mtx sync.Mutex
+}
+
+// UpdateSessionPolicies replaces the session policies with the raw JSON
+// document, which has the format of the session policies file. If dryRun is
+// set, the document is only validated and compared to the loaded session
+// policies.
+func (u *PolicyUpdater) UpdateSessionPolicies(
+	ctx context.Context,
+	raw []byte,
+	dryRun bool,
+) (PolicyUpdate, error) {
+
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return PolicyUpdate{}, serrors.Join(ErrInvalidPolicy, err)
+	}
+	return u.updateSessionPolicies(ctx, doc, dryRun)
+}
+
+// PatchSessionPolicies applies the JSON merge patch (RFC 7396) to the session
+// policies file and replaces the session policies with the result. If dryRun
+// is set, the result is only validated and compared to the loaded
+// session policies.
+func (u *PolicyUpdater) PatchSessionPolicies(
+	ctx context.Context,
+	patch []byte,
+	dryRun bool,
+) (PolicyUpdate, error) {
+
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	var p interface{}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return PolicyUpdate{}, serrors.Join(ErrInvalidPolicy, err)
+	}
+	var doc interface{}
+	raw, err := readPolicyFile(u.SessionPoliciesFile)
+	if err != nil {
+		return PolicyUpdate{}, err
+	}
+	if len(raw) != 0 {
+		if err := json.Unmarshal(raw, &doc); err != nil {
+			return PolicyUpdate{}, serrors.Wrap("parsing session policies file", err,
+				"file", u.SessionPoliciesFile)
+		}
+	}
+	return u.updateSessionPolicies(ctx, mergePatch(doc, p), dryRun)
+}
+
+func (u *PolicyUpdater) updateSessionPolicies(
+	ctx context.Context,
+	doc interface{},
+	dryRun bool,
+) (PolicyUpdate, error) {
+
+	if _, ok := doc.(map[string]interface{}); !ok {
+		return PolicyUpdate{}, serrors.Join(ErrInvalidPolicy,
+			serrors.New("session policies must be a JSON object"))
+	}
+	// The document is written in a canonical form, so that the file does not
+	// depend on the formatting of the request.
+	next, err := json.MarshalIndent(doc, "", "    ")
+	if err != nil {
+		return PolicyUpdate{}, serrors.Join(ErrInvalidPolicy, err)
+	}
+	next = append(next, '\n')
+	sp, err := u.SessionPolicyParser.Parse(ctx, next)
+	if err != nil {
+		return PolicyUpdate{}, serrors.Join(ErrInvalidPolicy, err)
+	}
+
+	// The difference is computed between the loaded and the new session
+	// policies, rather than the file, which might have been edited without
+	// being reloaded.
+	current, err := formatSessionPolicies(u.Publisher.SessionPolicies())
+	if err != nil {
+		return PolicyUpdate{}, err
+	}
+	formatted, err := formatSessionPolicies(sp)
+	if err != nil {
+		return PolicyUpdate{}, serrors.Join(ErrInvalidPolicy, err)
+	}
+	update := newPolicyUpdate(current, formatted, dryRun)
+	if dryRun {
+		return update, nil
+	}
+	if err := writePolicyFile(u.SessionPoliciesFile, next); err != nil {
+		return PolicyUpdate{}, serrors.Wrap("writing session policies file", err,
+			"file", u.SessionPoliciesFile)
+	}
+	update.Persisted = true
+	u.Publisher.Publish(sp, nil)
+	return update, nil
+}
+
+// UpdateRoutingPolicy replaces the routing policy with the raw policy, which
+// has the format of the routing policy file. If dryRun is set, the policy is
+// only validated and compared to the loaded policy.
+func (u *PolicyUpdater) UpdateRoutingPolicy(
+	ctx context.Context,
+	raw []byte,
+	dryRun bool,
+) (PolicyUpdate, error) {
+
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	var rp routing.Policy
+	if err := rp.UnmarshalText(raw); err != nil {
+		return PolicyUpdate{}, serrors.Join(ErrInvalidPolicy, err)
+	}
+	next, err := rp.MarshalText()
+	if err != nil {
+		return PolicyUpdate{}, serrors.Join(ErrInvalidPolicy, err)
+	}
+	current, err := u.currentRoutingPolicy()
+	if err != nil {
+		return PolicyUpdate{}, err
+	}
+	update := newPolicyUpdate(current, string(next), dryRun)
+	if dryRun {
+		return update, nil
+	}
+	if u.RoutingPolicyFile != "" {
+		if err := writePolicyFile(u.RoutingPolicyFile, next); err != nil {
+			return PolicyUpdate{}, serrors.Wrap("writing routing policy file", err,
+				"file", u.RoutingPolicyFile)
+		}
+		update.Persisted = true
+	}
+	u.Publisher.Publish(nil, &rp)
+	return update, nil
+}
+
+// currentRoutingPolicy returns the routing policy that is currently loaded.
+func (u *PolicyUpdater) currentRoutingPolicy() (string, error) {
+	rp := u.Publisher.RoutingPolicy()
+	if rp == nil {
+		return "", nil
+	}
+	raw, err := rp.MarshalText()
+	return string(raw), err
+}
+
+// formatSessionPolicies formats the session policies as indented JSON, sorted
+// by remote AS and ID, with the same fields as the management API uses for the
+// traffic policy.
+func formatSessionPolicies(sp SessionPolicies) (string, error) {
+	type entry struct {
+		IA                addr.IA         `json:"isd_as"`
+		ID                int             `json:"id"`
+		TrafficMatcher    string          `json:"traffic_matcher"`
+		PerformancePolicy string          `json:"performance_policy,omitempty"`
+		PathPolicy        json.RawMessage `json:"path_policy,omitempty"`
+		PathCount         int             `json:"path_count"`
+		LoadBalancing     LoadBalancing   `json:"load_balancing,omitempty"`
+		Prefixes          []string        `json:"prefixes"`
+	}
+	if len(sp) == 0 {
+		return "", nil
+	}
+	entries := make([]entry, 0, len(sp))
+	for _, p := range sp {
+		e := entry{
+			IA:                p.IA,
+			ID:                p.ID,
+			TrafficMatcher:    p.TrafficMatcher.String(),
+			PerformancePolicy: policies.PerfPolicyName(p.PerfPolicy),
+			PathCount:         p.PathCount,
+			LoadBalancing:     p.LoadBalancing,
+			Prefixes:          make([]string, 0, len(p.Prefixes)),
+		}
+		if p.PathPolicy != nil {
+			raw, err := json.Marshal(p.PathPolicy)
+			if err != nil {
+				return "", serrors.Wrap("marshaling path policy", err, "ia", p.IA, "id", p.ID)
+			}
+			e.PathPolicy = raw
+		}
+		for _, prefix := range p.Prefixes {
+			e.Prefixes = append(e.Prefixes, prefix.String())
+		}
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].IA != entries[j].IA {
+			return entries[i].IA < entries[j].IA
+		}
+		return entries[i].ID < entries[j].ID
+	})
+	raw, err := json.MarshalIndent(entries, "", "    ")
+	if err != nil {
+		return "", serrors.Wrap("marshaling session policies", err)
+	}
+	return string(raw) + "\n", nil
+}
+
+func newPolicyUpdate(current, next string, dryRun bool) PolicyUpdate {
+	return PolicyUpdate{
+		DryRun:  dryRun,
+		Changed: current != next,
+		Diff:    diffLines(current, next),
+	}
+}
+
+// mergePatch applies the JSON merge patch to the document as described in
+// RFC 7396.
+func mergePatch(doc, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	d, ok := doc.(map[string]interface{})
+	if !ok {
+		d = make(map[string]interface{})
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(d, k)
+			continue
+		}
+		d[k] = mergePatch(d[k], v)
+	}
+	return d
+}
+
+// diffLines returns the line-based difference between a and b.
+func diffLines(a, b string) string {
+	if a == b {
+		return ""
+	}
+	// Every line is encoded as a single rune, such that the diff is computed
+	// on whole lines.
+	var lines []string
+	index := make(map[string]rune)
+	encode := func(text string) []rune {
+		var encoded []rune
+		for _, line := range strings.SplitAfter(text, "\n") {
+			if line == "" {
+				continue
+			}
+			r, ok := index[line]
+			if !ok {
+				r = rune(len(lines))
+				index[line] = r
+				lines = append(lines, line)
+			}
+			encoded = append(encoded, r)
+		}
+		return encoded
+	}
+	ra, rb := encode(a), encode(b)
+	dmp := diffmatchpatch.New()
+	var sb strings.Builder
+	for _, d := range dmp.DiffMainRunes(ra, rb, false) {
+		prefix := " "
+		switch d.Type {
+		case diffmatchpatch.DiffDelete:
+			prefix = "-"
+		case diffmatchpatch.DiffInsert:
+			prefix = "+"
+		}
+		for _, r := range d.Text {
+			line := lines[r]
+			sb.WriteString(prefix)
+			sb.WriteString(line)
+			if !strings.HasSuffix(line, "\n") {
+				sb.WriteString("\n")
+			}
+		}
+	}
+	return sb.String()
+}
+
+// readPolicyFile reads the policy file. A file that does not exist is treated
+// as empty.
+func readPolicyFile(file string) ([]byte, error) {
+	raw, err := os.ReadFile(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, serrors.Wrap("reading file", err, "file", file)
+	}
+	return raw, nil
+}
+
+// writePolicyFile atomically replaces the policy file, such that a concurrent
+// reload never reads a partially written policy. The permissions of an
+// existing file are preserved.
+func writePolicyFile(file string, raw []byte) error {
+	perm := fs.FileMode(0644)
+	if info, err := os.Stat(file); err == nil {
+		perm = info.Mode().Perm()
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(file), "."+filepath.Base(file)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
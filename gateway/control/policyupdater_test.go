@@ -0,0 +1,151 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/gateway/control"
+	"github.com/scionproto/scion/pkg/addr"
+)
+
+func TestPolicyUpdaterSessionPolicies(t *testing.T) {
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "traffic.policy")
+	initial := `{"ASes": {"1-ff00:0:110": {"Nets": ["10.1.0.0/16"]}}, "ConfigVersion": 1}`
+	require.NoError(t, os.WriteFile(file, []byte(initial), 0600))
+	loaded, err := control.LoadSessionPolicies(ctx, file, control.LegacySessionPolicyAdapter{})
+	require.NoError(t, err)
+	publisher := &control.ConfigPublisher{}
+	publisher.Publish(loaded, nil)
+	u := &control.PolicyUpdater{
+		SessionPoliciesFile: file,
+		SessionPolicyParser: control.LegacySessionPolicyAdapter{},
+		Publisher:           publisher,
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		raw := `{"ASes": {"1-ff00:0:110": {"Nets": ["10.2.0.0/16"]}}, "ConfigVersion": 1}`
+		update, err := u.UpdateSessionPolicies(ctx, []byte(raw), true)
+		require.NoError(t, err)
+		assert.True(t, update.DryRun)
+		assert.True(t, update.Changed)
+		assert.False(t, update.Persisted)
+		assert.Contains(t, update.Diff, `-            "10.1.0.0/16"`)
+		assert.Contains(t, update.Diff, `+            "10.2.0.0/16"`)
+		assert.Contains(t, update.Diff, `         "isd_as": "1-ff00:0:110",`)
+		assert.Equal(t, loaded, publisher.SessionPolicies())
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		assert.Equal(t, initial, string(content))
+	})
+	t.Run("dry run compares to loaded policies", func(t *testing.T) {
+		edited := `{"ASes": {"1-ff00:0:110": {"Nets": ["10.2.0.0/16"]}}, "ConfigVersion": 1}`
+		require.NoError(t, os.WriteFile(file, []byte(edited), 0600))
+		defer func() { require.NoError(t, os.WriteFile(file, []byte(initial), 0600)) }()
+
+		update, err := u.UpdateSessionPolicies(ctx, []byte(edited), true)
+		require.NoError(t, err)
+		assert.True(t, update.Changed)
+		assert.Contains(t, update.Diff, `+            "10.2.0.0/16"`)
+
+		update, err = u.UpdateSessionPolicies(ctx, []byte(initial), true)
+		require.NoError(t, err)
+		assert.False(t, update.Changed)
+		assert.Empty(t, update.Diff)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		raw := `{"ASes": {"1-ff00:0:110": {"Nets": ["not a prefix"]}}}`
+		_, err := u.UpdateSessionPolicies(ctx, []byte(raw), false)
+		assert.ErrorIs(t, err, control.ErrInvalidPolicy)
+		_, err = u.UpdateSessionPolicies(ctx, []byte(`[]`), false)
+		assert.ErrorIs(t, err, control.ErrInvalidPolicy)
+		assert.Equal(t, loaded, publisher.SessionPolicies())
+	})
+	t.Run("update", func(t *testing.T) {
+		raw := `{"ASes": {"1-ff00:0:110": {"Nets": ["10.1.0.0/16"]}}, "ConfigVersion": 1}`
+		update, err := u.UpdateSessionPolicies(ctx, []byte(raw), false)
+		require.NoError(t, err)
+		assert.False(t, update.Changed)
+		assert.Empty(t, update.Diff)
+		assert.True(t, update.Persisted)
+		sp := publisher.SessionPolicies()
+		require.Len(t, sp, 1)
+		assert.Equal(t, addr.MustParseIA("1-ff00:0:110"), sp[0].IA)
+	})
+	t.Run("patch", func(t *testing.T) {
+		patch := `{"ASes": {"1-ff00:0:110": null, "1-ff00:0:111": {"Nets": ["10.3.0.0/16"]}}}`
+		update, err := u.PatchSessionPolicies(ctx, []byte(patch), false)
+		require.NoError(t, err)
+		assert.True(t, update.Changed)
+		assert.True(t, update.Persisted)
+		sp := publisher.SessionPolicies()
+		require.Len(t, sp, 1)
+		assert.Equal(t, addr.MustParseIA("1-ff00:0:111"), sp[0].IA)
+
+		loaded, err := control.LoadSessionPolicies(ctx, file, control.LegacySessionPolicyAdapter{})
+		require.NoError(t, err)
+		assert.Equal(t, sp, loaded)
+	})
+}
+
+func TestPolicyUpdaterRoutingPolicy(t *testing.T) {
+	ctx := context.Background()
+	raw := "accept 1-ff00:0:110 1-ff00:0:111 10.0.0.0/8 # internal\n"
+
+	t.Run("without file", func(t *testing.T) {
+		publisher := &control.ConfigPublisher{}
+		u := &control.PolicyUpdater{Publisher: publisher}
+		update, err := u.UpdateRoutingPolicy(ctx, []byte(raw), false)
+		require.NoError(t, err)
+		assert.True(t, update.Changed)
+		assert.False(t, update.Persisted)
+		assert.Regexp(t, `^\+accept +1-ff00:0:110 +1-ff00:0:111 +10\.0\.0\.0/8 +# internal\n$`,
+			update.Diff)
+		rp := publisher.RoutingPolicy()
+		require.NotNil(t, rp)
+		assert.Len(t, rp.Rules, 1)
+	})
+	t.Run("with file", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "routing.policy")
+		publisher := &control.ConfigPublisher{}
+		u := &control.PolicyUpdater{RoutingPolicyFile: file, Publisher: publisher}
+
+		_, err := u.UpdateRoutingPolicy(ctx, []byte("forward 0-0 0-0 0.0.0.0/0"), false)
+		assert.ErrorIs(t, err, control.ErrInvalidPolicy)
+
+		update, err := u.UpdateRoutingPolicy(ctx, []byte(raw), true)
+		require.NoError(t, err)
+		assert.True(t, update.Changed)
+		assert.Nil(t, publisher.RoutingPolicy())
+		assert.NoFileExists(t, file)
+
+		update, err = u.UpdateRoutingPolicy(ctx, []byte(raw), false)
+		require.NoError(t, err)
+		assert.True(t, update.Persisted)
+		assert.NotNil(t, publisher.RoutingPolicy())
+		assert.FileExists(t, file)
+
+		update, err = u.UpdateRoutingPolicy(ctx, []byte(raw), true)
+		require.NoError(t, err)
+		assert.False(t, update.Changed)
+	})
+}
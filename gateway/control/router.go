@@ -259,6 +259,19 @@ func (r *Router) aliveSessions(rtID int) int {
 	return alive
 }
 
+// ActiveSessions returns a copy of the mapping from routing table indices to the
+// session in use. Indices without a healthy session are not in the map.
+func (r *Router) ActiveSessions() map[int]uint8 {
+	r.stateMtx.RLock()
+	defer r.stateMtx.RUnlock()
+
+	active := make(map[int]uint8, len(r.currentSessions))
+	for rtID, sessID := range r.currentSessions {
+		active[rtID] = sessID
+	}
+	return active
+}
+
 // DiagnosticsWrite writes diagnostics for the router to the writer.
 func (r *Router) DiagnosticsWrite(w io.Writer) {
 	r.stateMtx.RLock()
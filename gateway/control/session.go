@@ -36,9 +36,11 @@ type SessionMetrics struct {
 // DataplaneSession represents a packet framer sending packets along a specific path.
 type DataplaneSession interface {
 	PktWriter
-	// SetPaths can be used to change the paths on which packets are sent. If a path is invalid
-	// or causes MTU issues, an error is returned.
-	SetPaths([]snet.Path) error
+	// SetPaths can be used to change the paths on which packets are sent. The weights are the
+	// relative capacities of the paths, in the same order as the paths. They may be nil, in
+	// which case all paths are treated equally. If a path is invalid or causes MTU issues, an
+	// error is returned.
+	SetPaths(paths []snet.Path, weights []float64) error
 	// Close informs the session it should shut down. It does not wait for the session to close.
 	Close()
 }
@@ -134,7 +136,8 @@ func (s *Session) Run(ctx context.Context) error {
 				diff.log(logger)
 			}
 			s.pathResult = newPathResult
-			if err := s.DataplaneSession.SetPaths(s.pathResult.Paths); err != nil {
+			err := s.DataplaneSession.SetPaths(s.pathResult.Paths, s.pathResult.Weights)
+			if err != nil {
 				logger.Error("setting paths", "err", err)
 			}
 			s.pathResultMtx.Unlock()
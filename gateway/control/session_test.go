@@ -42,7 +42,7 @@ func TestSessionRun(t *testing.T) {
 			Paths: []snet.Path{path}}).MinTimes(2)
 
 		dataplaneSession := mock_control.NewMockDataplaneSession(ctrl)
-		dataplaneSession.EXPECT().SetPaths([]snet.Path{path}).MinTimes(2)
+		dataplaneSession.EXPECT().SetPaths([]snet.Path{path}, gomock.Any()).MinTimes(2)
 
 		events := make(chan control.SessionEvent)
 		sessionMonitorEvents := make(chan control.SessionEvent)
@@ -56,6 +56,8 @@ type SessionConfig struct {
 	PathPolicy policies.PathPolicy
 	// PathCount is the max number of paths to use.
 	PathCount int
+	// LoadBalancing determines how the traffic is distributed across the paths.
+	LoadBalancing LoadBalancing
 	// Gateway describes a discovered remote gateway instance.
 	Gateway Gateway
 	// Prefixes contains the network prefixes that are reachable through this
@@ -95,6 +97,15 @@ func (sc *SessionConfigurator) Close(ctx context.Context) error {
 	return sc.workerBase.CloseWrapper(ctx, nil)
 }
 
+// RemoteGateways returns the remote gateways and the prefixes learned from
+// them, as last received on the routing updates channel. The returned object
+// must not be modified.
+func (sc *SessionConfigurator) RemoteGateways() RemoteGateways {
+	sc.stateMtx.RLock()
+	defer sc.stateMtx.RUnlock()
+	return sc.currentRemotes
+}
+
 // DiagnosticsWrite writes diagnostics to the writer.
 func (sc *SessionConfigurator) DiagnosticsWrite(w io.Writer) {
 	type sessionConfigDiagnostics struct {
@@ -214,6 +225,7 @@ func diffSessionPolicies(a, b SessionPolicies) bool {
 func diffSessionPolicy(a, b SessionPolicy) bool {
 	if a.TrafficMatcher.String() != b.TrafficMatcher.String() ||
 		a.PathCount != b.PathCount ||
+		a.LoadBalancing != b.LoadBalancing ||
 		// no better way than comparing pointers here:
 		a.PerfPolicy != b.PerfPolicy ||
 		prefixesKey(a.Prefixes) != prefixesKey(b.Prefixes) {
@@ -317,6 +329,7 @@ func buildSessionConfigs(sessionPolicies SessionPolicies,
 				PerfPolicy:     sessionPolicy.PerfPolicy,
 				PathPolicy:     pathPol,
 				PathCount:      sessionPolicy.PathCount,
+				LoadBalancing:  sessionPolicy.LoadBalancing,
 				Gateway:        entry.Gateway,
 				Prefixes:       mergePrefixes(sessionPolicy.Prefixes, entry.Prefixes),
 			})
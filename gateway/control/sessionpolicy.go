@@ -34,7 +34,7 @@ var (
 			Entries: []*pathpol.ACLEntry{{Action: pathpol.Allow}},
 		},
 	}
-	DefaultPerfPolicy = fingerPrintOrder{}
+	DefaultPerfPolicy = policies.ShortestPath{}
 	DefaultPathCount  = 1
 )
 
@@ -48,6 +48,13 @@ func (LegacySessionPolicyAdapter) Parse(ctx context.Context, raw []byte) (Sessio
 		ASes map[addr.IA]struct {
 			Nets      []string
 			PathCount int
+			// PerfPolicy is the name of the performance policy, e.g.,
+			// "latency". If empty, DefaultPerfPolicy is used.
+			PerfPolicy string
+			// LoadBalancing is the mode used to distribute the traffic across
+			// the paths of the session, i.e., "flow" or "packet". If empty,
+			// flows are distributed evenly.
+			LoadBalancing string
 		}
 		ConfigVersion uint64
 	}
@@ -65,13 +72,22 @@ func (LegacySessionPolicyAdapter) Parse(ctx context.Context, raw []byte) (Sessio
 		if asEntry.PathCount != 0 {
 			pathCount = asEntry.PathCount
 		}
+		perfPolicy, err := parsePerfPolicy(asEntry.PerfPolicy)
+		if err != nil {
+			return nil, serrors.Wrap("parsing performance policy", err, "ia", ia)
+		}
+		loadBalancing, err := ParseLoadBalancing(asEntry.LoadBalancing)
+		if err != nil {
+			return nil, serrors.Wrap("parsing load balancing", err, "ia", ia)
+		}
 		policies = append(policies, SessionPolicy{
 			ID:             0,
 			IA:             ia,
 			TrafficMatcher: pktcls.CondTrue,
-			PerfPolicy:     DefaultPerfPolicy,
+			PerfPolicy:     perfPolicy,
 			PathPolicy:     DefaultPathPolicy,
 			PathCount:      pathCount,
+			LoadBalancing:  loadBalancing,
 			Prefixes:       prefixes,
 		})
 	}
@@ -93,6 +109,43 @@ func parsePrefixes(rawNets []string) ([]*net.IPNet, error) {
 	return nets, nil
 }
 
+func parsePerfPolicy(name string) (policies.PerfPolicy, error) {
+	if name == "" {
+		return DefaultPerfPolicy, nil
+	}
+	return policies.ParsePerfPolicy(name)
+}
+
+// LoadBalancing determines how the traffic of a session is distributed across
+// the paths of the session.
+type LoadBalancing string
+
+const (
+	// LoadBalanceEqual assigns flows to the paths based on the hash of their
+	// 5-tuple, with every path getting the same share of the flows.
+	LoadBalanceEqual LoadBalancing = ""
+	// LoadBalanceFlows assigns flows to the paths based on the hash of their
+	// 5-tuple, with the share of each path proportional to its weight.
+	LoadBalanceFlows LoadBalancing = "flow"
+	// LoadBalancePackets distributes the individual packets across the paths,
+	// with the share of each path proportional to its weight. The remote
+	// gateway reassembles the frames of each path separately and does not
+	// restore the order across paths, so the packets of a flow can arrive out
+	// of order. TCP packets are therefore assigned to the paths by flow, like
+	// with LoadBalanceFlows.
+	LoadBalancePackets LoadBalancing = "packet"
+)
+
+// ParseLoadBalancing parses the load balancing mode.
+func ParseLoadBalancing(mode string) (LoadBalancing, error) {
+	switch lb := LoadBalancing(mode); lb {
+	case LoadBalanceEqual, LoadBalanceFlows, LoadBalancePackets:
+		return lb, nil
+	default:
+		return "", serrors.New("unknown load balancing mode", "mode", mode)
+	}
+}
+
 // SessionPolicyParser parses a raw session policy.
 type SessionPolicyParser interface {
 	Parse(context.Context, []byte) (SessionPolicies, error)
@@ -147,6 +200,7 @@ func (p SessionPolicies) Copy() SessionPolicies {
 // - a path class defined by a path policy,
 // - a performance policy,
 // - a path count,
+// - a load balancing mode,
 // - a remote IA,
 // - a set of prefixes.
 type SessionPolicy struct {
@@ -158,8 +212,7 @@ type SessionPolicy struct {
 	// this session.
 	TrafficMatcher pktcls.Cond
 	// PerfPolicy specifies which paths should be preferred (e.g., the path with
-	// the lowest latency). If unset, paths with the lowest latency are
-	// preferred.
+	// the lowest latency). If unset, the shortest paths are preferred.
 	PerfPolicy policies.PerfPolicy
 	// PathPolicy specifies the path properties that paths used for this session
 	// must satisfy.
@@ -167,6 +220,9 @@ type SessionPolicy struct {
 	// PathCount  defines the number of paths that can be simultaneously used
 	// within a session.
 	PathCount int
+	// LoadBalancing determines how the traffic is distributed across the paths
+	// of the session.
+	LoadBalancing LoadBalancing
 	// Prefixes contains the network prefixes that are reachable through this
 	// session.
 	Prefixes []*net.IPNet
@@ -179,10 +235,11 @@ func (sp SessionPolicy) Copy() SessionPolicy {
 		IA:             sp.IA,
 		TrafficMatcher: copyTrafficMatcher(sp.TrafficMatcher),
 		// TODO(lukedirtwalker): find a way to properly copy perf policies.
-		PerfPolicy: sp.PerfPolicy,
-		PathPolicy: copyPathPolicy(sp.PathPolicy),
-		PathCount:  sp.PathCount,
-		Prefixes:   copyPrefixes(sp.Prefixes),
+		PerfPolicy:    sp.PerfPolicy,
+		PathPolicy:    copyPathPolicy(sp.PathPolicy),
+		PathCount:     sp.PathCount,
+		LoadBalancing: sp.LoadBalancing,
+		Prefixes:      copyPrefixes(sp.Prefixes),
 	}
 }
 
@@ -221,7 +278,3 @@ func copyPrefixes(prefixes []*net.IPNet) []*net.IPNet {
 	}
 	return copy
 }
-
-type fingerPrintOrder struct{}
-
-func (fingerPrintOrder) Better(x, y *policies.Stats) bool { return x.Fingerprint < y.Fingerprint }
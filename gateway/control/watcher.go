@@ -61,13 +61,18 @@ type Gateway struct {
 	Data *net.UDPAddr
 	// Interfaces are the last-hop SCION interfaces that should be preferred.
 	Interfaces []uint64
+	// FrameEncryption indicates that the remote gateway accepts encrypted
+	// frames. It is not part of the discovery information, but learned when
+	// fetching the IP prefixes from the remote gateway.
+	FrameEncryption bool
 }
 
 func (g Gateway) Equal(other Gateway) bool {
 	return g.Control.String() == other.Control.String() &&
 		g.Probe.String() == other.Probe.String() &&
 		g.Data.String() == other.Data.String() &&
-		interfacesKey(g.Interfaces) == interfacesKey(other.Interfaces)
+		interfacesKey(g.Interfaces) == interfacesKey(other.Interfaces) &&
+		g.FrameEncryption == other.FrameEncryption
 }
 
 func interfacesKey(interfaces []uint64) string {
@@ -307,7 +312,9 @@ type PrefixConsumer interface {
 
 // PrefixFetcher fetches the IP prefixes from a remote gateway.
 type PrefixFetcher interface {
-	Prefixes(ctx context.Context, gateway *net.UDPAddr) ([]*net.IPNet, error)
+	// Prefixes fetches the IP prefixes advertised by the remote gateway. It
+	// also reports whether the remote gateway accepts encrypted frames.
+	Prefixes(ctx context.Context, gateway *net.UDPAddr) ([]*net.IPNet, bool, error)
 	Close() error
 }
 
@@ -425,7 +432,7 @@ func (w *prefixWatcher) run(ctx context.Context) {
 
 	logger := log.FromCtx(ctx)
 	logger.Debug("Fetching IP prefixes from remote gateway")
-	prefixes, err := w.fetcher.Prefixes(ctx, w.gateway.Control)
+	prefixes, frameEncryption, err := w.fetcher.Prefixes(ctx, w.gateway.Control)
 	if err != nil {
 		metrics.CounterInc(w.fetchErrors)
 		logger.Debug("Failed to fetch IP prefixes from remote gateway", "err", err)
@@ -434,7 +441,9 @@ func (w *prefixWatcher) run(ctx context.Context) {
 	logger.Debug("Fetched prefixes successfully", "prefixes", fmtPrefixes(prefixes))
 
 	snapshot := fmtPrefixes(prefixes)
-	if err := w.Consumer.Prefixes(w.remote, w.gateway, prefixes); err != nil {
+	gateway := w.gateway
+	gateway.FrameEncryption = frameEncryption
+	if err := w.Consumer.Prefixes(w.remote, gateway, prefixes); err != nil {
 		logger.Error("Failed to process prefixes", "prefixes", fmtPrefixes(prefixes), "err", err)
 	}
 
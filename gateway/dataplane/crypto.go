@@ -0,0 +1,226 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataplane
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+const (
+	// frameTagLen is the length of the authentication tag of encrypted frames.
+	frameTagLen = 16
+	// replayWindowSize is the number of sequence numbers below the highest
+	// sequence number seen that are still accepted.
+	replayWindowSize = 64
+)
+
+// ErrNoFrameKey indicates that no key is available to protect a frame.
+var ErrNoFrameKey = serrors.New("no frame key available")
+
+// FrameKey is a key used to encrypt and authenticate SIG frames.
+type FrameKey struct {
+	// ID identifies the key in the frame header. It only needs to be unique
+	// among the keys that are valid at the same time.
+	ID uint8
+	// NotAfter is the time after which frames protected with the key are no
+	// longer accepted.
+	NotAfter time.Time
+
+	aead cipher.AEAD
+	// counter is the last counter value used in the nonce of a frame protected
+	// with the key. It is shared by all encoders that use the key, so that no
+	// nonce is used twice with the same key.
+	counter atomic.Uint64
+}
+
+// NewFrameKey creates an AES-GCM frame key from the raw key material.
+//
+// The nonce counter starts at the current time in nanoseconds. As long as the
+// clock does not go backwards and less than one frame per nanosecond is sent,
+// it never falls back to a value that was used before, even if the same key
+// material is loaded again, e.g., after a restart of the gateway.
+func NewFrameKey(id uint8, raw []byte, notAfter time.Time) (*FrameKey, error) {
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, serrors.Wrap("creating block cipher", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, serrors.Wrap("creating AEAD", err)
+	}
+	key := &FrameKey{ID: id, NotAfter: notAfter, aead: aead}
+	key.counter.Store(uint64(time.Now().UnixNano()))
+	return key, nil
+}
+
+// FrameKeyProvider provides the keys for encrypted SIG frames. The methods are
+// called on the data path and must not block. If a key is not available yet,
+// ErrNoFrameKey is returned and the affected frame is dropped.
+type FrameKeyProvider interface {
+	// EgressKey returns the key to protect frames sent to the remote gateway.
+	EgressKey(remoteIA addr.IA, remoteIP net.IP) (*FrameKey, error)
+	// IngressKey returns the key with the given ID that protects frames received
+	// from the remote gateway.
+	IngressKey(remoteIA addr.IA, remoteIP net.IP, id uint8) (*FrameKey, error)
+	// Encrypted reports whether frame encryption was negotiated with the remote
+	// gateway. Plain frames received from such a gateway are discarded.
+	Encrypted(remoteIA addr.IA, remoteIP net.IP) bool
+}
+
+// newFrameSalt returns a random salt for an encoder of encrypted frames.
+func newFrameSalt() (uint32, error) {
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return 0, serrors.Wrap("generating salt", err)
+	}
+	return binary.BigEndian.Uint32(b[:]), nil
+}
+
+// sealFrame encrypts an encrypted frame produced by the encoder in place and
+// appends the authentication tag. The frame buffer must have enough capacity to
+// hold the tag. The nonce counter of the frame is taken from the key.
+func sealFrame(frame []byte, key *FrameKey) []byte {
+	frame[keyIDPos] = key.ID
+	binary.BigEndian.PutUint64(frame[counterPos:counterPos+8], key.counter.Add(1))
+	var nonce [12]byte
+	frameNonce(nonce[:], frame)
+	return key.aead.Seal(frame[:encHdrLen], nonce[:], frame[encHdrLen:], frame[:encHdrLen])
+}
+
+// openFrame decrypts and authenticates an encrypted frame in place. The
+// plaintext is moved directly behind the first hdrLen bytes of the header, so
+// that the result can be processed like an unencrypted frame. It returns the
+// length of the resulting frame.
+func openFrame(frame []byte, key *FrameKey) (int, error) {
+	if len(frame) < encHdrLen+frameTagLen {
+		return 0, serrors.New("frame too short", "length", len(frame))
+	}
+	var nonce [12]byte
+	frameNonce(nonce[:], frame)
+	payload, err := key.aead.Open(frame[encHdrLen:encHdrLen], nonce[:],
+		frame[encHdrLen:], frame[:encHdrLen])
+	if err != nil {
+		return 0, err
+	}
+	return hdrLen + copy(frame[hdrLen:], payload), nil
+}
+
+// frameNonce writes the nonce of an encrypted frame, i.e., the salt followed by
+// the nonce counter, to dst.
+func frameNonce(dst []byte, frame []byte) {
+	copy(dst[0:4], frame[saltPos:saltPos+4])
+	copy(dst[4:12], frame[counterPos:counterPos+8])
+}
+
+// replayWindow is a sliding window over the sequence numbers of the frames of
+// a single encoder.
+type replayWindow struct {
+	// highest is the highest sequence number accepted so far.
+	highest uint64
+	// seen has bit i set if sequence number highest-i was accepted.
+	seen uint64
+	// notAfter is the expiry time of the key that protects the frames.
+	notAfter time.Time
+}
+
+// check returns true if the sequence number was not seen before and is still
+// within the window. In that case, the sequence number is marked as seen.
+func (w *replayWindow) check(seq uint64) bool {
+	switch {
+	case w.seen == 0:
+		w.highest, w.seen = seq, 1
+		return true
+	case seq > w.highest:
+		shift := seq - w.highest
+		if shift >= replayWindowSize {
+			w.seen = 1
+		} else {
+			w.seen = w.seen<<shift | 1
+		}
+		w.highest = seq
+		return true
+	case w.highest-seq >= replayWindowSize:
+		return false
+	default:
+		bit := uint64(1) << (w.highest - seq)
+		if w.seen&bit != 0 {
+			return false
+		}
+		w.seen |= bit
+		return true
+	}
+}
+
+// replayKey identifies the encoder that produced a frame.
+type replayKey struct {
+	keyID  uint8
+	stream uint32
+	salt   uint32
+}
+
+// replayFilter rejects replayed encrypted frames of a single remote session.
+// The state is kept until the key protecting the frames expires, i.e., it
+// outlives the worker processing the frames.
+type replayFilter struct {
+	mtx     sync.Mutex
+	windows map[replayKey]*replayWindow
+}
+
+func newReplayFilter() *replayFilter {
+	return &replayFilter{windows: make(map[replayKey]*replayWindow)}
+}
+
+// check returns true if the authenticated frame with the given header is not a
+// replay.
+func (f *replayFilter) check(hdr []byte, key *FrameKey) bool {
+	k := replayKey{
+		keyID:  key.ID,
+		stream: binary.BigEndian.Uint32(hdr[streamPos:streamPos+4]) & 0xfffff,
+		salt:   binary.BigEndian.Uint32(hdr[saltPos : saltPos+4]),
+	}
+	seq := binary.BigEndian.Uint64(hdr[seqPos : seqPos+8])
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	w, ok := f.windows[k]
+	if !ok || !w.notAfter.Equal(key.NotAfter) {
+		w = &replayWindow{notAfter: key.NotAfter}
+		f.windows[k] = w
+	}
+	return w.check(seq)
+}
+
+// prune removes the windows of expired keys. It returns the number of windows
+// that are left.
+func (f *replayFilter) prune(now time.Time) int {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	for k, w := range f.windows {
+		if now.After(w.notAfter) {
+			delete(f.windows, k)
+		}
+	}
+	return len(f.windows)
+}
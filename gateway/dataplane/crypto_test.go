@@ -0,0 +1,242 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataplane
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/metrics"
+	"github.com/scionproto/scion/pkg/snet"
+)
+
+type staticFrameKeys struct {
+	key       *FrameKey
+	plaintext bool
+}
+
+func (k staticFrameKeys) EgressKey(addr.IA, net.IP) (*FrameKey, error) {
+	return k.key, nil
+}
+
+func (k staticFrameKeys) IngressKey(_ addr.IA, _ net.IP, id uint8) (*FrameKey, error) {
+	if k.key.ID != id {
+		return nil, ErrNoFrameKey
+	}
+	return k.key, nil
+}
+
+func (k staticFrameKeys) Encrypted(addr.IA, net.IP) bool {
+	return !k.plaintext
+}
+
+func newTestFrameKey(t *testing.T, id uint8) *FrameKey {
+	key, err := NewFrameKey(id, []byte("0123456789abcdef"), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	return key
+}
+
+func TestEncryptedEncoder(t *testing.T) {
+	e := newEncryptedEncoder(1, 2, 1500, 0xdeadbeef)
+	e.Write([]byte{
+		// IPv4 header.
+		0x40, 0, 0, 23, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		// Payload.
+		1, 2, 3,
+	})
+	e.Close()
+	f := e.Read()
+	assert.EqualValues(t, []byte{
+		// SIG frame header.
+		1, 1, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0,
+		// Salt.
+		0xde, 0xad, 0xbe, 0xef,
+		// Nonce counter.
+		0, 0, 0, 0, 0, 0, 0, 0,
+		// IPv4 header.
+		0x40, 0, 0, 23, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		// Payload.
+		1, 2, 3,
+	}, f)
+	assert.GreaterOrEqual(t, cap(f)-len(f), frameTagLen)
+	f = e.Read()
+	assert.Nil(t, f)
+}
+
+func TestSealOpenFrame(t *testing.T) {
+	plain := []byte{
+		// SIG frame header.
+		1, 1, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 7,
+		// Salt.
+		0xde, 0xad, 0xbe, 0xef,
+		// Nonce counter.
+		0, 0, 0, 0, 0, 0, 0, 0,
+		// IPv4 header.
+		0x40, 0, 0, 23, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		// Payload.
+		1, 2, 3,
+	}
+	seal := func(key *FrameKey) []byte {
+		buf := make([]byte, len(plain), len(plain)+frameTagLen)
+		copy(buf, plain)
+		return sealFrame(buf, key)
+	}
+	key := newTestFrameKey(t, 5)
+
+	t.Run("round trip", func(t *testing.T) {
+		sealed := seal(key)
+		require.Len(t, sealed, len(plain)+frameTagLen)
+		assert.Equal(t, uint8(5), sealed[keyIDPos])
+		assert.NotEqual(t, plain[encHdrLen:], sealed[encHdrLen:len(plain)])
+
+		n, err := openFrame(sealed, key)
+		require.NoError(t, err)
+		// The payload directly follows the plain header.
+		expected := append(append([]byte{}, sealed[:hdrLen]...), plain[encHdrLen:]...)
+		assert.Equal(t, expected, sealed[:n])
+	})
+	t.Run("nonce counter", func(t *testing.T) {
+		// Frames with the same salt and sequence number, e.g., from encoders
+		// created for new paths, must still use different nonces.
+		first, second := seal(key), seal(key)
+		assert.Equal(t, first[:counterPos], second[:counterPos])
+		assert.NotEqual(t, first[counterPos:encHdrLen], second[counterPos:encHdrLen])
+		assert.NotEqual(t, first[encHdrLen:], second[encHdrLen:])
+
+		other := newTestFrameKey(t, 5)
+		assert.Greater(t, binary.BigEndian.Uint64(seal(other)[counterPos:encHdrLen]),
+			binary.BigEndian.Uint64(second[counterPos:encHdrLen]),
+			"counter of a reloaded key must not fall back")
+	})
+	t.Run("modified header", func(t *testing.T) {
+		sealed := seal(key)
+		sealed[seqPos+7]++
+		_, err := openFrame(sealed, key)
+		assert.Error(t, err)
+	})
+	t.Run("modified payload", func(t *testing.T) {
+		sealed := seal(key)
+		sealed[encHdrLen]++
+		_, err := openFrame(sealed, key)
+		assert.Error(t, err)
+	})
+	t.Run("wrong key", func(t *testing.T) {
+		sealed := seal(key)
+		other, err := NewFrameKey(5, []byte("fedcba9876543210"), time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		_, err = openFrame(sealed, other)
+		assert.Error(t, err)
+	})
+	t.Run("too short", func(t *testing.T) {
+		_, err := openFrame(make([]byte, encHdrLen+frameTagLen-1), key)
+		assert.Error(t, err)
+	})
+}
+
+func TestReplayWindow(t *testing.T) {
+	var w replayWindow
+	assert.True(t, w.check(10))
+	assert.False(t, w.check(10), "duplicate")
+	assert.True(t, w.check(8), "reordered")
+	assert.False(t, w.check(8), "reordered duplicate")
+	assert.True(t, w.check(100), "jump ahead")
+	assert.False(t, w.check(100-replayWindowSize), "too old")
+	assert.True(t, w.check(100-replayWindowSize+1), "oldest in window")
+	assert.True(t, w.check(101))
+	assert.False(t, w.check(100))
+}
+
+func TestWorkerEncryptedFrames(t *testing.T) {
+	remote := &snet.UDPAddr{
+		IA: addr.MustParseIA("1-ff00:0:300"),
+		Host: &net.UDPAddr{
+			IP:   net.IP{192, 168, 1, 1},
+			Port: 80,
+		},
+	}
+	key := newTestFrameKey(t, 3)
+	pkt := []byte{
+		// IPv4 header.
+		0x40, 0, 0, 23, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		// Payload.
+		1, 2, 3,
+	}
+	e := newEncryptedEncoder(1, 2, 1500, 42)
+	e.Write(pkt)
+	first := append([]byte{}, sealFrame(e.Read(), key)...)
+	e.Write(pkt)
+	second := append([]byte{}, sealFrame(e.Read(), key)...)
+	e.Close()
+
+	newEncWorker := func(keys FrameKeyProvider) (*worker, *MockTun) {
+		mt := &MockTun{}
+		w := newWorker(remote, 1, mt, IngressMetrics{})
+		w.frameKeys = keys
+		w.replay = newReplayFilter()
+		return w, mt
+	}
+
+	t.Run("valid frames", func(t *testing.T) {
+		w, mt := newEncWorker(staticFrameKeys{key: key})
+		SendFrame(t, w, first)
+		SendFrame(t, w, second)
+		mt.AssertPacket(t, pkt)
+		mt.AssertPacket(t, pkt)
+		mt.AssertDone(t)
+	})
+	t.Run("replayed frame", func(t *testing.T) {
+		w, mt := newEncWorker(staticFrameKeys{key: key})
+		SendFrame(t, w, first)
+		SendFrame(t, w, first)
+		mt.AssertPacket(t, pkt)
+		mt.AssertDone(t)
+	})
+	t.Run("unknown key", func(t *testing.T) {
+		w, mt := newEncWorker(staticFrameKeys{key: newTestFrameKey(t, 4)})
+		SendFrame(t, w, first)
+		mt.AssertDone(t)
+	})
+	t.Run("no key provider", func(t *testing.T) {
+		w, mt := newEncWorker(nil)
+		SendFrame(t, w, first)
+		mt.AssertDone(t)
+	})
+
+	plain := append([]byte{
+		// SIG frame header.
+		0, 1, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1,
+	}, pkt...)
+	t.Run("plain frame from encrypting remote", func(t *testing.T) {
+		discarded := metrics.NewTestCounter()
+		w, mt := newEncWorker(staticFrameKeys{key: key})
+		w.Metrics.FramesDiscarded = discarded
+		SendFrame(t, w, plain)
+		mt.AssertDone(t)
+		assert.Equal(t, 1.0,
+			metrics.CounterValue(discarded.With("reason", "unencrypted")))
+	})
+	t.Run("plain frame from plaintext remote", func(t *testing.T) {
+		w, mt := newEncWorker(staticFrameKeys{key: key, plaintext: true})
+		SendFrame(t, w, plain)
+		mt.AssertPacket(t, pkt)
+		mt.AssertDone(t)
+	})
+}
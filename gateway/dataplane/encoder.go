@@ -35,20 +35,65 @@ import (
 //
 // The header is followed by raw IP packets (or parts thereof) one directly
 // following another with no intermediate padding.
+//
+// Encrypted frames use version 1. The upper 8 bits of the reserved field
+// carry the ID of the key used to protect the frame and the header is
+// extended by a 32-bit salt chosen randomly by each encoder and a 64-bit nonce
+// counter:
+//
+//  0                   1                   2                   3
+//  0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
+//  +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//  |     Version   |    Session    |            Index              |
+//  +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//  |     Key ID    |  Rsv  |          Stream (20 bits)             |
+//  +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//  |                                                               |
+//  +                       Sequence number                         +
+//  |                                                               |
+//  +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//  |                             Salt                              |
+//  +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//  |                                                               |
+//  +                         Nonce counter                         +
+//  |                                                               |
+//  +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//
+// The header is authenticated but sent in the clear. The IP packets that
+// follow it are encrypted and followed by a 16-byte authentication tag. The
+// nonce is the salt followed by the nonce counter. The counter is maintained
+// per key rather than per encoder, because all encoders towards the same remote
+// gateway use the same key. The salt and the sequence number identify the frame
+// for the replay protection.
 
 const (
 	// Length of the frame header, in bytes.
 	hdrLen = 16
+	// Length of the header of encrypted frames, in bytes.
+	encHdrLen = hdrLen + 12
 	// Location of individual fields in the frame header.
 	versionPos = 0
 	sessPos    = 1
 	indexPos   = 2
+	keyIDPos   = 4
 	streamPos  = 4
 	seqPos     = 8
+	saltPos    = 16
+	counterPos = 20
+)
+
+// Frame versions.
+const (
+	// plainFrameVersion is the version of unencrypted frames.
+	plainFrameVersion = 0
+	// encFrameVersion is the version of encrypted frames.
+	encFrameVersion = 1
 )
 
 // encoder reads packets from a ring buffer and transforms them into SIG frames.
 type encoder struct {
+	// version is the version written to the frame header.
+	version uint8
 	// sessionID of the session this encoder belongs to.
 	sessionID uint8
 	// streamID is identifies a flow within the session. Only the frames from
@@ -63,20 +108,43 @@ type encoder struct {
 	// frame is the frame being built at the moment.
 	// To avoid allocations, we reuse the same frame buffer over and over again.
 	frame []byte
+	// payloadPos is the position of the first payload byte in the frame.
+	payloadPos int
+	// maxLen is the maximum length of the frame returned by Read. For encrypted
+	// frames, the remaining capacity of the frame buffer is reserved for the
+	// authentication tag.
+	maxLen int
+	// salt is written to the header of encrypted frames.
+	salt uint32
 }
 
 // newEncoder creates a new encoder instance.
 // mtu is max size of the frame, excluding SCION header, but including SIG header.
 func newEncoder(sessionID uint8, streamID uint32, mtu uint16) *encoder {
 	return &encoder{
-		sessionID: sessionID,
-		streamID:  streamID,
-		seq:       0,
-		ring:      newPktRing(),
-		frame:     make([]byte, 0, mtu),
+		version:    plainFrameVersion,
+		sessionID:  sessionID,
+		streamID:   streamID,
+		seq:        0,
+		ring:       newPktRing(),
+		frame:      make([]byte, 0, mtu),
+		payloadPos: hdrLen,
+		maxLen:     int(mtu),
 	}
 }
 
+// newEncryptedEncoder creates a new encoder instance producing frames that are
+// to be encrypted with sealFrame before sending. mtu is max size of the sealed
+// frame, excluding SCION header, but including SIG header and authentication tag.
+func newEncryptedEncoder(sessionID uint8, streamID uint32, mtu uint16, salt uint32) *encoder {
+	e := newEncoder(sessionID, streamID, mtu)
+	e.version = encFrameVersion
+	e.payloadPos = encHdrLen
+	e.maxLen = int(mtu) - frameTagLen
+	e.salt = salt
+	return e
+}
+
 // Close initiates the close procedure. Frames can still be read.
 // Once there are no more frames available, Read will return nil.
 func (e *encoder) Close() {
@@ -92,17 +160,20 @@ func (e *encoder) Write(pkt []byte) {
 // The function blocks if there are no frames available.
 // When the encoder is closed, the function returns nil.
 func (e *encoder) Read() []byte {
-	e.frame = e.frame[:hdrLen]
+	e.frame = e.frame[:e.payloadPos]
 	// Write the header.
-	e.frame[versionPos] = 0
+	e.frame[versionPos] = e.version
 	e.frame[sessPos] = e.sessionID
 	binary.BigEndian.PutUint16(e.frame[indexPos:indexPos+2], 0xffff)
 	binary.BigEndian.PutUint32(e.frame[streamPos:streamPos+4], e.streamID&0xfffff)
 	binary.BigEndian.PutUint64(e.frame[seqPos:seqPos+8], e.seq)
+	if e.version == encFrameVersion {
+		binary.BigEndian.PutUint32(e.frame[saltPos:saltPos+4], e.salt)
+	}
 	// Increase the sequence number.
 	e.seq++
 	// First, use the data remaining from the last packet, if any.
-	var pos int = hdrLen
+	var pos int = e.payloadPos
 	if len(e.pkt) > 0 {
 		pos += e.copyToFrame()
 		if len(e.pkt) > 0 {
@@ -114,14 +185,14 @@ func (e *encoder) Read() []byte {
 	for {
 		// Check whether one more packet would fit into the frame.
 		// At least 40B are needed to fit IPv6 header into it.
-		if cap(e.frame)-pos < 40 {
+		if e.maxLen-pos < 40 {
 			return e.frame[:pos]
 		}
 		// If there's nothing but the header in the current frame we are going to fetch more
 		// data in blocking manner. If there's already some data in the frame we will
 		// still try to stuff it with more packets, but if there are no packets available,
 		// we'll send what we have immediately.
-		block := (pos == hdrLen)
+		block := (pos == e.payloadPos)
 		var n int
 		e.pkt, n = e.ring.Read(block)
 		if n == 0 {
@@ -167,7 +238,7 @@ func (e *encoder) Read() []byte {
 		}
 		// Set the first packet index in the frame header if appropriate.
 		if !indexSet {
-			binary.BigEndian.PutUint16(e.frame[indexPos:indexPos+2], uint16(pos-hdrLen))
+			binary.BigEndian.PutUint16(e.frame[indexPos:indexPos+2], uint16(pos-e.payloadPos))
 			indexSet = true
 		}
 		// Write the packet to the frame.
@@ -182,7 +253,7 @@ func (e *encoder) Read() []byte {
 // copyToFrame copies as much data as possible from the currently processed packet
 // to the current frame. Returns number of bytes copied.
 func (e *encoder) copyToFrame() int {
-	toCopy := cap(e.frame) - len(e.frame)
+	toCopy := e.maxLen - len(e.frame)
 	if len(e.pkt) < toCopy {
 		toCopy = len(e.pkt)
 	}
@@ -67,12 +67,19 @@ type IngressServer struct {
 	Conn          ReadConn
 	DeviceManager control.DeviceManager
 	Metrics       IngressMetrics
+	// FrameKeys provides the keys for encrypted frames. If nil, encrypted
+	// frames are discarded.
+	FrameKeys FrameKeyProvider
 
 	workers map[string]*worker
+	// replayFilters holds the replay state for encrypted frames. It is kept
+	// separately from the workers, because it must outlive idle workers.
+	replayFilters map[string]*replayFilter
 }
 
 func (d *IngressServer) Run(ctx context.Context) error {
 	d.workers = make(map[string]*worker)
+	d.replayFilters = make(map[string]*replayFilter)
 	return d.read(ctx)
 }
 
@@ -105,11 +112,14 @@ func (d *IngressServer) read(ctx context.Context) error {
 				frame.Release()
 				continue
 			}
-			if frame.raw[0] != 0 {
+			if frame.raw[versionPos] != plainFrameVersion &&
+				frame.raw[versionPos] != encFrameVersion {
+
 				metrics.CounterInc(metrics.CounterWith(d.Metrics.FramesDiscarded,
 					"remote_isd_as", v.IA.String(), "reason", "invalid"))
 				logger.Info("IngressServer: Unsupported SIG protocol version",
-					"supported", 0, "actual", frame.raw[0])
+					"supported", []int{plainFrameVersion, encFrameVersion},
+					"actual", frame.raw[versionPos])
 				frame.Release()
 				continue
 			}
@@ -148,6 +158,8 @@ func (d *IngressServer) dispatch(ctx context.Context, frame *frameBuf, src *snet
 		// Handle will be cleaned up when worker goroutine finishes.
 
 		worker = newWorker(src, frame.sessId, handle, metrics)
+		worker.frameKeys = d.FrameKeys
+		worker.replay = d.replayFilter(dispatchStr)
 		d.workers[dispatchStr] = worker
 		go func() {
 			defer log.HandlePanic()
@@ -164,6 +176,17 @@ func (d *IngressServer) dispatch(ctx context.Context, frame *frameBuf, src *snet
 	worker.Ring.Write(ringbuf.EntryList{frame}, true)
 }
 
+// replayFilter returns the replay filter for the given dispatch key, creating
+// one if none exists yet.
+func (d *IngressServer) replayFilter(dispatchStr string) *replayFilter {
+	f, ok := d.replayFilters[dispatchStr]
+	if !ok {
+		f = newReplayFilter()
+		d.replayFilters[dispatchStr] = f
+	}
+	return f
+}
+
 func createWorkerMetrics(in IngressMetrics, remoteIALabel string) IngressMetrics {
 	labels := []string{"remote_isd_as", remoteIALabel}
 	return IngressMetrics{
@@ -192,6 +215,13 @@ func (d *IngressServer) cleanup() {
 			worker.markedForCleanup = true
 		}
 	}
+	now := time.Now()
+	for key, f := range d.replayFilters {
+		left := f.prune(now)
+		if _, ok := d.workers[key]; !ok && left == 0 {
+			delete(d.replayFilters, key)
+		}
+	}
 }
 
 func increaseCounterMetric(m metrics.Counter, amount float64) {
@@ -29,7 +29,10 @@ import (
 const (
 	// minMTU is the minmal MTU that makes sense for the gateway. The SIG header
 	// must fit it as well as at least one IPv6 header plus 1 byte of content.
-	minMTU    = hdrLen + 41
+	minMTU = hdrLen + 41
+	// minEncMTU is the minimal MTU for encrypted frames. Additionally to minMTU,
+	// the extended header and the authentication tag must fit.
+	minEncMTU = encHdrLen + 41 + frameTagLen
 	udpHdrLen = 8
 )
 
@@ -42,11 +45,14 @@ type sender struct {
 	path               snet.Path
 	pathFingerprint    snet.PathFingerprint
 	metrics            SessionMetrics
+	// frameKeys provides the keys to encrypt the frames. If nil, the frames are
+	// sent unencrypted.
+	frameKeys FrameKeyProvider
 }
 
 func newSender(sessID uint8, conn net.PacketConn, path snet.Path,
 	gatewayAddr net.UDPAddr, pathStatsPublisher PathStatsPublisher,
-	metrics SessionMetrics) (*sender, error) {
+	metrics SessionMetrics, frameKeys FrameKeyProvider) (*sender, error) {
 
 	// MTU must account for the size of the SCION header.
 	localAddr := conn.LocalAddr().(*snet.UDPAddr)
@@ -57,12 +63,25 @@ func newSender(sessID uint8, conn net.PacketConn, path snet.Path,
 	}
 	pathLen := len(scionPath.Raw)
 	mtu := int(path.Metadata().MTU) - slayers.CmnHdrLen - addrLen - pathLen - udpHdrLen
-	if mtu < minMTU {
-		return nil, serrors.New("insufficient MTU", "mtu", mtu, "minMTU", minMTU)
+	var enc *encoder
+	if frameKeys != nil {
+		if mtu < minEncMTU {
+			return nil, serrors.New("insufficient MTU", "mtu", mtu, "minMTU", minEncMTU)
+		}
+		salt, err := newFrameSalt()
+		if err != nil {
+			return nil, err
+		}
+		enc = newEncryptedEncoder(sessID, NewStreamID(), uint16(mtu), salt)
+	} else {
+		if mtu < minMTU {
+			return nil, serrors.New("insufficient MTU", "mtu", mtu, "minMTU", minMTU)
+		}
+		enc = newEncoder(sessID, NewStreamID(), uint16(mtu))
 	}
 
 	c := &sender{
-		encoder: newEncoder(sessID, NewStreamID(), uint16(mtu)),
+		encoder: enc,
 		conn:    conn,
 		address: &snet.UDPAddr{
 			IA:      path.Destination(),
@@ -74,6 +93,7 @@ func newSender(sessID uint8, conn net.PacketConn, path snet.Path,
 		path:               path,
 		pathFingerprint:    snet.Fingerprint(path),
 		metrics:            metrics,
+		frameKeys:          frameKeys,
 	}
 	go func() {
 		defer log.HandlePanic()
@@ -103,6 +123,17 @@ func (c *sender) run() {
 			// Sender was closed and all the buffered frames were sent.
 			break
 		}
+		if c.frameKeys != nil {
+			remote := c.address.(*snet.UDPAddr)
+			key, err := c.frameKeys.EgressKey(remote.IA, remote.Host.IP)
+			if err != nil {
+				// Without a key the frame cannot be sent. Dropping it is
+				// accounted for like any other failure to send.
+				increaseCounterMetric(c.metrics.SendExternalErrors, 1)
+				continue
+			}
+			frame = sealFrame(frame, key)
+		}
 		_, err := c.conn.WriteTo(frame, c.address)
 		if err != nil {
 			increaseCounterMetric(c.metrics.SendExternalErrors, 1)
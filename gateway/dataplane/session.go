@@ -18,6 +18,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"hash/crc64"
+	"math"
 	"net"
 	"sort"
 	"strings"
@@ -26,6 +27,7 @@ import (
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
 
+	"github.com/scionproto/scion/gateway/control"
 	"github.com/scionproto/scion/pkg/metrics"
 	"github.com/scionproto/scion/pkg/snet"
 )
@@ -34,6 +36,11 @@ var (
 	crcTable = crc64.MakeTable(crc64.ECMA)
 )
 
+// weightResolution is the sum of the shares the weights of the paths are
+// converted to. Converting the weights to coarse integer shares keeps the
+// assignment of flows stable when the weights change only slightly.
+const weightResolution = 100
+
 type PathStatsPublisher interface {
 	PublishEgressStats(fingerprint string, frames int64, bytes int64)
 }
@@ -59,10 +66,26 @@ type Session struct {
 	DataPlaneConn      net.PacketConn
 	PathStatsPublisher PathStatsPublisher
 	Metrics            SessionMetrics
+	// FrameKeys provides the keys to encrypt the frames sent to the remote
+	// gateway. If nil, the frames are sent unencrypted.
+	FrameKeys FrameKeyProvider
+	// LoadBalancing determines how packets are distributed across the paths.
+	LoadBalancing control.LoadBalancing
 
 	mutex sync.Mutex
 	// senders is a list of currently used senders.
 	senders []*sender
+	// shares contains the integer share of each sender, in the same order as
+	// senders.
+	shares []int
+	// totalShare is the sum of shares.
+	totalShare int
+	// seeds contains the hash of the path fingerprint of each sender, in the
+	// same order as senders.
+	seeds []uint64
+	// credits contains the current credits of each sender for the smooth
+	// weighted round-robin used by control.LoadBalancePackets.
+	credits []int
 }
 
 // Close signals that the session should close up its internal Connections. Close returns as
@@ -86,10 +109,58 @@ func (s *Session) Write(packet gopacket.Packet) {
 		s.senders[0].Write(packet.Data())
 		return
 	}
-	// Choose the path based on the packet's quintuple.
+	s.senders[s.pick(packet)].Write(packet.Data())
+}
+
+// pick returns the index of the sender the packet is sent with.
+func (s *Session) pick(packet gopacket.Packet) int {
+	// The senders use different stream IDs, so the remote gateway does not
+	// restore the order of packets sent over different paths. TCP treats
+	// reordering as loss, hence TCP packets are assigned by flow even if the
+	// packets are distributed individually.
+	if s.LoadBalancing == control.LoadBalancePackets &&
+		packet.Layer(layers.LayerTypeTCP) == nil {
+
+		// Smooth weighted round-robin: every sender earns credits proportional
+		// to its share, the sender with the most credits is picked and pays for
+		// the packet. This interleaves the senders as evenly as possible.
+		best := 0
+		for i, share := range s.shares {
+			s.credits[i] += share
+			if s.credits[i] > s.credits[best] {
+				best = i
+			}
+		}
+		s.credits[best] -= s.totalShare
+		return best
+	}
+	// Choose the path based on the packet's quintuple. Weighted rendezvous
+	// hashing scores every sender for the flow and picks the best score. If
+	// the shares or the paths change, only the flows whose best score changes
+	// move, i.e., a number of flows proportional to the change.
 	hash := crc64.Checksum(extractQuintuple(packet), crcTable)
-	index := hash % uint64(len(s.senders))
-	s.senders[index].Write(packet.Data())
+	best, bestScore := 0, math.Inf(-1)
+	for i, share := range s.shares {
+		if score := rendezvousScore(hash, s.seeds[i], share); score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
+
+// rendezvousScore returns the score of a sender with the given seed and share
+// for the flow with the given hash. The probability that a sender has the
+// highest score of all senders is proportional to its share.
+func rendezvousScore(hash, seed uint64, share int) float64 {
+	// Mix the hashes (splitmix64 finalizer) to get a uniformly distributed
+	// value per flow and sender.
+	x := hash ^ seed
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	x ^= x >> 31
+	// Map to the open interval (0, 1).
+	u := (float64(x>>11) + 0.5) / (1 << 53)
+	return float64(share) / -math.Log(u)
 }
 
 func (s *Session) String() string {
@@ -113,7 +184,12 @@ func (s *Session) String() string {
 // could cause packets to be delivered out of order. Using new sender with new stream
 // ID causes creation of new reassemby queue on the remote side, thus avoiding the
 // reordering issues.
-func (s *Session) SetPaths(paths []snet.Path) error {
+//
+// The weights are the relative capacities of the paths, in the same order as
+// the paths. Depending on the load balancing mode, they determine the share of
+// the traffic each path gets. If the weights are nil, all paths get the same
+// share.
+func (s *Session) SetPaths(paths []snet.Path, weights []float64) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -138,6 +214,7 @@ func (s *Session) SetPaths(paths []snet.Path) error {
 			s.GatewayAddr,
 			s.PathStatsPublisher,
 			s.Metrics,
+			s.FrameKeys,
 		)
 		if err != nil {
 			// Collect newly created senders to avoid go routine leak.
@@ -165,9 +242,41 @@ func (s *Session) SetPaths(paths []snet.Path) error {
 			string(newSenders[y].pathFingerprint)) == -1
 	})
 	s.senders = newSenders
+	s.setShares(paths, weights)
 	return nil
 }
 
+// setShares converts the weights of the paths to the integer shares of the
+// senders. Every sender gets a share of at least 1.
+func (s *Session) setShares(paths []snet.Path, weights []float64) {
+	byPath := make(map[snet.PathFingerprint]float64, len(paths))
+	var sum float64
+	if s.LoadBalancing != control.LoadBalanceEqual && len(weights) == len(paths) {
+		for i, path := range paths {
+			w := weights[i]
+			if math.IsNaN(w) || math.IsInf(w, 0) || w < 0 {
+				w = 0
+			}
+			byPath[snet.Fingerprint(path)] = w
+			sum += w
+		}
+	}
+
+	s.shares = make([]int, len(s.senders))
+	s.credits = make([]int, len(s.senders))
+	s.seeds = make([]uint64, len(s.senders))
+	s.totalShare = 0
+	for i, snd := range s.senders {
+		s.seeds[i] = crc64.Checksum([]byte(snd.pathFingerprint), crcTable)
+		share := 1
+		if sum > 0 {
+			share = max(1, int(math.Round(byPath[snd.pathFingerprint]/sum*weightResolution)))
+		}
+		s.shares[i] = share
+		s.totalShare += share
+	}
+}
+
 func findSenderWithPath(senders []*sender, path snet.Path) (*sender, bool) {
 	for _, s := range senders {
 		if pathsEqual(path, s.path) {
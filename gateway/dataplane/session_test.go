@@ -26,8 +26,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
 
+	"github.com/scionproto/scion/gateway/control"
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/private/mocks/net/mock_net"
+	"github.com/scionproto/scion/pkg/segment/iface"
 	"github.com/scionproto/scion/pkg/snet"
 	"github.com/scionproto/scion/pkg/snet/mock_snet"
 	snetpath "github.com/scionproto/scion/pkg/snet/path"
@@ -51,7 +53,7 @@ func TestSinglePath(t *testing.T) {
 
 	frameChan := make(chan ([]byte))
 	sess := createSession(t, ctrl, frameChan)
-	require.NoError(t, sess.SetPaths([]snet.Path{createMockPath(ctrl, 200)}))
+	require.NoError(t, sess.SetPaths([]snet.Path{createMockPath(ctrl, 200)}, nil))
 	sendPackets(t, sess, 22, 10)
 	waitFrames(t, frameChan, 22, 10)
 	sess.Close()
@@ -67,17 +69,17 @@ func TestTwoPaths(t *testing.T) {
 
 	sess := createSession(t, ctrl, frameChan)
 
-	require.NoError(t, sess.SetPaths([]snet.Path{createMockPath(ctrl, 200)}))
+	require.NoError(t, sess.SetPaths([]snet.Path{createMockPath(ctrl, 200)}, nil))
 	sendPackets(t, sess, 22, 10)
 
 	// Reuse the same path, thus reusing the sender.
-	require.NoError(t, sess.SetPaths([]snet.Path{createMockPath(ctrl, 200)}))
+	require.NoError(t, sess.SetPaths([]snet.Path{createMockPath(ctrl, 200)}, nil))
 	sendPackets(t, sess, 22, 10)
 
 	// The previous packets are not yet sent, yet we set a new path thus creating a new
 	// sender. The goal is to test that the old packets will still be sent out.
 	// The MTU is used to differentiate the paths
-	require.NoError(t, sess.SetPaths([]snet.Path{createMockPath(ctrl, 202)}))
+	require.NoError(t, sess.SetPaths([]snet.Path{createMockPath(ctrl, 202)}, nil))
 	sendPackets(t, sess, 22, 10)
 	waitFrames(t, frameChan, 22, 30)
 
@@ -101,7 +103,7 @@ func TestNoLeak(t *testing.T) {
 	batchSize := 10
 
 	for i := 0; i < iterations; i++ {
-		require.NoError(t, sess.SetPaths([]snet.Path{createMockPath(ctrl, 200)}))
+		require.NoError(t, sess.SetPaths([]snet.Path{createMockPath(ctrl, 200)}, nil))
 		sendPackets(t, sess, payloadLen, batchSize)
 
 		require.NoError(t, sess.SetPaths([]snet.Path{
@@ -109,11 +111,11 @@ func TestNoLeak(t *testing.T) {
 			createMockPath(ctrl, 201),
 			createMockPath(ctrl, 202),
 			createMockPath(ctrl, 203),
-		}))
+		}, nil))
 		sendPackets(t, sess, payloadLen, batchSize)
 
 		// Cause error
-		err := sess.SetPaths([]snet.Path{createMockPath(ctrl, 15)})
+		err := sess.SetPaths([]snet.Path{createMockPath(ctrl, 15)}, nil)
 		assert.Error(t, err)
 		sendPackets(t, sess, payloadLen, batchSize)
 	}
@@ -122,6 +124,97 @@ func TestNoLeak(t *testing.T) {
 	sess.Close()
 }
 
+func TestLoadBalancing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	defaultPaths := []snet.Path{
+		createMockPathVia(ctrl, 1),
+		createMockPathVia(ctrl, 2),
+	}
+	newBalancingSession := func(lb control.LoadBalancing, weights []float64,
+		paths ...snet.Path) *Session {
+
+		if len(paths) == 0 {
+			paths = defaultPaths
+		}
+		sess := &Session{LoadBalancing: lb}
+		for _, path := range paths {
+			sess.senders = append(sess.senders, &sender{pathFingerprint: snet.Fingerprint(path)})
+		}
+		sess.setShares(paths, weights)
+		return sess
+	}
+	// count sends packets of different flows and counts the packets per sender.
+	count := func(sess *Session, n int) []int {
+		counts := make([]int, len(sess.senders))
+		for i := 0; i < n; i++ {
+			counts[sess.pick(createFlowPacket(uint16(i)))]++
+		}
+		return counts
+	}
+
+	t.Run("equal ignores weights", func(t *testing.T) {
+		sess := newBalancingSession(control.LoadBalanceEqual, []float64{3, 1})
+		assert.Equal(t, []int{1, 1}, sess.shares)
+	})
+	t.Run("shares", func(t *testing.T) {
+		sess := newBalancingSession(control.LoadBalanceFlows, []float64{3, 1})
+		assert.Equal(t, []int{75, 25}, sess.shares)
+		sess = newBalancingSession(control.LoadBalanceFlows, []float64{1, 0})
+		assert.Equal(t, []int{100, 1}, sess.shares)
+		sess = newBalancingSession(control.LoadBalanceFlows, nil)
+		assert.Equal(t, []int{1, 1}, sess.shares)
+	})
+	t.Run("flows", func(t *testing.T) {
+		sess := newBalancingSession(control.LoadBalanceFlows, []float64{3, 1})
+		counts := count(sess, 4000)
+		assert.InDelta(t, 3000, counts[0], 200)
+		assert.InDelta(t, 1000, counts[1], 200)
+		// Packets of the same flow always use the same path.
+		pkt := createFlowPacket(42)
+		first := sess.pick(pkt)
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, first, sess.pick(pkt))
+		}
+	})
+	t.Run("flows stay on their path", func(t *testing.T) {
+		// A small change of the weights only moves a small share of the flows,
+		// even if the sum of the shares changes.
+		paths := append(defaultPaths, createMockPathVia(ctrl, 3))
+		before := newBalancingSession(control.LoadBalanceFlows, []float64{1, 1, 1}, paths...)
+		after := newBalancingSession(control.LoadBalanceFlows, []float64{1, 1, 1.05}, paths...)
+		require.Equal(t, []int{33, 33, 33}, before.shares)
+		require.Equal(t, []int{33, 33, 34}, after.shares)
+		var moved int
+		for i := 0; i < 4000; i++ {
+			pkt := createFlowPacket(uint16(i))
+			if before.pick(pkt) != after.pick(pkt) {
+				moved++
+			}
+		}
+		assert.Less(t, moved, 200)
+	})
+	t.Run("packets", func(t *testing.T) {
+		sess := newBalancingSession(control.LoadBalancePackets, []float64{3, 1})
+		// A single flow is spread across the paths.
+		counts := make([]int, 2)
+		pkt := createFlowPacket(42)
+		for i := 0; i < 400; i++ {
+			counts[sess.pick(pkt)]++
+		}
+		assert.Equal(t, []int{300, 100}, counts)
+	})
+	t.Run("packets keep TCP flows", func(t *testing.T) {
+		sess := newBalancingSession(control.LoadBalancePackets, []float64{3, 1})
+		pkt := createTCPPacket(42)
+		first := sess.pick(pkt)
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, first, sess.pick(pkt))
+		}
+	})
+}
+
 func createSession(t *testing.T, ctrl *gomock.Controller, frameChan chan []byte) *Session {
 	conn := mock_net.NewMockPacketConn(ctrl)
 	conn.EXPECT().LocalAddr().Return(
@@ -172,6 +265,60 @@ Top:
 	assert.Equal(t, toRead, read)
 }
 
+func createFlowPacket(srcPort uint16) gopacket.Packet {
+	buf := gopacket.NewSerializeBuffer()
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolUDP,
+		SrcIP:    net.IP{10, 0, 0, 1},
+		DstIP:    net.IP{10, 0, 0, 2},
+	}
+	udp := &layers.UDP{SrcPort: layers.UDPPort(srcPort), DstPort: 53}
+	if err := udp.SetNetworkLayerForChecksum(ip); err != nil {
+		panic(err)
+	}
+	opts := gopacket.SerializeOptions{FixLengths: true}
+	if err := gopacket.SerializeLayers(buf, opts, ip, udp); err != nil {
+		panic(err)
+	}
+	return gopacket.NewPacket(buf.Bytes(), layers.LayerTypeIPv4, gopacket.Default)
+}
+
+func createTCPPacket(srcPort uint16) gopacket.Packet {
+	buf := gopacket.NewSerializeBuffer()
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolTCP,
+		SrcIP:    net.IP{10, 0, 0, 1},
+		DstIP:    net.IP{10, 0, 0, 2},
+	}
+	tcp := &layers.TCP{SrcPort: layers.TCPPort(srcPort), DstPort: 80, ACK: true}
+	if err := tcp.SetNetworkLayerForChecksum(ip); err != nil {
+		panic(err)
+	}
+	opts := gopacket.SerializeOptions{FixLengths: true}
+	if err := gopacket.SerializeLayers(buf, opts, ip, tcp); err != nil {
+		panic(err)
+	}
+	return gopacket.NewPacket(buf.Bytes(), layers.LayerTypeIPv4, gopacket.Default)
+}
+
+// createMockPathVia creates a path whose fingerprint is determined by the
+// interface ID.
+func createMockPathVia(ctrl *gomock.Controller, ifID uint16) snet.Path {
+	meta := &snet.PathMetadata{
+		MTU: 1400,
+		Interfaces: []snet.PathInterface{
+			{IA: addr.MustParseIA("1-ff00:0:300"), ID: iface.ID(ifID)},
+		},
+	}
+	path := mock_snet.NewMockPath(ctrl)
+	path.EXPECT().Metadata().Return(meta).AnyTimes()
+	return path
+}
+
 func createMockPath(ctrl *gomock.Controller, mtu uint16) snet.Path {
 	meta := &snet.PathMetadata{
 		MTU: mtu,
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/metrics"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/snet"
 	"github.com/scionproto/scion/private/ringbuf"
@@ -50,6 +51,11 @@ type worker struct {
 	rlists           map[int]*reassemblyList
 	markedForCleanup bool
 	tunIO            io.WriteCloser
+	// frameKeys provides the keys for encrypted frames. If nil, encrypted
+	// frames are discarded.
+	frameKeys FrameKeyProvider
+	// replay rejects replayed encrypted frames.
+	replay *replayFilter
 }
 
 func newWorker(remote *snet.UDPAddr, sessID uint8,
@@ -101,6 +107,19 @@ func (w *worker) Run(ctx context.Context) {
 // packets to the wire and then adding the frame to the corresponding reassembly
 // list if needed.
 func (w *worker) processFrame(ctx context.Context, frame *frameBuf) {
+	if frame.raw[versionPos] == encFrameVersion {
+		if reason, ok := w.decryptFrame(frame); !ok {
+			metrics.CounterInc(metrics.CounterWith(w.Metrics.FramesDiscarded, "reason", reason))
+			frame.Release()
+			return
+		}
+	} else if w.frameKeys != nil && w.frameKeys.Encrypted(w.Remote.IA, w.Remote.Host.IP) {
+		// Accepting plain frames would allow an attacker to bypass the
+		// encryption negotiated with the remote gateway.
+		metrics.CounterInc(metrics.CounterWith(w.Metrics.FramesDiscarded, "reason", "unencrypted"))
+		frame.Release()
+		return
+	}
 	index := int(binary.BigEndian.Uint16(frame.raw[2:4]))
 	epoch := int(binary.BigEndian.Uint32(frame.raw[4:8]) & 0xfffff)
 	seqNr := binary.BigEndian.Uint64(frame.raw[8:16])
@@ -118,6 +137,38 @@ func (w *worker) processFrame(ctx context.Context, frame *frameBuf) {
 	rlist.Insert(ctx, frame)
 }
 
+// decryptFrame authenticates and decrypts an encrypted frame in place, so that
+// it can be processed like an unencrypted one. If the frame must be discarded,
+// the reason is returned.
+func (w *worker) decryptFrame(frame *frameBuf) (string, bool) {
+	if w.frameKeys == nil || w.replay == nil {
+		return "unsupported", false
+	}
+	raw := frame.raw[:frame.frameLen]
+	if len(raw) < encHdrLen+frameTagLen {
+		return "invalid", false
+	}
+	key, err := w.frameKeys.IngressKey(w.Remote.IA, w.Remote.Host.IP, raw[keyIDPos])
+	if err != nil || time.Now().After(key.NotAfter) {
+		return "no_key", false
+	}
+	// Decryption overwrites the salt, keep a copy of the header for the replay
+	// check.
+	var hdr [encHdrLen]byte
+	copy(hdr[:], raw)
+	n, err := openFrame(raw, key)
+	if err != nil {
+		return "unauthenticated", false
+	}
+	// The replay check must happen after authentication, otherwise forged
+	// frames could advance the window.
+	if !w.replay.check(hdr[:], key) {
+		return "replayed", false
+	}
+	frame.frameLen = n
+	return "", true
+}
+
 func (w *worker) getRlist(epoch int) *reassemblyList {
 	rlist, ok := w.rlists[epoch]
 	if !ok {
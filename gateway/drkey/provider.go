@@ -0,0 +1,360 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package drkey provides the gateway with the keys used to encrypt the frames
+// exchanged with remote gateways.
+//
+// The keys are DRKey Host-Host keys between the data plane addresses of the
+// two gateways. Each direction uses its own key: frames sent to a remote
+// gateway are protected with the key from the local to the remote gateway,
+// frames received from it with the key in the opposite direction. Both
+// gateways can obtain the keys from their SCION Daemon, so no key exchange is
+// needed between them. The keys are rotated with the DRKey epochs.
+package drkey
+
+import (
+	"context"
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/scionproto/scion/gateway/dataplane"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/drkey"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+// Protocol is the DRKey protocol identifier of the frame keys. It is not one of
+// the predefined protocols, i.e., the keys are derived with the generic
+// derivation.
+const Protocol drkey.Protocol = 0x5347
+
+// defaultIdleTimeout is the default time after which unused remote gateways are
+// forgotten.
+const defaultIdleTimeout = 10 * time.Minute
+
+// KeyFetcher obtains Host-Host keys. It is implemented by the SCION Daemon
+// connector.
+type KeyFetcher interface {
+	DRKeyGetHostHostKey(ctx context.Context, meta drkey.HostHostMeta) (drkey.HostHostKey, error)
+}
+
+// Provider provides the keys for encrypted frames from cached DRKeys. The
+// DRKeys are fetched by Run, which is meant to be executed periodically, for
+// the remote gateways that were registered with Register. Key lookups never
+// block on the network; if the key for a remote gateway has not been fetched
+// yet, dataplane.ErrNoFrameKey is returned.
+//
+// Remote gateways are only registered explicitly, never as a side effect of a
+// lookup, because lookups for received frames happen before the frame is
+// authenticated. Remote gateways that were neither registered again nor used
+// for the idle timeout are forgotten.
+type Provider struct {
+	// LocalIA is the ISD-AS of the gateway.
+	LocalIA addr.IA
+	// LocalIP is the IP address from which frames are sent and on which frames
+	// are received.
+	LocalIP net.IP
+	// Fetcher fetches the Host-Host keys.
+	Fetcher KeyFetcher
+	// AcceptanceWindow is the time width around the current time for which
+	// received frames are accepted with the keys of neighbouring epochs. This
+	// tolerates clock skew between the gateways.
+	AcceptanceWindow time.Duration
+	// PrefetchLead is how long before the end of the current epoch the keys of
+	// the next epoch are fetched. If it is shorter than half of the acceptance
+	// window, half of the acceptance window is used instead.
+	PrefetchLead time.Duration
+	// IdleTimeout is the time after which a remote gateway that was neither
+	// registered again nor had any of its keys looked up is forgotten. If zero,
+	// this defaults to 10 minutes.
+	IdleTimeout time.Duration
+
+	mtx sync.RWMutex
+	// peers contains the state per remote gateway and direction.
+	peers map[remoteGateway]*peerState
+}
+
+// remoteGateway identifies a remote gateway and a direction.
+type remoteGateway struct {
+	egress bool
+	ia     addr.IA
+	host   string
+}
+
+// peerState contains the cached keys of a remote gateway in one direction.
+type peerState struct {
+	// keys are the cached keys, sorted by the start of their epoch.
+	keys []frameKey
+	// lastUsed is the time, in nanoseconds since the Unix epoch, the remote
+	// gateway was last registered or one of its keys was looked up.
+	lastUsed atomic.Int64
+}
+
+// touch updates the last use of the remote gateway. To avoid contention on the
+// data path, it is only written if it changes by more than a second.
+func (s *peerState) touch(now time.Time) {
+	if now.UnixNano()-s.lastUsed.Load() > int64(time.Second) {
+		s.lastUsed.Store(now.UnixNano())
+	}
+}
+
+type frameKey struct {
+	epoch drkey.Epoch
+	key   *dataplane.FrameKey
+}
+
+// Name returns the tasks name.
+func (p *Provider) Name() string {
+	return "gateway_drkey_frame_key_fetcher"
+}
+
+// Run fetches the keys that are needed now, or that will be needed once the
+// current epoch ends, and evicts those that can no longer be used.
+func (p *Provider) Run(ctx context.Context) {
+	if err := p.Refresh(ctx, time.Now()); err != nil {
+		log.FromCtx(ctx).Info("Failed to fetch DRKeys for frame encryption", "err", err)
+	}
+}
+
+// Refresh makes sure that the keys needed at time now are cached for all
+// registered remote gateways. For received frames, these are the keys of the epochs
+// covering the acceptance window around now. For sent frames, it is the key of
+// the current epoch and the one of the next epoch, if the current epoch ends
+// within the prefetch lead.
+func (p *Provider) Refresh(ctx context.Context, now time.Time) error {
+	peers := p.evict(now)
+
+	halfWindow := p.AcceptanceWindow / 2
+	lead := max(p.PrefetchLead, halfWindow)
+	var errs serrors.List
+	for _, peer := range peers {
+		times := []time.Time{now.Add(-halfWindow), now, now.Add(halfWindow)}
+		if peer.egress {
+			times = []time.Time{now, now.Add(lead)}
+		}
+		for _, t := range times {
+			if p.cached(peer, t) {
+				continue
+			}
+			key, err := p.fetch(ctx, peer, t)
+			if err != nil {
+				errs = append(errs, serrors.Wrap("fetching key", err, "isd_as", peer.ia,
+					"host", peer.host, "egress", peer.egress, "validity", t))
+				continue
+			}
+			p.insert(peer, key)
+		}
+	}
+	return errs.ToError()
+}
+
+// Register makes the remote gateway known, so that the keys for both
+// directions are fetched in the next refresh. Registering a known remote
+// gateway again keeps it from being evicted as idle. It must only be called
+// for remote gateways that were discovered or configured, but not for the
+// sources of received frames.
+func (p *Provider) Register(remoteIA addr.IA, remoteIP net.IP) {
+	now := time.Now()
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.peers == nil {
+		p.peers = make(map[remoteGateway]*peerState)
+	}
+	for _, egress := range []bool{true, false} {
+		peer := remoteGateway{egress: egress, ia: remoteIA, host: remoteIP.String()}
+		state, ok := p.peers[peer]
+		if !ok {
+			state = &peerState{}
+			p.peers[peer] = state
+		}
+		state.lastUsed.Store(now.UnixNano())
+	}
+}
+
+// EgressKey returns the key to protect frames sent to the remote gateway.
+func (p *Provider) EgressKey(remoteIA addr.IA, remoteIP net.IP) (*dataplane.FrameKey, error) {
+	now := time.Now()
+	peer := remoteGateway{egress: true, ia: remoteIA, host: remoteIP.String()}
+	k, ok := p.lookup(peer, now, func(k frameKey) bool { return contains(k.epoch, now) })
+	if !ok {
+		return nil, dataplane.ErrNoFrameKey
+	}
+	return k.key, nil
+}
+
+// IngressKey returns the key with the given ID that protects frames received
+// from the remote gateway.
+func (p *Provider) IngressKey(remoteIA addr.IA, remoteIP net.IP,
+	id uint8) (*dataplane.FrameKey, error) {
+
+	now := time.Now()
+	peer := remoteGateway{egress: false, ia: remoteIA, host: remoteIP.String()}
+	k, ok := p.lookup(peer, now, func(k frameKey) bool {
+		return k.key.ID == id && !now.After(k.key.NotAfter)
+	})
+	if !ok {
+		return nil, dataplane.ErrNoFrameKey
+	}
+	return k.key, nil
+}
+
+// Encrypted reports whether the remote gateway is registered, i.e., whether
+// frame encryption was negotiated with it.
+func (p *Provider) Encrypted(remoteIA addr.IA, remoteIP net.IP) bool {
+	peer := remoteGateway{egress: false, ia: remoteIA, host: remoteIP.String()}
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	_, ok := p.peers[peer]
+	return ok
+}
+
+func (p *Provider) fetch(ctx context.Context, peer remoteGateway, t time.Time) (frameKey, error) {
+	meta := drkey.HostHostMeta{
+		ProtoId:  Protocol,
+		Validity: t,
+		SrcIA:    p.LocalIA,
+		SrcHost:  p.LocalIP.String(),
+		DstIA:    peer.ia,
+		DstHost:  peer.host,
+	}
+	if !peer.egress {
+		meta.SrcIA, meta.DstIA = meta.DstIA, meta.SrcIA
+		meta.SrcHost, meta.DstHost = meta.DstHost, meta.SrcHost
+	}
+	hostKey, err := p.Fetcher.DRKeyGetHostHostKey(ctx, meta)
+	if err != nil {
+		return frameKey{}, err
+	}
+	if hostKey.ProtoId != Protocol || !contains(hostKey.Epoch, t) {
+		return frameKey{}, serrors.New("received unexpected key",
+			"protocol", hostKey.ProtoId, "epoch", hostKey.Epoch)
+	}
+	// Frames sent with the key of the previous epoch are accepted until the end
+	// of the acceptance window.
+	notAfter := hostKey.Epoch.NotAfter
+	if !peer.egress {
+		notAfter = notAfter.Add(p.AcceptanceWindow / 2)
+	}
+	key, err := dataplane.NewFrameKey(keyID(hostKey.Epoch), hostKey.Key[:], notAfter)
+	if err != nil {
+		return frameKey{}, err
+	}
+	return frameKey{epoch: hostKey.Epoch, key: key}, nil
+}
+
+// lookup returns the first cached key of the remote gateway that matches, and
+// marks the remote gateway as used.
+func (p *Provider) lookup(peer remoteGateway, now time.Time,
+	match func(frameKey) bool) (frameKey, bool) {
+
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	state, ok := p.peers[peer]
+	if !ok {
+		return frameKey{}, false
+	}
+	state.touch(now)
+	for _, k := range state.keys {
+		if match(k) {
+			return k, true
+		}
+	}
+	return frameKey{}, false
+}
+
+// cached checks whether the key of the epoch containing t is cached for the
+// remote gateway.
+func (p *Provider) cached(peer remoteGateway, t time.Time) bool {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	state, ok := p.peers[peer]
+	if !ok {
+		return false
+	}
+	for _, k := range state.keys {
+		if contains(k.epoch, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Provider) insert(peer remoteGateway, key frameKey) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	state, ok := p.peers[peer]
+	if !ok {
+		// The remote gateway was evicted while the key was fetched.
+		return
+	}
+	for _, cached := range state.keys {
+		if cached.epoch.NotBefore.Equal(key.epoch.NotBefore) {
+			return
+		}
+	}
+	keys := append(state.keys, key)
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].epoch.NotBefore.Before(keys[j].epoch.NotBefore)
+	})
+	state.keys = keys
+}
+
+// evict forgets the idle remote gateways, removes the keys that can no longer
+// be used and returns the remaining remote gateways.
+func (p *Provider) evict(now time.Time) []remoteGateway {
+	idleTimeout := p.IdleTimeout
+	if idleTimeout == 0 {
+		idleTimeout = defaultIdleTimeout
+	}
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	peers := make([]remoteGateway, 0, len(p.peers))
+	for peer, state := range p.peers {
+		if now.Sub(time.Unix(0, state.lastUsed.Load())) > idleTimeout {
+			delete(p.peers, peer)
+			continue
+		}
+		valid := state.keys[:0]
+		for _, k := range state.keys {
+			if !now.After(k.key.NotAfter) {
+				valid = append(valid, k)
+			}
+		}
+		state.keys = valid
+		peers = append(peers, peer)
+	}
+	return peers
+}
+
+// keyID returns the ID of the key of the given epoch. It is the index of the
+// epoch, truncated to 8 bits, so neighbouring epochs of the same length always
+// have different IDs.
+func keyID(epoch drkey.Epoch) uint8 {
+	duration := int64(epoch.NotAfter.Sub(epoch.NotBefore) / time.Second)
+	if duration <= 0 {
+		return uint8(epoch.NotBefore.Unix())
+	}
+	return uint8(epoch.NotBefore.Unix() / duration)
+}
+
+// contains checks whether t is in the half-open interval [NotBefore, NotAfter)
+// of the epoch. Consecutive epochs share their boundary, so the end of an epoch
+// belongs to the next one.
+func contains(epoch drkey.Epoch, t time.Time) bool {
+	return !t.Before(epoch.NotBefore) && t.Before(epoch.NotAfter)
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drkey_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/gateway/dataplane"
+	gwdrkey "github.com/scionproto/scion/gateway/drkey"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/drkey"
+	"github.com/scionproto/scion/private/periodic"
+)
+
+var _ periodic.Task = (*gwdrkey.Provider)(nil)
+var _ dataplane.FrameKeyProvider = (*gwdrkey.Provider)(nil)
+
+const epochDuration = time.Hour
+
+var (
+	localIA  = addr.MustParseIA("1-ff00:0:110")
+	localIP  = net.IP{10, 0, 0, 1}
+	remoteIA = addr.MustParseIA("1-ff00:0:111")
+	remoteIP = net.IP{10, 0, 0, 2}
+)
+
+// keyFetcher returns keys for hourly epochs and records the requests.
+type keyFetcher struct {
+	requests []drkey.HostHostMeta
+}
+
+func (f *keyFetcher) DRKeyGetHostHostKey(
+	_ context.Context,
+	meta drkey.HostHostMeta,
+) (drkey.HostHostKey, error) {
+
+	f.requests = append(f.requests, meta)
+	begin := meta.Validity.Truncate(epochDuration)
+	return drkey.HostHostKey{
+		ProtoId: meta.ProtoId,
+		Epoch:   drkey.NewEpoch(uint32(begin.Unix()), uint32(begin.Add(epochDuration).Unix())),
+		SrcIA:   meta.SrcIA,
+		DstIA:   meta.DstIA,
+		SrcHost: meta.SrcHost,
+		DstHost: meta.DstHost,
+		Key:     drkey.Key{1, 2, 3},
+	}, nil
+}
+
+func newProvider(fetcher gwdrkey.KeyFetcher) *gwdrkey.Provider {
+	return &gwdrkey.Provider{
+		LocalIA:          localIA,
+		LocalIP:          localIP,
+		Fetcher:          fetcher,
+		AcceptanceWindow: 5 * time.Minute,
+		PrefetchLead:     10 * time.Minute,
+	}
+}
+
+func TestProviderEgressKey(t *testing.T) {
+	fetcher := &keyFetcher{}
+	p := newProvider(fetcher)
+
+	// Nothing is fetched before the remote gateway is registered.
+	_, err := p.EgressKey(remoteIA, remoteIP)
+	assert.ErrorIs(t, err, dataplane.ErrNoFrameKey)
+	require.NoError(t, p.Refresh(context.Background(), time.Now()))
+	assert.Empty(t, fetcher.requests)
+
+	p.Register(remoteIA, remoteIP)
+	_, err = p.EgressKey(remoteIA, remoteIP)
+	assert.ErrorIs(t, err, dataplane.ErrNoFrameKey)
+
+	now := time.Now()
+	require.NoError(t, p.Refresh(context.Background(), now))
+	require.NotEmpty(t, fetcher.requests)
+	for _, meta := range fetcher.requests {
+		if meta.SrcIA != localIA {
+			// Ingress key.
+			continue
+		}
+		assert.Equal(t, gwdrkey.Protocol, meta.ProtoId)
+		assert.Equal(t, localIP.String(), meta.SrcHost)
+		assert.Equal(t, remoteIA, meta.DstIA)
+		assert.Equal(t, remoteIP.String(), meta.DstHost)
+	}
+	key, err := p.EgressKey(remoteIA, remoteIP)
+	require.NoError(t, err)
+	epochEnd := now.Truncate(epochDuration).Add(epochDuration)
+	assert.Equal(t, epochEnd, key.NotAfter)
+
+	// Cached keys are not fetched again.
+	requests := len(fetcher.requests)
+	require.NoError(t, p.Refresh(context.Background(), now))
+	assert.Len(t, fetcher.requests, requests)
+}
+
+func TestProviderIngressKey(t *testing.T) {
+	fetcher := &keyFetcher{}
+	p := newProvider(fetcher)
+
+	// Received frames do not make the remote gateway known, because they are
+	// not authenticated yet.
+	_, err := p.IngressKey(remoteIA, remoteIP, 0)
+	assert.ErrorIs(t, err, dataplane.ErrNoFrameKey)
+	require.NoError(t, p.Refresh(context.Background(), time.Now()))
+	assert.Empty(t, fetcher.requests)
+
+	p.Register(remoteIA, remoteIP)
+	now := time.Now()
+	require.NoError(t, p.Refresh(context.Background(), now))
+	require.NotEmpty(t, fetcher.requests)
+	for _, meta := range fetcher.requests {
+		if meta.SrcIA != remoteIA {
+			// Egress key.
+			continue
+		}
+		assert.Equal(t, remoteIP.String(), meta.SrcHost)
+		assert.Equal(t, localIA, meta.DstIA)
+		assert.Equal(t, localIP.String(), meta.DstHost)
+	}
+
+	// The key ID is the index of the epoch.
+	epochStart := now.Truncate(epochDuration)
+	id := uint8(epochStart.Unix() / int64(epochDuration/time.Second))
+	key, err := p.IngressKey(remoteIA, remoteIP, id)
+	require.NoError(t, err)
+	assert.Equal(t, id, key.ID)
+	assert.Equal(t, epochStart.Add(epochDuration).Add(5*time.Minute/2), key.NotAfter)
+
+	_, err = p.IngressKey(remoteIA, remoteIP, id+2)
+	assert.ErrorIs(t, err, dataplane.ErrNoFrameKey)
+
+	// Keys are evicted once they can no longer be used.
+	require.NoError(t, p.Refresh(context.Background(), key.NotAfter.Add(time.Second)))
+	_, err = p.IngressKey(remoteIA, remoteIP, id)
+	assert.ErrorIs(t, err, dataplane.ErrNoFrameKey)
+}
+
+func TestProviderEncrypted(t *testing.T) {
+	p := newProvider(&keyFetcher{})
+	assert.False(t, p.Encrypted(remoteIA, remoteIP))
+	p.Register(remoteIA, remoteIP)
+	assert.True(t, p.Encrypted(remoteIA, remoteIP))
+	assert.False(t, p.Encrypted(remoteIA, net.IP{192, 0, 2, 42}))
+}
+
+func TestProviderIdle(t *testing.T) {
+	fetcher := &keyFetcher{}
+	p := newProvider(fetcher)
+	p.IdleTimeout = time.Minute
+
+	p.Register(remoteIA, remoteIP)
+	now := time.Now()
+	require.NoError(t, p.Refresh(context.Background(), now))
+	_, err := p.EgressKey(remoteIA, remoteIP)
+	require.NoError(t, err)
+
+	// Used remote gateways are kept.
+	require.NoError(t, p.Refresh(context.Background(), now.Add(50*time.Second)))
+	_, err = p.EgressKey(remoteIA, remoteIP)
+	require.NoError(t, err)
+
+	// Idle remote gateways are forgotten and no longer fetched.
+	require.NoError(t, p.Refresh(context.Background(), time.Now().Add(2*time.Minute)))
+	_, err = p.EgressKey(remoteIA, remoteIP)
+	assert.ErrorIs(t, err, dataplane.ErrNoFrameKey)
+	requests := len(fetcher.requests)
+	require.NoError(t, p.Refresh(context.Background(), time.Now().Add(3*time.Minute)))
+	assert.Len(t, fetcher.requests, requests)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/scionproto/scion/gateway/control"
 	controlgrpc "github.com/scionproto/scion/gateway/control/grpc"
 	"github.com/scionproto/scion/gateway/dataplane"
+	gwdrkey "github.com/scionproto/scion/gateway/drkey"
 	"github.com/scionproto/scion/gateway/pathhealth"
 	"github.com/scionproto/scion/gateway/pathhealth/policies"
 	"github.com/scionproto/scion/gateway/routemgr"
@@ -47,6 +48,7 @@ import (
 	"github.com/scionproto/scion/pkg/snet"
 	"github.com/scionproto/scion/pkg/snet/squic"
 	infraenv "github.com/scionproto/scion/private/app/appnet"
+	"github.com/scionproto/scion/private/drkey/drkeyutil"
 	"github.com/scionproto/scion/private/periodic"
 	"github.com/scionproto/scion/private/service"
 	"github.com/scionproto/scion/private/svc"
@@ -64,15 +66,23 @@ type DataplaneSessionFactory struct {
 	PacketConnFactory  PacketConnFactory
 	PathStatsPublisher dataplane.PathStatsPublisher
 	Metrics            dataplane.SessionMetrics
+	// FrameKeys provides the keys to encrypt the frames. If nil, or if the
+	// remote gateway does not accept encrypted frames, the frames are sent
+	// unencrypted.
+	FrameKeys dataplane.FrameKeyProvider
 }
 
 func (dpf DataplaneSessionFactory) New(id uint8, policyID int,
-	remoteIA addr.IA, remoteAddr net.Addr) control.DataplaneSession {
+	remoteIA addr.IA, remote control.Gateway, lb control.LoadBalancing) control.DataplaneSession {
 
 	conn, err := dpf.PacketConnFactory.New()
 	if err != nil {
 		panic(err)
 	}
+	var frameKeys dataplane.FrameKeyProvider
+	if remote.FrameEncryption {
+		frameKeys = dpf.FrameKeys
+	}
 	labels := []string{"remote_isd_as", remoteIA.String(), "policy_id", strconv.Itoa(policyID)}
 	metrics := dataplane.SessionMetrics{
 		IPPktBytesSent:     metrics.CounterWith(dpf.Metrics.IPPktBytesSent, labels...),
@@ -83,10 +93,12 @@ func (dpf DataplaneSessionFactory) New(id uint8, policyID int,
 	}
 	sess := &dataplane.Session{
 		SessionID:          id,
-		GatewayAddr:        *remoteAddr.(*net.UDPAddr),
+		GatewayAddr:        *remote.Data,
 		DataPlaneConn:      conn,
 		PathStatsPublisher: dpf.PathStatsPublisher,
 		Metrics:            metrics,
+		FrameKeys:          frameKeys,
+		LoadBalancing:      lb,
 	}
 	return sess
 }
@@ -168,6 +180,11 @@ type Gateway struct {
 	// DataIP is the IP that should be used for dataplane traffic.
 	DataAddr *net.UDPAddr
 
+	// FrameEncryption enables the encryption of the frames exchanged with the
+	// remote gateways that support it as well. It is advertised to the remote
+	// gateways when they fetch the IP prefixes.
+	FrameEncryption bool
+
 	// Daemon is the API of the SCION Daemon.
 	Daemon daemon.Connector
 
@@ -193,6 +210,10 @@ type Gateway struct {
 
 	// Metrics are the metrics exported by the gateway.
 	Metrics *Metrics
+
+	// state holds the components of the running gateway that expose its
+	// state.
+	state state
 }
 
 func (g *Gateway) Run(ctx context.Context) error {
@@ -258,6 +279,7 @@ func (g *Gateway) Run(ctx context.Context) error {
 
 	var pathsMonitored, sessionPathsAvailable metrics.Gauge
 	var probesSent, probesReceived, probesSendErrors func(addr.IA) metrics.Counter
+	var probeRTT func(addr.IA) metrics.Histogram
 	if g.Metrics != nil {
 		perRemoteCounter := func(c *prometheus.CounterVec) func(addr.IA) metrics.Counter {
 			return func(remote addr.IA) metrics.Counter {
@@ -273,6 +295,12 @@ func (g *Gateway) Run(ctx context.Context) error {
 		probesSent = perRemoteCounter(g.Metrics.PathProbesSent)
 		probesReceived = perRemoteCounter(g.Metrics.PathProbesReceived)
 		probesSendErrors = perRemoteCounter(g.Metrics.PathProbesSendErrors)
+		probeRTT = func(remote addr.IA) metrics.Histogram {
+			return metrics.HistogramWith(
+				metrics.NewPromHistogram(g.Metrics.PathProbeRTT),
+				"remote_isd_as", remote.String(),
+			)
+		}
 	}
 	revStore := &pathhealth.MemoryRevocationStore{}
 
@@ -297,6 +325,7 @@ func (g *Gateway) Run(ctx context.Context) error {
 					ProbesSent:             probesSent,
 					ProbesReceived:         probesReceived,
 					ProbesSendErrors:       probesSendErrors,
+					ProbeRTT:               probeRTT,
 					SCMPErrors:             g.Metrics.SCMPErrors,
 					SCIONPacketConnMetrics: g.Metrics.SCIONPacketConnMetrics,
 					Topology:               g.Daemon,
@@ -331,6 +360,7 @@ func (g *Gateway) Run(ctx context.Context) error {
 	// We know we have two subscribers, so we initialize the subscriptions right from the start.
 	// Once subscribed, publish immediately.
 	configPublisher := &control.ConfigPublisher{}
+	g.state.setConfigPublisher(configPublisher)
 	remoteIAsChannel := configPublisher.SubscribeRemoteIAs()
 	sessionPoliciesChannel := configPublisher.SubscribeSessionPolicies()
 
@@ -348,6 +378,12 @@ func (g *Gateway) Run(ctx context.Context) error {
 			panic(err)
 		}
 	}()
+	g.state.setPolicyUpdater(&control.PolicyUpdater{
+		SessionPoliciesFile: g.TrafficPolicyFile,
+		RoutingPolicyFile:   g.RoutingPolicyFile,
+		SessionPolicyParser: legacySessionPolicyAdapter,
+		Publisher:           configPublisher,
+	})
 
 	// Trigger the initial load of the config. This is done in a go routine
 	// since it still might block until everything is set up.
@@ -391,6 +427,34 @@ func (g *Gateway) Run(ctx context.Context) error {
 		}
 	}()
 
+	// *********************************************************************************
+	// Set up the keys for encrypted frames. The keys are DRKeys fetched from the
+	// Daemon. Encryption is negotiated per remote gateway: this gateway advertises
+	// it in the prefix replies, and the remote gateways that advertise it are
+	// registered with the key provider when their prefixes are fetched. Keys are
+	// only fetched for registered remote gateways.
+	// *********************************************************************************
+
+	var prefixConsumer control.PrefixConsumer = filteredPrefixAggregator
+	var frameKeys dataplane.FrameKeyProvider
+	if g.FrameEncryption {
+		frameKeyProvider := &gwdrkey.Provider{
+			LocalIA:          localIA,
+			LocalIP:          g.DataClientIP,
+			Fetcher:          g.Daemon,
+			AcceptanceWindow: drkeyutil.LoadAcceptanceWindow(),
+			PrefetchLead:     drkeyutil.LoadAcceptanceWindow(),
+		}
+		frameKeyFetcher := periodic.Start(frameKeyProvider, time.Second, 5*time.Second)
+		defer frameKeyFetcher.Stop()
+		prefixConsumer = frameKeyRegistrar{
+			PrefixConsumer: filteredPrefixAggregator,
+			FrameKeys:      frameKeyProvider,
+		}
+		frameKeys = frameKeyProvider
+		logger.Info("Frame encryption enabled")
+	}
+
 	// ***********************************************************************************
 	// Set up QUIC client dialer and QUIC server listener
 	//
@@ -487,7 +551,7 @@ func (g *Gateway) Run(ctx context.Context) error {
 		RemoteDiscoveryErrors: rmErrorsMetric,
 		PrefixFetchErrors:     rmPrefixErrorsMetric,
 		GatewayWatcherFactory: &WatcherFactory{
-			Aggregator:  filteredPrefixAggregator,
+			Aggregator:  prefixConsumer,
 			PathMonitor: pathMonitor,
 			Policies: &policies.Policies{
 				PathPolicy: control.DefaultPathPolicy,
@@ -552,6 +616,7 @@ func (g *Gateway) Run(ctx context.Context) error {
 				ConfigPublisher: configPublisher,
 			},
 			PrefixesAdvertised: paMetric,
+			FrameEncryption:    g.FrameEncryption,
 		},
 	)
 
@@ -585,7 +650,7 @@ func (g *Gateway) Run(ctx context.Context) error {
 
 	// Start dataplane ingress
 	if err := StartIngress(ctx, scionNetwork, g.DataServerAddr, deviceManager,
-		g.Metrics); err != nil {
+		frameKeys, g.Metrics); err != nil {
 
 		return err
 	}
@@ -609,6 +674,7 @@ func (g *Gateway) Run(ctx context.Context) error {
 		}
 	}()
 	logger.Debug("Session configurator started")
+	g.state.setSessionConfigurator(sessionConfigurator)
 	g.HTTPEndpoints["sessionconfigurator"] = service.StatusPage{
 		Info: "session configurator diagnostics",
 		Handler: func(w http.ResponseWriter, _ *http.Request) {
@@ -635,7 +701,8 @@ func (g *Gateway) Run(ctx context.Context) error {
 					Network: scionNetwork,
 					Addr:    &net.UDPAddr{IP: g.DataClientIP},
 				},
-				Metrics: CreateSessionMetrics(g.Metrics),
+				Metrics:   CreateSessionMetrics(g.Metrics),
+				FrameKeys: frameKeys,
 			},
 			Metrics: CreateEngineMetrics(g.Metrics),
 		},
@@ -651,6 +718,7 @@ func (g *Gateway) Run(ctx context.Context) error {
 		}
 	}()
 	logger.Debug("Engine controller started")
+	g.state.setEngineController(engineController)
 
 	g.HTTPEndpoints["engine"] = service.StatusPage{
 		Info: "gateway diagnostics",
@@ -760,7 +828,8 @@ func CreateIngressMetrics(m *Metrics) dataplane.IngressMetrics {
 }
 
 func StartIngress(ctx context.Context, scionNetwork *snet.SCIONNetwork, dataAddr *net.UDPAddr,
-	deviceManager control.DeviceManager, metrics *Metrics) error {
+	deviceManager control.DeviceManager, frameKeys dataplane.FrameKeyProvider,
+	metrics *Metrics) error {
 
 	logger := log.FromCtx(ctx)
 	dataplaneServerConn, err := scionNetwork.Listen(
@@ -776,6 +845,7 @@ func StartIngress(ctx context.Context, scionNetwork *snet.SCIONNetwork, dataAddr
 		Conn:          dataplaneServerConn,
 		DeviceManager: deviceManager,
 		Metrics:       ingressMetrics,
+		FrameKeys:     frameKeys,
 	}
 	go func() {
 		defer log.HandlePanic()
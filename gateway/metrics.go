@@ -136,6 +136,12 @@ var (
 		Help:   "Number of send error for path probes.",
 		Labels: []string{"isd_as", "remote_isd_as"},
 	}
+	PathProbeRTTMeta = MetricMeta{
+		Name:    "gateway_path_probe_rtt_seconds",
+		Help:    "Round-trip time of the path probes.",
+		Labels:  []string{"isd_as", "remote_isd_as"},
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 12),
+	}
 	SessionProbesMeta = MetricMeta{
 		Name:   "gateway_session_probes",
 		Help:   "Number of probes sent per session.",
@@ -227,6 +233,9 @@ type MetricMeta struct {
 	Name   string
 	Help   string
 	Labels []string
+	// Buckets are the histogram buckets. Only used for histograms, if nil,
+	// the prometheus default buckets are used.
+	Buckets []float64
 }
 
 func (mm *MetricMeta) NewCounterVec() *prometheus.CounterVec {
@@ -249,6 +258,17 @@ func (mm *MetricMeta) NewGaugeVec() *prometheus.GaugeVec {
 	)
 }
 
+func (mm *MetricMeta) NewHistogramVec() *prometheus.HistogramVec {
+	return promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    mm.Name,
+			Help:    mm.Help,
+			Buckets: mm.Buckets,
+		},
+		mm.Labels,
+	)
+}
+
 // Metrics defines the metrics exported by the gateway.
 type Metrics struct {
 	// Traffic Metrics
@@ -279,6 +299,7 @@ type Metrics struct {
 	PathProbesSent        *prometheus.CounterVec
 	PathProbesReceived    *prometheus.CounterVec
 	PathProbesSendErrors  *prometheus.CounterVec
+	PathProbeRTT          *prometheus.HistogramVec
 
 	// Discovery Metrics
 	Remotes               *prometheus.GaugeVec
@@ -359,6 +380,8 @@ func NewMetrics(ia addr.IA) *Metrics {
 			NewCounterVec().MustCurryWith(labels),
 		PathProbesSendErrors: PathProbesSendErrorsMeta.
 			NewCounterVec().MustCurryWith(labels),
+		PathProbeRTT: PathProbeRTTMeta.
+			NewHistogramVec().MustCurryWith(labels).(*prometheus.HistogramVec),
 		SessionIsHealthy: SessionIsHealthyMeta.
 			NewGaugeVec().MustCurryWith(labels),
 		SessionStateChanges: SessionStateChangesMeta.
@@ -15,14 +15,48 @@
 package mgmtapi
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"sort"
+
+	"github.com/scionproto/scion/gateway/control"
+	"github.com/scionproto/scion/gateway/pathhealth/policies"
+	"github.com/scionproto/scion/gateway/routing"
+	"github.com/scionproto/scion/pkg/addr"
+	api "github.com/scionproto/scion/private/mgmtapi"
 )
 
+// Gateway exposes the state of a running gateway.
+type Gateway interface {
+	RemoteGateways() control.RemoteGateways
+	Sessions() []control.SessionInfo
+	RoutingChains() []control.RoutingChainInfo
+	SessionPolicies() control.SessionPolicies
+	RoutingPolicy() *routing.Policy
+	UpdateTrafficPolicy(ctx context.Context, raw []byte, dryRun bool) (control.PolicyUpdate, error)
+	PatchTrafficPolicy(ctx context.Context, patch []byte, dryRun bool) (control.PolicyUpdate, error)
+	UpdateRoutingPolicy(ctx context.Context, raw []byte, dryRun bool) (control.PolicyUpdate, error)
+}
+
+// maxPolicySize is the maximum size of a policy in an update request.
+const maxPolicySize = 1 << 20
+
 // Server implements the Posix Gateway Service API.
 type Server struct {
 	Config   http.HandlerFunc
 	Info     http.HandlerFunc
 	LogLevel http.HandlerFunc
+	Gateway  Gateway
+	// Authorize wraps the handlers of requests that change the policies, and
+	// only calls them for authorized requests. If it is nil, the policies
+	// cannot be changed.
+	Authorize func(http.Handler) http.Handler
 }
 
 // GetConfig is an indirection to the http handler.
@@ -44,3 +78,320 @@ func (s *Server) GetLogLevel(w http.ResponseWriter, r *http.Request) {
 func (s *Server) SetLogLevel(w http.ResponseWriter, r *http.Request) {
 	s.LogLevel(w, r)
 }
+
+// GetRemotes lists the remote ASes with the discovered gateways.
+func (s *Server) GetRemotes(w http.ResponseWriter, r *http.Request) {
+	remotes := s.Gateway.RemoteGateways()
+	ias := make([]addr.IA, 0, len(remotes.Gateways))
+	for ia := range remotes.Gateways {
+		ias = append(ias, ia)
+	}
+	sort.Slice(ias, func(i, j int) bool { return ias[i] < ias[j] })
+
+	rep := make([]Remote, 0, len(ias))
+	for _, ia := range ias {
+		gateways := make([]RemoteGateway, 0, len(remotes.Gateways[ia]))
+		for _, gw := range remotes.Gateways[ia] {
+			interfaces := make([]int, 0, len(gw.Gateway.Interfaces))
+			for _, intf := range gw.Gateway.Interfaces {
+				interfaces = append(interfaces, int(intf))
+			}
+			gateways = append(gateways, RemoteGateway{
+				ControlAddress: gw.Gateway.Control.String(),
+				DataAddress:    gw.Gateway.Data.String(),
+				ProbeAddress:   gw.Gateway.Probe.String(),
+				Interfaces:     interfaces,
+				Prefixes:       prefixStrings(gw.Prefixes),
+			})
+		}
+		rep = append(rep, Remote{
+			IsdAs:    ia.String(),
+			Gateways: gateways,
+		})
+	}
+	writeJSON(w, rep)
+}
+
+// GetSessions lists the sessions with their health and paths.
+func (s *Server) GetSessions(w http.ResponseWriter, r *http.Request) {
+	sessions := s.Gateway.Sessions()
+	rep := make([]Session, 0, len(sessions))
+	for _, session := range sessions {
+		paths := make([]SessionPath, 0, len(session.PathInfo))
+		for _, p := range session.PathInfo {
+			path := SessionPath{
+				Path:     p.Path,
+				Selected: p.Current,
+				Revoked:  p.Revoked,
+				Rejected: p.Rejected,
+			}
+			if p.Rejected {
+				path.RejectReason = api.StringRef(p.RejectReason)
+			} else {
+				loss := float32(p.DropRate)
+				path.Loss = &loss
+			}
+			if p.Latency != 0 {
+				path.Latency = api.StringRef(p.Latency.String())
+			}
+			if p.Jitter != 0 {
+				path.Jitter = api.StringRef(p.Jitter.String())
+			}
+			paths = append(paths, path)
+		}
+		entry := Session{
+			Id:       int(session.ID),
+			IsdAs:    session.RemoteIA.String(),
+			PolicyId: session.PolicyID,
+			Healthy:  session.Healthy,
+			Paths:    paths,
+		}
+		if session.ProbeAddr != nil {
+			entry.ProbeAddress = api.StringRef(session.ProbeAddr.String())
+		}
+		rep = append(rep, entry)
+	}
+	writeJSON(w, rep)
+}
+
+// GetRoutingTable lists the routing chains of the routing table in use.
+func (s *Server) GetRoutingTable(w http.ResponseWriter, r *http.Request) {
+	chains := s.Gateway.RoutingChains()
+	rep := make([]RoutingChain, 0, len(chains))
+	for _, chain := range chains {
+		classes := make([]TrafficClass, 0, len(chain.TrafficClasses))
+		for _, tc := range chain.TrafficClasses {
+			sessions := make([]int, 0, len(tc.Sessions))
+			for _, id := range tc.Sessions {
+				sessions = append(sessions, int(id))
+			}
+			class := TrafficClass{
+				Id:       tc.ID,
+				Matcher:  tc.Matcher.String(),
+				Sessions: sessions,
+			}
+			if tc.Active {
+				active := int(tc.ActiveSession)
+				class.ActiveSession = &active
+			}
+			classes = append(classes, class)
+		}
+		rep = append(rep, RoutingChain{
+			IsdAs:          chain.RemoteIA.String(),
+			Prefixes:       prefixStrings(chain.Prefixes),
+			TrafficClasses: classes,
+		})
+	}
+	writeJSON(w, rep)
+}
+
+// GetTrafficPolicy gets the loaded traffic policy.
+func (s *Server) GetTrafficPolicy(w http.ResponseWriter, r *http.Request) {
+	sessionPolicies := s.Gateway.SessionPolicies()
+	sort.Slice(sessionPolicies, func(i, j int) bool {
+		if sessionPolicies[i].IA != sessionPolicies[j].IA {
+			return sessionPolicies[i].IA < sessionPolicies[j].IA
+		}
+		return sessionPolicies[i].ID < sessionPolicies[j].ID
+	})
+	rep := make([]SessionPolicy, 0, len(sessionPolicies))
+	for _, sp := range sessionPolicies {
+		pathPolicy, err := pathPolicyObject(sp.PathPolicy)
+		if err != nil {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusInternalServerError,
+				Title:  "unable to marshal path policy",
+				Type:   api.StringRef(api.InternalError),
+			})
+			return
+		}
+		policy := SessionPolicy{
+			IsdAs:          sp.IA.String(),
+			Id:             sp.ID,
+			TrafficMatcher: sp.TrafficMatcher.String(),
+			PathPolicy:     pathPolicy,
+			PathCount:      sp.PathCount,
+			Prefixes:       prefixStrings(sp.Prefixes),
+		}
+		if name := policies.PerfPolicyName(sp.PerfPolicy); name != "" {
+			policy.PerformancePolicy = api.StringRef(name)
+		}
+		if sp.LoadBalancing != control.LoadBalanceEqual {
+			policy.LoadBalancing = api.StringRef(string(sp.LoadBalancing))
+		}
+		rep = append(rep, policy)
+	}
+	writeJSON(w, rep)
+}
+
+// GetRoutingPolicy gets the loaded IP routing policy.
+func (s *Server) GetRoutingPolicy(w http.ResponseWriter, r *http.Request) {
+	policy := s.Gateway.RoutingPolicy()
+	if policy == nil {
+		ErrorResponse(w, Problem{
+			Status: http.StatusNotFound,
+			Title:  "no routing policy loaded",
+			Type:   api.StringRef(api.NotFound),
+		})
+		return
+	}
+	rep := RoutingPolicy{
+		DefaultAction: policy.DefaultAction.String(),
+		Rules:         make([]RoutingPolicyRule, 0, len(policy.Rules)),
+	}
+	for _, rule := range policy.Rules {
+		entry := RoutingPolicyRule{
+			Action:  rule.Action.String(),
+			From:    fmt.Sprint(rule.From),
+			To:      fmt.Sprint(rule.To),
+			Network: rule.Network.String(),
+		}
+		if rule.NextHop != nil {
+			entry.NextHop = api.StringRef(rule.NextHop.String())
+		}
+		if rule.Comment != "" {
+			entry.Comment = api.StringRef(rule.Comment)
+		}
+		rep.Rules = append(rep.Rules, entry)
+	}
+	writeJSON(w, rep)
+}
+
+// PutTrafficPolicy replaces the traffic policy.
+func (s *Server) PutTrafficPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	params PutTrafficPolicyParams,
+) {
+
+	s.updatePolicy(w, r, params.DryRun, s.Gateway.UpdateTrafficPolicy)
+}
+
+// PatchTrafficPolicy applies a JSON merge patch to the traffic policy.
+func (s *Server) PatchTrafficPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	params PatchTrafficPolicyParams,
+) {
+
+	s.updatePolicy(w, r, params.DryRun, s.Gateway.PatchTrafficPolicy)
+}
+
+// PutRoutingPolicy replaces the IP routing policy.
+func (s *Server) PutRoutingPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	params PutRoutingPolicyParams,
+) {
+
+	s.updatePolicy(w, r, params.DryRun, s.Gateway.UpdateRoutingPolicy)
+}
+
+func (s *Server) updatePolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	dryRun *bool,
+	update func(context.Context, []byte, bool) (control.PolicyUpdate, error),
+) {
+
+	if s.Authorize == nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef("no shared secret for policy updates configured"),
+			Status: http.StatusForbidden,
+			Title:  "policy updates disabled",
+			Type:   api.StringRef(api.Forbidden),
+		})
+		return
+	}
+	s.Authorize(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPolicySize))
+		if err != nil {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusBadRequest,
+				Title:  "unable to read policy",
+				Type:   api.StringRef(api.BadRequest),
+			})
+			return
+		}
+		result, err := update(r.Context(), raw, dryRun != nil && *dryRun)
+		if errors.Is(err, control.ErrInvalidPolicy) {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusBadRequest,
+				Title:  "invalid policy",
+				Type:   api.StringRef(api.BadRequest),
+			})
+			return
+		}
+		if err != nil {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusInternalServerError,
+				Title:  "unable to update policy",
+				Type:   api.StringRef(api.InternalError),
+			})
+			return
+		}
+		writeJSON(w, PolicyUpdate{
+			DryRun:    result.DryRun,
+			Changed:   result.Changed,
+			Persisted: result.Persisted,
+			Diff:      result.Diff,
+		})
+	})).ServeHTTP(w, r)
+}
+
+// pathPolicyObject converts the path policy to its JSON object representation.
+func pathPolicyObject(p policies.PathPolicy) (*map[string]interface{}, error) {
+	if p == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, err
+	}
+	return &obj, nil
+}
+
+func prefixStrings(prefixes []*net.IPNet) []string {
+	s := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		s = append(s, prefix.String())
+	}
+	return s
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "    ")
+	// Paths contain '>', which is not supposed to be escaped.
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(buf.Bytes())
+}
+
+// ErrorResponse writes the problem as JSON to the response writer.
+func ErrorResponse(w http.ResponseWriter, p Problem) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(p.Status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	// no point in catching error here, there is nothing we can do about it anymore.
+	_ = enc.Encode(p)
+}
@@ -0,0 +1,391 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/gateway/control"
+	"github.com/scionproto/scion/gateway/pathhealth"
+	"github.com/scionproto/scion/gateway/pathhealth/policies"
+	"github.com/scionproto/scion/gateway/pktcls"
+	"github.com/scionproto/scion/gateway/routing"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/private/xtest"
+	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
+)
+
+var update = xtest.UpdateGoldenFiles()
+
+// fakeGateway returns the state it was created with.
+type fakeGateway struct {
+	remotes         control.RemoteGateways
+	sessions        []control.SessionInfo
+	chains          []control.RoutingChainInfo
+	sessionPolicies control.SessionPolicies
+	routingPolicy   *routing.Policy
+	// updateErr is returned by the policy updates, if set.
+	updateErr error
+}
+
+func (g fakeGateway) RemoteGateways() control.RemoteGateways    { return g.remotes }
+func (g fakeGateway) Sessions() []control.SessionInfo           { return g.sessions }
+func (g fakeGateway) RoutingChains() []control.RoutingChainInfo { return g.chains }
+func (g fakeGateway) SessionPolicies() control.SessionPolicies  { return g.sessionPolicies }
+func (g fakeGateway) RoutingPolicy() *routing.Policy            { return g.routingPolicy }
+
+func (g fakeGateway) UpdateTrafficPolicy(
+	_ context.Context,
+	raw []byte,
+	dryRun bool,
+) (control.PolicyUpdate, error) {
+
+	return g.policyUpdate(raw, dryRun)
+}
+
+func (g fakeGateway) PatchTrafficPolicy(
+	_ context.Context,
+	patch []byte,
+	dryRun bool,
+) (control.PolicyUpdate, error) {
+
+	return g.policyUpdate(patch, dryRun)
+}
+
+func (g fakeGateway) UpdateRoutingPolicy(
+	_ context.Context,
+	raw []byte,
+	dryRun bool,
+) (control.PolicyUpdate, error) {
+
+	return g.policyUpdate(raw, dryRun)
+}
+
+// policyUpdate pretends that the raw policy replaces an empty policy.
+func (g fakeGateway) policyUpdate(raw []byte, dryRun bool) (control.PolicyUpdate, error) {
+	if g.updateErr != nil {
+		return control.PolicyUpdate{}, g.updateErr
+	}
+	return control.PolicyUpdate{
+		DryRun:    dryRun,
+		Changed:   true,
+		Persisted: !dryRun,
+		Diff:      "+" + string(raw) + "\n",
+	}, nil
+}
+
+func TestAPI(t *testing.T) {
+	ia110 := addr.MustParseIA("1-ff00:0:110")
+	ia111 := addr.MustParseIA("1-ff00:0:111")
+	gw := fakeGateway{
+		remotes: control.RemoteGateways{
+			Gateways: map[addr.IA][]control.RemoteGateway{
+				ia111: {
+					{
+						Gateway: control.Gateway{
+							Control:    xtest.MustParseUDPAddr(t, "10.1.0.2:30256"),
+							Probe:      xtest.MustParseUDPAddr(t, "10.1.0.2:30856"),
+							Data:       xtest.MustParseUDPAddr(t, "10.1.0.2:30056"),
+							Interfaces: []uint64{2},
+						},
+						Prefixes: xtest.MustParseCIDRs(t, "10.111.0.0/16"),
+					},
+				},
+				ia110: {
+					{
+						Gateway: control.Gateway{
+							Control: xtest.MustParseUDPAddr(t, "10.1.0.1:30256"),
+							Probe:   xtest.MustParseUDPAddr(t, "10.1.0.1:30856"),
+							Data:    xtest.MustParseUDPAddr(t, "10.1.0.1:30056"),
+						},
+						Prefixes: xtest.MustParseCIDRs(t, "10.110.0.0/16", "10.112.0.0/16"),
+					},
+				},
+			},
+		},
+		sessions: []control.SessionInfo{
+			{
+				ID:        1,
+				RemoteIA:  ia110,
+				ProbeAddr: xtest.MustParseUDPAddr(t, "10.1.0.1:30856"),
+				Healthy:   true,
+				PathInfo: pathhealth.PathInfo{
+					{
+						Path:     "Hops: [1-ff00:0:112 1>2 1-ff00:0:110]",
+						Current:  true,
+						Latency:  10 * time.Millisecond,
+						Jitter:   time.Millisecond,
+						DropRate: 0.25,
+					},
+					{
+						Path:         "Hops: [1-ff00:0:112 3>4 1-ff00:0:110]",
+						Rejected:     true,
+						RejectReason: "not alive",
+					},
+				},
+			},
+			{
+				ID:       2,
+				RemoteIA: ia111,
+				PolicyID: 1,
+			},
+		},
+		chains: []control.RoutingChainInfo{
+			{
+				RemoteIA: ia110,
+				Prefixes: xtest.MustParseCIDRs(t, "10.110.0.0/16"),
+				TrafficClasses: []control.TrafficClassInfo{
+					{
+						ID:            1,
+						Matcher:       pktcls.CondTrue,
+						Sessions:      []uint8{1},
+						Active:        true,
+						ActiveSession: 1,
+					},
+				},
+			},
+			{
+				RemoteIA: ia111,
+				Prefixes: xtest.MustParseCIDRs(t, "10.111.0.0/16"),
+				TrafficClasses: []control.TrafficClassInfo{
+					{
+						ID:       2,
+						Matcher:  pktcls.CondFalse,
+						Sessions: []uint8{2},
+					},
+				},
+			},
+		},
+		sessionPolicies: control.SessionPolicies{
+			{
+				IA:             ia111,
+				ID:             0,
+				TrafficMatcher: pktcls.CondTrue,
+				PerfPolicy:     policies.Latency{},
+				PathPolicy:     control.DefaultPathPolicy,
+				PathCount:      2,
+				LoadBalancing:  control.LoadBalanceFlows,
+				Prefixes:       xtest.MustParseCIDRs(t, "10.111.0.0/16"),
+			},
+			{
+				IA:             ia110,
+				ID:             0,
+				TrafficMatcher: pktcls.CondTrue,
+				PerfPolicy:     control.DefaultPerfPolicy,
+				PathPolicy:     control.DefaultPathPolicy,
+				PathCount:      1,
+				Prefixes:       xtest.MustParseCIDRs(t, "10.110.0.0/16"),
+			},
+		},
+		routingPolicy: &routing.Policy{
+			DefaultAction: routing.Reject,
+			Rules: []routing.Rule{
+				{
+					Action: routing.Accept,
+					From:   routing.SingleIAMatcher{IA: ia110},
+					To:     routing.SingleIAMatcher{IA: addr.MustParseIA("1-0")},
+					Network: routing.NetworkMatcher{
+						Allowed: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+					},
+					Comment: "Accept all from 110.",
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		Gateway      Gateway
+		RequestURL   string
+		ResponseFile string
+		Status       int
+	}{
+		"remotes": {
+			Gateway:      gw,
+			RequestURL:   "/remotes",
+			ResponseFile: "testdata/remotes.json",
+			Status:       http.StatusOK,
+		},
+		"remotes empty": {
+			Gateway:      fakeGateway{},
+			RequestURL:   "/remotes",
+			ResponseFile: "testdata/empty.json",
+			Status:       http.StatusOK,
+		},
+		"sessions": {
+			Gateway:      gw,
+			RequestURL:   "/sessions",
+			ResponseFile: "testdata/sessions.json",
+			Status:       http.StatusOK,
+		},
+		"routing table": {
+			Gateway:      gw,
+			RequestURL:   "/routing/table",
+			ResponseFile: "testdata/routing-table.json",
+			Status:       http.StatusOK,
+		},
+		"traffic policy": {
+			Gateway:      gw,
+			RequestURL:   "/policies/traffic",
+			ResponseFile: "testdata/traffic-policy.json",
+			Status:       http.StatusOK,
+		},
+		"routing policy": {
+			Gateway:      gw,
+			RequestURL:   "/policies/routing",
+			ResponseFile: "testdata/routing-policy.json",
+			Status:       http.StatusOK,
+		},
+		"routing policy not loaded": {
+			Gateway:      fakeGateway{},
+			RequestURL:   "/policies/routing",
+			ResponseFile: "testdata/routing-policy-not-loaded.json",
+			Status:       http.StatusNotFound,
+		},
+	}
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req, err := http.NewRequest("GET", tc.RequestURL, nil)
+			require.NoError(t, err)
+
+			rr := httptest.NewRecorder()
+			Handler(&Server{Gateway: tc.Gateway}).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.Status, rr.Result().StatusCode)
+			if *update {
+				require.NoError(t, os.WriteFile(tc.ResponseFile, rr.Body.Bytes(), 0666))
+			}
+			golden, err := os.ReadFile(tc.ResponseFile)
+			require.NoError(t, err)
+			assert.Equal(t, string(golden), rr.Body.String())
+		})
+	}
+}
+
+func TestPolicyUpdate(t *testing.T) {
+	secret := func() ([]byte, error) {
+		return []byte("0123456789abcdef0123456789abcdef"), nil
+	}
+	verifier := &jwtauth.HTTPVerifier{Generator: secret}
+	token, err := (&jwtauth.JWTTokenSource{Subject: "automation", Generator: secret}).Token()
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		Gateway      Gateway
+		Method       string
+		RequestURL   string
+		Body         string
+		Token        string
+		Disabled     bool
+		ResponseFile string
+		Status       int
+	}{
+		"put traffic policy dry run": {
+			Gateway:      fakeGateway{},
+			Method:       http.MethodPut,
+			RequestURL:   "/policies/traffic?dry_run=true",
+			Body:         `{"ASes": {}}`,
+			Token:        token.String(),
+			ResponseFile: "testdata/traffic-policy-dry-run.json",
+			Status:       http.StatusOK,
+		},
+		"patch traffic policy": {
+			Gateway:      fakeGateway{},
+			Method:       http.MethodPatch,
+			RequestURL:   "/policies/traffic",
+			Body:         `{"ASes": {"1-ff00:0:110": null}}`,
+			Token:        token.String(),
+			ResponseFile: "testdata/traffic-policy-patch.json",
+			Status:       http.StatusOK,
+		},
+		"put routing policy": {
+			Gateway:      fakeGateway{},
+			Method:       http.MethodPut,
+			RequestURL:   "/policies/routing",
+			Body:         "accept 1-ff00:0:110 1-0 10.0.0.0/8",
+			Token:        token.String(),
+			ResponseFile: "testdata/routing-policy-put.json",
+			Status:       http.StatusOK,
+		},
+		"invalid policy": {
+			Gateway: fakeGateway{
+				updateErr: serrors.Join(control.ErrInvalidPolicy,
+					serrors.New("invalid number of columns")),
+			},
+			Method:       http.MethodPut,
+			RequestURL:   "/policies/routing",
+			Body:         "accept",
+			Token:        token.String(),
+			ResponseFile: "testdata/routing-policy-invalid.json",
+			Status:       http.StatusBadRequest,
+		},
+		"unauthorized": {
+			Gateway:      fakeGateway{},
+			Method:       http.MethodPut,
+			RequestURL:   "/policies/routing",
+			Body:         "accept 1-ff00:0:110 1-0 10.0.0.0/8",
+			ResponseFile: "testdata/policy-update-unauthorized.json",
+			Status:       http.StatusInternalServerError,
+		},
+		"disabled": {
+			Gateway:      fakeGateway{},
+			Method:       http.MethodPut,
+			RequestURL:   "/policies/traffic",
+			Body:         `{"ASes": {}}`,
+			Token:        token.String(),
+			Disabled:     true,
+			ResponseFile: "testdata/policy-update-disabled.json",
+			Status:       http.StatusForbidden,
+		},
+	}
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req, err := http.NewRequest(tc.Method, tc.RequestURL, strings.NewReader(tc.Body))
+			require.NoError(t, err)
+			if tc.Token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.Token)
+			}
+
+			server := &Server{Gateway: tc.Gateway}
+			if !tc.Disabled {
+				server.Authorize = verifier.AddAuthorization
+			}
+			rr := httptest.NewRecorder()
+			Handler(server).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.Status, rr.Result().StatusCode)
+			if *update {
+				require.NoError(t, os.WriteFile(tc.ResponseFile, rr.Body.Bytes(), 0666))
+			}
+			golden, err := os.ReadFile(tc.ResponseFile)
+			require.NoError(t, err)
+			assert.Equal(t, string(golden), rr.Body.String())
+		})
+	}
+}
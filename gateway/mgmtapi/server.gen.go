@@ -4,10 +4,12 @@
 package mgmtapi
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
 )
 
 // ServerInterface represents all server handlers.
@@ -24,6 +26,30 @@ type ServerInterface interface {
 	// Set logging level
 	// (PUT /log/level)
 	SetLogLevel(w http.ResponseWriter, r *http.Request)
+	// Get the IP routing policy
+	// (GET /policies/routing)
+	GetRoutingPolicy(w http.ResponseWriter, r *http.Request)
+	// Replace the IP routing policy
+	// (PUT /policies/routing)
+	PutRoutingPolicy(w http.ResponseWriter, r *http.Request, params PutRoutingPolicyParams)
+	// Get the traffic policy
+	// (GET /policies/traffic)
+	GetTrafficPolicy(w http.ResponseWriter, r *http.Request)
+	// Update the traffic policy
+	// (PATCH /policies/traffic)
+	PatchTrafficPolicy(w http.ResponseWriter, r *http.Request, params PatchTrafficPolicyParams)
+	// Replace the traffic policy
+	// (PUT /policies/traffic)
+	PutTrafficPolicy(w http.ResponseWriter, r *http.Request, params PutTrafficPolicyParams)
+	// List the remote gateways
+	// (GET /remotes)
+	GetRemotes(w http.ResponseWriter, r *http.Request)
+	// List the routing table
+	// (GET /routing/table)
+	GetRoutingTable(w http.ResponseWriter, r *http.Request)
+	// List the sessions
+	// (GET /sessions)
+	GetSessions(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -54,6 +80,54 @@ func (_ Unimplemented) SetLogLevel(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the IP routing policy
+// (GET /policies/routing)
+func (_ Unimplemented) GetRoutingPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the IP routing policy
+// (PUT /policies/routing)
+func (_ Unimplemented) PutRoutingPolicy(w http.ResponseWriter, r *http.Request, params PutRoutingPolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the traffic policy
+// (GET /policies/traffic)
+func (_ Unimplemented) GetTrafficPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update the traffic policy
+// (PATCH /policies/traffic)
+func (_ Unimplemented) PatchTrafficPolicy(w http.ResponseWriter, r *http.Request, params PatchTrafficPolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the traffic policy
+// (PUT /policies/traffic)
+func (_ Unimplemented) PutTrafficPolicy(w http.ResponseWriter, r *http.Request, params PutTrafficPolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the remote gateways
+// (GET /remotes)
+func (_ Unimplemented) GetRemotes(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the routing table
+// (GET /routing/table)
+func (_ Unimplemented) GetRoutingTable(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the sessions
+// (GET /sessions)
+func (_ Unimplemented) GetSessions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -123,6 +197,171 @@ func (siw *ServerInterfaceWrapper) SetLogLevel(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetRoutingPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetRoutingPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRoutingPolicy(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutRoutingPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PutRoutingPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutRoutingPolicyParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutRoutingPolicy(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTrafficPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetTrafficPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrafficPolicy(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchTrafficPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PatchTrafficPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTrafficPolicyParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchTrafficPolicy(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutTrafficPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PutTrafficPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTrafficPolicyParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutTrafficPolicy(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRemotes operation middleware
+func (siw *ServerInterfaceWrapper) GetRemotes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRemotes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRoutingTable operation middleware
+func (siw *ServerInterfaceWrapper) GetRoutingTable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRoutingTable(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSessions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -248,6 +487,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/log/level", wrapper.SetLogLevel)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies/routing", wrapper.GetRoutingPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/policies/routing", wrapper.PutRoutingPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies/traffic", wrapper.GetTrafficPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/policies/traffic", wrapper.PatchTrafficPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/policies/traffic", wrapper.PutTrafficPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/remotes", wrapper.GetRemotes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/routing/table", wrapper.GetRoutingTable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/sessions", wrapper.GetSessions)
+	})
 
 	return r
 }
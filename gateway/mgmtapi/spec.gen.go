@@ -18,17 +18,69 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xVTW/bOBD9K8TsHgXL+dg96NYERWDAbYK6t8AHWhrLDCgOS1KuDUP/vRjSUmM5QYoe",
-	"ArQ3ajh88+bxcXSAkhpLBk3wUBzAobdkPMaPG1l9wW8t+sBfJZmAJi6ltVqVMigy+ZMnwzFfbrCRvPrX",
-	"4RoK+Cf/CZ2nXZ8vgjSVdNVH58hB13UZVOhLpyyDQcE1hTsW5d3jQcadUz3HLWpeW0cWXVCJqO7Dp1hz",
-	"qmtlapG2M0DTNlA8QoWrtoYMlFkThyOXZQa4k43VCEW/E/aWv3xwytSRDlNTDiuGSbDLIY1WT1gG6DI4",
-	"7fKMLvbhU7oxWzTovazxzeoD61F1zov0z/AX6LaqRPHhYSbW5ETYoHggr3biTgb8LvdcU4UowEmcT0AG",
-	"W3Q+IU0n08kFN0oWjbQKCriaTCeXkIGVYRObzEsya1XzssboGpYgemZWQQF3GG5TRnbqusvpdGS3gLuQ",
-	"Wy3VyGhjgc7MtGjLEr1ft1rc98WZ9nUq8ZJRByr5M/dHI7ZNI92etXHKBB/l+3r/aS5So22CF2ulccJK",
-	"ytrzPZXUNGRgyRh5fzGvKTJLvvuz9LiRXpWCW3NN0sDKGoVcURuiSkzdkRb+aEDriGm8qpKmOh+e9GtS",
-	"DdPgTbl+f1oNNd5NyzsMQo/G1plGGdj2BVEWI1Ei/g1V+3fRox+2z+unWRVci91fdUuLX7mleAQdT00o",
-	"Hg/QOg0FbEKwRZ4fNuRDVxwsudDl0qp8e8EjVjolVzppxClpiq9lqwMUoKmUOobZA+RG21fT6//+ZxWW",
-	"A53xP+A2sou/ANxZ8liJ1V4sbmf3n/vnGd+lkQ2PkmMz3bL7EQAA//93UAL/KQgAAA==",
+	"H4sIAAAAAAAC/+xb6XPcNpb/V1CcqfKkwr4kO4m7aj8ozqUpx1JJ8uaDrVWhyddNxCTAAGBLvd7+37ce",
+	"AJIgiT7kyWgyE5e+qEkcD+/4vQOPH6NEFKXgwLWK5h8jCaoUXIH58S1Nr+C3CpTGX4ngGrj5l5ZlzhKq",
+	"meCTX5Xg+EwlGRQU//urhGU0j/4yaZee2Ldqcq0pT6lMv5dSyGi73cZRCiqRrMTFojnuSaTbFN+6ibju",
+	"uUrPzD/wQIsyh2gezUbL5XQ6n85ns2kURyXVGiQu8z/v36dfjv72jo6W09HL24+z+Pl2/sXHk2330Rf/",
+	"h+P+GsWRZtqseH793ejsmpynwDVbMpD4blPiK6Ul46toG0evxeo1rCFHYkopSpCaWZbl9ePuqV6L1Yrx",
+	"FbGv4wh4VUTzd1EKi2oVxRHjS4GPDVduY++E7k2PhG0cIZOYhBSXscveNsPE4ldINFJ6KXKWbN6WKdUw",
+	"pDbJKF9BOqT3lwx0BpLoDEhpliApWy5BKrKUojDPk0pK4Nq9H7dELoTIgXLcHicFuME4jBZUQepWBZ4A",
+	"WYC+B+CdtSlPzW8O9/U+5AoKsYaU5IyDIlQCKSUs2QOk5J7pjDwbPYsJTdNmiH365bMx+b4o9YawZfdc",
+	"KeFCE8uLcUjcqdzcyYofxaZ7qojg+Yasac6Q62lMFpU2WxizgTTMqhKkYkpDevQ295JpjQwT/pslyyG0",
+	"Q09l6jPFjRL4JDjJ3bZ2cVHpRBRAxJLQeqvKqNU4CumdFIsciqHKpaApC1jIGcmqgnIigaZ0kQOBhzKn",
+	"3GAMUSUkbMkSe1SmiEishiSGIHN6uyHRGdWEKZJBXi6rHGfkIqEaOqNQr1ZsDYSma4aLcJKJexxcSpEA",
+	"pGPyi+Mu4+R7vsqZysyshr6lkAT4inEAqWJSqYrm+cbIWVVMQ2pGcMGJhiTjLKE5UZp+gEzkKRoSroaj",
+	"kbyc/a/Vi9bwXwnOITHH14KkVFO0GKJZASkRlQ4pKuNKU55AiL1vr86JhNrYLJtqkFOGOQ2Xd3I3JjBe",
+	"jclig/aFeEbJUtJVAdxbTBIhiaoWo5LqrFHOWjybEsbkZ7ohCyAVIkBXQFIIbTdlqpnELCgoUckESCJS",
+	"6LJq4gZOkoZnIwOlf9HiA/ARYugIBTcy3BtZ7i2FLKiO5lEl2ajhTIitSlNdqSFTbzIgP93cXBI7wFBG",
+	"VsBBot0jm5BsIdmKcaJArkEapdivwp2zvZiexlFBH1iBDuPFy5dxVDBuf82m04ZYxjWsQEbbxmKHGqAy",
+	"IVE5i4LKzcBujGD+1Up/DdLY41tO15TluGdIIPYBnnBJqxxlSBei0vNFTvmHKD5G9yvOfqsg3/SNwOeH",
+	"BXKnfSb8edAe39YMfczZ5fmYXJSlcMrsW5JFL8bJ1Q+vRl9/M/06JsygEwdmEF1CIooCeGrnLoCkUBNq",
+	"GI78KgXjGl9Ti5GjRhypSCo0PrsPF5KscrEwIrHnc+rWE/NxxvMIE+n5FmcvtSqG4hJ04qGIZEU13NON",
+	"+Z9pKNShgNIu9KOd1upGRKW0v5lK7+jBZWxs2T+Imxu3ZHke0e5Mzq5tgIFcTplKxBokpKSeEfSOXaKH",
+	"YZngWor8jqapBBUAnld2wAg9JBA3rNZMt3NXyrPpeDaejmfz0+nJi6+CQQ7VdPeO31FNP227aXg7BCy5",
+	"pAkENntNlR5loiTXr84v3pB2qDXdOuQDKa3ZSKBJtpOYd7P45DZutSmAmD2VcfFkgLLzyzrYVCQHKjmk",
+	"bUAc3BxZ8fLleDqeTmZfRQE6+qjmkyEWsFskGGI9WhrfhKTR0/q++vUp6elKR5Ye8zxT+a61C2mtxqNz",
+	"aB2i0oyvXmWU8aFxPMqej5WlFJXnsaUlAFMCxn83cWpJl0uW3CU5VSpE0I0dQNyA2vEImYLEH/cZs2q+",
+	"MSYAa5pXGGiMI4+MfUxxG7zC9YcU7sK+hoPDI/ho6POs1saakRq91T5Z2zQ1lC0YB39HE8ujQVBjnteJ",
+	"FWZ2XBBZ5UAKqpMMVNcQJJh9A3CEcwIiucLHv78gOqfGPQ5Kw9IX9xniCeD8smH3ICPfwW+z84DnLa9b",
+	"xtEkgTLIOBu/6O7wMzOc0Dy34M1p3thaMMNGDB1y/2cjQ9kEMc9w2DNiSzQ9iOsWggYbcND3Qn44vIeH",
+	"CgMQNX+Tb8LrP+i7TJQBFXUAbVxXUSlNbI3NeK6S8ZXygjS7sQsFabpGqShIg5TMQnRocQQbtTjIxNlB",
+	"L+HUxMnO7Nyy2QcGtEaHB8fp6DUo5TSwq5kZ0FxnmwCU87QOtsWS3Hvlkq67IZSre5A2s+wJWMsKQnUZ",
+	"FijIOAq9nLez1CyUlj3Wb1GdHR8FO4Iuqc6CkYRh9l3oKOde2m6F5EDeScg8Uu68WHVKJFCX6HUOHcxF",
+	"PyWEGUYInxzJsDSKPUfWcCFuVKlmtKewtXBNwnVEuOLzfqCyvzKtQQZsEqiqMB6yA5qskupsTC4KfGjc",
+	"WcU/cHHPe1wYnxQqZP051cCTzZ7t3IhH7ncyPn2+Y0cRkusP0qIDbmNNzcLfPUgguVC6qznjaWsxvCoW",
+	"TnccQ3uJSPurc4gOwT+JUs3JO98pkNn7ajo9hRPio9wt+fnm7ZzMnn99Qt7Ag/5JlHNS4+v8dDadnoRO",
+	"beOIOwlUhaKSK/O8oc2YjZ3Sh3JTFc7ZGnbvAulj8G7/fkuaqyDISViLD5+8k5l73EYK8k87EzNhWKXg",
+	"CNDuoQDOj7yt2+N6PPYAAC2ZJIIrlpqUBf0mrTFwLwTsiGKPw10LT3FduSlB1uhzdn0YaR/pXnJB07sF",
+	"zSlPUNuGgCHStj6bMlTKRaWh4yBoIoVSjYhUB0jw6TIX9/aGpl0hJbAGnvdwHUeGTADXvUtExXWARFsV",
+	"JRYxDNQgFZZqxYoq15SDqFS+MRVrX4L7XbXZtWyEibVu3JPml55Yrer1/Blqjuc3LUFOvm57ZYNARTVT",
+	"y7BHKUGayhtPwCOju9UbWrSF43Z8uzvVxGq8amJLWzAJy8rNy6giXBBOi66hNa4lJKSjUuymdGOqNaZ8",
+	"qTMpqlXW4c7vnm7bRFAGi2hWrnVo6mZ05IPqXynYTWL07cXF6/9CZTgcjdSBiIlA+vR1lD1cRrl0N3xC",
+	"dujpRCoWK4YI1bl3HyAU1I+7LDKjSQFK0dXh8zW314PdO4WHYMK5hjvVhv2HoFLV8bf1CL4uc9G+VcSF",
+	"eXGbtSdUdVGMKZJKUZaQHgaGEJBf+TUOwngKD/1I2pRLDq/+j2nq0VoZR45Bah+rG9iCnK0YHq2eFRMl",
+	"pKuWlZIJyfQ/VnANBe2tVTTEeobQqZTZK+lBza6nhObYSYXEXqMzdB0uQCXIs8pGmgvz64f60uPvv9xE",
+	"rgHFhBfmbbtypnVpO1hMj0YgSbR3WWeX543JXgrFHkhd+Pct23uOM6I4WoO09hDZbH8bR6IETksWzaPT",
+	"8XR8EnlpIl7fLJnx5Csw3hJNzMRT52k0j34E/cqOiLtNPifTaa+7B6+5JmXuqq9tX0/f+ge9O9dVkoBS",
+	"eO1+UW+OZD+fTncFJg0pE6/ZyAjLXlOaJJFx58RuLn5+TexBK7t82+5AV8oWr4sCC2O4xqQWzC6OnNvm",
+	"mn8vfnxLFcIWt5dzyIOSroCYG9DmplKKnCingKalQamdXMrFatL0Le1iVdPydJBdn94c1uzxZLz8ETTJ",
+	"e71ZAx7FUVkFmHLdY4pZ/1uRbp6EH3VHmb+/RVBE/e1/lJSuj5ESarIJYhmoifMGnkJ36fwRrKkMCpKu",
+	"TkEVwQzJFExstWJgEN2bi38iv7sbBZgeqKoalj/fQ4K79P/ycaTUXV0BIt6IPic9Jm5AG5peTKdPSdN5",
+	"ffdgItNxwPaDOuBpl3vgYUC/0lPm7mI6pExNY4D77S6SHFaQhUg3Y3LTzb5wgIX2nVVz6/T8iUz5/Ya9",
+	"xsDw/BijZcEB59YuFSdjx4nXqNjV+8tqoPcllbQADRIZ1ufPfzuifCbYhiJdSe56JpoOUEdvt7HUMBFd",
+	"GxK1wUMwY5DojaPfKpBIBOar0dxramx1p+kR2lGT2t7uA+9dvr9/NUa6tcb2x4zsuzjabp8SuTvNwAF7",
+	"uWwN19MmTyFIxXNQijALkZSkckNkxccexj+dcRsau5B3+pQUXPpdsE2dC9PA9F+PdjERsgM2TNke5Epn",
+	"QtrOOz8pMsbrp0Pvbre3Plw6qDseMjsO2WWsBx3y4PLpSG/sMsLfxxs/6srNueVBUjuM2JgykF6XJ2re",
+	"/HE9Y1cYO9wiJupDeb51RhGQKTqf1jP+/friDSlArky1NMnI365+eEW+Pn351Rchd2k7kGPy8X10dg3q",
+	"fTTHf33wxUe8yvPt1pTE1o4I4No2vfqVMuNCJagq973jXmcaOsx+l4mn6qvnf7bT9FXXiHZkRBtQ332l",
+	"9X4B57Or/Owq/z1cpVWb4yH0YGbRXcXrN3b937sTi2ZEOLUIoNnxecXjobDSf14g/Ax+n8Hvz5YnHEY/",
+	"TBJsPKZ25gYmcPY6wzDw2/vNRfPZ6L52/WJMLvDznm7jZX1JbbP6tiV8WEPBUbn5WjJcHnSHeopUxO71",
+	"mBzE4+QfL/3oy7sWq6c/9k2tP1YwE3MDe4QW+TeFKtiq3lwsf78GuWnemRnmF5oxUaBtg1vbtTtILrxb",
+	"ZveNgbvF7V5hNxeESyaVbmbZ+02m/W72XYXoG/ex3BOom/99xmOUrsP4P7Le+brga5197tTOv0Xfr3F+",
+	"o0ZArxssY9I1LDQAZhuIer1oFrxumtcNaDldwn8LKj9ASqgiddtbUHeu6zM8YcXkE2olf2RlUS0Laz1x",
+	"j1BPcI758NfGt5XMXefAfDL5mAmlt/OPpZB6O6Elm6yx7X5NJUPFsy3vQunul67my1nz2DR2y97r0+nz",
+	"F1/hcW4begZdPQhqOkMNl5BTXX9E11HKcRsXy9q9HLeQ3yblVlCN5I9bIvjtUE2Nff6otZqORUwefDfO",
+	"wCezrGt5wwYcvGQ0nRzwYL/0XWzc14nult1fx91Jbm+3/z8AGEtww19FAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
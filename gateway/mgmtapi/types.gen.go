@@ -3,6 +3,10 @@
 // Code generated by unknown module path version unknown version DO NOT EDIT.
 package mgmtapi
 
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
 // Defines values for LogLevelLevel.
 const (
 	Debug LogLevelLevel = "debug"
@@ -10,6 +14,9 @@ const (
 	Info  LogLevelLevel = "info"
 )
 
+// IsdAs defines model for IsdAs.
+type IsdAs = string
+
 // LogLevel defines model for LogLevel.
 type LogLevel struct {
 	// Level Logging level
@@ -19,14 +26,229 @@ type LogLevel struct {
 // LogLevelLevel Logging level
 type LogLevelLevel string
 
+// PolicyUpdate defines model for PolicyUpdate.
+type PolicyUpdate struct {
+	// Changed Whether the policy differs from the current policy.
+	Changed bool `json:"changed"`
+
+	// Diff Line-based difference between the current and the new policy. Removed lines are prefixed with '-', added lines with '+'. Empty if the policy did not change.
+	Diff string `json:"diff"`
+
+	// DryRun Whether the policy was only validated, but not applied.
+	DryRun bool `json:"dry_run"`
+
+	// Persisted Whether the policy was written to the policy file.
+	Persisted bool `json:"persisted"`
+}
+
+// Problem defines model for Problem.
+type Problem struct {
+	// Detail A human readable explanation specific to this occurrence of the problem that is helpful to locate the problem and give advice on how to proceed. Written in English and readable for engineers, usually not suited for non technical stakeholders and not localized.
+	Detail *string `json:"detail,omitempty"`
+
+	// Instance A URI reference that identifies the specific occurrence of the problem, e.g. by adding a fragment identifier or sub-path to the problem type. May be used to locate the root of this problem in the source code.
+	Instance *string `json:"instance,omitempty"`
+
+	// Status The HTTP status code generated by the origin server for this occurrence of the problem.
+	Status int `json:"status"`
+
+	// Title A short summary of the problem type. Written in English and readable for engineers, usually not suited for non technical stakeholders and not localized.
+	Title string `json:"title"`
+
+	// Type A URI reference that uniquely identifies the problem type only in the context of the provided API. Opposed to the specification in RFC-7807, it is neither recommended to be dereferencable and point to a human-readable documentation nor globally unique for the problem type.
+	Type *string `json:"type,omitempty"`
+}
+
+// Remote defines model for Remote.
+type Remote struct {
+	Gateways []RemoteGateway `json:"gateways"`
+	IsdAs    IsdAs           `json:"isd_as"`
+}
+
+// RemoteGateway defines model for RemoteGateway.
+type RemoteGateway struct {
+	// ControlAddress Control-plane address of the gateway.
+	ControlAddress string `json:"control_address"`
+
+	// DataAddress Data-plane address of the gateway.
+	DataAddress string `json:"data_address"`
+
+	// Interfaces Last-hop SCION interfaces that are preferred to reach the gateway.
+	Interfaces []int `json:"interfaces"`
+
+	// Prefixes IP prefixes learned from the gateway.
+	Prefixes []string `json:"prefixes"`
+
+	// ProbeAddress Probe address of the gateway.
+	ProbeAddress string `json:"probe_address"`
+}
+
+// RoutingChain defines model for RoutingChain.
+type RoutingChain struct {
+	IsdAs IsdAs `json:"isd_as"`
+
+	// Prefixes IP prefixes routed by the routing chain.
+	Prefixes []string `json:"prefixes"`
+
+	// TrafficClasses Traffic classes in the order in which they are evaluated.
+	TrafficClasses []TrafficClass `json:"traffic_classes"`
+}
+
+// RoutingPolicy defines model for RoutingPolicy.
+type RoutingPolicy struct {
+	// DefaultAction Action applied if no rule matches.
+	DefaultAction string `json:"default_action"`
+
+	// Rules Rules in the order in which they are evaluated.
+	Rules []RoutingPolicyRule `json:"rules"`
+}
+
+// RoutingPolicyRule defines model for RoutingPolicyRule.
+type RoutingPolicyRule struct {
+	Action  string  `json:"action"`
+	Comment *string `json:"comment,omitempty"`
+
+	// From Matcher for the 'from' ISD-AS.
+	From string `json:"from"`
+
+	// Network Matcher for the IP prefixes.
+	Network string `json:"network"`
+
+	// NextHop Address that must respond to pings for the prefixes to be advertised.
+	NextHop *string `json:"next_hop,omitempty"`
+
+	// To Matcher for the 'to' ISD-AS.
+	To string `json:"to"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	// Healthy Indication of whether the remote gateway answers probes.
+	Healthy bool `json:"healthy"`
+
+	// Id Session identifier.
+	Id    int           `json:"id"`
+	IsdAs IsdAs         `json:"isd_as"`
+	Paths []SessionPath `json:"paths"`
+
+	// PolicyId Identifier of the traffic policy the session was created for.
+	PolicyId int `json:"policy_id"`
+
+	// ProbeAddress Probe address of the remote gateway.
+	ProbeAddress *string `json:"probe_address,omitempty"`
+}
+
+// SessionPath defines model for SessionPath.
+type SessionPath struct {
+	// Jitter Measured jitter of the path. Omitted if unknown.
+	Jitter *string `json:"jitter,omitempty"`
+
+	// Latency Measured latency of the path. Omitted if unknown.
+	Latency *string `json:"latency,omitempty"`
+
+	// Loss Fraction of probes that were lost.
+	Loss *float32 `json:"loss,omitempty"`
+
+	// Path Description of the path.
+	Path string `json:"path"`
+
+	// RejectReason Reason the path was rejected.
+	RejectReason *string `json:"reject_reason,omitempty"`
+
+	// Rejected Indication of whether the path was rejected.
+	Rejected bool `json:"rejected"`
+
+	// Revoked Indication of whether the path was revoked.
+	Revoked bool `json:"revoked"`
+
+	// Selected Indication of whether the path is in use.
+	Selected bool `json:"selected"`
+}
+
+// SessionPolicy defines model for SessionPolicy.
+type SessionPolicy struct {
+	// Id Identifier of the policy, unique per remote AS.
+	Id    int   `json:"id"`
+	IsdAs IsdAs `json:"isd_as"`
+
+	// LoadBalancing Mode used to distribute the traffic across the paths. Omitted if the flows are distributed evenly.
+	LoadBalancing *string `json:"load_balancing,omitempty"`
+
+	// PathCount Maximum number of paths used simultaneously by a session.
+	PathCount int `json:"path_count"`
+
+	// PathPolicy Path policy the paths of the sessions must satisfy.
+	PathPolicy *map[string]interface{} `json:"path_policy,omitempty"`
+
+	// PerformancePolicy Name of the performance policy that selects the preferred paths. Omitted if the policy has no name.
+	PerformancePolicy *string `json:"performance_policy,omitempty"`
+
+	// Prefixes IP prefixes that are reachable through the sessions.
+	Prefixes []string `json:"prefixes"`
+
+	// TrafficMatcher Condition the IP traffic must satisfy to use the sessions.
+	TrafficMatcher string `json:"traffic_matcher"`
+}
+
 // StandardError defines model for StandardError.
 type StandardError struct {
 	// Error Error message
 	Error string `json:"error"`
 }
 
+// TrafficClass defines model for TrafficClass.
+type TrafficClass struct {
+	// ActiveSession Identifier of the session in use. Omitted if no session is healthy, in which case the traffic is dropped.
+	ActiveSession *int `json:"active_session,omitempty"`
+
+	// Id Routing table index of the traffic class.
+	Id int `json:"id"`
+
+	// Matcher Condition the IP traffic must satisfy.
+	Matcher string `json:"matcher"`
+
+	// Sessions Identifiers of the eligible sessions, sorted by priority.
+	Sessions []int `json:"sessions"`
+}
+
 // BadRequest defines model for BadRequest.
 type BadRequest = StandardError
 
+// PutRoutingPolicyTextBody defines parameters for PutRoutingPolicy.
+type PutRoutingPolicyTextBody = string
+
+// PutRoutingPolicyParams defines parameters for PutRoutingPolicy.
+type PutRoutingPolicyParams struct {
+	// DryRun Validate the policy and return the difference to the current policy without applying it.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// PatchTrafficPolicyApplicationMergePatchPlusJSONBody defines parameters for PatchTrafficPolicy.
+type PatchTrafficPolicyApplicationMergePatchPlusJSONBody map[string]interface{}
+
+// PatchTrafficPolicyParams defines parameters for PatchTrafficPolicy.
+type PatchTrafficPolicyParams struct {
+	// DryRun Validate the policy and return the difference to the current policy without applying it.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// PutTrafficPolicyJSONBody defines parameters for PutTrafficPolicy.
+type PutTrafficPolicyJSONBody map[string]interface{}
+
+// PutTrafficPolicyParams defines parameters for PutTrafficPolicy.
+type PutTrafficPolicyParams struct {
+	// DryRun Validate the policy and return the difference to the current policy without applying it.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
 // SetLogLevelJSONRequestBody defines body for SetLogLevel for application/json ContentType.
 type SetLogLevelJSONRequestBody = LogLevel
+
+// PutRoutingPolicyTextRequestBody defines body for PutRoutingPolicy for text/plain ContentType.
+type PutRoutingPolicyTextRequestBody = PutRoutingPolicyTextBody
+
+// PatchTrafficPolicyApplicationMergePatchPlusJSONRequestBody defines body for PatchTrafficPolicy for application/merge-patch+json ContentType.
+type PatchTrafficPolicyApplicationMergePatchPlusJSONRequestBody PatchTrafficPolicyApplicationMergePatchPlusJSONBody
+
+// PutTrafficPolicyJSONRequestBody defines body for PutTrafficPolicy for application/json ContentType.
+type PutTrafficPolicyJSONRequestBody PutTrafficPolicyJSONBody
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net"
 	"net/netip"
+	"sort"
 	"sync"
 	"time"
 
@@ -36,6 +37,12 @@ import (
 const (
 	// defaultProbeInterval specifies how often should path probes be sent.
 	defaultProbeInterval = 500 * time.Millisecond
+	// probeTimeout is the time after which a probe without reply is
+	// considered lost.
+	probeTimeout = 2 * defaultProbeInterval
+	// probeWindow is the number of most recent probes that are used to
+	// estimate the latency, jitter and drop rate of a path.
+	probeWindow = 20
 )
 
 // DefaultPathWatcherFactory creates PathWatchers.
@@ -61,6 +68,9 @@ type DefaultPathWatcherFactory struct {
 	// ProbesSendErrors keeps track of how many time sending probes failed per
 	// remote.
 	ProbesSendErrors func(remote addr.IA) metrics.Counter
+	// ProbeRTT keeps track of the round-trip times of the path probes per
+	// remote AS.
+	ProbeRTT func(remote addr.IA) metrics.Histogram
 
 	SCMPErrors             metrics2.Counter
 	SCIONPacketConnMetrics snet.SCIONPacketConnMetrics
@@ -82,6 +92,14 @@ func (f *DefaultPathWatcherFactory) New(
 		}
 		return create(remote)
 	}
+	createHistogram := func(
+		create func(addr.IA) metrics.Histogram, remote addr.IA,
+	) metrics.Histogram {
+		if create == nil {
+			return nil
+		}
+		return create(remote)
+	}
 	conn, err := (&snet.SCIONNetwork{
 		SCMPHandler: scmpHandler{
 			wrappedHandler: snet.DefaultSCMPHandler{
@@ -109,6 +127,7 @@ func (f *DefaultPathWatcherFactory) New(
 		probesSent:       createCounter(f.ProbesSent, remote),
 		probesReceived:   createCounter(f.ProbesReceived, remote),
 		probesSendErrors: createCounter(f.ProbesSendErrors, remote),
+		probeRTT:         createHistogram(f.ProbeRTT, remote),
 		path:             createPathWrap(path),
 	}, nil
 }
@@ -134,6 +153,7 @@ type pathWatcher struct {
 	probesSent       metrics.Counter
 	probesReceived   metrics.Counter
 	probesSendErrors metrics.Counter
+	probeRTT         metrics.Histogram
 
 	// nextSeq is the sequence number to use for the next probe.
 	// Assuming 2 probes a second, this will wrap over in ~9hrs.
@@ -169,9 +189,14 @@ func (w *pathWatcher) Run(ctx context.Context) {
 	defer probeTicker.Stop()
 	for {
 		select {
-		case <-w.pktChan:
+		case pkt := <-w.pktChan:
+			if pkt.Identifier != w.id {
+				continue
+			}
 			metrics.CounterInc(w.probesReceived)
-			w.pathState.receiveProbe(time.Now())
+			if rtt := w.pathState.receiveProbe(pkt.Sequence, time.Now()); rtt > 0 {
+				metrics.HistogramObserve(w.probeRTT, rtt.Seconds())
+			}
 		case <-probeTicker.C:
 			w.sendProbe(ctx)
 		case <-ctx.Done():
@@ -218,8 +243,12 @@ func (w *pathWatcher) State() State {
 			IsExpired: true,
 		}
 	}
+	stats := w.pathState.stats(now)
 	return State{
-		IsAlive: w.pathState.active(),
+		IsAlive:  w.pathState.active(),
+		Latency:  stats.Latency,
+		Jitter:   stats.Jitter,
+		DropRate: stats.DropRate,
 	}
 }
 
@@ -253,8 +282,8 @@ func (w *pathWatcher) sendProbe(ctx context.Context) {
 	w.pathMtx.RLock()
 	defer w.pathMtx.RUnlock()
 
-	w.pathState.sendProbe(time.Now())
 	w.nextSeq++
+	w.pathState.sendProbe(w.nextSeq, time.Now())
 	metrics.CounterInc(w.probesSent)
 	logger := log.FromCtx(ctx)
 	if err := w.prepareProbePacket(); err != nil {
@@ -291,29 +320,68 @@ func (w *pathWatcher) prepareProbePacket() error {
 	return nil
 }
 
+// pathState keeps track of the probes sent on a path and estimates the
+// liveness, latency, jitter and drop rate of the path from the replies.
 type pathState struct {
 	mu                sync.Mutex
 	consecutiveProbes int
 	lastReceived      time.Time
+	// probes is a ring buffer of the most recently sent probes.
+	probes [probeWindow]probe
+	// next is the index in probes that is used for the next sent probe.
+	next int
+}
+
+// probe is a probe sent on the path.
+type probe struct {
+	seq     uint16
+	sent    time.Time
+	rtt     time.Duration
+	replied bool
 }
 
-func (s *pathState) sendProbe(now time.Time) {
+// probeStats are the statistics computed from the recent probes.
+type probeStats struct {
+	// Latency is the median one-way latency.
+	Latency time.Duration
+	// Jitter is the average difference between consecutive one-way latencies.
+	Jitter time.Duration
+	// DropRate is the share of timed out probes.
+	DropRate float64
+}
+
+func (s *pathState) sendProbe(seq uint16, now time.Time) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.probes[s.next] = probe{seq: seq, sent: now}
+	s.next = (s.next + 1) % probeWindow
 	// Probe timed out.
-	if s.lastReceived.Add(defaultProbeInterval * 2).Before(now) {
+	if s.lastReceived.Add(probeTimeout).Before(now) {
 		s.consecutiveProbes = 0
 		return
 	}
 }
 
-func (s *pathState) receiveProbe(now time.Time) {
+// receiveProbe registers the reply to a probe. It returns the round-trip time
+// of the probe, or zero if the probe is unknown, e.g., because it was sent too
+// long ago or a reply was already received.
+func (s *pathState) receiveProbe(seq uint16, now time.Time) time.Duration {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.lastReceived = now
 	if s.consecutiveProbes < 3 {
 		s.consecutiveProbes++
 	}
+	for i := range s.probes {
+		p := &s.probes[i]
+		if p.sent.IsZero() || p.replied || p.seq != seq {
+			continue
+		}
+		p.replied = true
+		p.rtt = now.Sub(p.sent)
+		return p.rtt
+	}
+	return 0
 }
 
 func (s *pathState) active() bool {
@@ -322,6 +390,55 @@ func (s *pathState) active() bool {
 	return s.consecutiveProbes == 3
 }
 
+// stats computes the statistics of the path from the recent probes. Probes
+// that were neither replied nor timed out yet are ignored.
+func (s *pathState) stats(now time.Time) probeStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var latencies []time.Duration
+	var jitterSum time.Duration
+	var considered, dropped int
+	// Iterate in sending order, starting with the oldest probe.
+	for i := 0; i < probeWindow; i++ {
+		p := s.probes[(s.next+i)%probeWindow]
+		switch {
+		case p.sent.IsZero():
+			continue
+		case p.replied:
+			latency := p.rtt / 2
+			if len(latencies) > 0 {
+				jitterSum += absDuration(latency - latencies[len(latencies)-1])
+			}
+			latencies = append(latencies, latency)
+		case p.sent.Add(probeTimeout).Before(now):
+			dropped++
+		default:
+			continue
+		}
+		considered++
+	}
+	var stats probeStats
+	if considered > 0 {
+		stats.DropRate = float64(dropped) / float64(considered)
+	}
+	if len(latencies) > 1 {
+		stats.Jitter = jitterSum / time.Duration(len(latencies)-1)
+	}
+	if len(latencies) > 0 {
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		stats.Latency = latencies[len(latencies)/2]
+	}
+	return stats
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
 // pathWrap is the monitored pathWrap it already contains a few precalculated values to
 // prevent too much repeated work.
 type pathWrap struct {
@@ -0,0 +1,76 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pathhealth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPathStateStats(t *testing.T) {
+	ms := time.Millisecond
+	start := time.Now()
+
+	t.Run("no probes", func(t *testing.T) {
+		s := &pathState{}
+		assert.Equal(t, probeStats{}, s.stats(start))
+		assert.False(t, s.active())
+	})
+	t.Run("latency, jitter and drop rate", func(t *testing.T) {
+		s := &pathState{}
+		// Round-trip times of the probes, zero means the probe is lost.
+		rtts := []time.Duration{20 * ms, 0, 40 * ms, 20 * ms, 0}
+		for i, rtt := range rtts {
+			sent := start.Add(time.Duration(i) * defaultProbeInterval)
+			s.sendProbe(uint16(i), sent)
+			if rtt != 0 {
+				assert.Equal(t, rtt, s.receiveProbe(uint16(i), sent.Add(rtt)))
+			}
+		}
+		// The last probe has not timed out yet and is ignored.
+		now := start.Add(time.Duration(len(rtts)-1) * defaultProbeInterval)
+		assert.Equal(t, probeStats{
+			Latency:  10 * ms,
+			Jitter:   10 * ms,
+			DropRate: 0.25,
+		}, s.stats(now))
+		assert.True(t, s.active())
+
+		// Once it timed out, it is considered lost.
+		assert.Equal(t, 0.4, s.stats(now.Add(probeTimeout+ms)).DropRate)
+	})
+	t.Run("unknown and duplicate replies", func(t *testing.T) {
+		s := &pathState{}
+		s.sendProbe(1, start)
+		assert.Zero(t, s.receiveProbe(2, start.Add(ms)))
+		assert.Equal(t, 2*ms, s.receiveProbe(1, start.Add(2*ms)))
+		assert.Zero(t, s.receiveProbe(1, start.Add(3*ms)))
+		assert.Equal(t, ms, s.stats(start.Add(3*ms)).Latency)
+	})
+	t.Run("window", func(t *testing.T) {
+		s := &pathState{}
+		for i := 0; i < 2*probeWindow; i++ {
+			sent := start.Add(time.Duration(i) * defaultProbeInterval)
+			s.sendProbe(uint16(i), sent)
+			if i >= probeWindow {
+				s.receiveProbe(uint16(i), sent.Add(2*ms))
+			}
+		}
+		stats := s.stats(start.Add(2 * probeWindow * defaultProbeInterval))
+		assert.Equal(t, probeStats{Latency: ms}, stats)
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+import (
+	"time"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+const (
+	// DefaultLatencyHysteresis is the default relative latency improvement
+	// that is required to move away from the current path.
+	DefaultLatencyHysteresis = 0.1
+	// DefaultDropRateHysteresis is the default absolute drop rate improvement
+	// that is required to move away from the current path.
+	DefaultDropRateHysteresis = 0.05
+)
+
+// Names of the performance policies, as used in the configuration.
+const (
+	ShortestPathName = "shortest_path"
+	LatencyName      = "latency"
+	JitterName       = "jitter"
+	DropRateName     = "droprate"
+)
+
+// ParsePerfPolicy returns the performance policy with the given name, using the
+// default parameters.
+func ParsePerfPolicy(name string) (PerfPolicy, error) {
+	switch name {
+	case ShortestPathName:
+		return ShortestPath{}, nil
+	case LatencyName:
+		return Latency{}, nil
+	case JitterName:
+		return Jitter{}, nil
+	case DropRateName:
+		return DropRate{}, nil
+	default:
+		return nil, serrors.New("unknown performance policy", "name", name)
+	}
+}
+
+// PerfPolicyName returns the name of the performance policy, or an empty string
+// if the policy has no name.
+func PerfPolicyName(p PerfPolicy) string {
+	switch p.(type) {
+	case ShortestPath:
+		return ShortestPathName
+	case Latency:
+		return LatencyName
+	case Jitter:
+		return JitterName
+	case DropRate:
+		return DropRateName
+	default:
+		return ""
+	}
+}
+
+// ShortestPath prefers paths with fewer hops.
+type ShortestPath struct{}
+
+func (ShortestPath) Better(x, y *Stats) bool {
+	return x.Hops < y.Hops
+}
+
+// Latency prefers paths with lower latency. To prevent flapping between paths
+// with similar latency, the current path is only replaced if the other path is
+// better by more than the hysteresis.
+type Latency struct {
+	// Hysteresis is the relative latency improvement that is required to move
+	// away from the current path. If zero, DefaultLatencyHysteresis is used.
+	Hysteresis float64
+}
+
+func (p Latency) Better(x, y *Stats) bool {
+	hysteresis := p.Hysteresis
+	if hysteresis == 0 {
+		hysteresis = DefaultLatencyHysteresis
+	}
+	return betterDuration(x, y, x.Latency, y.Latency, hysteresis)
+}
+
+// Jitter prefers paths with lower jitter. Like Latency, it applies a hysteresis
+// to the current path.
+type Jitter struct {
+	// Hysteresis is the relative jitter improvement that is required to move
+	// away from the current path. If zero, DefaultLatencyHysteresis is used.
+	Hysteresis float64
+}
+
+func (p Jitter) Better(x, y *Stats) bool {
+	hysteresis := p.Hysteresis
+	if hysteresis == 0 {
+		hysteresis = DefaultLatencyHysteresis
+	}
+	return betterDuration(x, y, x.Jitter, y.Jitter, hysteresis)
+}
+
+// DropRate prefers paths with a lower drop rate. Paths with the same drop rate
+// are ordered by latency. If exactly one of the paths is current, drop rates
+// that differ by no more than the hysteresis are considered the same.
+type DropRate struct {
+	// Hysteresis is the absolute drop rate improvement that is required to
+	// move away from the current path. If zero, DefaultDropRateHysteresis is
+	// used.
+	Hysteresis float64
+}
+
+func (p DropRate) Better(x, y *Stats) bool {
+	hysteresis := p.Hysteresis
+	if hysteresis == 0 {
+		hysteresis = DefaultDropRateHysteresis
+	}
+	if x.IsCurrent == y.IsCurrent {
+		hysteresis = 0
+	}
+	diff := y.DropRate - x.DropRate
+	if diff > hysteresis {
+		return true
+	}
+	if diff < -hysteresis {
+		return false
+	}
+	return Latency{}.Better(x, y)
+}
+
+// betterDuration compares a duration metric of two paths, where a zero value
+// indicates that the metric is not known yet. Known values are preferred over
+// unknown ones. If exactly one of the paths is current, the other path must be
+// better by more than the relative hysteresis.
+func betterDuration(x, y *Stats, xv, yv time.Duration, hysteresis float64) bool {
+	switch {
+	case xv == 0 || yv == 0:
+		return xv != 0
+	case x.IsCurrent && !y.IsCurrent:
+		return float64(xv) <= float64(yv)*(1+hysteresis)
+	case !x.IsCurrent && y.IsCurrent:
+		return float64(xv)*(1+hysteresis) < float64(yv)
+	default:
+		return xv < yv
+	}
+}
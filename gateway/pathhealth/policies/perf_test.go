@@ -0,0 +1,129 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/gateway/pathhealth/policies"
+)
+
+func TestPerfPolicies(t *testing.T) {
+	ms := time.Millisecond
+	tests := map[string]struct {
+		Policy policies.PerfPolicy
+		X, Y   policies.Stats
+		Better bool
+		Worse  bool
+	}{
+		"shortest path": {
+			Policy: policies.ShortestPath{},
+			X:      policies.Stats{Hops: 2, Latency: 50 * ms},
+			Y:      policies.Stats{Hops: 3, Latency: 10 * ms},
+			Better: true,
+		},
+		"shortest path equal": {
+			Policy: policies.ShortestPath{},
+			X:      policies.Stats{Hops: 2},
+			Y:      policies.Stats{Hops: 2},
+		},
+		"latency": {
+			Policy: policies.Latency{},
+			X:      policies.Stats{Latency: 10 * ms},
+			Y:      policies.Stats{Latency: 20 * ms},
+			Better: true,
+		},
+		"latency unknown": {
+			Policy: policies.Latency{},
+			X:      policies.Stats{Latency: 20 * ms},
+			Y:      policies.Stats{},
+			Better: true,
+		},
+		"latency current within hysteresis": {
+			Policy: policies.Latency{},
+			X:      policies.Stats{Latency: 21 * ms, IsCurrent: true},
+			Y:      policies.Stats{Latency: 20 * ms},
+			Better: true,
+		},
+		"latency current outside hysteresis": {
+			Policy: policies.Latency{},
+			X:      policies.Stats{Latency: 30 * ms, IsCurrent: true},
+			Y:      policies.Stats{Latency: 20 * ms},
+			Worse:  true,
+		},
+		"latency custom hysteresis": {
+			Policy: policies.Latency{Hysteresis: 1},
+			X:      policies.Stats{Latency: 30 * ms, IsCurrent: true},
+			Y:      policies.Stats{Latency: 20 * ms},
+			Better: true,
+		},
+		"jitter": {
+			Policy: policies.Jitter{},
+			X:      policies.Stats{Jitter: ms, Latency: 30 * ms},
+			Y:      policies.Stats{Jitter: 5 * ms, Latency: 10 * ms},
+			Better: true,
+		},
+		"droprate": {
+			Policy: policies.DropRate{},
+			X:      policies.Stats{DropRate: 0, Latency: 30 * ms},
+			Y:      policies.Stats{DropRate: 0.2, Latency: 10 * ms},
+			Better: true,
+		},
+		"droprate equal falls back to latency": {
+			Policy: policies.DropRate{},
+			X:      policies.Stats{DropRate: 0.02, Latency: 30 * ms},
+			Y:      policies.Stats{DropRate: 0.02, Latency: 10 * ms},
+			Worse:  true,
+		},
+		"droprate without current path is strict": {
+			Policy: policies.DropRate{},
+			X:      policies.Stats{DropRate: 0, Latency: 30 * ms},
+			Y:      policies.Stats{DropRate: 0.02, Latency: 10 * ms},
+			Better: true,
+		},
+		"droprate current within hysteresis falls back to latency": {
+			Policy: policies.DropRate{},
+			X:      policies.Stats{DropRate: 0.02, Latency: 10 * ms, IsCurrent: true},
+			Y:      policies.Stats{DropRate: 0, Latency: 30 * ms},
+			Better: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.Better, tc.Policy.Better(&tc.X, &tc.Y), "x better than y")
+			assert.Equal(t, tc.Worse, tc.Policy.Better(&tc.Y, &tc.X), "y better than x")
+		})
+	}
+}
+
+func TestParsePerfPolicy(t *testing.T) {
+	for name, expected := range map[string]policies.PerfPolicy{
+		"shortest_path": policies.ShortestPath{},
+		"latency":       policies.Latency{},
+		"jitter":        policies.Jitter{},
+		"droprate":      policies.DropRate{},
+	} {
+		p, err := policies.ParsePerfPolicy(name)
+		require.NoError(t, err)
+		assert.Equal(t, expected, p)
+		assert.Equal(t, name, policies.PerfPolicyName(p))
+	}
+	_, err := policies.ParsePerfPolicy("bandwidth")
+	assert.Error(t, err)
+}
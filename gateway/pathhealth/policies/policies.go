@@ -42,6 +42,8 @@ type Stats struct {
 	Jitter time.Duration
 	// DropRate is a percentage of probes with no replies. From interval (0,1).
 	DropRate float64
+	// Hops is the number of inter-domain links on the path.
+	Hops int
 
 	// Is Alive is true if the probes are passing through at the moment.
 	IsAlive bool
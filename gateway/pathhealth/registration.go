@@ -16,6 +16,7 @@ package pathhealth
 
 import (
 	"sync"
+	"time"
 
 	"github.com/scionproto/scion/pkg/snet"
 )
@@ -27,6 +28,14 @@ type State struct {
 	// IsExpired indicates that the path is expired. IsExpired == true implies IsAlive == false but
 	// not vice versa.
 	IsExpired bool
+	// Latency is the median one-way latency measured by the path probes. Zero
+	// if no probe reply was received yet.
+	Latency time.Duration
+	// Jitter is the average difference between consecutive one-way latencies
+	// measured by the path probes.
+	Jitter time.Duration
+	// DropRate is the share of path probes without reply. From interval [0,1].
+	DropRate float64
 }
 
 // Selectable is a subset of the PathWatcher that is used for path selection.
@@ -49,6 +58,9 @@ type PathInfoEntry struct {
 	RejectReason string
 	Current      bool
 	Revoked      bool
+	Latency      time.Duration
+	Jitter       time.Duration
+	DropRate     float64
 }
 
 // PathInfo contains debug info about onging path monitoring.
@@ -59,6 +71,9 @@ type Selection struct {
 	// Path is the list of selected paths. The list is sorted from best to worst
 	// according to the scoring function used by the selector.
 	Paths []snet.Path
+	// Weights are the relative capacities of the selected paths, in the same
+	// order as Paths. They can be used to distribute traffic across the paths.
+	Weights []float64
 	// PathInfo provides more info about why the path was selected.
 	PathInfo PathInfo
 	// PathsAlive is the number of active paths available.
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/scionproto/scion/gateway/pathhealth/policies"
 	"github.com/scionproto/scion/pkg/snet"
 )
 
@@ -39,22 +40,25 @@ type FilteringPathSelector struct {
 	PathPolicy PathPolicy
 	// RevocationStore keeps track of the revocations.
 	RevocationStore
+	// PerfPolicy determines which of the eligible paths are preferred. If
+	// nil, shorter paths are preferred.
+	PerfPolicy policies.PerfPolicy
 	// PathCount is the max number of paths to return to the user. Defaults to 1.
 	PathCount int
 }
 
+// allowedPath is a path that is allowed by the path policy and alive.
+type allowedPath struct {
+	Fingerprint snet.PathFingerprint
+	Path        snet.Path
+	Selectable  Selectable
+	Stats       policies.Stats
+}
+
 // Select selects the best paths.
 func (f *FilteringPathSelector) Select(selectables []Selectable, current FingerprintSet) Selection {
-	type Allowed struct {
-		Fingerprint snet.PathFingerprint
-		Path        snet.Path
-		Selectable  Selectable
-		IsCurrent   bool
-		IsRevoked   bool
-	}
-
 	// Sort out the paths allowed by the path policy.
-	var allowed []Allowed
+	var allowed []allowedPath
 	var dead []snet.Path
 	var rejected []snet.Path
 	for _, selectable := range selectables {
@@ -71,35 +75,69 @@ func (f *FilteringPathSelector) Select(selectables []Selectable, current Fingerp
 		}
 		fingerprint := snet.Fingerprint(path)
 		_, isCurrent := current[fingerprint]
-		allowed = append(allowed, Allowed{
+		allowed = append(allowed, allowedPath{
 			Path:        path,
 			Fingerprint: fingerprint,
-			IsCurrent:   isCurrent,
-			IsRevoked:   f.RevocationStore.IsRevoked(path),
+			Stats: policies.Stats{
+				Fingerprint: fingerprint,
+				Latency:     state.Latency,
+				Jitter:      state.Jitter,
+				DropRate:    state.DropRate,
+				Hops:        hops(path),
+				IsAlive:     state.IsAlive,
+				IsCurrent:   isCurrent,
+				IsRevoked:   f.RevocationStore.IsRevoked(path),
+			},
 		})
 	}
-	// Sort the allowed paths according the the perf policy.
+	pathCount := f.PathCount
+	if pathCount == 0 {
+		pathCount = 1
+	}
+	if pathCount > len(allowed) {
+		pathCount = len(allowed)
+	}
+
+	// Sort the allowed paths according the the perf policy. The hysteresis
+	// of the perf policy towards the current paths would make the order
+	// depend on the order of comparisons, so the paths are sorted without it
+	// and the current paths are only preferred afterwards.
 	sort.SliceStable(allowed, func(i, j int) bool {
 		// If some of the paths are alive (probes are passing through), yet still revoked
 		// prefer the non-revoked paths as the revoked ones may be flaky.
 		switch {
-		case allowed[i].IsRevoked && !allowed[j].IsRevoked:
+		case allowed[i].Stats.IsRevoked && !allowed[j].Stats.IsRevoked:
 			return false
-		case !allowed[i].IsRevoked && allowed[j].IsRevoked:
+		case !allowed[i].Stats.IsRevoked && allowed[j].Stats.IsRevoked:
 			return true
 		}
+		if f.PerfPolicy != nil {
+			x, y := allowed[i].Stats, allowed[j].Stats
+			x.IsCurrent, y.IsCurrent = false, false
+			iBetter := f.PerfPolicy.Better(&x, &y)
+			jBetter := f.PerfPolicy.Better(&y, &x)
+			if iBetter != jBetter {
+				return iBetter
+			}
+		}
 		if shorter, ok := isShorter(allowed[i].Path, allowed[j].Path); ok {
 			return shorter
 		}
 		return allowed[i].Fingerprint > allowed[j].Fingerprint
 	})
+	if f.PerfPolicy != nil {
+		keepCurrent(allowed, pathCount, f.PerfPolicy)
+	}
 
 	var pathInfo PathInfo
 	for _, a := range allowed {
 		pathInfo = append(pathInfo, PathInfoEntry{
-			Current: a.IsCurrent,
-			Revoked: a.IsRevoked,
-			Path:    fmt.Sprintf("%s", a.Path),
+			Current:  a.Stats.IsCurrent,
+			Revoked:  a.Stats.IsRevoked,
+			Path:     fmt.Sprintf("%s", a.Path),
+			Latency:  a.Stats.Latency,
+			Jitter:   a.Stats.Jitter,
+			DropRate: a.Stats.DropRate,
 		})
 	}
 	for _, path := range dead {
@@ -117,20 +155,15 @@ func (f *FilteringPathSelector) Select(selectables []Selectable, current Fingerp
 		})
 	}
 
-	pathCount := f.PathCount
-	if pathCount == 0 {
-		pathCount = 1
-	}
-	if pathCount > len(allowed) {
-		pathCount = len(allowed)
-	}
-
 	paths := make([]snet.Path, 0, pathCount)
+	stats := make([]policies.Stats, 0, pathCount)
 	for i := 0; i < pathCount; i++ {
 		paths = append(paths, allowed[i].Path)
+		stats = append(stats, allowed[i].Stats)
 	}
 	return Selection{
 		Paths:         paths,
+		Weights:       pathWeights(paths, stats),
 		PathInfo:      pathInfo,
 		PathsAlive:    len(allowed),
 		PathsDead:     len(dead),
@@ -138,6 +171,33 @@ func (f *FilteringPathSelector) Select(selectables []Selectable, current Fingerp
 	}
 }
 
+// keepCurrent goes through the first n of the sorted paths, and moves the best
+// remaining current path to the position of a path that is not current, unless
+// the latter is better according to the perf policy, i.e., including its
+// hysteresis towards the current path. Revoked current paths are not moved
+// ahead of paths that are not revoked.
+func keepCurrent(allowed []allowedPath, n int, perfPolicy policies.PerfPolicy) {
+	for i := 0; i < n; i++ {
+		candidate := &allowed[i].Stats
+		if candidate.IsCurrent {
+			continue
+		}
+		for j := i + 1; j < len(allowed); j++ {
+			cur := &allowed[j].Stats
+			if !cur.IsCurrent {
+				continue
+			}
+			if (cur.IsRevoked && !candidate.IsRevoked) || perfPolicy.Better(candidate, cur) {
+				break
+			}
+			kept := allowed[j]
+			copy(allowed[i+1:j+1], allowed[i:j])
+			allowed[i] = kept
+			break
+		}
+	}
+}
+
 // isPathAllowed returns true is path is allowed by the policy.
 func isPathAllowed(policy PathPolicy, path snet.Path) bool {
 	if policy == nil {
@@ -146,6 +206,59 @@ func isPathAllowed(policy PathPolicy, path snet.Path) bool {
 	return len(policy.Filter([]snet.Path{path})) > 0
 }
 
+// pathWeights estimates the relative capacities of the paths. The weight of a
+// path is proportional to its bottleneck bandwidth and to the fraction of probes
+// that pass through, and inversely proportional to its latency. Bandwidth and
+// latency are only taken into account if they are known for all paths, as
+// otherwise the weights would not be comparable.
+func pathWeights(paths []snet.Path, stats []policies.Stats) []float64 {
+	useBandwidth, useLatency := true, true
+	for i, path := range paths {
+		useBandwidth = useBandwidth && bottleneckBandwidth(path) != 0
+		useLatency = useLatency && stats[i].Latency > 0
+	}
+	weights := make([]float64, 0, len(paths))
+	for i, path := range paths {
+		w := 1 - stats[i].DropRate
+		if useBandwidth {
+			w *= float64(bottleneckBandwidth(path))
+		}
+		if useLatency {
+			w /= stats[i].Latency.Seconds()
+		}
+		weights = append(weights, w)
+	}
+	return weights
+}
+
+// bottleneckBandwidth returns the lowest bandwidth announced for the links of
+// the path, in Kbit/s. It returns 0 if the bandwidth of any link is unknown.
+func bottleneckBandwidth(path snet.Path) uint64 {
+	md := path.Metadata()
+	if md == nil || len(md.Bandwidth) == 0 {
+		return 0
+	}
+	var bottleneck uint64
+	for i, bw := range md.Bandwidth {
+		if bw == 0 {
+			return 0
+		}
+		if i == 0 || bw < bottleneck {
+			bottleneck = bw
+		}
+	}
+	return bottleneck
+}
+
+// hops returns the number of inter-domain links on the path.
+func hops(path snet.Path) int {
+	md := path.Metadata()
+	if md == nil {
+		return 0
+	}
+	return len(md.Interfaces) / 2
+}
+
 func isShorter(a, b snet.Path) (bool, bool) {
 	mA, mB := a.Metadata(), b.Metadata()
 	if mA == nil || mB == nil {
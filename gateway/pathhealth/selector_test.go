@@ -0,0 +1,118 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pathhealth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/scionproto/scion/gateway/pathhealth/policies"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+)
+
+func TestPathWeights(t *testing.T) {
+	ms := time.Millisecond
+	withBandwidth := func(bw ...uint64) snet.Path {
+		return snetpath.Path{Meta: snet.PathMetadata{Bandwidth: bw}}
+	}
+
+	t.Run("bandwidth and latency", func(t *testing.T) {
+		paths := []snet.Path{withBandwidth(100, 50), withBandwidth(200)}
+		stats := []policies.Stats{
+			{Latency: 10 * ms},
+			{Latency: 20 * ms, DropRate: 0.5},
+		}
+		weights := pathWeights(paths, stats)
+		assert.InDelta(t, 5000, weights[0], 1e-6)
+		assert.InDelta(t, 5000, weights[1], 1e-6)
+	})
+	t.Run("unknown bandwidth", func(t *testing.T) {
+		paths := []snet.Path{withBandwidth(100), withBandwidth(100, 0)}
+		stats := []policies.Stats{{Latency: 10 * ms}, {Latency: 20 * ms}}
+		weights := pathWeights(paths, stats)
+		assert.InDelta(t, 2*weights[1], weights[0], 1e-6)
+	})
+	t.Run("unknown latency", func(t *testing.T) {
+		paths := []snet.Path{withBandwidth(100), withBandwidth(300)}
+		stats := []policies.Stats{{Latency: 10 * ms}, {}}
+		weights := pathWeights(paths, stats)
+		assert.Equal(t, []float64{100, 300}, weights)
+	})
+}
+
+type testSelectable struct {
+	path  snet.Path
+	state State
+}
+
+func (s testSelectable) Path() snet.Path { return s.path }
+func (s testSelectable) State() State    { return s.state }
+
+func TestSelectHysteresis(t *testing.T) {
+	ms := time.Millisecond
+	ia := addr.MustParseIA("1-ff00:0:110")
+	withLatency := func(ifID uint16, latency time.Duration) Selectable {
+		return testSelectable{
+			path: snetpath.Path{Meta: snet.PathMetadata{
+				Interfaces: []snet.PathInterface{
+					{IA: ia, ID: iface.ID(ifID)},
+					{IA: addr.MustParseIA("1-ff00:0:111"), ID: iface.ID(ifID)},
+				},
+			}},
+			state: State{IsAlive: true, Latency: latency},
+		}
+	}
+	fast, medium, slow := withLatency(1, 10*ms), withLatency(2, 11*ms), withLatency(3, 12*ms)
+	currentSet := func(s Selectable) FingerprintSet {
+		return FingerprintSet{snet.Fingerprint(s.Path()): {}}
+	}
+	selector := &FilteringPathSelector{
+		RevocationStore: &MemoryRevocationStore{},
+		PerfPolicy:      policies.Latency{},
+	}
+
+	tests := map[string]struct {
+		Current  Selectable
+		Expected Selectable
+	}{
+		"no current path":            {Expected: fast},
+		"current within hysteresis":  {Current: medium, Expected: medium},
+		"current outside hysteresis": {Current: slow, Expected: fast},
+		"current is the best path":   {Current: fast, Expected: fast},
+		"current path is gone":       {Current: withLatency(4, ms), Expected: fast},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var current FingerprintSet
+			if tc.Current != nil {
+				current = currentSet(tc.Current)
+			}
+			// The result must not depend on the order of the paths.
+			for _, order := range [][]Selectable{
+				{fast, medium, slow},
+				{slow, medium, fast},
+				{medium, slow, fast},
+			} {
+				selection := selector.Select(order, current)
+				assert.Equal(t, []snet.Path{tc.Expected.Path()}, selection.Paths)
+			}
+		})
+	}
+}
@@ -75,6 +75,59 @@ func TestClassMapMarshalUnMarshal(t *testing.T) {
 				),
 			},
 		},
+		{
+			Name:     "IPv6",
+			FileName: "class_3",
+			Classes: pktcls.ClassMap{
+				"ipv6 voice": pktcls.NewClass(
+					"ipv6 voice",
+					pktcls.NewCondAllOf(
+						pktcls.NewCondIPv6(&pktcls.IPv6MatchTrafficClass{TrafficClass: 0xb8}),
+						pktcls.NewCondIPv6(&pktcls.IPv6MatchNextHeader{NextHeader: 17}),
+						pktcls.NewCondIPv6(&pktcls.IPv6MatchDestination{
+							Net: &net.IPNet{
+								IP:   net.ParseIP("2001:db8::"),
+								Mask: net.CIDRMask(32, 128),
+							},
+						}),
+					),
+				),
+				"ipv6 flow": pktcls.NewClass(
+					"ipv6 flow",
+					pktcls.NewCondAnyOf(
+						pktcls.NewCondIPv6(&pktcls.IPv6MatchFlowLabel{FlowLabel: 0x12345}),
+						pktcls.NewCondIPv6(&pktcls.IPv6MatchSource{
+							Net: &net.IPNet{
+								IP:   net.ParseIP("fd00::"),
+								Mask: net.CIDRMask(8, 128),
+							},
+						}),
+					),
+				),
+			},
+		},
+		{
+			Name:     "L4",
+			FileName: "class_4",
+			Classes: pktcls.ClassMap{
+				"probes": pktcls.NewClass(
+					"probes",
+					pktcls.NewCondAnyOf(
+						pktcls.NewCondL4(&pktcls.L4MatchICMPType{Name: "echo"}),
+						pktcls.NewCondL4(&pktcls.L4MatchICMPType{Name: "echoreply"}),
+					),
+				),
+				"bulk": pktcls.NewClass(
+					"bulk",
+					pktcls.NewCondAllOf(
+						pktcls.NewCondL4(&pktcls.L4MatchProtocol{Protocol: 6}),
+						pktcls.NewCondNot(pktcls.NewCondL4(&pktcls.L4MatchTCPFlags{
+							Flags: pktcls.TCPFlagSYN,
+						})),
+					),
+				),
+			},
+		},
 		{
 			Name:     "nil ClassMap stays nil",
 			FileName: "class_2",
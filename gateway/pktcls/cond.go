@@ -226,6 +226,55 @@ func (c *CondIPv4) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+var _ Cond = (*CondIPv6)(nil)
+
+// CondIPv6 conditions return true if the embedded IPv6 predicate returns true.
+type CondIPv6 struct {
+	Predicate IPv6Predicate
+}
+
+func NewCondIPv6(p IPv6Predicate) *CondIPv6 {
+	return &CondIPv6{Predicate: p}
+}
+
+func (c *CondIPv6) Eval(v gopacket.Layer) bool {
+	if c.Predicate == nil || v == nil {
+		return false
+	}
+	t := v.LayerType()
+	if t != layers.LayerTypeIPv6 {
+		return false
+	}
+
+	p, ok := v.(*layers.IPv6)
+	if !ok {
+		return false
+	}
+
+	return c.Predicate.Eval(p)
+}
+
+func (c *CondIPv6) Type() string {
+	return TypeCondIPv6
+}
+
+func (c *CondIPv6) String() string {
+	if c.Predicate == nil {
+		return "<nil>"
+	}
+	return c.Predicate.String()
+}
+
+func (c *CondIPv6) MarshalJSON() ([]byte, error) {
+	return marshalInterface(c.Predicate)
+}
+
+func (c *CondIPv6) UnmarshalJSON(b []byte) error {
+	var err error
+	c.Predicate, err = unmarshalIPv6Predicate(b)
+	return err
+}
+
 var _ Cond = (*CondPorts)(nil)
 
 // CondPorts conditions return true if the embedded port predicate returns true.
@@ -243,19 +292,15 @@ func (c *CondPorts) Eval(v gopacket.Layer) bool {
 	}
 	// Port predicates are independent on particular L3 or L4 protocol.
 	// Here we extract the ports and pass them to the embedded predicate.
-	l3 := v.LayerType()
-	if l3 != layers.LayerTypeIPv4 {
-		return false
-	}
-	ipv4, ok := v.(*layers.IPv4)
+	proto, payload, ok := transportPayload(v)
 	if !ok {
 		return false
 	}
 
-	switch ipv4.NextLayerType() {
-	case layers.LayerTypeUDP:
+	switch proto {
+	case layers.IPProtocolUDP:
 		udp := &layers.UDP{}
-		err := udp.DecodeFromBytes(ipv4.LayerPayload(), gopacket.NilDecodeFeedback)
+		err := udp.DecodeFromBytes(payload, gopacket.NilDecodeFeedback)
 		if err != nil {
 			return false
 		}
@@ -263,9 +308,9 @@ func (c *CondPorts) Eval(v gopacket.Layer) bool {
 			Src: uint16(udp.SrcPort),
 			Dst: uint16(udp.DstPort),
 		})
-	case layers.LayerTypeTCP:
+	case layers.IPProtocolTCP:
 		tcp := &layers.TCP{}
-		err := tcp.DecodeFromBytes(ipv4.LayerPayload(), gopacket.NilDecodeFeedback)
+		err := tcp.DecodeFromBytes(payload, gopacket.NilDecodeFeedback)
 		if err != nil {
 			return false
 		}
@@ -278,6 +323,19 @@ func (c *CondPorts) Eval(v gopacket.Layer) bool {
 	}
 }
 
+// transportPayload returns the protocol and the contents of the layer carried by the
+// IPv4 or IPv6 layer v. For IPv6, extension headers are not skipped.
+func transportPayload(v gopacket.Layer) (layers.IPProtocol, []byte, bool) {
+	switch l3 := v.(type) {
+	case *layers.IPv4:
+		return l3.Protocol, l3.LayerPayload(), true
+	case *layers.IPv6:
+		return l3.NextHeader, l3.LayerPayload(), true
+	default:
+		return 0, nil, false
+	}
+}
+
 func (c *CondPorts) Type() string {
 	return TypeCondPorts
 }
@@ -299,6 +357,97 @@ func (c *CondPorts) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+var _ Cond = (*CondL4)(nil)
+
+// CondL4 conditions return true if the embedded L4 predicate returns true.
+type CondL4 struct {
+	Predicate L4Predicate
+}
+
+func NewCondL4(p L4Predicate) *CondL4 {
+	return &CondL4{Predicate: p}
+}
+
+func (c *CondL4) Eval(v gopacket.Layer) bool {
+	if c.Predicate == nil || v == nil {
+		return false
+	}
+	// L4 predicates are independent on particular L3 protocol. Here we extract
+	// the transport layer information and pass it to the embedded predicate.
+	proto, payload, ok := transportPayload(v)
+	if !ok {
+		return false
+	}
+	l4 := &L4{Protocol: uint8(proto)}
+	switch proto {
+	case layers.IPProtocolTCP:
+		tcp := &layers.TCP{}
+		err := tcp.DecodeFromBytes(payload, gopacket.NilDecodeFeedback)
+		if err != nil {
+			return false
+		}
+		l4.TCPFlags = tcpFlags(tcp)
+	case layers.IPProtocolICMPv4:
+		icmp := &layers.ICMPv4{}
+		err := icmp.DecodeFromBytes(payload, gopacket.NilDecodeFeedback)
+		if err != nil {
+			return false
+		}
+		l4.ICMPType = icmp.TypeCode.Type()
+	case layers.IPProtocolICMPv6:
+		icmp := &layers.ICMPv6{}
+		err := icmp.DecodeFromBytes(payload, gopacket.NilDecodeFeedback)
+		if err != nil {
+			return false
+		}
+		l4.ICMPType = icmp.TypeCode.Type()
+	}
+	return c.Predicate.Eval(l4)
+}
+
+func (c *CondL4) Type() string {
+	return TypeCondL4
+}
+
+func (c *CondL4) String() string {
+	if c.Predicate == nil {
+		return "<nil>"
+	}
+	return c.Predicate.String()
+}
+
+func (c *CondL4) MarshalJSON() ([]byte, error) {
+	return marshalInterface(c.Predicate)
+}
+
+func (c *CondL4) UnmarshalJSON(b []byte) error {
+	var err error
+	c.Predicate, err = unmarshalL4Predicate(b)
+	return err
+}
+
+func tcpFlags(tcp *layers.TCP) uint8 {
+	var flags uint8
+	for _, f := range []struct {
+		set  bool
+		flag uint8
+	}{
+		{tcp.FIN, TCPFlagFIN},
+		{tcp.SYN, TCPFlagSYN},
+		{tcp.RST, TCPFlagRST},
+		{tcp.PSH, TCPFlagPSH},
+		{tcp.ACK, TCPFlagACK},
+		{tcp.URG, TCPFlagURG},
+		{tcp.ECE, TCPFlagECE},
+		{tcp.CWR, TCPFlagCWR},
+	} {
+		if f.set {
+			flags |= f.flag
+		}
+	}
+	return flags
+}
+
 const typeCondClass = "CondClass"
 
 // CondClass conditions return true if the embedded traffic class returns true
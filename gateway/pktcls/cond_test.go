@@ -155,6 +155,76 @@ func TestIPCond(t *testing.T) {
 			},
 			ExpEval: false,
 		},
+		{
+			Name: "Match IPv6 destination",
+			Cond: pktcls.NewCondAllOf(
+				pktcls.NewCondIPv6(
+					&pktcls.IPv6MatchDestination{
+						Net: &net.IPNet{
+							IP:   net.ParseIP("2001:db8::"),
+							Mask: net.CIDRMask(32, 128),
+						},
+					},
+				),
+			),
+			Packet: &layers.IPv6{
+				SrcIP: net.ParseIP("2001:db9::1"),
+				DstIP: net.ParseIP("2001:db8::1"),
+			},
+			ExpEval: true,
+		},
+		{
+			Name: "Match IPv6 traffic class and flow label",
+			Cond: pktcls.NewCondAllOf(
+				pktcls.NewCondIPv6(&pktcls.IPv6MatchTrafficClass{TrafficClass: 0xb8}),
+				pktcls.NewCondIPv6(&pktcls.IPv6MatchFlowLabel{FlowLabel: 0x12345}),
+			),
+			Packet: &layers.IPv6{
+				TrafficClass: 0xb8,
+				FlowLabel:    0x12345,
+			},
+			ExpEval: true,
+		},
+		{
+			Name: "Match IPv6 next header",
+			Cond: pktcls.NewCondIPv6(
+				&pktcls.IPv6MatchNextHeader{NextHeader: 17},
+			),
+			Packet: &layers.IPv6{
+				NextHeader: layers.IPProtocolUDP,
+			},
+			ExpEval: true,
+		},
+		{
+			Name: "Do not match IPv6 condition on IPv4 packet",
+			Cond: pktcls.NewCondIPv6(
+				&pktcls.IPv6MatchSource{
+					Net: &net.IPNet{
+						IP:   net.ParseIP("::"),
+						Mask: net.CIDRMask(0, 128),
+					},
+				},
+			),
+			Packet: &layers.IPv4{
+				SrcIP: net.IP{192, 168, 1, 1},
+			},
+			ExpEval: false,
+		},
+		{
+			Name: "Do not match IPv4 condition on IPv6 packet",
+			Cond: pktcls.NewCondIPv4(
+				&pktcls.IPv4MatchSource{
+					Net: &net.IPNet{
+						IP:   net.IP{0, 0, 0, 0},
+						Mask: net.IPv4Mask(0, 0, 0, 0),
+					},
+				},
+			),
+			Packet: &layers.IPv6{
+				SrcIP: net.ParseIP("2001:db8::1"),
+			},
+			ExpEval: false,
+		},
 	}
 
 	for _, test := range testCases {
@@ -167,6 +237,7 @@ func TestIPCond(t *testing.T) {
 func TestPortCond(t *testing.T) {
 	testCases := map[string]struct {
 		Cond    pktcls.Cond
+		IPv6    bool
 		SrcPort uint16
 		DstPort uint16
 		ExpEval bool
@@ -195,6 +266,19 @@ func TestPortCond(t *testing.T) {
 			DstPort: 200,
 			ExpEval: false,
 		},
+		"Match UDP dst port over IPv6": {
+			Cond: pktcls.NewCondAllOf(
+				pktcls.NewCondPorts(
+					&pktcls.PortMatchDestination{
+						MinPort: 100,
+						MaxPort: 199,
+					},
+				),
+			),
+			IPv6:    true,
+			DstPort: 150,
+			ExpEval: true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -202,11 +286,179 @@ func TestPortCond(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 			pkt := createUDPPacket(tc.SrcPort, tc.DstPort)
+			if tc.IPv6 {
+				pkt = createUDPv6Packet(tc.SrcPort, tc.DstPort)
+			}
 			assert.Equal(t, tc.ExpEval, tc.Cond.Eval(pkt))
 		})
 	}
 }
 
+func TestL4Cond(t *testing.T) {
+	testCases := map[string]struct {
+		Cond    pktcls.Cond
+		Packet  gopacket.Layer
+		ExpEval bool
+	}{
+		"Match L4 protocol IPv4": {
+			Cond:    pktcls.NewCondL4(&pktcls.L4MatchProtocol{Protocol: 17}),
+			Packet:  createUDPPacket(1, 2),
+			ExpEval: true,
+		},
+		"Match L4 protocol IPv6": {
+			Cond:    pktcls.NewCondL4(&pktcls.L4MatchProtocol{Protocol: 17}),
+			Packet:  createUDPv6Packet(1, 2),
+			ExpEval: true,
+		},
+		"Do not match L4 protocol": {
+			Cond:    pktcls.NewCondL4(&pktcls.L4MatchProtocol{Protocol: 6}),
+			Packet:  createUDPPacket(1, 2),
+			ExpEval: false,
+		},
+		"Match TCP flags": {
+			Cond: pktcls.NewCondL4(&pktcls.L4MatchTCPFlags{
+				Flags: pktcls.TCPFlagSYN | pktcls.TCPFlagACK,
+			}),
+			Packet:  createTCPPacket(&layers.TCP{SYN: true, ACK: true, ECE: true}),
+			ExpEval: true,
+		},
+		"Do not match TCP flags": {
+			Cond: pktcls.NewCondL4(&pktcls.L4MatchTCPFlags{
+				Flags: pktcls.TCPFlagSYN | pktcls.TCPFlagACK,
+			}),
+			Packet:  createTCPPacket(&layers.TCP{SYN: true}),
+			ExpEval: false,
+		},
+		"Do not match TCP flags on UDP": {
+			Cond:    pktcls.NewCondL4(&pktcls.L4MatchTCPFlags{}),
+			Packet:  createUDPPacket(1, 2),
+			ExpEval: false,
+		},
+		"Match ICMP type": {
+			Cond:    pktcls.NewCondL4(&pktcls.L4MatchICMPType{ICMPType: 8}),
+			Packet:  createICMPPacket(layers.ICMPv4TypeEchoRequest),
+			ExpEval: true,
+		},
+		"Do not match ICMP type": {
+			Cond:    pktcls.NewCondL4(&pktcls.L4MatchICMPType{ICMPType: 8}),
+			Packet:  createICMPPacket(layers.ICMPv4TypeEchoReply),
+			ExpEval: false,
+		},
+		"Do not match ICMP type on UDP": {
+			Cond:    pktcls.NewCondL4(&pktcls.L4MatchICMPType{ICMPType: 0}),
+			Packet:  createUDPPacket(1, 2),
+			ExpEval: false,
+		},
+		"Match named ICMP type": {
+			Cond:    pktcls.NewCondL4(&pktcls.L4MatchICMPType{Name: "echo"}),
+			Packet:  createICMPPacket(layers.ICMPv4TypeEchoRequest),
+			ExpEval: true,
+		},
+		"Match named ICMPv6 type": {
+			Cond:    pktcls.NewCondL4(&pktcls.L4MatchICMPType{Name: "echo"}),
+			Packet:  createICMPv6Packet(layers.ICMPv6TypeEchoRequest),
+			ExpEval: true,
+		},
+		"Do not match named ICMPv6 type": {
+			Cond:    pktcls.NewCondL4(&pktcls.L4MatchICMPType{Name: "echo"}),
+			Packet:  createICMPv6Packet(layers.ICMPv6TypeEchoReply),
+			ExpEval: false,
+		},
+		"Match ICMPv6 only type": {
+			Cond:    pktcls.NewCondL4(&pktcls.L4MatchICMPType{Name: "packettoobig"}),
+			Packet:  createICMPv6Packet(layers.ICMPv6TypePacketTooBig),
+			ExpEval: true,
+		},
+		"Do not match ICMPv6 only type on ICMPv4": {
+			Cond:    pktcls.NewCondL4(&pktcls.L4MatchICMPType{Name: "packettoobig"}),
+			Packet:  createICMPPacket(layers.ICMPv6TypePacketTooBig),
+			ExpEval: false,
+		},
+		"Match ICMPv6 type number": {
+			Cond:    pktcls.NewCondL4(&pktcls.L4MatchICMPType{ICMPType: 135}),
+			Packet:  createICMPv6Packet(layers.ICMPv6TypeNeighborSolicitation),
+			ExpEval: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.ExpEval, tc.Cond.Eval(tc.Packet))
+		})
+	}
+}
+
+func createTCPPacket(tcp *layers.TCP) gopacket.Layer {
+	ip := &layers.IPv4{
+		Version:  4,
+		IHL:      5,
+		TTL:      64,
+		SrcIP:    net.IP{192, 168, 14, 3},
+		DstIP:    net.IP{192, 168, 14, 2},
+		Protocol: layers.IPProtocolTCP,
+	}
+	_ = tcp.SetNetworkLayerForChecksum(ip)
+	return serializeIPv4(ip, tcp)
+}
+
+func createICMPPacket(typ uint8) gopacket.Layer {
+	ip := &layers.IPv4{
+		Version:  4,
+		IHL:      5,
+		TTL:      64,
+		SrcIP:    net.IP{192, 168, 14, 3},
+		DstIP:    net.IP{192, 168, 14, 2},
+		Protocol: layers.IPProtocolICMPv4,
+	}
+	icmp := &layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(typ, 0)}
+	return serializeIPv4(ip, icmp)
+}
+
+func createICMPv6Packet(typ uint8) gopacket.Layer {
+	ip := &layers.IPv6{
+		Version:    6,
+		HopLimit:   64,
+		SrcIP:      net.ParseIP("2001:db8::3"),
+		DstIP:      net.ParseIP("2001:db8::2"),
+		NextHeader: layers.IPProtocolICMPv6,
+	}
+	icmp := &layers.ICMPv6{TypeCode: layers.CreateICMPv6TypeCode(typ, 0)}
+	_ = icmp.SetNetworkLayerForChecksum(ip)
+	input := gopacket.NewSerializeBuffer()
+	options := gopacket.SerializeOptions{
+		FixLengths:       true,
+		ComputeChecksums: true,
+	}
+	if err := gopacket.SerializeLayers(input, options,
+		ip, icmp, gopacket.Payload("payload")); err != nil {
+		panic(err)
+	}
+	pkt := &layers.IPv6{}
+	if err := pkt.DecodeFromBytes(input.Bytes(), gopacket.NilDecodeFeedback); err != nil {
+		panic(err)
+	}
+	return pkt
+}
+
+func serializeIPv4(ip *layers.IPv4, l4 gopacket.SerializableLayer) gopacket.Layer {
+	input := gopacket.NewSerializeBuffer()
+	options := gopacket.SerializeOptions{
+		FixLengths:       true,
+		ComputeChecksums: true,
+	}
+	if err := gopacket.SerializeLayers(input, options,
+		ip, l4, gopacket.Payload("payload")); err != nil {
+		panic(err)
+	}
+	pkt := &layers.IPv4{}
+	if err := pkt.DecodeFromBytes(input.Bytes(), gopacket.NilDecodeFeedback); err != nil {
+		panic(err)
+	}
+	return pkt
+}
+
 func createUDPPacket(src, dst uint16) gopacket.Layer {
 	ip := &layers.IPv4{
 		Version:  4,
@@ -239,7 +491,38 @@ func createUDPPacket(src, dst uint16) gopacket.Layer {
 	return pkt
 }
 
+func createUDPv6Packet(src, dst uint16) gopacket.Layer {
+	ip := &layers.IPv6{
+		Version:    6,
+		HopLimit:   64,
+		SrcIP:      net.ParseIP("2001:db8::3"),
+		DstIP:      net.ParseIP("2001:db8::2"),
+		NextHeader: layers.IPProtocolUDP,
+	}
+	udp := &layers.UDP{
+		SrcPort: layers.UDPPort(src),
+		DstPort: layers.UDPPort(dst),
+	}
+	_ = udp.SetNetworkLayerForChecksum(ip)
+	payload := []byte("payload")
+	input := gopacket.NewSerializeBuffer()
+	options := gopacket.SerializeOptions{
+		FixLengths:       true,
+		ComputeChecksums: true,
+	}
+	if err := gopacket.SerializeLayers(input, options,
+		ip, udp, gopacket.Payload(payload)); err != nil {
+		panic(err)
+	}
+	pkt := &layers.IPv6{}
+	if err := pkt.DecodeFromBytes(input.Bytes(), gopacket.NilDecodeFeedback); err != nil {
+		panic(err)
+	}
+	return pkt
+}
+
 func TestStringer(t *testing.T) {
+	_, net6, _ := net.ParseCIDR("2001:db8::/32")
 	_, net, _ := net.ParseCIDR("12.12.12.0/26")
 	tests := map[string]struct {
 		Cond pktcls.Cond
@@ -266,6 +549,37 @@ func TestStringer(t *testing.T) {
 				},
 			},
 		},
+		"ANY ALL proto tcpflags icmp-type": {
+			Str: "any(all(proto=TCP,tcpflags=syn|ack),all(proto=ICMP,icmp-type=echo)," +
+				"icmp-type=42,proto=58)",
+			Cond: pktcls.CondAnyOf{
+				pktcls.CondAllOf{
+					pktcls.NewCondL4(&pktcls.L4MatchProtocol{Protocol: 6}),
+					pktcls.NewCondL4(&pktcls.L4MatchTCPFlags{
+						Flags: pktcls.TCPFlagSYN | pktcls.TCPFlagACK,
+					}),
+				},
+				pktcls.CondAllOf{
+					pktcls.NewCondL4(&pktcls.L4MatchProtocol{Protocol: 1}),
+					pktcls.NewCondL4(&pktcls.L4MatchICMPType{Name: "echo"}),
+				},
+				pktcls.NewCondL4(&pktcls.L4MatchICMPType{ICMPType: 42}),
+				pktcls.NewCondL4(&pktcls.L4MatchProtocol{Protocol: 58}),
+			},
+		},
+		"ANY ALL src dst tc flowlabel nexthdr": {
+			Str: "any(src=2001:db8::/32,all(dst=2001:db8::/32,tc=0xb8,flowlabel=0x12345," +
+				"nexthdr=UDP))",
+			Cond: pktcls.CondAnyOf{
+				pktcls.NewCondIPv6(&pktcls.IPv6MatchSource{Net: net6}),
+				pktcls.CondAllOf{
+					pktcls.NewCondIPv6(&pktcls.IPv6MatchDestination{Net: net6}),
+					pktcls.NewCondIPv6(&pktcls.IPv6MatchTrafficClass{TrafficClass: 0xb8}),
+					pktcls.NewCondIPv6(&pktcls.IPv6MatchFlowLabel{FlowLabel: 0x12345}),
+					pktcls.NewCondIPv6(&pktcls.IPv6MatchNextHeader{NextHeader: 17}),
+				},
+			},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -20,14 +20,17 @@
 // true for a ClsPkt, that packet is considered to be part of that class.
 //
 // The following conditions are supported:
-// AnyOf, AllOf, Boolean true, Boolean false and IPv4. AnyOf returns true if at
-// least one subcondition returns true. AllOf returns true if all subconditions
-// return true.  AllOf or AnyOf without subconditions return true. Boolean
-// conditions always return their internal value. IPv4 conditions include
-// predicates that compare the analyzed packet to preset values. Supported IPv4
-// conditions currently include destination network match, source network match
-// and ToS/DSCP fields match. Multiple predicates can be checked by enumerating
-// them under AllOf or AnyOf.
+// AnyOf, AllOf, Boolean true, Boolean false, IPv4, IPv6, ports and L4. AnyOf
+// returns true if at least one subcondition returns true. AllOf returns true if
+// all subconditions return true.  AllOf or AnyOf without subconditions return
+// true. Boolean conditions always return their internal value. IPv4 conditions
+// include predicates that compare the analyzed packet to preset values.
+// Supported IPv4 conditions currently include destination network match, source
+// network match and ToS/DSCP fields match. IPv6 conditions work the same way
+// and support destination network match, source network match, traffic class,
+// flow label and next header match. L4 conditions apply to both IPv4 and IPv6
+// packets and support L4 protocol, TCP flags and ICMP type match. Multiple
+// predicates can be checked by enumerating them under AllOf or AnyOf.
 //
 // The package contains support for JSON marshaling and unmarshaling of
 // classes. Due to the custom formatting of the JSON output, marshaling must be
@@ -41,19 +41,29 @@ type Typer interface {
 // concrete type is unmarshaled.
 
 const (
-	TypeCondAllOf            = "CondAllOf"
-	TypeCondAnyOf            = "CondAnyOf"
-	TypeCondNot              = "CondNot"
-	TypeCondBool             = "CondBool"
-	TypeCondIPv4             = "CondIPv4"
-	TypeIPv4MatchSource      = "MatchSource"
-	TypeIPv4MatchDestination = "MatchDestination"
-	TypeIPv4MatchToS         = "MatchToS"
-	TypeIPv4MatchDSCP        = "MatchDSCP"
-	TypeIPv4MatchProtocol    = "MatchProtocol"
-	TypeCondPorts            = "CondPorts"
-	TypePortMatchSource      = "MatchSourcePort"
-	TypePortMatchDestination = "MatchDestinationPort"
+	TypeCondAllOf             = "CondAllOf"
+	TypeCondAnyOf             = "CondAnyOf"
+	TypeCondNot               = "CondNot"
+	TypeCondBool              = "CondBool"
+	TypeCondIPv4              = "CondIPv4"
+	TypeIPv4MatchSource       = "MatchSource"
+	TypeIPv4MatchDestination  = "MatchDestination"
+	TypeIPv4MatchToS          = "MatchToS"
+	TypeIPv4MatchDSCP         = "MatchDSCP"
+	TypeIPv4MatchProtocol     = "MatchProtocol"
+	TypeCondIPv6              = "CondIPv6"
+	TypeIPv6MatchSource       = "MatchSourceIPv6"
+	TypeIPv6MatchDestination  = "MatchDestinationIPv6"
+	TypeIPv6MatchTrafficClass = "MatchTrafficClass"
+	TypeIPv6MatchFlowLabel    = "MatchFlowLabel"
+	TypeIPv6MatchNextHeader   = "MatchNextHeader"
+	TypeCondPorts             = "CondPorts"
+	TypePortMatchSource       = "MatchSourcePort"
+	TypePortMatchDestination  = "MatchDestinationPort"
+	TypeCondL4                = "CondL4"
+	TypeL4MatchProtocol       = "MatchL4Protocol"
+	TypeL4MatchTCPFlags       = "MatchTCPFlags"
+	TypeL4MatchICMPType       = "MatchICMPType"
 )
 
 // generic container for marshaling custom data
@@ -120,6 +130,30 @@ func unmarshalInterface(b []byte) (Typer, error) {
 			var p IPv4MatchProtocol
 			err := json.Unmarshal(*v, &p)
 			return &p, err
+		case TypeCondIPv6:
+			var c CondIPv6
+			err := json.Unmarshal(*v, &c)
+			return &c, err
+		case TypeIPv6MatchSource:
+			var p IPv6MatchSource
+			err := json.Unmarshal(*v, &p)
+			return &p, err
+		case TypeIPv6MatchDestination:
+			var p IPv6MatchDestination
+			err := json.Unmarshal(*v, &p)
+			return &p, err
+		case TypeIPv6MatchTrafficClass:
+			var p IPv6MatchTrafficClass
+			err := json.Unmarshal(*v, &p)
+			return &p, err
+		case TypeIPv6MatchFlowLabel:
+			var p IPv6MatchFlowLabel
+			err := json.Unmarshal(*v, &p)
+			return &p, err
+		case TypeIPv6MatchNextHeader:
+			var p IPv6MatchNextHeader
+			err := json.Unmarshal(*v, &p)
+			return &p, err
 		case TypeCondPorts:
 			var c CondPorts
 			err := json.Unmarshal(*v, &c)
@@ -132,6 +166,22 @@ func unmarshalInterface(b []byte) (Typer, error) {
 			var p PortMatchDestination
 			err := json.Unmarshal(*v, &p)
 			return &p, err
+		case TypeCondL4:
+			var c CondL4
+			err := json.Unmarshal(*v, &c)
+			return &c, err
+		case TypeL4MatchProtocol:
+			var p L4MatchProtocol
+			err := json.Unmarshal(*v, &p)
+			return &p, err
+		case TypeL4MatchTCPFlags:
+			var p L4MatchTCPFlags
+			err := json.Unmarshal(*v, &p)
+			return &p, err
+		case TypeL4MatchICMPType:
+			var p L4MatchICMPType
+			err := json.Unmarshal(*v, &p)
+			return &p, err
 		default:
 			return nil, serrors.New("Unknown type", "type", k)
 		}
@@ -165,6 +215,19 @@ func unmarshalIPv4Predicate(b []byte) (IPv4Predicate, error) {
 	return p, nil
 }
 
+// unmarshalIPv6Predicate extracts an IPv6Predicate from a JSON encoding
+func unmarshalIPv6Predicate(b []byte) (IPv6Predicate, error) {
+	t, err := unmarshalInterface(b)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := t.(IPv6Predicate)
+	if !ok {
+		return nil, serrors.New("Unable to extract Cond from interface")
+	}
+	return p, nil
+}
+
 // unmarshalPortPredicate extracts an PortPredicate from a JSON encoding
 func unmarshalPortPredicate(b []byte) (PortPredicate, error) {
 	t, err := unmarshalInterface(b)
@@ -178,6 +241,19 @@ func unmarshalPortPredicate(b []byte) (PortPredicate, error) {
 	return p, nil
 }
 
+// unmarshalL4Predicate extracts an L4Predicate from a JSON encoding
+func unmarshalL4Predicate(b []byte) (L4Predicate, error) {
+	t, err := unmarshalInterface(b)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := t.(L4Predicate)
+	if !ok {
+		return nil, serrors.New("Unable to extract Cond from interface")
+	}
+	return p, nil
+}
+
 // Special case slices because we only need them for Conds
 
 func marshalCondSlice(conds []Cond) ([]byte, error) {
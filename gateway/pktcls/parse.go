@@ -126,6 +126,64 @@ func (l *classListener) EnterMatchProtocol(ctx *traffic_class.MatchProtocolConte
 	l.pushCond(NewCondIPv4(prot))
 }
 
+func (l *classListener) EnterMatchSrcIPv6(ctx *traffic_class.MatchSrcIPv6Context) {
+	// Push Selector as Predicate on stack and update the number of Conds on the stack
+	var err error
+	msrc := &IPv6MatchSource{}
+	_, msrc.Net, err = net.ParseCIDR(ctx.GetStop().GetText())
+	if err != nil {
+		l.err = serrors.Wrap("CIDR parsing failed!", err, "cidr", ctx.GetStop().GetText())
+	}
+	l.pushCond(NewCondIPv6(msrc))
+}
+
+func (l *classListener) EnterMatchDstIPv6(ctx *traffic_class.MatchDstIPv6Context) {
+	// Push Selector as Predicate on stack and update the number of Conds on the stack
+	var err error
+	mdst := &IPv6MatchDestination{}
+	_, mdst.Net, err = net.ParseCIDR(ctx.GetStop().GetText())
+	if err != nil {
+		l.err = serrors.Wrap("CIDR parsing failed!", err, "cidr", ctx.GetStop().GetText())
+	}
+	l.pushCond(NewCondIPv6(mdst))
+}
+
+func (l *classListener) EnterMatchTrafficClass(ctx *traffic_class.MatchTrafficClassContext) {
+	// Push Selector as Predicate on stack and update the number of Conds on the stack
+	mtc := &IPv6MatchTrafficClass{}
+	tc, err := strconv.ParseUint(ctx.GetStop().GetText(), 16, 8)
+	if err != nil {
+		l.err = serrors.Wrap("Traffic class parsing failed!", err,
+			"tc", ctx.GetStop().GetText())
+	}
+	mtc.TrafficClass = uint8(tc)
+	l.pushCond(NewCondIPv6(mtc))
+}
+
+func (l *classListener) EnterMatchFlowLabel(ctx *traffic_class.MatchFlowLabelContext) {
+	// Push Selector as Predicate on stack and update the number of Conds on the stack
+	mfl := &IPv6MatchFlowLabel{}
+	fl, err := strconv.ParseUint(ctx.GetStop().GetText(), 16, 20)
+	if err != nil {
+		l.err = serrors.Wrap("Flow label parsing failed!", err,
+			"flowlabel", ctx.GetStop().GetText())
+	}
+	mfl.FlowLabel = uint32(fl)
+	l.pushCond(NewCondIPv6(mfl))
+}
+
+func (l *classListener) EnterMatchNextHeader(ctx *traffic_class.MatchNextHeaderContext) {
+	// Push Selector as Predicate on stack and update the number of Conds on the stack
+	nh := &IPv6MatchNextHeader{}
+	number, err := protocolNameToNumber(ctx.GetStop().GetText())
+	if err != nil {
+		l.err = serrors.Wrap("Next header parsing failed!", err,
+			"nexthdr", ctx.GetStop().GetText())
+	}
+	nh.NextHeader = number
+	l.pushCond(NewCondIPv6(nh))
+}
+
 func (l *classListener) EnterMatchSrcPort(ctx *traffic_class.MatchSrcPortContext) {
 	// Push Selector as Predicate on stack and update the number of Conds on the stack
 	src := &PortMatchSource{}
@@ -190,6 +248,42 @@ func (l *classListener) EnterMatchDstPortRange(ctx *traffic_class.MatchDstPortRa
 	l.pushCond(NewCondPorts(dst))
 }
 
+func (l *classListener) EnterMatchL4Protocol(ctx *traffic_class.MatchL4ProtocolContext) {
+	// Push Selector as Predicate on stack and update the number of Conds on the stack
+	prot := &L4MatchProtocol{}
+	number, err := parseL4Protocol(ctx.GetStop().GetText())
+	if err != nil {
+		l.err = serrors.Wrap("Protocol parsing failed!", err,
+			"protocol", ctx.GetStop().GetText())
+	}
+	prot.Protocol = number
+	l.pushCond(NewCondL4(prot))
+}
+
+func (l *classListener) EnterMatchTCPFlags(ctx *traffic_class.MatchTCPFlagsContext) {
+	// Push Selector as Predicate on stack and update the number of Conds on the stack
+	mflags := &L4MatchTCPFlags{}
+	// The flags are everything after the '=' sign, e.g., "syn|ack".
+	flags := strings.SplitN(ctx.GetText(), "=", 2)[1]
+	var err error
+	mflags.Flags, err = parseTCPFlags(flags)
+	if err != nil {
+		l.err = serrors.Wrap("TCP flags parsing failed!", err, "flags", flags)
+	}
+	l.pushCond(NewCondL4(mflags))
+}
+
+func (l *classListener) EnterMatchICMPType(ctx *traffic_class.MatchICMPTypeContext) {
+	// Push Selector as Predicate on stack and update the number of Conds on the stack
+	mtype, err := parseICMPType(ctx.GetStop().GetText())
+	if err != nil {
+		l.err = serrors.Wrap("ICMP type parsing failed!", err,
+			"type", ctx.GetStop().GetText())
+		mtype = &L4MatchICMPType{}
+	}
+	l.pushCond(NewCondL4(mtype))
+}
+
 func (l *classListener) EnterCondCls(ctx *traffic_class.CondClsContext) {
 	l.pushCond(CondClass{TrafficClass: ctx.GetStop().GetText()})
 }
@@ -79,6 +79,96 @@ func TestTrafficClassValidation(t *testing.T) {
 			Class: "protocol=FOO",
 			Valid: false,
 		},
+		{
+			Name:  "src IPv6Cond",
+			Class: "src=2001:db8::/32",
+			Valid: true,
+		},
+		{
+			Name:  "dst IPv6Cond",
+			Class: "dst=::ffff:10.0.0.0/104",
+			Valid: true,
+		},
+		{
+			Name:  "bad dst IPv6Cond",
+			Class: "dst=2001:db8::",
+			Valid: false,
+		},
+		{
+			Name:  "tc IPv6Cond",
+			Class: "tc=0xb8",
+			Valid: true,
+		},
+		{
+			Name:  "flowlabel IPv6Cond",
+			Class: "flowlabel=0x12345",
+			Valid: true,
+		},
+		{
+			Name:  "flowlabel IPv6Cond too large",
+			Class: "flowlabel=0x123456",
+			Valid: false,
+		},
+		{
+			Name:  "nexthdr IPv6Cond",
+			Class: "nexthdr=udp",
+			Valid: true,
+		},
+		{
+			Name:  "nexthdr IPv6Cond invalid",
+			Class: "nexthdr=FOO",
+			Valid: false,
+		},
+		{
+			Name:  "proto L4Cond",
+			Class: "proto=icmp",
+			Valid: true,
+		},
+		{
+			Name:  "numeric proto L4Cond",
+			Class: "proto=58",
+			Valid: true,
+		},
+		{
+			Name:  "proto L4Cond invalid",
+			Class: "proto=FOO",
+			Valid: false,
+		},
+		{
+			Name:  "tcpflags L4Cond",
+			Class: "tcpflags=syn",
+			Valid: true,
+		},
+		{
+			Name:  "multiple tcpflags L4Cond",
+			Class: "tcpflags=ece|cwr|syn",
+			Valid: true,
+		},
+		{
+			Name:  "bad tcpflags L4Cond",
+			Class: "tcpflags=syn|",
+			Valid: false,
+		},
+		{
+			Name:  "tcpflags L4Cond invalid",
+			Class: "tcpflags=foo",
+			Valid: false,
+		},
+		{
+			Name:  "icmp-type L4Cond",
+			Class: "icmp-type=echo",
+			Valid: true,
+		},
+		{
+			Name:  "numeric icmp-type L4Cond",
+			Class: "icmp-type=42",
+			Valid: true,
+		},
+		{
+			Name:  "icmp-type L4Cond invalid",
+			Class: "icmp-type=foo",
+			Valid: false,
+		},
 		{
 			Name:  "BOOL",
 			Class: "BOOL=true",
@@ -134,6 +224,7 @@ func TestTrafficClassValidation(t *testing.T) {
 }
 
 func TestTrafficClassTree(t *testing.T) {
+	_, net6, _ := net.ParseCIDR("2001:db8::/32")
 	_, net, _ := net.ParseCIDR("12.12.12.0/26")
 	testCases := []struct {
 		Name  string
@@ -257,6 +348,61 @@ func TestTrafficClassTree(t *testing.T) {
 			Class: "protocol=udp",
 			Tree:  pktcls.NewCondIPv4(&pktcls.IPv4MatchProtocol{Protocol: uint8(17)}),
 		},
+		{
+			Name:  "src IPv6Cond",
+			Class: "src=2001:db8::/32",
+			Tree:  pktcls.NewCondIPv6(&pktcls.IPv6MatchSource{Net: net6}),
+		},
+		{
+			Name:  "dst IPv6Cond",
+			Class: "dst=2001:db8::/32",
+			Tree:  pktcls.NewCondIPv6(&pktcls.IPv6MatchDestination{Net: net6}),
+		},
+		{
+			Name:  "tc IPv6Cond",
+			Class: "tc=0xb8",
+			Tree:  pktcls.NewCondIPv6(&pktcls.IPv6MatchTrafficClass{TrafficClass: 0xb8}),
+		},
+		{
+			Name:  "flowlabel IPv6Cond",
+			Class: "flowlabel=0x12345",
+			Tree:  pktcls.NewCondIPv6(&pktcls.IPv6MatchFlowLabel{FlowLabel: 0x12345}),
+		},
+		{
+			Name:  "nexthdr IPv6Cond",
+			Class: "nexthdr=TCP",
+			Tree:  pktcls.NewCondIPv6(&pktcls.IPv6MatchNextHeader{NextHeader: uint8(6)}),
+		},
+		{
+			Name:  "proto L4Cond",
+			Class: "proto=udp",
+			Tree:  pktcls.NewCondL4(&pktcls.L4MatchProtocol{Protocol: uint8(17)}),
+		},
+		{
+			Name:  "tcpflags L4Cond",
+			Class: "tcpflags=SYN|ack",
+			Tree: pktcls.NewCondL4(&pktcls.L4MatchTCPFlags{
+				Flags: pktcls.TCPFlagSYN | pktcls.TCPFlagACK,
+			}),
+		},
+		{
+			Name:  "icmp-type L4Cond",
+			Class: "icmp-type=echo",
+			Tree:  pktcls.NewCondL4(&pktcls.L4MatchICMPType{Name: "echo"}),
+		},
+		{
+			Name:  "numeric icmp-type L4Cond",
+			Class: "ICMP-TYPE=42",
+			Tree:  pktcls.NewCondL4(&pktcls.L4MatchICMPType{ICMPType: 42}),
+		},
+		{
+			Name:  "ANY src IPv4 IPv6",
+			Class: "ANY(src=12.12.12.0/26,src=2001:db8::/32)",
+			Tree: pktcls.CondAnyOf{
+				pktcls.NewCondIPv4(&pktcls.IPv4MatchSource{Net: net}),
+				pktcls.NewCondIPv6(&pktcls.IPv6MatchSource{Net: net6}),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,244 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pktcls
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/google/gopacket/layers"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+// IPv6Predicate describes a single test on various IPv6 packet fields.
+type IPv6Predicate interface {
+	// Eval returns true if the IPv6 packet matched the predicate
+	Eval(*layers.IPv6) bool
+	Typer
+	fmt.Stringer
+}
+
+var _ IPv6Predicate = (*IPv6MatchSource)(nil)
+
+// IPv6MatchSource checks whether the source IPv6 address is contained in Net.
+type IPv6MatchSource struct {
+	Net *net.IPNet
+}
+
+func (m *IPv6MatchSource) Type() string {
+	return TypeIPv6MatchSource
+}
+
+func (m *IPv6MatchSource) Eval(p *layers.IPv6) bool {
+	return m.Net.Contains(p.SrcIP)
+}
+
+func (m *IPv6MatchSource) String() string {
+	if m.Net == nil {
+		return "src="
+	}
+	return fmt.Sprintf("src=%s", m.Net)
+}
+
+func (m *IPv6MatchSource) MarshalJSON() ([]byte, error) {
+	return json.Marshal(
+		jsonContainer{
+			"Net": m.Net.String(),
+		},
+	)
+}
+
+func (m *IPv6MatchSource) UnmarshalJSON(b []byte) error {
+	s, err := unmarshalStringField(b, TypeIPv6MatchSource, "Net")
+	if err != nil {
+		return err
+	}
+	_, network, err := net.ParseCIDR(s)
+	if err != nil {
+		return serrors.Wrap("Unable to parse MatchSourceIPv6 operand", err)
+	}
+	m.Net = network
+	return nil
+}
+
+var _ IPv6Predicate = (*IPv6MatchDestination)(nil)
+
+// IPv6MatchDestination checks whether the destination IPv6 address is contained in
+// Net.
+type IPv6MatchDestination struct {
+	Net *net.IPNet
+}
+
+func (m *IPv6MatchDestination) Type() string {
+	return TypeIPv6MatchDestination
+}
+
+func (m *IPv6MatchDestination) Eval(p *layers.IPv6) bool {
+	return m.Net.Contains(p.DstIP)
+}
+
+func (m *IPv6MatchDestination) String() string {
+	if m.Net == nil {
+		return "dst="
+	}
+	return fmt.Sprintf("dst=%s", m.Net)
+}
+
+func (m *IPv6MatchDestination) MarshalJSON() ([]byte, error) {
+	return json.Marshal(
+		jsonContainer{
+			"Net": m.Net.String(),
+		},
+	)
+}
+
+func (m *IPv6MatchDestination) UnmarshalJSON(b []byte) error {
+	s, err := unmarshalStringField(b, TypeIPv6MatchDestination, "Net")
+	if err != nil {
+		return err
+	}
+	_, network, err := net.ParseCIDR(s)
+	if err != nil {
+		return serrors.Wrap("Unable to parse MatchDestinationIPv6 operand", err)
+	}
+	m.Net = network
+	return nil
+}
+
+var _ IPv6Predicate = (*IPv6MatchTrafficClass)(nil)
+
+// IPv6MatchTrafficClass checks whether the traffic class field matches.
+type IPv6MatchTrafficClass struct {
+	TrafficClass uint8
+}
+
+func (m *IPv6MatchTrafficClass) Type() string {
+	return TypeIPv6MatchTrafficClass
+}
+
+func (m *IPv6MatchTrafficClass) Eval(p *layers.IPv6) bool {
+	return m.TrafficClass == p.TrafficClass
+}
+
+func (m *IPv6MatchTrafficClass) String() string {
+	return fmt.Sprintf("tc=%s", m.toHex())
+}
+
+func (m *IPv6MatchTrafficClass) MarshalJSON() ([]byte, error) {
+	return json.Marshal(
+		jsonContainer{
+			"TrafficClass": m.toHex(),
+		},
+	)
+}
+
+func (m *IPv6MatchTrafficClass) toHex() string {
+	return fmt.Sprintf("%#x", m.TrafficClass)
+}
+
+func (m *IPv6MatchTrafficClass) UnmarshalJSON(b []byte) error {
+	// Format is 0x hex number in quoted string
+	i, err := unmarshalUintField(b, TypeIPv6MatchTrafficClass, "TrafficClass", 8)
+	if err != nil {
+		return err
+	}
+	m.TrafficClass = uint8(i)
+	return nil
+}
+
+var _ IPv6Predicate = (*IPv6MatchFlowLabel)(nil)
+
+// IPv6MatchFlowLabel checks whether the 20-bit flow label matches.
+type IPv6MatchFlowLabel struct {
+	FlowLabel uint32
+}
+
+func (m *IPv6MatchFlowLabel) Type() string {
+	return TypeIPv6MatchFlowLabel
+}
+
+func (m *IPv6MatchFlowLabel) Eval(p *layers.IPv6) bool {
+	return m.FlowLabel == p.FlowLabel
+}
+
+func (m *IPv6MatchFlowLabel) String() string {
+	return fmt.Sprintf("flowlabel=%s", m.toHex())
+}
+
+func (m *IPv6MatchFlowLabel) MarshalJSON() ([]byte, error) {
+	return json.Marshal(
+		jsonContainer{
+			"FlowLabel": m.toHex(),
+		},
+	)
+}
+
+func (m *IPv6MatchFlowLabel) toHex() string {
+	return fmt.Sprintf("%#x", m.FlowLabel)
+}
+
+func (m *IPv6MatchFlowLabel) UnmarshalJSON(b []byte) error {
+	// Format is 0x hex number in quoted string
+	i, err := unmarshalUintField(b, TypeIPv6MatchFlowLabel, "FlowLabel", 20)
+	if err != nil {
+		return err
+	}
+	m.FlowLabel = uint32(i)
+	return nil
+}
+
+var _ IPv6Predicate = (*IPv6MatchNextHeader)(nil)
+
+// IPv6MatchNextHeader checks whether the next header field matches. Note that
+// extension headers are not skipped, i.e., the predicate only inspects the
+// header directly following the fixed IPv6 header.
+type IPv6MatchNextHeader struct {
+	NextHeader uint8
+}
+
+func (m *IPv6MatchNextHeader) Type() string {
+	return TypeIPv6MatchNextHeader
+}
+
+func (m *IPv6MatchNextHeader) Eval(p *layers.IPv6) bool {
+	return m.NextHeader == uint8(p.NextHeader)
+}
+
+func (m *IPv6MatchNextHeader) String() string {
+	return fmt.Sprintf("nexthdr=%s", layers.IPProtocolMetadata[m.NextHeader].Name)
+}
+
+func (m *IPv6MatchNextHeader) MarshalJSON() ([]byte, error) {
+	return json.Marshal(
+		jsonContainer{
+			"NextHeader": layers.IPProtocolMetadata[m.NextHeader].Name,
+		},
+	)
+}
+
+func (m *IPv6MatchNextHeader) UnmarshalJSON(b []byte) error {
+	s, err := unmarshalStringField(b, TypeIPv6MatchNextHeader, "NextHeader")
+	if err != nil {
+		return err
+	}
+	n, err := protocolNameToNumber(s)
+	if err != nil {
+		return err
+	}
+	m.NextHeader = n
+	return nil
+}
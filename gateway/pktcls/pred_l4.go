@@ -0,0 +1,324 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pktcls
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/google/gopacket/layers"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+// TCP flags as they appear in the TCP header.
+const (
+	TCPFlagFIN uint8 = 1 << iota
+	TCPFlagSYN
+	TCPFlagRST
+	TCPFlagPSH
+	TCPFlagACK
+	TCPFlagURG
+	TCPFlagECE
+	TCPFlagCWR
+)
+
+// tcpFlagNames contains the names of the TCP flags, indexed by bit position.
+var tcpFlagNames = [8]string{"fin", "syn", "rst", "psh", "ack", "urg", "ece", "cwr"}
+
+// icmpTypeNames maps names of common ICMPv4 message types to their type number.
+var icmpTypeNames = map[string]uint8{
+	"echoreply":           uint8(layers.ICMPv4TypeEchoReply),
+	"unreachable":         uint8(layers.ICMPv4TypeDestinationUnreachable),
+	"sourcequench":        uint8(layers.ICMPv4TypeSourceQuench),
+	"redirect":            uint8(layers.ICMPv4TypeRedirect),
+	"echo":                uint8(layers.ICMPv4TypeEchoRequest),
+	"routeradvertisement": uint8(layers.ICMPv4TypeRouterAdvertisement),
+	"routersolicitation":  uint8(layers.ICMPv4TypeRouterSolicitation),
+	"timeexceeded":        uint8(layers.ICMPv4TypeTimeExceeded),
+	"parameterproblem":    uint8(layers.ICMPv4TypeParameterProblem),
+	"timestamp":           uint8(layers.ICMPv4TypeTimestampRequest),
+	"timestampreply":      uint8(layers.ICMPv4TypeTimestampReply),
+}
+
+// icmpv6TypeNames maps names of common ICMPv6 message types to their type
+// number. Types that also exist in ICMPv4 have the same name as there.
+var icmpv6TypeNames = map[string]uint8{
+	"unreachable":           layers.ICMPv6TypeDestinationUnreachable,
+	"packettoobig":          layers.ICMPv6TypePacketTooBig,
+	"timeexceeded":          layers.ICMPv6TypeTimeExceeded,
+	"parameterproblem":      layers.ICMPv6TypeParameterProblem,
+	"echo":                  layers.ICMPv6TypeEchoRequest,
+	"echoreply":             layers.ICMPv6TypeEchoReply,
+	"routersolicitation":    layers.ICMPv6TypeRouterSolicitation,
+	"routeradvertisement":   layers.ICMPv6TypeRouterAdvertisement,
+	"neighborsolicitation":  layers.ICMPv6TypeNeighborSolicitation,
+	"neighboradvertisement": layers.ICMPv6TypeNeighborAdvertisement,
+	"redirect":              layers.ICMPv6TypeRedirect,
+}
+
+// L4 represents the transport layer information of a packet, irrespective of
+// the specific L3 protocol.
+type L4 struct {
+	// Protocol is the IPv4 protocol number or the IPv6 next header.
+	Protocol uint8
+	// TCPFlags contains the flags of a TCP segment. It is zero for all other
+	// protocols.
+	TCPFlags uint8
+	// ICMPType contains the type of an ICMPv4 or ICMPv6 message. It is only
+	// valid if Protocol is ICMPv4 or ICMPv6.
+	ICMPType uint8
+}
+
+// L4Predicate describes a single test on transport layer fields.
+type L4Predicate interface {
+	// Eval returns true if the packet matched the predicate
+	Eval(*L4) bool
+	Typer
+	fmt.Stringer
+}
+
+var _ L4Predicate = (*L4MatchProtocol)(nil)
+
+// L4MatchProtocol checks whether the L4 protocol matches. Contrary to
+// IPv4MatchProtocol, it applies to both IPv4 and IPv6 packets, and protocols
+// can also be given by number.
+type L4MatchProtocol struct {
+	Protocol uint8
+}
+
+func (m *L4MatchProtocol) Type() string {
+	return TypeL4MatchProtocol
+}
+
+func (m *L4MatchProtocol) Eval(p *L4) bool {
+	return m.Protocol == p.Protocol
+}
+
+func (m *L4MatchProtocol) String() string {
+	return fmt.Sprintf("proto=%s", l4ProtocolToString(m.Protocol))
+}
+
+func (m *L4MatchProtocol) MarshalJSON() ([]byte, error) {
+	return json.Marshal(
+		jsonContainer{
+			"Protocol": l4ProtocolToString(m.Protocol),
+		},
+	)
+}
+
+func (m *L4MatchProtocol) UnmarshalJSON(b []byte) error {
+	s, err := unmarshalStringField(b, TypeL4MatchProtocol, "Protocol")
+	if err != nil {
+		return err
+	}
+	n, err := parseL4Protocol(s)
+	if err != nil {
+		return err
+	}
+	m.Protocol = n
+	return nil
+}
+
+var _ L4Predicate = (*L4MatchTCPFlags)(nil)
+
+// L4MatchTCPFlags checks whether the packet is a TCP segment with all the
+// flags in Flags set. Flags that are not part of Flags are ignored.
+type L4MatchTCPFlags struct {
+	Flags uint8
+}
+
+func (m *L4MatchTCPFlags) Type() string {
+	return TypeL4MatchTCPFlags
+}
+
+func (m *L4MatchTCPFlags) Eval(p *L4) bool {
+	return p.Protocol == uint8(layers.IPProtocolTCP) && p.TCPFlags&m.Flags == m.Flags
+}
+
+func (m *L4MatchTCPFlags) String() string {
+	return fmt.Sprintf("tcpflags=%s", tcpFlagsToString(m.Flags))
+}
+
+func (m *L4MatchTCPFlags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(
+		jsonContainer{
+			"Flags": tcpFlagsToString(m.Flags),
+		},
+	)
+}
+
+func (m *L4MatchTCPFlags) UnmarshalJSON(b []byte) error {
+	s, err := unmarshalStringField(b, TypeL4MatchTCPFlags, "Flags")
+	if err != nil {
+		return err
+	}
+	flags, err := parseTCPFlags(s)
+	if err != nil {
+		return err
+	}
+	m.Flags = flags
+	return nil
+}
+
+var _ L4Predicate = (*L4MatchICMPType)(nil)
+
+// L4MatchICMPType checks whether the packet is an ICMPv4 or ICMPv6 message of
+// the given type. The type numbers differ between the two protocols, hence a
+// named type is matched against the number it has in the protocol of the
+// message.
+type L4MatchICMPType struct {
+	// Name is the lower case name of the type, e.g., "echo". If it is empty,
+	// ICMPType is used instead.
+	Name string
+	// ICMPType is the type number. It matches ICMPv4 and ICMPv6 messages
+	// alike, the protocol can be restricted with L4MatchProtocol.
+	ICMPType uint8
+}
+
+func (m *L4MatchICMPType) Type() string {
+	return TypeL4MatchICMPType
+}
+
+func (m *L4MatchICMPType) Eval(p *L4) bool {
+	var names map[string]uint8
+	switch p.Protocol {
+	case uint8(layers.IPProtocolICMPv4):
+		names = icmpTypeNames
+	case uint8(layers.IPProtocolICMPv6):
+		names = icmpv6TypeNames
+	default:
+		return false
+	}
+	if m.Name == "" {
+		return p.ICMPType == m.ICMPType
+	}
+	t, ok := names[m.Name]
+	return ok && p.ICMPType == t
+}
+
+func (m *L4MatchICMPType) String() string {
+	return fmt.Sprintf("icmp-type=%s", m.typeString())
+}
+
+func (m *L4MatchICMPType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(
+		jsonContainer{
+			"ICMPType": m.typeString(),
+		},
+	)
+}
+
+func (m *L4MatchICMPType) UnmarshalJSON(b []byte) error {
+	s, err := unmarshalStringField(b, TypeL4MatchICMPType, "ICMPType")
+	if err != nil {
+		return err
+	}
+	t, err := parseICMPType(s)
+	if err != nil {
+		return err
+	}
+	*m = *t
+	return nil
+}
+
+func (m *L4MatchICMPType) typeString() string {
+	if m.Name != "" {
+		return m.Name
+	}
+	return strconv.Itoa(int(m.ICMPType))
+}
+
+// l4ProtocolToString returns the name of the protocol if the name can be
+// expressed in the traffic class language, and its number otherwise. ICMPv4 is
+// named ICMP.
+func l4ProtocolToString(p uint8) string {
+	if p == uint8(layers.IPProtocolICMPv4) {
+		return "ICMP"
+	}
+	name := layers.IPProtocolMetadata[p].Name
+	if name == "UnknownIPProtocol" || strings.IndexFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}) >= 0 {
+		return strconv.Itoa(int(p))
+	}
+	return name
+}
+
+// parseL4Protocol parses a protocol given either by name (e.g. "tcp") or by
+// number. Names are case insensitive.
+func parseL4Protocol(s string) (uint8, error) {
+	if strings.EqualFold(s, "icmp") {
+		return uint8(layers.IPProtocolICMPv4), nil
+	}
+	if n, err := strconv.ParseUint(s, 10, 8); err == nil {
+		return uint8(n), nil
+	}
+	return protocolNameToNumber(s)
+}
+
+// tcpFlagsToString returns the names of the flags joined by '|', e.g.,
+// "syn|ack".
+func tcpFlagsToString(flags uint8) string {
+	var names []string
+	for i, name := range tcpFlagNames {
+		if flags&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// parseTCPFlags parses flag names joined by '|', e.g., "syn|ack". The
+// function is case insensitive.
+func parseTCPFlags(s string) (uint8, error) {
+	var flags uint8
+	for _, name := range strings.Split(s, "|") {
+		flag, err := tcpFlagNameToFlag(name)
+		if err != nil {
+			return 0, err
+		}
+		flags |= flag
+	}
+	return flags, nil
+}
+
+func tcpFlagNameToFlag(name string) (uint8, error) {
+	for i, n := range tcpFlagNames {
+		if strings.EqualFold(name, n) {
+			return 1 << i, nil
+		}
+	}
+	return 0, serrors.New("unknown TCP flag", "name", name)
+}
+
+// parseICMPType parses an ICMP type given either by name (e.g. "echo") or by
+// number. Names are case insensitive and must be known for ICMPv4 or ICMPv6.
+func parseICMPType(s string) (*L4MatchICMPType, error) {
+	name := strings.ToLower(s)
+	_, v4 := icmpTypeNames[name]
+	_, v6 := icmpv6TypeNames[name]
+	if v4 || v6 {
+		return &L4MatchICMPType{Name: name}, nil
+	}
+	t, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return nil, serrors.New("unknown ICMP type", "type", s)
+	}
+	return &L4MatchICMPType{ICMPType: uint8(t)}, nil
+}
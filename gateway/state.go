@@ -0,0 +1,163 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"sync"
+
+	"github.com/scionproto/scion/gateway/control"
+	"github.com/scionproto/scion/gateway/routing"
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+// state holds the components of a running gateway that expose its state. The
+// components are set while the gateway starts up.
+type state struct {
+	mtx                 sync.RWMutex
+	configPublisher     *control.ConfigPublisher
+	sessionConfigurator *control.SessionConfigurator
+	engineController    *control.EngineController
+	policyUpdater       *control.PolicyUpdater
+}
+
+func (s *state) setConfigPublisher(p *control.ConfigPublisher) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.configPublisher = p
+}
+
+func (s *state) setSessionConfigurator(sc *control.SessionConfigurator) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.sessionConfigurator = sc
+}
+
+func (s *state) setEngineController(c *control.EngineController) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.engineController = c
+}
+
+func (s *state) setPolicyUpdater(u *control.PolicyUpdater) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.policyUpdater = u
+}
+
+// RemoteGateways returns the discovered remote gateways and the prefixes
+// learned from them. The returned object must not be modified.
+func (g *Gateway) RemoteGateways() control.RemoteGateways {
+	g.state.mtx.RLock()
+	defer g.state.mtx.RUnlock()
+	if g.state.sessionConfigurator == nil {
+		return control.RemoteGateways{}
+	}
+	return g.state.sessionConfigurator.RemoteGateways()
+}
+
+// Sessions returns the state of the sessions to the remote gateways.
+func (g *Gateway) Sessions() []control.SessionInfo {
+	g.state.mtx.RLock()
+	defer g.state.mtx.RUnlock()
+	if g.state.engineController == nil {
+		return nil
+	}
+	return g.state.engineController.Sessions()
+}
+
+// RoutingChains returns the routing chains of the routing table in use.
+func (g *Gateway) RoutingChains() []control.RoutingChainInfo {
+	g.state.mtx.RLock()
+	defer g.state.mtx.RUnlock()
+	if g.state.engineController == nil {
+		return nil
+	}
+	return g.state.engineController.RoutingChains()
+}
+
+// SessionPolicies returns the loaded traffic policy.
+func (g *Gateway) SessionPolicies() control.SessionPolicies {
+	g.state.mtx.RLock()
+	defer g.state.mtx.RUnlock()
+	if g.state.configPublisher == nil {
+		return nil
+	}
+	return g.state.configPublisher.SessionPolicies()
+}
+
+// RoutingPolicy returns the loaded IP routing policy.
+func (g *Gateway) RoutingPolicy() *routing.Policy {
+	g.state.mtx.RLock()
+	defer g.state.mtx.RUnlock()
+	if g.state.configPublisher == nil {
+		return nil
+	}
+	return g.state.configPublisher.RoutingPolicy()
+}
+
+// UpdateTrafficPolicy replaces the traffic policy with the raw document, see
+// control.PolicyUpdater.UpdateSessionPolicies.
+func (g *Gateway) UpdateTrafficPolicy(
+	ctx context.Context,
+	raw []byte,
+	dryRun bool,
+) (control.PolicyUpdate, error) {
+
+	u, err := g.policyUpdater()
+	if err != nil {
+		return control.PolicyUpdate{}, err
+	}
+	return u.UpdateSessionPolicies(ctx, raw, dryRun)
+}
+
+// PatchTrafficPolicy applies the JSON merge patch to the traffic policy, see
+// control.PolicyUpdater.PatchSessionPolicies.
+func (g *Gateway) PatchTrafficPolicy(
+	ctx context.Context,
+	patch []byte,
+	dryRun bool,
+) (control.PolicyUpdate, error) {
+
+	u, err := g.policyUpdater()
+	if err != nil {
+		return control.PolicyUpdate{}, err
+	}
+	return u.PatchSessionPolicies(ctx, patch, dryRun)
+}
+
+// UpdateRoutingPolicy replaces the IP routing policy with the raw policy, see
+// control.PolicyUpdater.UpdateRoutingPolicy.
+func (g *Gateway) UpdateRoutingPolicy(
+	ctx context.Context,
+	raw []byte,
+	dryRun bool,
+) (control.PolicyUpdate, error) {
+
+	u, err := g.policyUpdater()
+	if err != nil {
+		return control.PolicyUpdate{}, err
+	}
+	return u.UpdateRoutingPolicy(ctx, raw, dryRun)
+}
+
+func (g *Gateway) policyUpdater() (*control.PolicyUpdater, error) {
+	g.state.mtx.RLock()
+	defer g.state.mtx.RUnlock()
+	if g.state.policyUpdater == nil {
+		return nil, serrors.New("gateway not started")
+	}
+	return g.state.policyUpdater, nil
+}
@@ -17,10 +17,12 @@ package gateway
 import (
 	"context"
 	"fmt"
+	"net"
 	"sync"
 
 	"github.com/scionproto/scion/gateway/control"
 	controlgrpc "github.com/scionproto/scion/gateway/control/grpc"
+	gwdrkey "github.com/scionproto/scion/gateway/drkey"
 	"github.com/scionproto/scion/gateway/pathhealth/policies"
 	"github.com/scionproto/scion/pkg/addr"
 	libgrpc "github.com/scionproto/scion/pkg/grpc"
@@ -79,6 +81,23 @@ func (f *prefixFetcher) Close() error {
 	return nil
 }
 
+// frameKeyRegistrar registers the remote gateways that accept encrypted frames
+// with the frame key provider, so that the keys for them are fetched. The
+// prefixes are passed on unchanged.
+type frameKeyRegistrar struct {
+	control.PrefixConsumer
+	FrameKeys *gwdrkey.Provider
+}
+
+func (r frameKeyRegistrar) Prefixes(remote addr.IA, gateway control.Gateway,
+	prefixes []*net.IPNet) error {
+
+	if gateway.FrameEncryption && gateway.Data != nil {
+		r.FrameKeys.Register(remote, gateway.Data.IP)
+	}
+	return r.PrefixConsumer.Prefixes(remote, gateway, prefixes)
+}
+
 type WatcherFactory struct {
 	Dialer      libgrpc.Dialer
 	PathMonitor control.PathMonitor
@@ -71,6 +71,9 @@ type Connector interface {
 	Interfaces(ctx context.Context) (map[uint16]netip.AddrPort, error)
 	// Paths requests from the daemon a set of end to end paths between the source and destination.
 	Paths(ctx context.Context, dst, src addr.IA, f PathReqFlags) ([]snet.Path, error)
+	// WatchPaths subscribes to the set of end to end paths between the source
+	// and destination. The subscription ends when the context is canceled.
+	WatchPaths(ctx context.Context, dst, src addr.IA) (PathWatcher, error)
 	// ASInfo requests from the daemon information about AS ia, the zero IA can be
 	// use to detect the local IA.
 	ASInfo(ctx context.Context, ia addr.IA) (ASInfo, error)
@@ -91,3 +94,12 @@ type Connector interface {
 	// Close shuts down the connection to the daemon.
 	Close() error
 }
+
+// PathWatcher receives updates of a set of paths from the daemon.
+type PathWatcher interface {
+	// Next blocks until the daemon announces a new set of paths and returns
+	// the complete set. The first call returns the current set of paths. An
+	// error is returned if the subscription ended, e.g., because the context
+	// passed to WatchPaths was canceled.
+	Next() ([]snet.Path, error)
+}
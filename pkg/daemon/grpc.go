@@ -124,6 +124,18 @@ func (c grpcConn) Paths(ctx context.Context, dst, src addr.IA,
 	return paths, err
 }
 
+func (c grpcConn) WatchPaths(ctx context.Context, dst, src addr.IA) (PathWatcher, error) {
+	client := sdpb.NewDaemonServiceClient(c.conn)
+	stream, err := client.WatchPaths(ctx, &sdpb.WatchPathsRequest{
+		SourceIsdAs:      uint64(src),
+		DestinationIsdAs: uint64(dst),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return grpcPathWatcher{stream: stream, dst: dst}, nil
+}
+
 func (c grpcConn) ASInfo(ctx context.Context, ia addr.IA) (ASInfo, error) {
 	client := sdpb.NewDaemonServiceClient(c.conn)
 	response, err := client.AS(ctx, &sdpb.ASRequest{IsdAs: uint64(ia)})
@@ -228,6 +240,19 @@ func (c grpcConn) Close() error {
 	return c.conn.Close()
 }
 
+type grpcPathWatcher struct {
+	stream sdpb.DaemonService_WatchPathsClient
+	dst    addr.IA
+}
+
+func (w grpcPathWatcher) Next() ([]snet.Path, error) {
+	response, err := w.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return pathResponseToPaths(response.Paths, w.dst)
+}
+
 func pathResponseToPaths(paths []*sdpb.Path, dst addr.IA) ([]snet.Path, error) {
 	result := make([]snet.Path, 0, len(paths))
 	for _, p := range paths {
@@ -203,3 +203,18 @@ func (mr *MockConnectorMockRecorder) SVCInfo(arg0, arg1 interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SVCInfo", reflect.TypeOf((*MockConnector)(nil).SVCInfo), arg0, arg1)
 }
+
+// WatchPaths mocks base method.
+func (m *MockConnector) WatchPaths(arg0 context.Context, arg1, arg2 addr.IA) (daemon.PathWatcher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchPaths", arg0, arg1, arg2)
+	ret0, _ := ret[0].(daemon.PathWatcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchPaths indicates an expected call of WatchPaths.
+func (mr *MockConnectorMockRecorder) WatchPaths(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchPaths", reflect.TypeOf((*MockConnector)(nil).WatchPaths), arg0, arg1, arg2)
+}
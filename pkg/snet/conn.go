@@ -17,6 +17,7 @@ package snet
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"time"
 
@@ -39,6 +40,40 @@ func (e *OpError) Error() string {
 	return e.typeCode.String()
 }
 
+// PacketTooBigError is returned when an SCMP Packet Too Big message is
+// received, i.e., when a packet that was sent earlier exceeded the MTU of a
+// link on its path.
+type PacketTooBigError struct {
+	// MTU is the MTU of the link that the packet did not fit on.
+	MTU uint16
+	// Source is the address of the router that dropped the packet.
+	Source SCIONAddress
+
+	// hopFields are the hop fields of the path of the dropped packet, as
+	// quoted in the SCMP message.
+	hopFields []byte
+}
+
+// Matches reports whether the dropped packet was sent over the path, e.g., to
+// lower the MTU recorded for the path in a PathMTUCache. The path is
+// identified by the hop fields of the quoted packet, because the fingerprint
+// of a path cannot be derived from the dataplane path alone. If the quoted
+// packet was truncated before the end of its path, or if it used a path type
+// other than SCION, no path matches.
+func (e *PacketTooBigError) Matches(path Path) bool {
+	return path != nil && sameHopFields(path.Dataplane(), e.hopFields)
+}
+
+func (e *PacketTooBigError) Error() string {
+	return fmt.Sprintf("packet too big: mtu=%d source=%s", e.MTU, e.Source)
+}
+
+// Temporary indicates that the connection remains usable, only the packet that
+// was too big was dropped.
+func (e *PacketTooBigError) Temporary() bool {
+	return true
+}
+
 var _ net.Conn = (*Conn)(nil)
 var _ net.PacketConn = (*Conn)(nil)
 
@@ -96,3 +96,17 @@ func NewSCMPErrors(opts ...Option) metrics.Counter {
 		Name: "lib_snet_scmp_error_total",
 		Help: "Total number of SCMP errors"})
 }
+
+func NewSCMPVerificationMetrics(opts ...Option) snet.SCMPVerificationMetrics {
+	o := apply(opts)
+	auto := promauto.With(o.registry)
+
+	return snet.SCMPVerificationMetrics{
+		Accepted: auto.NewCounter(prometheus.CounterOpts{
+			Name: "lib_snet_scmp_auth_accepted_total",
+			Help: "Total number of SCMP messages with valid authentication"}),
+		Rejected: auto.NewCounter(prometheus.CounterOpts{
+			Name: "lib_snet_scmp_auth_rejected_total",
+			Help: "Total number of SCMP messages dropped due to missing or invalid authentication"}),
+	}
+}
@@ -0,0 +1,461 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snet
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/pkg/slayers"
+)
+
+const (
+	// DefaultPathRefreshInterval is the default interval in which the paths of
+	// a MultipathConn are refreshed.
+	DefaultPathRefreshInterval = time.Minute
+	// DefaultInterfaceDownTimeout is the default duration for which paths
+	// through an interface that was reported down by SCMP are not used.
+	DefaultInterfaceDownTimeout = 10 * time.Second
+	// DefaultRedundancy is the default number of paths a packet is sent on with
+	// SelectRedundant.
+	DefaultRedundancy = 2
+)
+
+// ErrNoUsablePath indicates that a MultipathConn currently has no path to the
+// remote that can be used.
+var ErrNoUsablePath = serrors.New("no usable path")
+
+// PathPolicy filters the paths a MultipathConn may use. The paths are used in
+// the order in which they are returned. It is implemented by pathpol.Policy.
+type PathPolicy interface {
+	Filter(paths []Path) []Path
+}
+
+// PathSelection determines on which paths a MultipathConn sends a packet.
+type PathSelection int
+
+const (
+	// SelectRoundRobin sends consecutive packets on consecutive paths.
+	SelectRoundRobin PathSelection = iota
+	// SelectSticky sends all packets on the same path for as long as it can be
+	// used. Once it can no longer be used, the first usable path is picked.
+	SelectSticky
+	// SelectRedundant sends every packet on multiple paths at once. Duplicates
+	// are not filtered on the receiving side.
+	SelectRedundant
+)
+
+// MultipathOption is a functional option type for configuring a MultipathConn.
+type MultipathOption func(o *multipathOptions)
+
+// WithPathPolicy sets the policy the paths must satisfy.
+func WithPathPolicy(policy PathPolicy) MultipathOption {
+	return func(o *multipathOptions) {
+		o.policy = policy
+	}
+}
+
+// WithPathSelection sets how the paths are chosen for the packets.
+func WithPathSelection(selection PathSelection) MultipathOption {
+	return func(o *multipathOptions) {
+		o.selection = selection
+	}
+}
+
+// WithRedundancy sets the number of paths a packet is sent on with
+// SelectRedundant. Values smaller than 1 are ignored.
+func WithRedundancy(n int) MultipathOption {
+	return func(o *multipathOptions) {
+		if n > 0 {
+			o.redundancy = n
+		}
+	}
+}
+
+// WithPathRefreshInterval sets the interval in which the paths are refreshed.
+// Values smaller or equal to 0 are ignored.
+func WithPathRefreshInterval(interval time.Duration) MultipathOption {
+	return func(o *multipathOptions) {
+		if interval > 0 {
+			o.refreshInterval = interval
+		}
+	}
+}
+
+// WithInterfaceDownTimeout sets for how long the paths through an interface
+// that was reported down are not used. Values smaller or equal to 0 are
+// ignored.
+func WithInterfaceDownTimeout(timeout time.Duration) MultipathOption {
+	return func(o *multipathOptions) {
+		if timeout > 0 {
+			o.downTimeout = timeout
+		}
+	}
+}
+
+type multipathOptions struct {
+	policy          PathPolicy
+	selection       PathSelection
+	redundancy      int
+	refreshInterval time.Duration
+	downTimeout     time.Duration
+}
+
+func applyMultipath(opts []MultipathOption) multipathOptions {
+	o := multipathOptions{
+		selection:       SelectRoundRobin,
+		redundancy:      DefaultRedundancy,
+		refreshInterval: DefaultPathRefreshInterval,
+		downTimeout:     DefaultInterfaceDownTimeout,
+	}
+	for _, option := range opts {
+		option(&o)
+	}
+	return o
+}
+
+var _ net.Conn = (*MultipathConn)(nil)
+
+// MultipathConn is a connection to a fixed remote that is not bound to a single
+// path. It keeps a pool of paths to the remote that is periodically refreshed
+// with the path querier, and chooses the paths for every written packet. Paths
+// through interfaces that are reported down via SCMP are not used until the
+// report times out, or until the refreshed paths no longer contain them.
+//
+// Reads are not affected by the paths; they return the packets received from
+// any address, like the reads of Conn.
+type MultipathConn struct {
+	conn    *Conn
+	remote  *UDPAddr
+	querier PathQuerier
+	opts    multipathOptions
+
+	mtx   sync.Mutex
+	paths []Path
+	down  map[downKey]time.Time
+	// next is the index of the next path used with SelectRoundRobin.
+	next int
+	// current is the path used with SelectSticky.
+	current PathFingerprint
+
+	refresh chan struct{}
+	// cancel cancels the context of the refresh goroutine, including a
+	// refresh that is in progress.
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// downKey identifies an interface that was reported down. For the internal
+// connectivity between two interfaces of an AS, ifID and peerIfID are the two
+// interfaces. For an external interface, peerIfID is 0.
+type downKey struct {
+	ia       addr.IA
+	ifID     iface.ID
+	peerIfID iface.ID
+}
+
+// NewMultipathConn returns a MultipathConn to remote. The paths are obtained
+// from the querier; the context is only used for the initial query, which must
+// yield at least one usable path. The path and next hop of remote are ignored.
+//
+// If pconn is a *SCIONPacketConn, the connection reads and writes through a
+// copy of it, whose SCMP handler is wrapped so that the connection learns about
+// interfaces that are down; pconn itself is not modified. For other packet
+// connections, the handler returned by SCMPHandler should be installed.
+func NewMultipathConn(
+	ctx context.Context,
+	pconn PacketConn,
+	topo Topology,
+	remote *UDPAddr,
+	querier PathQuerier,
+	options ...MultipathOption,
+) (*MultipathConn, error) {
+
+	if remote == nil {
+		return nil, serrors.New("Unable to dial to nil remote")
+	}
+	if querier == nil {
+		return nil, serrors.New("path querier must not be nil")
+	}
+	c := &MultipathConn{
+		remote:  remote.Copy(),
+		querier: querier,
+		opts:    applyMultipath(options),
+		down:    make(map[downKey]time.Time),
+		refresh: make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	c.remote.Path, c.remote.NextHop = nil, nil
+	if spconn, ok := pconn.(*SCIONPacketConn); ok {
+		wrapped := *spconn
+		wrapped.SCMPHandler = c.SCMPHandler(spconn.SCMPHandler)
+		pconn = &wrapped
+	}
+	conn, err := NewCookedConn(pconn, topo, WithRemote(c.remote))
+	if err != nil {
+		return nil, err
+	}
+	c.conn = conn
+	if err := c.refreshPaths(ctx); err != nil {
+		return nil, err
+	}
+	if len(c.Paths()) == 0 {
+		return nil, serrors.Wrap("initializing paths", ErrNoUsablePath, "remote", remote)
+	}
+	runCtx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	go func() {
+		defer log.HandlePanic()
+		c.run(runCtx)
+	}()
+	return c, nil
+}
+
+// Paths returns the paths that can currently be used, in the order of
+// preference.
+func (c *MultipathConn) Paths() []Path {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.usable(time.Now())
+}
+
+// Write sends b to the remote on the paths chosen by the path selection. It
+// succeeds if b is sent on at least one path.
+func (c *MultipathConn) Write(b []byte) (int, error) {
+	paths := c.selectPaths()
+	if len(paths) == 0 {
+		c.triggerRefresh()
+		return 0, ErrNoUsablePath
+	}
+	var errs serrors.List
+	for _, path := range paths {
+		if _, err := c.WriteVia(b, path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == len(paths) {
+		return 0, errs.ToError()
+	}
+	return len(b), nil
+}
+
+// WriteVia sends b to the remote on the given path. The path does not need to
+// be one of the paths of the connection.
+func (c *MultipathConn) WriteVia(b []byte, path Path) (int, error) {
+	dst := c.remote.Copy()
+	dst.Path = path.Dataplane()
+	dst.NextHop = CopyUDPAddr(path.UnderlayNextHop())
+	return c.conn.WriteTo(b, dst)
+}
+
+// Read reads data into b. See Conn.Read.
+func (c *MultipathConn) Read(b []byte) (int, error) {
+	return c.conn.Read(b)
+}
+
+// SCMPHandler returns an SCMP handler that records the interfaces reported
+// down for the connection, and then passes the packet on to next. If next is
+// nil, the packet is dropped after recording.
+func (c *MultipathConn) SCMPHandler(next SCMPHandler) SCMPHandler {
+	return multipathSCMPHandler{conn: c, next: next}
+}
+
+func (c *MultipathConn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+func (c *MultipathConn) RemoteAddr() net.Addr {
+	return c.remote
+}
+
+func (c *MultipathConn) SetDeadline(t time.Time) error {
+	return c.conn.SetDeadline(t)
+}
+
+func (c *MultipathConn) SetReadDeadline(t time.Time) error {
+	return c.conn.SetReadDeadline(t)
+}
+
+func (c *MultipathConn) SetWriteDeadline(t time.Time) error {
+	return c.conn.SetWriteDeadline(t)
+}
+
+// Close stops the path refreshing, aborting a refresh that is in progress, and
+// closes the underlying connection.
+func (c *MultipathConn) Close() error {
+	c.cancel()
+	<-c.done
+	return c.conn.Close()
+}
+
+func (c *MultipathConn) run(runCtx context.Context) {
+	defer close(c.done)
+	ticker := time.NewTicker(c.opts.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-runCtx.Done():
+			return
+		case <-ticker.C:
+		case <-c.refresh:
+		}
+		ctx, cancel := context.WithTimeout(runCtx, c.opts.refreshInterval)
+		if err := c.refreshPaths(ctx); err != nil {
+			log.Info("Failed to refresh paths", "remote", c.remote, "err", err)
+		}
+		cancel()
+	}
+}
+
+// triggerRefresh requests a refresh of the paths without waiting for it.
+func (c *MultipathConn) triggerRefresh() {
+	select {
+	case c.refresh <- struct{}{}:
+	default:
+	}
+}
+
+func (c *MultipathConn) refreshPaths(ctx context.Context) error {
+	paths, err := c.querier.Query(ctx, c.remote.IA)
+	if err != nil {
+		return serrors.Wrap("querying paths", err, "isd_as", c.remote.IA)
+	}
+	if c.opts.policy != nil {
+		paths = c.opts.policy.Filter(paths)
+	}
+	now := time.Now()
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.paths = paths
+	for k, expiry := range c.down {
+		if now.After(expiry) {
+			delete(c.down, k)
+		}
+	}
+	return nil
+}
+
+// selectPaths returns the paths to send the next packet on.
+func (c *MultipathConn) selectPaths() []Path {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	paths := c.usable(time.Now())
+	if len(paths) == 0 {
+		return nil
+	}
+	switch c.opts.selection {
+	case SelectSticky:
+		for _, path := range paths {
+			if Fingerprint(path) == c.current {
+				return []Path{path}
+			}
+		}
+		c.current = Fingerprint(paths[0])
+		return paths[:1]
+	case SelectRedundant:
+		return paths[:min(c.opts.redundancy, len(paths))]
+	default:
+		path := paths[c.next%len(paths)]
+		c.next = (c.next + 1) % len(paths)
+		return []Path{path}
+	}
+}
+
+// usable returns the paths that are neither expired nor traverse an interface
+// that is down. The caller must hold the lock.
+func (c *MultipathConn) usable(now time.Time) []Path {
+	usable := make([]Path, 0, len(c.paths))
+	for _, path := range c.paths {
+		md := path.Metadata()
+		if md != nil && !md.Expiry.IsZero() && now.After(md.Expiry) {
+			continue
+		}
+		if c.traversesDown(path, now) {
+			continue
+		}
+		usable = append(usable, path)
+	}
+	return usable
+}
+
+// traversesDown checks whether the path traverses an interface that is
+// currently reported down. The caller must hold the lock.
+func (c *MultipathConn) traversesDown(path Path, now time.Time) bool {
+	if len(c.down) == 0 {
+		return false
+	}
+	md := path.Metadata()
+	if md == nil {
+		return false
+	}
+	isDown := func(k downKey) bool {
+		expiry, ok := c.down[k]
+		return ok && !now.After(expiry)
+	}
+	for i, intf := range md.Interfaces {
+		if isDown(downKey{ia: intf.IA, ifID: intf.ID}) {
+			return true
+		}
+		if i > 0 && md.Interfaces[i-1].IA == intf.IA &&
+			isDown(newInternalDownKey(intf.IA, md.Interfaces[i-1].ID, intf.ID)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *MultipathConn) setDown(k downKey) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.down[k] = time.Now().Add(c.opts.downTimeout)
+	// The daemon may already know alternative paths that avoid the interface.
+	c.triggerRefresh()
+}
+
+func newInternalDownKey(ia addr.IA, a, b iface.ID) downKey {
+	if a > b {
+		a, b = b, a
+	}
+	return downKey{ia: ia, ifID: a, peerIfID: b}
+}
+
+type multipathSCMPHandler struct {
+	conn *MultipathConn
+	next SCMPHandler
+}
+
+func (h multipathSCMPHandler) Handle(pkt *Packet) error {
+	switch msg := pkt.Payload.(type) {
+	case SCMPExternalInterfaceDown:
+		h.conn.setDown(downKey{ia: msg.IA, ifID: iface.ID(msg.Interface)})
+	case SCMPInternalConnectivityDown:
+		h.conn.setDown(newInternalDownKey(msg.IA, iface.ID(msg.Ingress),
+			iface.ID(msg.Egress)))
+	}
+	if h.next == nil {
+		if scmp, ok := pkt.Payload.(SCMPPayload); ok {
+			log.Debug("Ignoring scmp packet", "scmp",
+				slayers.CreateSCMPTypeCode(scmp.Type(), scmp.Code()), "src", pkt.Source)
+		}
+		return nil
+	}
+	return h.next.Handle(pkt)
+}
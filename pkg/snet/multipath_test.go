@@ -0,0 +1,282 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snet_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/pkg/snet"
+	"github.com/scionproto/scion/pkg/snet/mock_snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+)
+
+var (
+	mpLocalIA  = addr.MustParseIA("1-ff00:0:110")
+	mpRemoteIA = addr.MustParseIA("1-ff00:0:111")
+)
+
+type staticTopology struct{}
+
+func (staticTopology) LocalIA(context.Context) (addr.IA, error) {
+	return mpLocalIA, nil
+}
+
+func (staticTopology) PortRange(context.Context) (uint16, uint16, error) {
+	return 31000, 32767, nil
+}
+
+func (staticTopology) Interfaces(context.Context) (map[uint16]netip.AddrPort, error) {
+	return nil, nil
+}
+
+// pathFilter is a path policy that drops the paths via the given next hop
+// port.
+type pathFilter struct {
+	port int
+}
+
+func (f pathFilter) Filter(paths []snet.Path) []snet.Path {
+	var filtered []snet.Path
+	for _, p := range paths {
+		if p.UnderlayNextHop().Port != f.port {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered
+}
+
+// testPath returns a path that leaves the local AS via the interface with the
+// given ID. The next hop port equals the interface ID, so that the path used
+// for a packet can be identified.
+func testPath(ifID uint16) snet.Path {
+	return snetpath.Path{
+		Src:           mpLocalIA,
+		Dst:           mpRemoteIA,
+		DataplanePath: snetpath.SCION{Raw: []byte{}},
+		NextHop:       &net.UDPAddr{IP: net.IP{127, 0, 0, 1}, Port: int(ifID)},
+		Meta: snet.PathMetadata{
+			Interfaces: []snet.PathInterface{
+				{IA: mpLocalIA, ID: iface.ID(ifID)},
+				{IA: mpRemoteIA, ID: iface.ID(ifID + 10)},
+			},
+		},
+	}
+}
+
+// newTestMultipathConn creates a connection with the paths via interfaces 1,
+// 2 and 3. It returns the connection and a function that returns the next hop
+// ports of the packets written so far.
+func newTestMultipathConn(
+	t *testing.T,
+	ctrl *gomock.Controller,
+	options ...snet.MultipathOption,
+) (*snet.MultipathConn, func() []int) {
+
+	var mtx sync.Mutex
+	var written []int
+	pconn := mock_snet.NewMockPacketConn(ctrl)
+	pconn.EXPECT().LocalAddr().Return(
+		&net.UDPAddr{IP: net.IP{127, 0, 0, 1}, Port: 31000},
+	).AnyTimes()
+	pconn.EXPECT().WriteTo(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ *snet.Packet, nextHop *net.UDPAddr) error {
+			mtx.Lock()
+			defer mtx.Unlock()
+			written = append(written, nextHop.Port)
+			return nil
+		},
+	).AnyTimes()
+	pconn.EXPECT().Close().AnyTimes()
+
+	querier := mock_snet.NewMockPathQuerier(ctrl)
+	querier.EXPECT().Query(gomock.Any(), mpRemoteIA).Return(
+		[]snet.Path{testPath(1), testPath(2), testPath(3)}, nil,
+	).AnyTimes()
+
+	remote := &snet.UDPAddr{
+		IA:   mpRemoteIA,
+		Host: &net.UDPAddr{IP: net.IP{127, 0, 0, 2}, Port: 40000},
+	}
+	conn, err := snet.NewMultipathConn(context.Background(), pconn, staticTopology{},
+		remote, querier, options...)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn, func() []int {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return append([]int(nil), written...)
+	}
+}
+
+func writeN(t *testing.T, conn *snet.MultipathConn, n int) {
+	for i := 0; i < n; i++ {
+		_, err := conn.Write([]byte("hello"))
+		require.NoError(t, err)
+	}
+}
+
+func interfaceDown(conn *snet.MultipathConn, ia addr.IA, ifID uint64) {
+	pkt := &snet.Packet{
+		PacketInfo: snet.PacketInfo{
+			Payload: snet.SCMPExternalInterfaceDown{IA: ia, Interface: ifID},
+		},
+	}
+	_ = conn.SCMPHandler(nil).Handle(pkt)
+}
+
+func TestMultipathConnRoundRobin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	conn, written := newTestMultipathConn(t, ctrl)
+
+	writeN(t, conn, 6)
+	assert.Equal(t, []int{1, 2, 3, 1, 2, 3}, written())
+}
+
+func TestMultipathConnSticky(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	conn, written := newTestMultipathConn(t, ctrl, snet.WithPathSelection(snet.SelectSticky))
+
+	writeN(t, conn, 2)
+	// The interface of the first path is reported down, the second path is
+	// used from now on, even after the first one is usable again.
+	interfaceDown(conn, mpLocalIA, 1)
+	assert.Len(t, conn.Paths(), 2)
+	writeN(t, conn, 2)
+	assert.Equal(t, []int{1, 1, 2, 2}, written())
+}
+
+func TestMultipathConnRedundant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	conn, written := newTestMultipathConn(t, ctrl,
+		snet.WithPathSelection(snet.SelectRedundant),
+		snet.WithRedundancy(2),
+	)
+
+	writeN(t, conn, 1)
+	assert.Equal(t, []int{1, 2}, written())
+
+	interfaceDown(conn, mpRemoteIA, 11)
+	writeN(t, conn, 1)
+	assert.Equal(t, []int{1, 2, 2, 3}, written())
+}
+
+func TestMultipathConnPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	conn, written := newTestMultipathConn(t, ctrl, snet.WithPathPolicy(pathFilter{port: 2}))
+
+	writeN(t, conn, 4)
+	assert.Equal(t, []int{1, 3, 1, 3}, written())
+}
+
+func TestMultipathConnNoPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	pconn := mock_snet.NewMockPacketConn(ctrl)
+	pconn.EXPECT().LocalAddr().Return(
+		&net.UDPAddr{IP: net.IP{127, 0, 0, 1}, Port: 31000},
+	).AnyTimes()
+	querier := mock_snet.NewMockPathQuerier(ctrl)
+	querier.EXPECT().Query(gomock.Any(), mpRemoteIA).Return(nil, nil)
+
+	remote := &snet.UDPAddr{
+		IA:   mpRemoteIA,
+		Host: &net.UDPAddr{IP: net.IP{127, 0, 0, 2}, Port: 40000},
+	}
+	_, err := snet.NewMultipathConn(context.Background(), pconn, staticTopology{},
+		remote, querier)
+	assert.ErrorIs(t, err, snet.ErrNoUsablePath)
+}
+
+func TestMultipathConnKeepsSCMPHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	udpConn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IP{127, 0, 0, 1}})
+	require.NoError(t, err)
+	handler := snet.DefaultSCMPHandler{}
+	pconn := &snet.SCIONPacketConn{Conn: udpConn, SCMPHandler: handler}
+
+	querier := mock_snet.NewMockPathQuerier(ctrl)
+	querier.EXPECT().Query(gomock.Any(), mpRemoteIA).Return(
+		[]snet.Path{testPath(1)}, nil,
+	).AnyTimes()
+
+	remote := &snet.UDPAddr{
+		IA:   mpRemoteIA,
+		Host: &net.UDPAddr{IP: net.IP{127, 0, 0, 2}, Port: 40000},
+	}
+	conn, err := snet.NewMultipathConn(context.Background(), pconn, staticTopology{},
+		remote, querier)
+	require.NoError(t, err)
+	defer conn.Close()
+	assert.Equal(t, handler, pconn.SCMPHandler)
+}
+
+func TestMultipathConnCloseAbortsRefresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	pconn := mock_snet.NewMockPacketConn(ctrl)
+	pconn.EXPECT().LocalAddr().Return(
+		&net.UDPAddr{IP: net.IP{127, 0, 0, 1}, Port: 31000},
+	).AnyTimes()
+	pconn.EXPECT().Close()
+
+	querier := mock_snet.NewMockPathQuerier(ctrl)
+	refreshing := make(chan struct{})
+	gomock.InOrder(
+		querier.EXPECT().Query(gomock.Any(), mpRemoteIA).Return(
+			[]snet.Path{testPath(1)}, nil,
+		),
+		// The refresh blocks until its context is canceled.
+		querier.EXPECT().Query(gomock.Any(), mpRemoteIA).DoAndReturn(
+			func(ctx context.Context, _ addr.IA) ([]snet.Path, error) {
+				close(refreshing)
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+		),
+	)
+
+	remote := &snet.UDPAddr{
+		IA:   mpRemoteIA,
+		Host: &net.UDPAddr{IP: net.IP{127, 0, 0, 2}, Port: 40000},
+	}
+	conn, err := snet.NewMultipathConn(context.Background(), pconn, staticTopology{},
+		remote, querier)
+	require.NoError(t, err)
+	// Without a usable path, a write triggers a refresh.
+	interfaceDown(conn, mpLocalIA, 1)
+	_, err = conn.Write([]byte("hello"))
+	require.ErrorIs(t, err, snet.ErrNoUsablePath)
+	<-refreshing
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		assert.NoError(t, conn.Close())
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("Close did not abort the refresh")
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package path
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/common"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/pkg/snet"
+)
+
+// RecordingVersion is the version of the recording format written by
+// WriteRecording.
+const RecordingVersion = 1
+
+// Recording is a set of paths captured at a point in time, including the raw
+// dataplane paths and the metadata. It can be stored in a file and later be
+// used as a path source instead of the SCION Daemon, e.g., to reproduce a
+// problem with a specific set of paths.
+//
+// Recording implements snet.PathQuerier. The recorded paths are returned as
+// is, even if they are expired.
+type Recording struct {
+	Version int `json:"version"`
+	// Recorded is the time the paths were captured.
+	Recorded time.Time `json:"recorded"`
+	// LocalIA is the ISD-AS the paths were captured in.
+	LocalIA addr.IA        `json:"local_isd_as"`
+	Paths   []RecordedPath `json:"paths"`
+}
+
+// RecordedPath is the serializable form of a path.
+type RecordedPath struct {
+	Src addr.IA `json:"src"`
+	Dst addr.IA `json:"dst"`
+	// Raw is the raw SCION dataplane path. It is empty for paths within the
+	// local AS.
+	Raw     []byte `json:"raw,omitempty"`
+	NextHop string `json:"next_hop,omitempty"`
+
+	Interfaces   []RecordedInterface `json:"interfaces,omitempty"`
+	MTU          uint16              `json:"mtu"`
+	Expiry       time.Time           `json:"expiry"`
+	Latency      []time.Duration     `json:"latency_ns,omitempty"`
+	Bandwidth    []uint64            `json:"bandwidth_kbps,omitempty"`
+	Geo          []RecordedGeo       `json:"geo,omitempty"`
+	LinkType     []snet.LinkType     `json:"link_type,omitempty"`
+	InternalHops []uint32            `json:"internal_hops,omitempty"`
+	Notes        []string            `json:"notes,omitempty"`
+	EpicAuthPHVF []byte              `json:"epic_auth_phvf,omitempty"`
+	EpicAuthLHVF []byte              `json:"epic_auth_lhvf,omitempty"`
+}
+
+// RecordedInterface is the serializable form of a path interface.
+type RecordedInterface struct {
+	IA addr.IA  `json:"isd_as"`
+	ID iface.ID `json:"id"`
+}
+
+// RecordedGeo is the serializable form of geographic coordinates.
+type RecordedGeo struct {
+	Latitude  float32 `json:"latitude"`
+	Longitude float32 `json:"longitude"`
+	Address   string  `json:"address,omitempty"`
+}
+
+// NewRecording captures the paths. Only paths with a SCION or empty dataplane
+// path can be recorded.
+func NewRecording(localIA addr.IA, paths []snet.Path) (*Recording, error) {
+	r := &Recording{
+		Version:  RecordingVersion,
+		Recorded: time.Now().UTC(),
+		LocalIA:  localIA,
+		Paths:    make([]RecordedPath, 0, len(paths)),
+	}
+	for i, p := range paths {
+		rp, err := recordPath(p)
+		if err != nil {
+			return nil, serrors.Wrap("recording path", err, "index", i)
+		}
+		r.Paths = append(r.Paths, rp)
+	}
+	return r, nil
+}
+
+func recordPath(p snet.Path) (RecordedPath, error) {
+	rp := RecordedPath{
+		Src: p.Source(),
+		Dst: p.Destination(),
+	}
+	switch dp := p.Dataplane().(type) {
+	case SCION:
+		rp.Raw = append([]byte(nil), dp.Raw...)
+	case Empty:
+	default:
+		return RecordedPath{}, serrors.New("unsupported dataplane path",
+			"type", common.TypeOf(dp))
+	}
+	if nextHop := p.UnderlayNextHop(); nextHop != nil {
+		rp.NextHop = nextHop.String()
+	}
+	md := p.Metadata()
+	if md == nil {
+		return rp, nil
+	}
+	for _, intf := range md.Interfaces {
+		rp.Interfaces = append(rp.Interfaces, RecordedInterface{IA: intf.IA, ID: intf.ID})
+	}
+	for _, g := range md.Geo {
+		rp.Geo = append(rp.Geo, RecordedGeo{
+			Latitude:  g.Latitude,
+			Longitude: g.Longitude,
+			Address:   g.Address,
+		})
+	}
+	rp.MTU = md.MTU
+	rp.Expiry = md.Expiry
+	rp.Latency = md.Latency
+	rp.Bandwidth = md.Bandwidth
+	rp.LinkType = md.LinkType
+	rp.InternalHops = md.InternalHops
+	rp.Notes = md.Notes
+	rp.EpicAuthPHVF = md.EpicAuths.AuthPHVF
+	rp.EpicAuthLHVF = md.EpicAuths.AuthLHVF
+	return rp, nil
+}
+
+// Path converts the recorded path back to a path.
+func (rp RecordedPath) Path() (Path, error) {
+	p := Path{
+		Src: rp.Src,
+		Dst: rp.Dst,
+		Meta: snet.PathMetadata{
+			MTU:          rp.MTU,
+			Expiry:       rp.Expiry,
+			Latency:      rp.Latency,
+			Bandwidth:    rp.Bandwidth,
+			LinkType:     rp.LinkType,
+			InternalHops: rp.InternalHops,
+			Notes:        rp.Notes,
+			EpicAuths: snet.EpicAuths{
+				AuthPHVF: rp.EpicAuthPHVF,
+				AuthLHVF: rp.EpicAuthLHVF,
+			},
+		},
+		DataplanePath: Empty{},
+	}
+	if len(rp.Raw) > 0 {
+		p.DataplanePath = SCION{Raw: append([]byte(nil), rp.Raw...)}
+	}
+	if rp.NextHop != "" {
+		nextHop, err := net.ResolveUDPAddr("udp", rp.NextHop)
+		if err != nil {
+			return Path{}, serrors.Wrap("resolving next hop", err, "next_hop", rp.NextHop)
+		}
+		p.NextHop = nextHop
+	}
+	for _, intf := range rp.Interfaces {
+		p.Meta.Interfaces = append(p.Meta.Interfaces, snet.PathInterface{IA: intf.IA, ID: intf.ID})
+	}
+	for _, g := range rp.Geo {
+		p.Meta.Geo = append(p.Meta.Geo, snet.GeoCoordinates{
+			Latitude:  g.Latitude,
+			Longitude: g.Longitude,
+			Address:   g.Address,
+		})
+	}
+	return p, nil
+}
+
+// Query returns the recorded paths to the destination.
+func (r *Recording) Query(_ context.Context, dst addr.IA) ([]snet.Path, error) {
+	var paths []snet.Path
+	for i, rp := range r.Paths {
+		if rp.Dst != dst {
+			continue
+		}
+		p, err := rp.Path()
+		if err != nil {
+			return nil, serrors.Wrap("converting recorded path", err, "index", i)
+		}
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
+
+// WriteRecording writes the recording as JSON to the writer.
+func WriteRecording(w io.Writer, r *Recording) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// ReadRecording reads a recording written by WriteRecording.
+func ReadRecording(rd io.Reader) (*Recording, error) {
+	var r Recording
+	if err := json.NewDecoder(rd).Decode(&r); err != nil {
+		return nil, serrors.Wrap("decoding recording", err)
+	}
+	if r.Version != RecordingVersion {
+		return nil, serrors.New("unsupported recording version",
+			"version", r.Version, "supported", RecordingVersion)
+	}
+	return &r, nil
+}
+
+// LoadRecording reads a recording from a file.
+func LoadRecording(file string) (*Recording, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, serrors.Wrap("opening recording", err)
+	}
+	defer f.Close()
+	return ReadRecording(f)
+}
+
+var _ snet.PathQuerier = (*Recording)(nil)
@@ -0,0 +1,97 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package path_test
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+)
+
+func TestRecordingRoundTrip(t *testing.T) {
+	local := addr.MustParseIA("1-ff00:0:110")
+	remote := addr.MustParseIA("1-ff00:0:111")
+	expiry := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	scionPath := snetpath.Path{
+		Src:           local,
+		Dst:           remote,
+		DataplanePath: snetpath.SCION{Raw: []byte{1, 2, 3, 4}},
+		NextHop:       &net.UDPAddr{IP: net.ParseIP("10.0.0.1"), Port: 30042},
+		Meta: snet.PathMetadata{
+			Interfaces: []snet.PathInterface{
+				{IA: local, ID: 1},
+				{IA: remote, ID: 2},
+			},
+			MTU:          1400,
+			Expiry:       expiry,
+			Latency:      []time.Duration{5 * time.Millisecond},
+			Bandwidth:    []uint64{1000},
+			Geo:          []snet.GeoCoordinates{{Latitude: 47.3, Longitude: 8.5, Address: "Zurich"}},
+			LinkType:     []snet.LinkType{snet.LinkTypeDirect},
+			InternalHops: []uint32{},
+			Notes:        []string{"note"},
+			EpicAuths: snet.EpicAuths{
+				AuthPHVF: []byte{5},
+				AuthLHVF: []byte{6},
+			},
+		},
+	}
+	localPath := snetpath.Path{
+		Src:           local,
+		Dst:           local,
+		DataplanePath: snetpath.Empty{},
+		Meta:          snet.PathMetadata{MTU: 1472, Expiry: expiry},
+	}
+
+	rec, err := snetpath.NewRecording(local, []snet.Path{scionPath, localPath})
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, snetpath.WriteRecording(&buf, rec))
+	loaded, err := snetpath.ReadRecording(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, local, loaded.LocalIA)
+
+	paths, err := loaded.Query(context.Background(), remote)
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	got := paths[0].(snetpath.Path)
+	// Empty slices are not recorded.
+	scionPath.Meta.InternalHops = nil
+	assert.Equal(t, scionPath, got)
+	assert.Equal(t, snet.Fingerprint(scionPath), snet.Fingerprint(got))
+
+	paths, err = loaded.Query(context.Background(), local)
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	assert.Equal(t, localPath, paths[0])
+}
+
+func TestRecordingUnsupported(t *testing.T) {
+	p := snetpath.Path{DataplanePath: snetpath.OneHop{}}
+	_, err := snetpath.NewRecording(addr.MustParseIA("1-ff00:0:110"), []snet.Path{p})
+	assert.Error(t, err)
+
+	_, err = snetpath.ReadRecording(bytes.NewBufferString(`{"version": 42}`))
+	assert.Error(t, err)
+}
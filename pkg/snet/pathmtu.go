@@ -0,0 +1,400 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snet
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/private/topology"
+)
+
+const (
+	// DefaultMTUProbeTimeout is the default time to wait for the reply to a
+	// path MTU probe.
+	DefaultMTUProbeTimeout = time.Second
+	// DefaultMTUProbeAttempts is the default number of probes sent for a size
+	// before the size is considered too big.
+	DefaultMTUProbeAttempts = 2
+	// DefaultMTUResolution is the default precision, in bytes, of the
+	// discovered path MTU.
+	DefaultMTUResolution = 8
+)
+
+// PathMTUCache remembers the MTU of paths that was validated by path MTU
+// discovery or learned from SCMP Packet Too Big messages. Paths are identified
+// by their fingerprint; paths without interface metadata are not cached. An
+// entry expires together with the path it was learned for.
+type PathMTUCache struct {
+	mtx     sync.Mutex
+	entries map[PathFingerprint]pathMTUEntry
+}
+
+type pathMTUEntry struct {
+	mtu    uint16
+	expiry time.Time
+}
+
+// Set records the MTU that was validated for the path.
+func (c *PathMTUCache) Set(path Path, mtu uint16) {
+	fp := Fingerprint(path)
+	if fp == "" {
+		return
+	}
+	now := time.Now()
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[PathFingerprint]pathMTUEntry)
+	}
+	for k, e := range c.entries {
+		if !e.expiry.IsZero() && now.After(e.expiry) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[fp] = pathMTUEntry{mtu: mtu, expiry: path.Metadata().Expiry}
+}
+
+// Lower records that packets larger than mtu do not pass through the path,
+// e.g., as reported by a PacketTooBigError. It never raises the recorded MTU.
+func (c *PathMTUCache) Lower(path Path, mtu uint16) {
+	if current := c.MTU(path); current != 0 && current <= mtu {
+		return
+	}
+	c.Set(path, mtu)
+}
+
+// Get returns the recorded MTU of the path, if any.
+func (c *PathMTUCache) Get(path Path) (uint16, bool) {
+	fp := Fingerprint(path)
+	if fp == "" {
+		return 0, false
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	e, ok := c.entries[fp]
+	if !ok || (!e.expiry.IsZero() && time.Now().After(e.expiry)) {
+		return 0, false
+	}
+	return e.mtu, true
+}
+
+// MTU returns the recorded MTU of the path. If none is recorded, the MTU
+// advertised in the path metadata is returned.
+func (c *PathMTUCache) MTU(path Path) uint16 {
+	if mtu, ok := c.Get(path); ok {
+		return mtu
+	}
+	if md := path.Metadata(); md != nil {
+		return md.MTU
+	}
+	return 0
+}
+
+// MaxUDPPayload returns the largest SCION/UDP payload between src and dst that
+// fits into a packet of size mtu with the given path.
+func MaxUDPPayload(mtu int, src, dst SCIONAddress, path DataplanePath) (int, error) {
+	pkt := &Packet{
+		PacketInfo: PacketInfo{
+			Source:      src,
+			Destination: dst,
+			Path:        path,
+			Payload:     UDPPayload{},
+		},
+	}
+	if err := pkt.Serialize(); err != nil {
+		return 0, serrors.Wrap("serializing packet", err)
+	}
+	return mtu - len(pkt.Bytes), nil
+}
+
+// PathMTUDiscoverer performs packetization-layer path MTU discovery: it sends
+// SCMP echo requests of different sizes to a remote host and determines the
+// largest packet size that is echoed back. SCMP Packet Too Big messages
+// received for the probes are used to narrow down the search.
+type PathMTUDiscoverer struct {
+	// Topology provides local AS information.
+	Topology Topology
+	// Local is the IP address the probes are sent from.
+	Local netip.Addr
+	// Cache, if set, records the discovered MTUs.
+	Cache *PathMTUCache
+	// ProbeTimeout is the time to wait for the reply to a probe. If zero,
+	// DefaultMTUProbeTimeout is used.
+	ProbeTimeout time.Duration
+	// Attempts is the number of probes sent for a size before the size is
+	// considered too big. If zero, DefaultMTUProbeAttempts is used.
+	Attempts int
+	// Resolution is the precision, in bytes, of the discovered MTU. If zero,
+	// DefaultMTUResolution is used.
+	Resolution int
+}
+
+// mtuProbeReply is the outcome of a probe as reported by the SCMP handler.
+type mtuProbeReply struct {
+	// seq is the sequence number of the probe, as echoed or quoted in the
+	// Packet Too Big message.
+	seq uint16
+	// tooBig is set if a Packet Too Big message was received, in which case
+	// mtu is the reported MTU.
+	tooBig bool
+	mtu    uint16
+}
+
+// Discover determines the MTU of the path to the remote host. The search is
+// bounded by the MTU advertised in the path metadata. It returns an error if
+// not even the smallest probe is echoed back.
+func (d *PathMTUDiscoverer) Discover(
+	ctx context.Context,
+	remote SCIONAddress,
+	path Path,
+) (uint16, error) {
+
+	md := path.Metadata()
+	if md == nil || md.MTU == 0 {
+		return 0, serrors.New("path MTU is not advertised")
+	}
+	replies := make(chan mtuProbeReply, 10)
+	handler := &mtuProbeHandler{replies: replies}
+	network := &SCIONNetwork{Topology: d.Topology, SCMPHandler: handler}
+	conn, err := network.OpenRaw(ctx, net.UDPAddrFromAddrPort(netip.AddrPortFrom(d.Local, 0)))
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	port := conn.LocalAddr().(*net.UDPAddr).Port
+	handler.id = uint16(port)
+	localIA, err := d.Topology.LocalIA(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	go func() {
+		defer log.HandlePanic()
+		// Reading dispatches the SCMP messages to the handler. It stops once
+		// the connection is closed.
+		for {
+			var pkt Packet
+			var ov net.UDPAddr
+			if err := conn.ReadFrom(&pkt, &ov); errors.Is(err, net.ErrClosed) {
+				return
+			}
+		}
+	}()
+
+	p := &mtuProber{
+		conn:     conn,
+		local:    addr.Addr{IA: localIA, Host: addr.HostIP(d.Local)},
+		remote:   remote,
+		path:     path,
+		id:       uint16(port),
+		replies:  replies,
+		timeout:  d.ProbeTimeout,
+		attempts: d.Attempts,
+	}
+	if p.timeout == 0 {
+		p.timeout = DefaultMTUProbeTimeout
+	}
+	if p.attempts == 0 {
+		p.attempts = DefaultMTUProbeAttempts
+	}
+	resolution := d.Resolution
+	if resolution == 0 {
+		resolution = DefaultMTUResolution
+	}
+	if p.overhead, err = p.size(0); err != nil {
+		return 0, err
+	}
+
+	mtu, err := p.search(ctx, int(md.MTU), resolution)
+	if err != nil {
+		return 0, err
+	}
+	if d.Cache != nil {
+		d.Cache.Set(path, mtu)
+	}
+	return mtu, nil
+}
+
+type mtuProber struct {
+	conn     PacketConn
+	local    SCIONAddress
+	remote   SCIONAddress
+	path     Path
+	id       uint16
+	replies  <-chan mtuProbeReply
+	timeout  time.Duration
+	attempts int
+	// overhead is the size of an echo request without payload.
+	overhead int
+	seq      uint16
+}
+
+// search performs a binary search for the largest size in [overhead, upper]
+// that passes through the path.
+func (p *mtuProber) search(ctx context.Context, upper, resolution int) (uint16, error) {
+	// lo is the largest size known to pass, hi the smallest known to fail.
+	lo, hi := 0, upper+1
+	next := upper
+	for hi-lo > resolution && next > lo {
+		next = max(next, p.overhead)
+		ok, hint, err := p.probe(ctx, next)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			lo = next
+		} else {
+			hi = next
+		}
+		switch {
+		case hint > lo && hint < hi:
+			// Probe the size reported by the router first, it most likely
+			// passes.
+			next = hint
+		case next == p.overhead && !ok:
+			return 0, serrors.New("no probe was echoed back", "remote", p.remote)
+		default:
+			next = lo + (hi-lo)/2
+		}
+	}
+	if lo == 0 {
+		return 0, serrors.New("no probe was echoed back", "remote", p.remote)
+	}
+	return uint16(lo), nil
+}
+
+// probe sends probes of the given packet size until one is echoed back or the
+// attempts are exhausted. It returns whether the size passes and the MTU
+// reported in a Packet Too Big message, if any.
+func (p *mtuProber) probe(ctx context.Context, size int) (bool, int, error) {
+	for i := 0; i < p.attempts; i++ {
+		p.seq++
+		if err := p.send(size); err != nil {
+			// A local error, e.g., the underlay refusing the size, counts as
+			// a failed probe.
+			log.FromCtx(ctx).Debug("Failed to send path MTU probe", "size", size, "err", err)
+			return false, 0, nil
+		}
+		timer := time.NewTimer(p.timeout)
+	wait:
+		for {
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return false, 0, ctx.Err()
+			case <-timer.C:
+				break wait
+			case r := <-p.replies:
+				// Replies to earlier probes, e.g., of other sizes, are
+				// ignored.
+				if r.seq != p.seq {
+					continue
+				}
+				timer.Stop()
+				if r.tooBig {
+					return false, int(r.mtu), nil
+				}
+				return true, 0, nil
+			}
+		}
+	}
+	return false, 0, nil
+}
+
+func (p *mtuProber) send(size int) error {
+	pkt, err := p.packet(size - p.overhead)
+	if err != nil {
+		return err
+	}
+	nextHop := p.path.UnderlayNextHop()
+	if nextHop == nil && p.local.IA.Equal(p.remote.IA) {
+		nextHop = &net.UDPAddr{
+			IP:   p.remote.Host.IP().AsSlice(),
+			Port: topology.EndhostPort,
+			Zone: p.remote.Host.IP().Zone(),
+		}
+	}
+	return p.conn.WriteTo(pkt, nextHop)
+}
+
+// size returns the size of a probe with the given payload size.
+func (p *mtuProber) size(payload int) (int, error) {
+	pkt, err := p.packet(payload)
+	if err != nil {
+		return 0, err
+	}
+	if err := pkt.Serialize(); err != nil {
+		return 0, serrors.Wrap("serializing probe", err)
+	}
+	return len(pkt.Bytes), nil
+}
+
+func (p *mtuProber) packet(payload int) (*Packet, error) {
+	if payload < 0 {
+		return nil, serrors.New("probe size below minimum", "payload", payload)
+	}
+	return &Packet{
+		PacketInfo: PacketInfo{
+			Destination: p.remote,
+			Source:      p.local,
+			Path:        p.path.Dataplane(),
+			Payload: SCMPEchoRequest{
+				Identifier: p.id,
+				SeqNumber:  p.seq,
+				Payload:    make([]byte, payload),
+			},
+		},
+	}, nil
+}
+
+// mtuProbeHandler forwards the echo replies and Packet Too Big messages for
+// the probes to the prober. Packet Too Big messages are attributed to a probe
+// by the echo request they quote.
+type mtuProbeHandler struct {
+	id      uint16
+	replies chan<- mtuProbeReply
+}
+
+func (h *mtuProbeHandler) Handle(pkt *Packet) error {
+	var r mtuProbeReply
+	switch msg := pkt.Payload.(type) {
+	case SCMPEchoReply:
+		if msg.Identifier != h.id {
+			return nil
+		}
+		r = mtuProbeReply{seq: msg.SeqNumber}
+	case SCMPPacketTooBig:
+		echo, err := quotedEcho(msg.Payload)
+		if err != nil || echo.Identifier != h.id {
+			return nil
+		}
+		r = mtuProbeReply{seq: echo.SeqNumber, tooBig: true, mtu: msg.MTU}
+	default:
+		return nil
+	}
+	select {
+	case h.replies <- r:
+	default:
+	}
+	return nil
+}
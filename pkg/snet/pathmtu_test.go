@@ -0,0 +1,297 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snet_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/pkg/slayers"
+	"github.com/scionproto/scion/pkg/slayers/path"
+	"github.com/scionproto/scion/pkg/slayers/path/scion"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+)
+
+func TestPathMTUCache(t *testing.T) {
+	p := testPath(1).(snetpath.Path)
+	p.Meta.MTU = 1400
+	p.Meta.Expiry = time.Now().Add(time.Hour)
+
+	var c snet.PathMTUCache
+	_, ok := c.Get(p)
+	assert.False(t, ok)
+	assert.Equal(t, uint16(1400), c.MTU(p))
+
+	c.Lower(p, 1500)
+	_, ok = c.Get(p)
+	assert.False(t, ok, "the advertised MTU is lower")
+
+	c.Lower(p, 1300)
+	assert.Equal(t, uint16(1300), c.MTU(p))
+	c.Lower(p, 1350)
+	assert.Equal(t, uint16(1300), c.MTU(p))
+
+	c.Set(p, 1350)
+	assert.Equal(t, uint16(1350), c.MTU(p))
+
+	// Entries of a path expire with the path.
+	expired := p
+	expired.Meta.Expiry = time.Now().Add(-time.Second)
+	c.Set(expired, 1200)
+	_, ok = c.Get(expired)
+	assert.False(t, ok)
+}
+
+func TestDefaultSCMPHandlerPacketTooBig(t *testing.T) {
+	src := addr.Addr{IA: mpRemoteIA, Host: addr.MustParseHost("10.0.0.1")}
+	pkt := &snet.Packet{
+		PacketInfo: snet.PacketInfo{
+			Source:  src,
+			Payload: snet.SCMPPacketTooBig{MTU: 1280},
+		},
+	}
+	assert.NoError(t, snet.DefaultSCMPHandler{}.Handle(pkt))
+
+	err := snet.DefaultSCMPHandler{ReportPacketTooBig: true}.Handle(pkt)
+	var tooBig *snet.PacketTooBigError
+	require.ErrorAs(t, err, &tooBig)
+	assert.Equal(t, uint16(1280), tooBig.MTU)
+	assert.Equal(t, src, tooBig.Source)
+	assert.True(t, tooBig.Temporary())
+}
+
+func TestPacketTooBigErrorMatches(t *testing.T) {
+	sent := testSCIONPath(t, 1, 0)
+	// Routers update the path meta header and the segment IDs on the way.
+	traversed := testSCIONPath(t, 1, 1)
+	other := testSCIONPath(t, 2, 0)
+
+	quote := func(dp snet.DataplanePath) []byte {
+		pkt := &snet.Packet{
+			PacketInfo: snet.PacketInfo{
+				Source:      addr.Addr{IA: mpLocalIA, Host: addr.MustParseHost("10.0.0.1")},
+				Destination: addr.Addr{IA: mpRemoteIA, Host: addr.MustParseHost("10.0.0.2")},
+				Path:        dp,
+				Payload:     snet.UDPPayload{SrcPort: 1, DstPort: 2, Payload: make([]byte, 100)},
+			},
+		}
+		require.NoError(t, pkt.Serialize())
+		return pkt.Bytes
+	}
+	tooBig := func(quote []byte) *snet.PacketTooBigError {
+		pkt := &snet.Packet{
+			PacketInfo: snet.PacketInfo{
+				Payload: snet.SCMPPacketTooBig{MTU: 1280, Payload: quote},
+			},
+		}
+		err := snet.DefaultSCMPHandler{ReportPacketTooBig: true}.Handle(pkt)
+		var tooBig *snet.PacketTooBigError
+		require.ErrorAs(t, err, &tooBig)
+		return tooBig
+	}
+	withPath := func(dp snet.DataplanePath) snet.Path {
+		return snetpath.Path{Src: mpLocalIA, Dst: mpRemoteIA, DataplanePath: dp}
+	}
+
+	err := tooBig(quote(traversed))
+	assert.True(t, err.Matches(withPath(sent)))
+	assert.False(t, err.Matches(withPath(other)))
+	assert.False(t, err.Matches(withPath(snetpath.Empty{})))
+
+	// Without the full path in the quote, no path matches.
+	assert.False(t, tooBig(quote(traversed)[:40]).Matches(withPath(sent)))
+}
+
+// testSCIONPath returns a SCION path with a single segment of two hops. The
+// first hop leaves via ifID, the segment ID and current hop field are set to
+// the given progress.
+func testSCIONPath(t *testing.T, ifID uint16, progress uint8) snetpath.SCION {
+	d := scion.Decoded{
+		Base: scion.Base{
+			PathMeta: scion.MetaHdr{CurrHF: progress, SegLen: [3]uint8{2, 0, 0}},
+			NumINF:   1,
+			NumHops:  2,
+		},
+		InfoFields: []path.InfoField{{ConsDir: true, SegID: 0x100 + uint16(progress)}},
+		HopFields: []path.HopField{
+			{ConsEgress: ifID, ExpTime: 63, Mac: [path.MacLen]byte{1, 2, 3, 4, 5, 6}},
+			{ConsIngress: ifID + 10, ExpTime: 63, Mac: [path.MacLen]byte{6, 5, 4, 3, 2, 1}},
+		},
+	}
+	p, err := snetpath.NewSCIONFromDecoded(d)
+	require.NoError(t, err)
+	return p
+}
+
+// mtuResponder echoes SCMP echo requests up to a given size. For larger
+// requests, it either replies with Packet Too Big or silently drops them.
+type mtuResponder struct {
+	conn       *net.UDPConn
+	mtu        int
+	sendTooBig bool
+	routerAddr addr.Addr
+	// strayTooBig makes the responder precede every reply with a Packet Too
+	// Big message for an echo request with a different identifier.
+	strayTooBig bool
+}
+
+func newMTUResponder(t *testing.T, mtu int, sendTooBig, strayTooBig bool) *mtuResponder {
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IP{127, 0, 0, 1}})
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	r := &mtuResponder{
+		conn:        conn,
+		mtu:         mtu,
+		sendTooBig:  sendTooBig,
+		routerAddr:  addr.Addr{IA: mpLocalIA, Host: addr.MustParseHost("127.0.0.2")},
+		strayTooBig: strayTooBig,
+	}
+	go r.run()
+	return r
+}
+
+func (r *mtuResponder) run() {
+	buf := make([]byte, 9000)
+	for {
+		n, from, err := r.conn.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+		pkt := &snet.Packet{Bytes: append([]byte(nil), buf[:n]...)}
+		if err := pkt.Decode(); err != nil {
+			continue
+		}
+		req, ok := pkt.Payload.(snet.SCMPEchoRequest)
+		if !ok {
+			continue
+		}
+		reply := &snet.Packet{
+			PacketInfo: snet.PacketInfo{
+				Source:      pkt.Destination,
+				Destination: pkt.Source,
+				Path:        snetpath.Empty{},
+				Payload: snet.SCMPEchoReply{
+					Identifier: req.Identifier,
+					SeqNumber:  req.SeqNumber,
+					Payload:    req.Payload,
+				},
+			},
+		}
+		if r.strayTooBig {
+			r.sendStrayTooBig(pkt, req, from)
+		}
+		if n > r.mtu {
+			if !r.sendTooBig {
+				continue
+			}
+			reply.Source = r.routerAddr
+			reply.Payload = snet.SCMPPacketTooBig{MTU: uint16(r.mtu), Payload: buf[:64]}
+		}
+		if err := reply.Serialize(); err != nil {
+			continue
+		}
+		_, _ = r.conn.WriteToUDP(reply.Bytes, from)
+	}
+}
+
+// sendStrayTooBig sends a Packet Too Big message that quotes the request with
+// a different identifier, as if it was sent by another prober.
+func (r *mtuResponder) sendStrayTooBig(
+	pkt *snet.Packet,
+	req snet.SCMPEchoRequest,
+	to *net.UDPAddr,
+) {
+	stray := &snet.Packet{PacketInfo: pkt.PacketInfo}
+	req.Identifier++
+	stray.Path = snetpath.Empty{}
+	stray.Payload = req
+	if err := stray.Serialize(); err != nil {
+		return
+	}
+	tooBig := &snet.Packet{
+		PacketInfo: snet.PacketInfo{
+			Source:      r.routerAddr,
+			Destination: pkt.Source,
+			Path:        snetpath.Empty{},
+			Payload:     snet.SCMPPacketTooBig{MTU: 1000, Payload: stray.Bytes[:64]},
+		},
+	}
+	if err := tooBig.Serialize(); err != nil {
+		return
+	}
+	_, _ = r.conn.WriteToUDP(tooBig.Bytes, to)
+}
+
+func TestPathMTUDiscoverer(t *testing.T) {
+	tests := map[string]struct {
+		sendTooBig  bool
+		strayTooBig bool
+	}{
+		"packet too big":       {sendTooBig: true},
+		"silent drop":          {},
+		"stray packet too big": {sendTooBig: true, strayTooBig: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			responder := newMTUResponder(t, 1320, tc.sendTooBig, tc.strayTooBig)
+			path := snetpath.Path{
+				Src:           mpLocalIA,
+				Dst:           mpLocalIA,
+				DataplanePath: snetpath.Empty{},
+				NextHop:       responder.conn.LocalAddr().(*net.UDPAddr),
+				Meta: snet.PathMetadata{
+					MTU:    1500,
+					Expiry: time.Now().Add(time.Hour),
+					Interfaces: []snet.PathInterface{
+						{IA: mpLocalIA, ID: iface.ID(1)},
+					},
+				},
+			}
+			cache := &snet.PathMTUCache{}
+			d := snet.PathMTUDiscoverer{
+				Topology:     staticTopology{},
+				Local:        netip.MustParseAddr("127.0.0.1"),
+				Cache:        cache,
+				ProbeTimeout: 50 * time.Millisecond,
+				Resolution:   1,
+			}
+			remote := addr.Addr{IA: mpLocalIA, Host: addr.MustParseHost("127.0.0.1")}
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			mtu, err := d.Discover(ctx, remote, path)
+			require.NoError(t, err)
+			assert.Equal(t, uint16(1320), mtu)
+			assert.Equal(t, uint16(1320), cache.MTU(path))
+		})
+	}
+}
+
+func TestMaxUDPPayload(t *testing.T) {
+	src := addr.Addr{IA: mpLocalIA, Host: addr.MustParseHost("10.0.0.1")}
+	dst := addr.Addr{IA: mpLocalIA, Host: addr.MustParseHost("10.0.0.2")}
+	payload, err := snet.MaxUDPPayload(1400, src, dst, snetpath.Empty{})
+	require.NoError(t, err)
+	// Common header, address header with two IPv4 addresses and UDP header.
+	assert.Equal(t, 1400-slayers.CmnHdrLen-(16+8)-8, payload)
+}
@@ -15,9 +15,12 @@
 package snet
 
 import (
+	"bytes"
 	"context"
 	"time"
 
+	"github.com/google/gopacket"
+
 	"github.com/scionproto/scion/pkg/log"
 	"github.com/scionproto/scion/pkg/metrics/v2"
 	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt"
@@ -25,6 +28,8 @@ import (
 	"github.com/scionproto/scion/pkg/private/util"
 	"github.com/scionproto/scion/pkg/segment/iface"
 	"github.com/scionproto/scion/pkg/slayers"
+	"github.com/scionproto/scion/pkg/slayers/path"
+	"github.com/scionproto/scion/pkg/slayers/path/scion"
 )
 
 // RevocationHandler is called by the default SCMP Handler whenever revocations are encountered.
@@ -58,6 +63,10 @@ type DefaultSCMPHandler struct {
 	RevocationHandler RevocationHandler
 	// SCMPErrors reports the total number of SCMP Errors encountered.
 	SCMPErrors metrics.Counter
+	// ReportPacketTooBig enables returning Packet Too Big messages as
+	// *PacketTooBigError. If false, they are ignored, so that callers that do
+	// not expect them are not disrupted.
+	ReportPacketTooBig bool
 }
 
 func (h DefaultSCMPHandler) Handle(pkt *Packet) error {
@@ -86,8 +95,19 @@ func (h DefaultSCMPHandler) Handle(pkt *Packet) error {
 			RawTimestamp: util.TimeToSecs(time.Now()),
 			RawTTL:       10,
 		})
+	case slayers.SCMPTypePacketTooBig:
+		if !h.ReportPacketTooBig {
+			log.Debug("Ignoring scmp packet", "scmp", typeCode, "src", pkt.Source)
+			return nil
+		}
+		msg := pkt.Payload.(SCMPPacketTooBig)
+		tooBig := &PacketTooBigError{MTU: msg.MTU, Source: pkt.Source}
+		if quote, err := decodeQuote(msg.Payload); err == nil {
+			tooBig.hopFields = hopFields(quote)
+		}
+		return tooBig
 	default:
-		// Only handle connectivity down for now
+		// Only handle connectivity down and packet too big for now
 		log.Debug("Ignoring scmp packet", "scmp", typeCode, "src", pkt.Source)
 		return nil
 	}
@@ -124,3 +144,68 @@ func (h SCMPPropagationStopper) Handle(pkt *Packet) error {
 	}
 	return nil
 }
+
+// decodeQuote decodes the SCION header of the packet quoted in an SCMP error
+// message. The payload of the returned header is the possibly truncated L4
+// header and payload of the quoted packet.
+func decodeQuote(quote []byte) (*slayers.SCION, error) {
+	var scn slayers.SCION
+	if err := scn.DecodeFromBytes(quote, gopacket.NilDecodeFeedback); err != nil {
+		return nil, serrors.Wrap("decoding quoted packet", err)
+	}
+	return &scn, nil
+}
+
+// quotedEcho decodes the SCMP echo request quoted in an SCMP error message.
+func quotedEcho(quote []byte) (*slayers.SCMPEcho, error) {
+	scn, err := decodeQuote(quote)
+	if err != nil {
+		return nil, err
+	}
+	if scn.NextHdr != slayers.L4SCMP {
+		return nil, serrors.New("quoted packet is not SCMP", "next_hdr", scn.NextHdr)
+	}
+	var scmp slayers.SCMP
+	if err := scmp.DecodeFromBytes(scn.Payload, gopacket.NilDecodeFeedback); err != nil {
+		return nil, serrors.Wrap("decoding quoted SCMP header", err)
+	}
+	if scmp.TypeCode.Type() != slayers.SCMPTypeEchoRequest {
+		return nil, serrors.New("quoted packet is not an echo request", "type", scmp.TypeCode)
+	}
+	var echo slayers.SCMPEcho
+	if err := echo.DecodeFromBytes(scmp.Payload, gopacket.NilDecodeFeedback); err != nil {
+		return nil, serrors.Wrap("decoding quoted echo request", err)
+	}
+	return &echo, nil
+}
+
+// hopFields returns the hop fields of a SCION path, or nil for other path
+// types. Contrary to the path meta header and the info fields, the hop fields
+// are not modified while the packet traverses the path, so they identify the
+// path both at the sender and in quoted packets.
+func hopFields(scn *slayers.SCION) []byte {
+	if scn.PathType != scion.PathType || scn.Path == nil {
+		return nil
+	}
+	raw := make([]byte, scn.Path.Len())
+	if err := scn.Path.SerializeTo(raw); err != nil {
+		return nil
+	}
+	var base scion.Base
+	if err := base.DecodeFromBytes(raw); err != nil {
+		return nil
+	}
+	return raw[scion.MetaLen+base.NumINF*path.InfoLen:]
+}
+
+// sameHopFields reports whether the dataplane path has the given hop fields.
+func sameHopFields(dp DataplanePath, hops []byte) bool {
+	if dp == nil || len(hops) == 0 {
+		return false
+	}
+	var scn slayers.SCION
+	if err := dp.SetPath(&scn); err != nil {
+		return false
+	}
+	return bytes.Equal(hopFields(&scn), hops)
+}
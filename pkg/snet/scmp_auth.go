@@ -0,0 +1,235 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snet
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"github.com/google/gopacket"
+
+	"github.com/scionproto/scion/pkg/drkey"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/metrics/v2"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/pkg/slayers"
+	"github.com/scionproto/scion/pkg/spao"
+)
+
+const (
+	// DefaultSCMPAcceptanceWindow is the default time width around the current time in which the
+	// timestamps of authenticated SCMP messages are accepted.
+	DefaultSCMPAcceptanceWindow = 5 * time.Minute
+	// DefaultSCMPKeyTimeout is the default timeout for obtaining the key to verify an SCMP
+	// message.
+	DefaultSCMPKeyTimeout = time.Second
+)
+
+// SCMPKeyProvider provides the DRKeys that are needed to verify the authentication of SCMP
+// messages sent by routers. It is implemented by daemon.Connector.
+type SCMPKeyProvider interface {
+	DRKeyGetASHostKey(ctx context.Context, meta drkey.ASHostMeta) (drkey.ASHostKey, error)
+}
+
+// SCMPVerificationMetrics contains the metrics reported by the VerifyingSCMPHandler.
+type SCMPVerificationMetrics struct {
+	// Accepted counts the SCMP messages with valid authentication.
+	Accepted metrics.Counter
+	// Rejected counts the SCMP messages that were dropped because their authentication is
+	// missing or invalid.
+	Rejected metrics.Counter
+}
+
+// VerifyingSCMPHandler checks the DRKey-based authentication of the SCMP messages that report an
+// interface or the internal connectivity of an AS to be down, before passing them on to the
+// wrapped handler. These messages are sent by routers and are authenticated with the AS-Host key
+// shared between the AS of the router and the receiving host, see
+// https://docs.scion.org/en/latest/dev/design/scmp-authentication.html. Messages without valid
+// authentication are dropped silently. All other SCMP messages are passed on unverified.
+//
+// Wrapping a DefaultSCMPHandler ensures that only authenticated SCMP messages are turned into
+// revocations.
+//
+// EXPERIMENTAL: This handler is experimental and may be changed in the future.
+type VerifyingSCMPHandler struct {
+	// Handler handles the SCMP messages that passed verification.
+	Handler SCMPHandler
+	// KeyProvider provides the keys used to verify the SCMP messages.
+	KeyProvider SCMPKeyProvider
+	// AcceptanceWindow is the time width around the current time in which message timestamps
+	// are accepted. If zero, DefaultSCMPAcceptanceWindow is used.
+	AcceptanceWindow time.Duration
+	// Timeout bounds the time spent obtaining a key. If zero, DefaultSCMPKeyTimeout is used.
+	Timeout time.Duration
+	// Metrics are the metrics reported by the handler. Unset metrics are not reported.
+	Metrics SCMPVerificationMetrics
+}
+
+func (h VerifyingSCMPHandler) Handle(pkt *Packet) error {
+	scmp, ok := pkt.Payload.(SCMPPayload)
+	if !ok {
+		return serrors.New("scmp handler invoked with non-scmp packet", "pkt", pkt)
+	}
+	switch scmp.Type() {
+	case slayers.SCMPTypeExternalInterfaceDown, slayers.SCMPTypeInternalConnectivityDown:
+		if err := h.verify(pkt, time.Now()); err != nil {
+			metrics.CounterInc(h.Metrics.Rejected)
+			log.Debug("Dropping SCMP message with invalid authentication",
+				"scmp", slayers.CreateSCMPTypeCode(scmp.Type(), scmp.Code()),
+				"src", pkt.Source, "err", err)
+			return nil
+		}
+		metrics.CounterInc(h.Metrics.Accepted)
+	}
+	return h.Handler.Handle(pkt)
+}
+
+func (h VerifyingSCMPHandler) verify(pkt *Packet, now time.Time) error {
+	var (
+		scionLayer slayers.SCION
+		hbhLayer   slayers.HopByHopExtnSkipper
+		e2eLayer   slayers.EndToEndExtn
+	)
+	parser := gopacket.NewDecodingLayerParser(
+		slayers.LayerTypeSCION, &scionLayer, &hbhLayer, &e2eLayer,
+	)
+	parser.IgnoreUnsupported = true
+	decoded := make([]gopacket.LayerType, 0, 3)
+	if err := parser.DecodeLayers(pkt.Bytes, &decoded); err != nil {
+		return serrors.Wrap("decoding packet", err)
+	}
+	if decoded[len(decoded)-1] != slayers.LayerTypeEndToEndExtn {
+		return serrors.New("no end-to-end extension")
+	}
+	if e2eLayer.NextHdr != slayers.L4SCMP {
+		return serrors.New("unexpected next header", "next_hdr", e2eLayer.NextHdr)
+	}
+	opt, err := e2eLayer.FindOption(slayers.OptTypeAuthenticator)
+	if err != nil {
+		return serrors.Wrap("no authenticator option", err)
+	}
+	auth, err := slayers.ParsePacketAuthOption(opt)
+	if err != nil {
+		return serrors.Wrap("parsing authenticator option", err)
+	}
+	spi := auth.SPI()
+	if !spi.IsDRKey() || spi.DRKeyProto() != uint16(drkey.SCMP) ||
+		spi.Type() != slayers.PacketAuthASHost ||
+		spi.Direction() != slayers.PacketAuthSenderSide {
+
+		return serrors.New("unexpected SPI", "spi", spi)
+	}
+	if auth.Algorithm() != slayers.PacketAuthCMAC {
+		return serrors.New("unsupported algorithm", "algorithm", auth.Algorithm())
+	}
+
+	timeout := h.Timeout
+	if timeout == 0 {
+		timeout = DefaultSCMPKeyTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	key, err := h.selectKey(ctx, pkt, auth.TimestampSN(), now)
+	if err != nil {
+		return err
+	}
+
+	mac := make([]byte, len(auth.Authenticator()))
+	_, err = spao.ComputeAuthCMAC(
+		spao.MACInput{
+			Key:        key.Key[:],
+			Header:     auth,
+			ScionLayer: &scionLayer,
+			PldType:    slayers.L4SCMP,
+			Pld:        e2eLayer.Payload,
+		},
+		make([]byte, spao.MACBufferSize),
+		mac,
+	)
+	if err != nil {
+		return serrors.Wrap("computing CMAC", err)
+	}
+	if subtle.ConstantTimeCompare(auth.Authenticator(), mac) != 1 {
+		return serrors.New("invalid authenticator")
+	}
+	return nil
+}
+
+// selectKey returns the key of the epoch that maps the relative timestamp of the message to an
+// absolute time within the acceptance window. The key of the current epoch is tried first; near
+// the boundary of the epoch, the key of the neighbouring epoch is tried as well.
+func (h VerifyingSCMPHandler) selectKey(
+	ctx context.Context,
+	pkt *Packet,
+	timestamp uint64,
+	now time.Time,
+) (drkey.ASHostKey, error) {
+
+	window := h.AcceptanceWindow
+	if window == 0 {
+		window = DefaultSCMPAcceptanceWindow
+	}
+	validity := cppki.Validity{
+		NotBefore: now.Add(-window / 2),
+		NotAfter:  now.Add(window / 2),
+	}
+	key, err := h.fetchKey(ctx, pkt, now)
+	if err != nil {
+		return drkey.ASHostKey{}, err
+	}
+	if validity.Contains(spao.AbsoluteTimestamp(key.Epoch, timestamp)) {
+		return key, nil
+	}
+	var neighbour time.Time
+	switch {
+	case validity.NotBefore.Before(key.Epoch.NotBefore):
+		neighbour = key.Epoch.NotBefore.Add(-time.Second)
+	case validity.NotAfter.After(key.Epoch.NotAfter):
+		neighbour = key.Epoch.NotAfter
+	default:
+		return drkey.ASHostKey{}, serrors.New("timestamp outside of acceptance window",
+			"timestamp", timestamp, "epoch", key.Epoch)
+	}
+	key, err = h.fetchKey(ctx, pkt, neighbour)
+	if err != nil {
+		return drkey.ASHostKey{}, err
+	}
+	if !validity.Contains(spao.AbsoluteTimestamp(key.Epoch, timestamp)) {
+		return drkey.ASHostKey{}, serrors.New("timestamp outside of acceptance window",
+			"timestamp", timestamp, "epoch", key.Epoch)
+	}
+	return key, nil
+}
+
+func (h VerifyingSCMPHandler) fetchKey(
+	ctx context.Context,
+	pkt *Packet,
+	validity time.Time,
+) (drkey.ASHostKey, error) {
+
+	key, err := h.KeyProvider.DRKeyGetASHostKey(ctx, drkey.ASHostMeta{
+		ProtoId:  drkey.SCMP,
+		Validity: validity,
+		SrcIA:    pkt.Source.IA,
+		DstIA:    pkt.Destination.IA,
+		DstHost:  pkt.Destination.Host.String(),
+	})
+	if err != nil {
+		return drkey.ASHostKey{}, serrors.Wrap("fetching DRKey", err)
+	}
+	return key, nil
+}
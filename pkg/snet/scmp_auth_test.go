@@ -0,0 +1,265 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snet_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/drkey"
+	"github.com/scionproto/scion/pkg/metrics/v2"
+	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt"
+	"github.com/scionproto/scion/pkg/slayers"
+	"github.com/scionproto/scion/pkg/slayers/path"
+	"github.com/scionproto/scion/pkg/slayers/path/scion"
+	"github.com/scionproto/scion/pkg/snet"
+	"github.com/scionproto/scion/pkg/spao"
+)
+
+var (
+	routerIA = addr.MustParseIA("1-ff00:0:111")
+	hostIA   = addr.MustParseIA("1-ff00:0:110")
+	hostAddr = addr.MustParseHost("10.0.0.1")
+)
+
+// keyProvider derives a distinct key per epoch. Epochs last one hour, one of them starts at base.
+type keyProvider struct {
+	base     time.Time
+	requests []drkey.ASHostMeta
+}
+
+func (p *keyProvider) DRKeyGetASHostKey(
+	_ context.Context,
+	meta drkey.ASHostMeta,
+) (drkey.ASHostKey, error) {
+
+	p.requests = append(p.requests, meta)
+	return epochKey(p.base, meta.Validity), nil
+}
+
+func epochKey(base, t time.Time) drkey.ASHostKey {
+	idx := t.Sub(base).Truncate(time.Second) / time.Hour
+	if t.Before(base) {
+		idx--
+	}
+	begin := base.Add(idx * time.Hour)
+	key := drkey.ASHostKey{
+		ProtoId: drkey.SCMP,
+		Epoch:   drkey.NewEpoch(uint32(begin.Unix()), uint32(begin.Add(time.Hour).Unix())),
+	}
+	key.Key[0] = byte(idx)
+	return key
+}
+
+type revocationHandler struct {
+	revocations []*path_mgmt.RevInfo
+}
+
+func (h *revocationHandler) Revoke(_ context.Context, revInfo *path_mgmt.RevInfo) error {
+	h.revocations = append(h.revocations, revInfo)
+	return nil
+}
+
+// authenticatedSCMP returns an ExternalInterfaceDown message, sent from a router in routerIA to
+// hostAddr in hostIA. If key is not nil, the message carries an authenticator computed with it,
+// with the timestamp of sendTime.
+func authenticatedSCMP(t *testing.T, key *drkey.ASHostKey, sendTime time.Time) *snet.Packet {
+	scionL := slayers.SCION{
+		Version:  0,
+		PathType: scion.PathType,
+		Path: &scion.Decoded{
+			Base: scion.Base{
+				PathMeta: scion.MetaHdr{SegLen: [3]uint8{2, 0, 0}},
+				NumINF:   1,
+				NumHops:  2,
+			},
+			InfoFields: []path.InfoField{{ConsDir: true}},
+			HopFields:  []path.HopField{{ConsEgress: 4}, {ConsIngress: 1}},
+		},
+		DstIA: hostIA,
+		SrcIA: routerIA,
+	}
+	require.NoError(t, scionL.SetDstAddr(hostAddr))
+	require.NoError(t, scionL.SetSrcAddr(addr.MustParseHost("10.0.0.2")))
+	scmpH := slayers.SCMP{
+		TypeCode: slayers.CreateSCMPTypeCode(slayers.SCMPTypeExternalInterfaceDown, 0),
+	}
+	scmpH.SetNetworkLayerForChecksum(&scionL)
+	scmpP := &slayers.SCMPExternalInterfaceDown{IA: routerIA, IfID: 42}
+
+	buffer := gopacket.NewSerializeBuffer()
+	sopts := gopacket.SerializeOptions{ComputeChecksums: true, FixLengths: true}
+	require.NoError(t, gopacket.SerializeLayers(buffer, sopts,
+		&scmpH, scmpP, gopacket.Payload("quote")))
+
+	if key != nil {
+		scionL.NextHdr = slayers.End2EndClass
+		spi, err := slayers.MakePacketAuthSPIDRKey(uint16(drkey.SCMP),
+			slayers.PacketAuthASHost, slayers.PacketAuthSenderSide)
+		require.NoError(t, err)
+		timestamp, err := spao.RelativeTimestamp(key.Epoch, sendTime)
+		require.NoError(t, err)
+		optAuth, err := slayers.NewPacketAuthOption(slayers.PacketAuthOptionParams{
+			SPI:         spi,
+			Algorithm:   slayers.PacketAuthCMAC,
+			TimestampSN: timestamp,
+			Auth:        make([]byte, 16),
+		})
+		require.NoError(t, err)
+		_, err = spao.ComputeAuthCMAC(
+			spao.MACInput{
+				Key:        key.Key[:],
+				Header:     optAuth,
+				ScionLayer: &scionL,
+				PldType:    slayers.L4SCMP,
+				Pld:        buffer.Bytes(),
+			},
+			make([]byte, spao.MACBufferSize),
+			optAuth.Authenticator(),
+		)
+		require.NoError(t, err)
+		e2e := slayers.EndToEndExtn{
+			Options: []*slayers.EndToEndOption{optAuth.EndToEndOption},
+		}
+		e2e.NextHdr = slayers.L4SCMP
+		require.NoError(t, e2e.SerializeTo(buffer, sopts))
+	} else {
+		scionL.NextHdr = slayers.L4SCMP
+	}
+	require.NoError(t, scionL.SerializeTo(buffer, sopts))
+
+	pkt := &snet.Packet{Bytes: append([]byte(nil), buffer.Bytes()...)}
+	require.NoError(t, pkt.Decode())
+	return pkt
+}
+
+func TestVerifyingSCMPHandler(t *testing.T) {
+	now := time.Now()
+	// The current epoch started a minute ago, so that the acceptance window reaches into the
+	// previous epoch.
+	base := now.Add(-time.Minute).Truncate(time.Second)
+	current := epochKey(base, now)
+	previous := epochKey(base, base.Add(-time.Second))
+	wrongKey := current
+	wrongKey.Key[1] = 0xff
+
+	testCases := map[string]struct {
+		pkt     func(t *testing.T) *snet.Packet
+		revoked bool
+	}{
+		"valid": {
+			pkt: func(t *testing.T) *snet.Packet {
+				return authenticatedSCMP(t, &current, now)
+			},
+			revoked: true,
+		},
+		"valid with key of previous epoch": {
+			pkt: func(t *testing.T) *snet.Packet {
+				return authenticatedSCMP(t, &previous, previous.Epoch.NotAfter.Add(-time.Second))
+			},
+			revoked: true,
+		},
+		"not authenticated": {
+			pkt: func(t *testing.T) *snet.Packet {
+				return authenticatedSCMP(t, nil, now)
+			},
+		},
+		"wrong key": {
+			pkt: func(t *testing.T) *snet.Packet {
+				return authenticatedSCMP(t, &wrongKey, now)
+			},
+		},
+		"stale timestamp": {
+			pkt: func(t *testing.T) *snet.Packet {
+				return authenticatedSCMP(t, &previous, now.Add(-snet.DefaultSCMPAcceptanceWindow))
+			},
+		},
+		"tampered": {
+			pkt: func(t *testing.T) *snet.Packet {
+				pkt := authenticatedSCMP(t, &current, now)
+				// Change the reported interface.
+				pkt.Bytes[len(pkt.Bytes)-len("quote")-1]++
+				require.NoError(t, pkt.Decode())
+				return pkt
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			revHandler := &revocationHandler{}
+			keys := &keyProvider{base: base}
+			accepted, rejected := metrics.NewTestCounter(), metrics.NewTestCounter()
+			handler := snet.VerifyingSCMPHandler{
+				Handler:     snet.DefaultSCMPHandler{RevocationHandler: revHandler},
+				KeyProvider: keys,
+				Metrics: snet.SCMPVerificationMetrics{
+					Accepted: accepted,
+					Rejected: rejected,
+				},
+			}
+			pkt := tc.pkt(t)
+			err := handler.Handle(pkt)
+			if !tc.revoked {
+				assert.NoError(t, err)
+				assert.Empty(t, revHandler.revocations)
+				assert.Equal(t, float64(0), metrics.CounterValue(accepted))
+				assert.Equal(t, float64(1), metrics.CounterValue(rejected))
+				return
+			}
+			var opErr *snet.OpError
+			require.ErrorAs(t, err, &opErr)
+			require.Len(t, revHandler.revocations, 1)
+			assert.Equal(t, routerIA, revHandler.revocations[0].IA())
+			assert.Equal(t, float64(1), metrics.CounterValue(accepted))
+			assert.Equal(t, float64(0), metrics.CounterValue(rejected))
+			for _, meta := range keys.requests {
+				assert.Equal(t, routerIA, meta.SrcIA)
+				assert.Equal(t, hostIA, meta.DstIA)
+				assert.Equal(t, hostAddr.String(), meta.DstHost)
+				assert.Equal(t, drkey.SCMP, meta.ProtoId)
+			}
+		})
+	}
+}
+
+func TestVerifyingSCMPHandlerPassesOtherMessages(t *testing.T) {
+	pkt := &snet.Packet{
+		PacketInfo: snet.PacketInfo{
+			Payload: snet.SCMPEchoReply{Identifier: 1, SeqNumber: 1},
+		},
+	}
+	var handled bool
+	handler := snet.VerifyingSCMPHandler{
+		Handler: scmpHandlerFunc(func(*snet.Packet) error {
+			handled = true
+			return nil
+		}),
+		KeyProvider: &keyProvider{},
+	}
+	assert.NoError(t, handler.Handle(pkt))
+	assert.True(t, handled)
+}
+
+type scmpHandlerFunc func(*snet.Packet) error
+
+func (f scmpHandlerFunc) Handle(pkt *snet.Packet) error {
+	return f(pkt)
+}
@@ -27,6 +27,11 @@
 // from the connection and find out the sender's address; and WriteTo can be
 // used to send a message to a chosen destination.
 //
+// A connection that is not bound to a single path can be created by calling
+// DialMultipath. The returned MultipathConn keeps a pool of paths to the remote,
+// refreshes it periodically, avoids paths through interfaces that are reported
+// down via SCMP, and chooses the paths for every written packet.
+//
 // Multiple networking contexts can share the same SCIOND.
 //
 // Write calls never return SCMP errors directly. If a write call caused an
@@ -34,6 +39,19 @@
 // Read. In this case, the error value is non-nil and can be type asserted to
 // *OpError. Method SCMP() can be called on the error to extract the SCMP
 // header.
+//
+// If a written packet exceeded the MTU of a link on its path, the SCMP Packet
+// Too Big message is returned by Read as *PacketTooBigError, if enabled with
+// DefaultSCMPHandler.ReportPacketTooBig. The MTU of paths can be validated with
+// PathMTUDiscoverer and recorded in a PathMTUCache, which can also be lowered for
+// the paths that a PacketTooBigError matches.
+//
+// QUIC connections (package squic) use neither. quic-go performs its own
+// packetization-layer path MTU discovery, which does not depend on Packet Too
+// Big messages, and offers no way to feed an externally learned MTU into a
+// connection. Moreover, quic-go closes its transport on read errors other
+// than temporary net.Errors, which is why Packet Too Big errors are not
+// reported by default.
 package snet
 
 import (
@@ -154,6 +172,36 @@ func (n *SCIONNetwork) Dial(ctx context.Context, network string, listen *net.UDP
 	return NewCookedConn(packetConn, n.Topology, WithReplyPather(n.ReplyPather), WithRemote(remote))
 }
 
+// DialMultipath returns a multipath connection to remote. Parameter network
+// must be "udp". The paths to the remote are obtained from the querier and
+// chosen per packet according to the options. The path and next hop of remote
+// are ignored.
+//
+// The context is used for connection setup, it doesn't affect the returned
+// connection.
+func (n *SCIONNetwork) DialMultipath(ctx context.Context, network string, listen *net.UDPAddr,
+	remote *UDPAddr, querier PathQuerier, options ...MultipathOption) (*MultipathConn, error) {
+
+	metrics.CounterInc(n.Metrics.Dials)
+	if network != "udp" {
+		return nil, serrors.New("Unknown network", "network", network)
+	}
+	if remote == nil {
+		return nil, serrors.New("Unable to dial to nil remote")
+	}
+	packetConn, err := n.OpenRaw(ctx, listen)
+	if err != nil {
+		return nil, err
+	}
+	log.FromCtx(ctx).Debug("UDP socket opened on", "addr", packetConn.LocalAddr(), "to", remote)
+	conn, err := NewMultipathConn(ctx, packetConn, n.Topology, remote, querier, options...)
+	if err != nil {
+		packetConn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
 // Listen opens a Conn. The returned connection's ReadFrom and WriteTo methods
 // can be used to receive and send SCION packets with per-packet addressing.
 // Parameter network must be "udp".
@@ -18,10 +18,8 @@ import (
 	"bufio"
 	"context"
 	"fmt"
-	"math/rand"
 	"net"
 	"os"
-	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -37,28 +35,10 @@ import (
 	"github.com/scionproto/scion/private/path/pathpol"
 )
 
-// Sort sorts paths according to hops and interfaces.
+// Sort sorts paths according to hops and interfaces. Paths with the same
+// interfaces are sorted by expiration time and fingerprint.
 func Sort(paths []snet.Path) {
-	sort.Slice(paths, func(a, b int) bool {
-		intfA, intfB := paths[a].Metadata().Interfaces, paths[b].Metadata().Interfaces
-		// Sort according to path length.
-		if len(intfA) != len(intfB) {
-			return len(intfA) < len(intfB)
-		}
-		for i := range intfA {
-			if iaA, iaB := intfA[i].IA, intfB[i].IA; iaA != iaB {
-				return iaA < iaB
-			}
-			if idA, idB := intfA[i].ID, intfB[i].ID; idA != idB {
-				return idA < idB
-			}
-		}
-		expA, expB := paths[a].Metadata().Expiry, paths[b].Metadata().Expiry
-		if !expA.Equal(expB) {
-			return expA.Before(expB)
-		}
-		return true
-	})
+	(&pathpol.Ordering{}).Sort(paths, nil)
 }
 
 // Filter filters out paths according to a sequence.
@@ -70,7 +50,8 @@ func Filter(seq string, paths []snet.Path) ([]snet.Path, error) {
 	return s.Eval(paths), nil
 }
 
-// Choose selects a path to the remote.
+// Choose selects a path to the remote. Unless the path is chosen interactively,
+// the first path according to the ordering is selected.
 func Choose(
 	ctx context.Context,
 	conn daemon.Connector,
@@ -110,11 +91,11 @@ func Choose(
 			return nil, serrors.New("no healthy paths available")
 		}
 	}
+	o.ordering.Sort(paths, nil)
 	if o.interactive {
 		return printAndChoose(paths, remote, o.colorScheme)
 	}
-
-	return paths[rand.Intn(len(paths))], nil
+	return paths[0], nil
 }
 
 func filterUnhealthy(
@@ -188,8 +169,6 @@ func fetchPaths(
 }
 
 func printAndChoose(paths []snet.Path, remote addr.IA, cs ColorScheme) (snet.Path, error) {
-	Sort(paths)
-
 	sectionHeader := func(intfs int) {
 		cs.Header.Printf("%d Hops:\n", (intfs/2)+1)
 	}
@@ -318,6 +297,7 @@ type options struct {
 	colorScheme ColorScheme
 	probeCfg    *ProbeConfig
 	epic        bool
+	ordering    *pathpol.Ordering
 }
 
 type Option func(o *options)
@@ -365,3 +345,11 @@ func WithEPIC(epic bool) Option {
 		o.epic = epic
 	}
 }
+
+// WithOrdering sets the ordering the paths are ranked by. If nil, the default
+// ordering is used.
+func WithOrdering(ordering *pathpol.Ordering) Option {
+	return func(o *options) {
+		o.ordering = ordering
+	}
+}
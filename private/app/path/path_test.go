@@ -15,14 +15,20 @@
 package path_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/daemon/mock_daemon"
 	"github.com/scionproto/scion/pkg/snet"
 	"github.com/scionproto/scion/pkg/snet/path"
 	apppath "github.com/scionproto/scion/private/app/path"
+	"github.com/scionproto/scion/private/path/pathpol"
 )
 
 func TestFilter(t *testing.T) {
@@ -64,3 +70,52 @@ func TestFilter(t *testing.T) {
 	}
 
 }
+
+func TestChoose(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	remote := addr.MustParseIA("1-ff00:0:112")
+	short := path.Path{
+		Dst: remote,
+		Meta: snet.PathMetadata{
+			Interfaces: []snet.PathInterface{
+				{IA: addr.MustParseIA("1-ff00:0:110"), ID: 1},
+				{IA: remote, ID: 2},
+			},
+			Latency: []time.Duration{50 * time.Millisecond},
+		},
+	}
+	long := path.Path{
+		Dst: remote,
+		Meta: snet.PathMetadata{
+			Interfaces: []snet.PathInterface{
+				{IA: addr.MustParseIA("1-ff00:0:110"), ID: 3},
+				{IA: addr.MustParseIA("1-ff00:0:111"), ID: 4},
+				{IA: addr.MustParseIA("1-ff00:0:111"), ID: 5},
+				{IA: remote, ID: 6},
+			},
+			Latency: []time.Duration{
+				5 * time.Millisecond, time.Millisecond, 5 * time.Millisecond,
+			},
+		},
+	}
+	conn := mock_daemon.NewMockConnector(ctrl)
+	conn.EXPECT().Paths(gomock.Any(), remote, gomock.Any(), gomock.Any()).
+		Return([]snet.Path{long, short}, nil).AnyTimes()
+
+	t.Run("default ordering", func(t *testing.T) {
+		p, err := apppath.Choose(context.Background(), conn, remote)
+		require.NoError(t, err)
+		assert.Equal(t, short, p)
+	})
+	t.Run("latency ordering", func(t *testing.T) {
+		ordering := &pathpol.Ordering{
+			Criteria: []pathpol.OrderingCriterion{pathpol.OrderLatency},
+		}
+		p, err := apppath.Choose(context.Background(), conn, remote,
+			apppath.WithOrdering(ordering))
+		require.NoError(t, err)
+		assert.Equal(t, long, p)
+	})
+}
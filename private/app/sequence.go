@@ -17,6 +17,9 @@ package app
 const (
 	// SequenceUsage defines the usage message for the sequence flag.
 	SequenceUsage = "Space separated list of hop predicates"
+	// OrderingUsage defines the usage message for the ordering flag.
+	OrderingUsage = "Comma separated list of criteria the paths are ranked by " +
+		"(latency, bandwidth, hops, disjointness)"
 	// SequenceHelp defines the help message for a hop predicate sequence.
 	SequenceHelp = `The paths can be filtered according to a sequence. A sequence is a string of
 space separated HopPredicates. A Hop Predicate (HP) is of the form
@@ -16,6 +16,7 @@ package drkeyutil
 
 import (
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/scionproto/scion/pkg/private/util"
@@ -31,6 +32,9 @@ const (
 	// where aw:= acceptance window, T := time instant and a := aw/2
 	DefaultAcceptanceWindow = 5 * time.Minute
 	EnvVarAcceptanceWindow  = "SCION_TESTING_ACCEPTANCE_WINDOW"
+	// EnvVarDummyKeys makes the router authenticate SCMP messages with keys derived from a
+	// dummy value instead of fetching the secret values from the control service.
+	EnvVarDummyKeys = "SCION_TESTING_DRKEY_DUMMY_KEYS"
 )
 
 func LoadEpochDuration() time.Duration {
@@ -56,3 +60,11 @@ func LoadAcceptanceWindow() time.Duration {
 	}
 	return duration
 }
+
+func LoadDummyKeys() bool {
+	dummy, err := strconv.ParseBool(os.Getenv(EnvVarDummyKeys))
+	if err != nil {
+		return false
+	}
+	return dummy
+}
@@ -40,8 +40,8 @@ type Features struct {
 	// ExperimentalSCMPAuthentication enables experimental, DRKey-based
 	// authentication of SCMP messages.
 	//
-	// When enabled, the router inserts the SPAO authenticator for SCMP error messages,
-	// generated with a dummy key!
+	// When enabled, the router inserts the SPAO authenticator for SCMP error messages. The
+	// keys are derived from the SCMP secret value fetched from the local control service.
 	//
 	// Experimental: This field is experimental and will be subject to change.
 	ExperimentalSCMPAuthentication bool `toml:"experimental_scmp_authentication"`
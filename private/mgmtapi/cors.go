@@ -0,0 +1,41 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi
+
+import (
+	"net/http"
+
+	"github.com/go-chi/cors"
+)
+
+// CORS returns a middleware that allows cross-origin requests from any origin,
+// except for the restricted requests. Restricted requests are served without
+// CORS headers, so that browsers do not allow web pages of other origins to
+// issue them.
+func CORS(restricted func(*http.Request) bool) func(http.Handler) http.Handler {
+	allowAll := cors.Handler(cors.Options{
+		AllowedOrigins: []string{"*"},
+	})
+	return func(next http.Handler) http.Handler {
+		withCORS := allowAll(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if restricted != nil && restricted(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			withCORS.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/scionproto/scion/private/mgmtapi"
+)
+
+func TestCORS(t *testing.T) {
+	h := mgmtapi.CORS(func(r *http.Request) bool {
+		return r.URL.Path == "/restricted"
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	testCases := map[string]struct {
+		Path   string
+		Origin string
+	}{
+		"unrestricted": {Path: "/info", Origin: "*"},
+		"restricted":   {Path: "/restricted", Origin: ""},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.Path, nil)
+			req.Header.Set("Origin", "https://example.com")
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, req)
+			assert.Equal(t, tc.Origin, rr.Header().Get("Access-Control-Allow-Origin"))
+		})
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pathpol
+
+import (
+	"strings"
+	"time"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/private/util"
+	"github.com/scionproto/scion/pkg/snet"
+)
+
+// Metadata is a path policy that filters paths based on the metadata
+// announced by the ASes on the path (see snet.PathMetadata). A path is accepted
+// only if it satisfies all the conditions that are set.
+//
+// Metadata is optional for ASes to announce. By default, a path is rejected if
+// any of the metadata required to evaluate a condition is missing. If
+// AllowUnknown is set, missing metadata is ignored instead, i.e., only the
+// announced values are checked.
+type Metadata struct {
+	// MaxLatency is the maximum total latency of the path.
+	MaxLatency *util.DurWrap `json:"max_latency,omitempty" yaml:"max_latency,omitempty"`
+	// MinBandwidth is the minimum bottleneck bandwidth of the path in Kbit/s.
+	MinBandwidth uint64 `json:"min_bandwidth,omitempty" yaml:"min_bandwidth,omitempty"`
+	// ExcludeLinkTypes lists the inter-domain link types that must not be
+	// traversed by the path.
+	ExcludeLinkTypes []LinkType `json:"exclude_links,omitempty" yaml:"exclude_links,omitempty"`
+	// Geofence restricts the location of the routers on the path.
+	Geofence *Geofence `json:"geofence,omitempty" yaml:"geofence,omitempty"`
+	// AllowUnknown accepts paths for which some of the metadata is unknown.
+	AllowUnknown bool `json:"allow_unknown,omitempty" yaml:"allow_unknown,omitempty"`
+}
+
+// Geofence restricts the location of the routers on a path. If both bounding
+// boxes and countries are set, the location of every router must satisfy both.
+type Geofence struct {
+	// BoundingBoxes lists the areas the routers are allowed to be in. Every
+	// router on the path must be located inside at least one of the boxes.
+	BoundingBoxes []BoundingBox `json:"bounding_boxes,omitempty" yaml:"bounding_boxes,omitempty"`
+	// Countries lists the countries the routers are allowed to be in. The
+	// country of a router is the last comma separated element of its announced
+	// address. Countries are compared case-insensitively.
+	Countries []string `json:"countries,omitempty" yaml:"countries,omitempty"`
+}
+
+// BoundingBox is an area delimited by latitude and longitude, in degrees.
+type BoundingBox struct {
+	MinLatitude  float32 `json:"min_latitude" yaml:"min_latitude"`
+	MaxLatitude  float32 `json:"max_latitude" yaml:"max_latitude"`
+	MinLongitude float32 `json:"min_longitude" yaml:"min_longitude"`
+	MaxLongitude float32 `json:"max_longitude" yaml:"max_longitude"`
+}
+
+// Contains indicates whether the coordinates are inside the bounding box.
+func (b BoundingBox) Contains(geo snet.GeoCoordinates) bool {
+	return geo.Latitude >= b.MinLatitude && geo.Latitude <= b.MaxLatitude &&
+		geo.Longitude >= b.MinLongitude && geo.Longitude <= b.MaxLongitude
+}
+
+// LinkType is an inter-domain link type that can be marshaled to and
+// unmarshaled from its textual representation, e.g., "opennet".
+type LinkType snet.LinkType
+
+func (lt LinkType) MarshalText() ([]byte, error) {
+	return []byte(snet.LinkType(lt).String()), nil
+}
+
+func (lt *LinkType) UnmarshalText(b []byte) error {
+	for _, t := range []snet.LinkType{
+		snet.LinkTypeDirect,
+		snet.LinkTypeMultihop,
+		snet.LinkTypeOpennet,
+	} {
+		if strings.EqualFold(string(b), t.String()) {
+			*lt = LinkType(t)
+			return nil
+		}
+	}
+	return serrors.New("unknown link type", "type", string(b))
+}
+
+// Eval returns the paths that satisfy all conditions of the metadata policy.
+// Empty paths, i.e., paths within the local AS, traverse no inter-domain links
+// and therefore satisfy all conditions. Paths without any metadata are only
+// accepted if AllowUnknown is set.
+func (m *Metadata) Eval(paths []snet.Path) []snet.Path {
+	var result []snet.Path
+	for _, path := range paths {
+		meta := path.Metadata()
+		if meta == nil {
+			if m.AllowUnknown {
+				result = append(result, path)
+			}
+			continue
+		}
+		if len(meta.Interfaces) == 0 || m.match(meta) {
+			result = append(result, path)
+		}
+	}
+	return result
+}
+
+func (m *Metadata) match(meta *snet.PathMetadata) bool {
+	return m.matchLatency(meta) && m.matchBandwidth(meta) &&
+		m.matchLinkTypes(meta) && m.matchGeofence(meta)
+}
+
+func (m *Metadata) matchLatency(meta *snet.PathMetadata) bool {
+	if m.MaxLatency == nil {
+		return true
+	}
+	// The path has one latency value per hop between consecutive interfaces.
+	if len(meta.Latency) != len(meta.Interfaces)-1 && !m.AllowUnknown {
+		return false
+	}
+	var total time.Duration
+	for _, l := range meta.Latency {
+		if l < 0 {
+			if !m.AllowUnknown {
+				return false
+			}
+			continue
+		}
+		total += l
+	}
+	return total <= m.MaxLatency.Duration
+}
+
+func (m *Metadata) matchBandwidth(meta *snet.PathMetadata) bool {
+	if m.MinBandwidth == 0 {
+		return true
+	}
+	if len(meta.Bandwidth) != len(meta.Interfaces)-1 && !m.AllowUnknown {
+		return false
+	}
+	for _, bw := range meta.Bandwidth {
+		if bw == 0 {
+			if !m.AllowUnknown {
+				return false
+			}
+			continue
+		}
+		if bw < m.MinBandwidth {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Metadata) matchLinkTypes(meta *snet.PathMetadata) bool {
+	if len(m.ExcludeLinkTypes) == 0 {
+		return true
+	}
+	if len(meta.LinkType) != len(meta.Interfaces)/2 && !m.AllowUnknown {
+		return false
+	}
+	for _, lt := range meta.LinkType {
+		if lt == snet.LinkTypeUnset {
+			if !m.AllowUnknown {
+				return false
+			}
+			continue
+		}
+		for _, excluded := range m.ExcludeLinkTypes {
+			if lt == snet.LinkType(excluded) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (m *Metadata) matchGeofence(meta *snet.PathMetadata) bool {
+	if m.Geofence == nil {
+		return true
+	}
+	if len(meta.Geo) != len(meta.Interfaces) && !m.AllowUnknown {
+		return false
+	}
+	for _, geo := range meta.Geo {
+		if geo == (snet.GeoCoordinates{}) {
+			if !m.AllowUnknown {
+				return false
+			}
+			continue
+		}
+		if !m.Geofence.contains(geo) {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *Geofence) contains(geo snet.GeoCoordinates) bool {
+	if len(g.BoundingBoxes) > 0 && !g.inBoundingBoxes(geo) {
+		return false
+	}
+	if len(g.Countries) > 0 && !g.inCountries(geo) {
+		return false
+	}
+	return true
+}
+
+func (g *Geofence) inBoundingBoxes(geo snet.GeoCoordinates) bool {
+	for _, box := range g.BoundingBoxes {
+		if box.Contains(geo) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Geofence) inCountries(geo snet.GeoCoordinates) bool {
+	parts := strings.Split(geo.Address, ",")
+	country := strings.TrimSpace(parts[len(parts)-1])
+	if country == "" {
+		return false
+	}
+	for _, c := range g.Countries {
+		if strings.EqualFold(c, country) {
+			return true
+		}
+	}
+	return false
+}
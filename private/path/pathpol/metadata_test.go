@@ -0,0 +1,252 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pathpol
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/util"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+)
+
+func TestMetadataEval(t *testing.T) {
+	zurich := snet.GeoCoordinates{Latitude: 47.37, Longitude: 8.54, Address: "Zurich, Switzerland"}
+	bern := snet.GeoCoordinates{Latitude: 46.95, Longitude: 7.45, Address: "Bern, Switzerland"}
+	berlin := snet.GeoCoordinates{Latitude: 52.52, Longitude: 13.40, Address: "Berlin, Germany"}
+
+	// fast is a path within Switzerland over direct links.
+	fast := metadataPath(snet.PathMetadata{
+		Latency:   []time.Duration{5 * time.Millisecond, 1 * time.Millisecond, 5 * time.Millisecond},
+		Bandwidth: []uint64{10000, 50000, 10000},
+		LinkType:  []snet.LinkType{snet.LinkTypeDirect, snet.LinkTypeDirect},
+		Geo:       []snet.GeoCoordinates{zurich, zurich, bern, bern},
+	})
+	// slow is a path through Germany, partially over the open internet.
+	slow := metadataPath(snet.PathMetadata{
+		Latency:   []time.Duration{20 * time.Millisecond, 5 * time.Millisecond, 20 * time.Millisecond},
+		Bandwidth: []uint64{1000, 50000, 1000},
+		LinkType:  []snet.LinkType{snet.LinkTypeDirect, snet.LinkTypeOpennet},
+		Geo:       []snet.GeoCoordinates{zurich, berlin, berlin, bern},
+	})
+	// unknown is a path for which only partial metadata is announced.
+	unknown := metadataPath(snet.PathMetadata{
+		Latency:   []time.Duration{5 * time.Millisecond, snet.LatencyUnset, 5 * time.Millisecond},
+		Bandwidth: []uint64{10000, 0, 10000},
+		LinkType:  []snet.LinkType{snet.LinkTypeDirect, snet.LinkTypeUnset},
+		Geo:       []snet.GeoCoordinates{zurich, {}, {}, bern},
+	})
+	paths := []snet.Path{fast, slow, unknown}
+
+	switzerland := &Geofence{
+		BoundingBoxes: []BoundingBox{
+			{MinLatitude: 45.8, MaxLatitude: 47.8, MinLongitude: 5.9, MaxLongitude: 10.5},
+		},
+	}
+
+	tests := map[string]struct {
+		Metadata *Metadata
+		Expected []snet.Path
+	}{
+		"empty": {
+			Metadata: &Metadata{},
+			Expected: paths,
+		},
+		"max latency": {
+			Metadata: &Metadata{MaxLatency: &util.DurWrap{Duration: 20 * time.Millisecond}},
+			Expected: []snet.Path{fast},
+		},
+		"max latency allow unknown": {
+			Metadata: &Metadata{
+				MaxLatency:   &util.DurWrap{Duration: 20 * time.Millisecond},
+				AllowUnknown: true,
+			},
+			Expected: []snet.Path{fast, unknown},
+		},
+		"min bandwidth": {
+			Metadata: &Metadata{MinBandwidth: 5000},
+			Expected: []snet.Path{fast},
+		},
+		"min bandwidth allow unknown": {
+			Metadata: &Metadata{MinBandwidth: 5000, AllowUnknown: true},
+			Expected: []snet.Path{fast, unknown},
+		},
+		"exclude opennet": {
+			Metadata: &Metadata{ExcludeLinkTypes: []LinkType{LinkType(snet.LinkTypeOpennet)}},
+			Expected: []snet.Path{fast},
+		},
+		"exclude opennet allow unknown": {
+			Metadata: &Metadata{
+				ExcludeLinkTypes: []LinkType{LinkType(snet.LinkTypeOpennet)},
+				AllowUnknown:     true,
+			},
+			Expected: []snet.Path{fast, unknown},
+		},
+		"geofence bounding box": {
+			Metadata: &Metadata{Geofence: switzerland},
+			Expected: []snet.Path{fast},
+		},
+		"geofence country": {
+			Metadata: &Metadata{
+				Geofence:     &Geofence{Countries: []string{"switzerland"}},
+				AllowUnknown: true,
+			},
+			Expected: []snet.Path{fast, unknown},
+		},
+		"geofence two countries": {
+			Metadata: &Metadata{Geofence: &Geofence{Countries: []string{"Switzerland", "Germany"}}},
+			Expected: []snet.Path{fast, slow},
+		},
+		"combined": {
+			Metadata: &Metadata{
+				MaxLatency:   &util.DurWrap{Duration: 100 * time.Millisecond},
+				MinBandwidth: 1000,
+				Geofence:     &Geofence{Countries: []string{"Germany", "Switzerland"}},
+			},
+			Expected: []snet.Path{fast, slow},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.Expected, test.Metadata.Eval(paths))
+		})
+	}
+
+	t.Run("no metadata", func(t *testing.T) {
+		path := metadataPath(snet.PathMetadata{})
+		assert.Empty(t, (&Metadata{MinBandwidth: 1}).Eval([]snet.Path{path}))
+		assert.Equal(t, []snet.Path{path},
+			(&Metadata{MinBandwidth: 1, AllowUnknown: true}).Eval([]snet.Path{path}))
+	})
+	t.Run("nil metadata", func(t *testing.T) {
+		path := nilMetadataPath{Path: metadataPath(snet.PathMetadata{})}
+		assert.Empty(t, (&Metadata{MinBandwidth: 1}).Eval([]snet.Path{path}))
+		assert.Equal(t, []snet.Path{path},
+			(&Metadata{MinBandwidth: 1, AllowUnknown: true}).Eval([]snet.Path{path}))
+	})
+	t.Run("empty path", func(t *testing.T) {
+		ia := addr.MustParseIA("1-ff00:0:110")
+		path := snetpath.Path{Src: ia, Dst: ia, Meta: snet.PathMetadata{}}
+		m := &Metadata{
+			MaxLatency:   &util.DurWrap{Duration: time.Millisecond},
+			MinBandwidth: 1,
+			Geofence:     switzerland,
+		}
+		assert.Equal(t, []snet.Path{path}, m.Eval([]snet.Path{path}))
+		m.AllowUnknown = true
+		assert.Equal(t, []snet.Path{path}, m.Eval([]snet.Path{path}))
+	})
+}
+
+func TestMetadataMarshal(t *testing.T) {
+	expected := &Metadata{
+		MaxLatency:       &util.DurWrap{Duration: 150 * time.Millisecond},
+		MinBandwidth:     1000,
+		ExcludeLinkTypes: []LinkType{LinkType(snet.LinkTypeOpennet)},
+		Geofence: &Geofence{
+			BoundingBoxes: []BoundingBox{
+				{MinLatitude: 45.5, MaxLatitude: 48, MinLongitude: 5.5, MaxLongitude: 10.5},
+			},
+			Countries: []string{"Switzerland"},
+		},
+	}
+	tests := map[string]struct {
+		Unmarshal func([]byte, interface{}) error
+		Input     string
+	}{
+		"json": {
+			Unmarshal: json.Unmarshal,
+			Input: `{
+				"max_latency": "150ms",
+				"min_bandwidth": 1000,
+				"exclude_links": ["opennet"],
+				"geofence": {
+					"bounding_boxes": [{
+						"min_latitude": 45.5,
+						"max_latitude": 48,
+						"min_longitude": 5.5,
+						"max_longitude": 10.5
+					}],
+					"countries": ["Switzerland"]
+				}
+			}`,
+		},
+		"yaml": {
+			Unmarshal: yaml.Unmarshal,
+			Input: `
+max_latency: 150ms
+min_bandwidth: 1000
+exclude_links: [opennet]
+geofence:
+  bounding_boxes:
+    - min_latitude: 45.5
+      max_latitude: 48
+      min_longitude: 5.5
+      max_longitude: 10.5
+  countries: [Switzerland]
+`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var m Metadata
+			require.NoError(t, test.Unmarshal([]byte(test.Input), &m))
+			assert.Equal(t, expected, &m)
+		})
+	}
+	t.Run("yaml round trip", func(t *testing.T) {
+		raw, err := yaml.Marshal(expected)
+		require.NoError(t, err)
+		var m Metadata
+		require.NoError(t, yaml.Unmarshal(raw, &m))
+		assert.Equal(t, expected, &m)
+	})
+	t.Run("invalid link type", func(t *testing.T) {
+		var m Metadata
+		err := json.Unmarshal([]byte(`{"exclude_links": ["carrier-pigeon"]}`), &m)
+		assert.Error(t, err)
+	})
+}
+
+func metadataPath(meta snet.PathMetadata) snet.Path {
+	ia := addr.MustParseIA("1-ff00:0:110")
+	meta.Interfaces = []snet.PathInterface{
+		{IA: ia, ID: 1},
+		{IA: addr.MustParseIA("1-ff00:0:120"), ID: 2},
+		{IA: addr.MustParseIA("1-ff00:0:120"), ID: 3},
+		{IA: addr.MustParseIA("1-ff00:0:130"), ID: 4},
+	}
+	return snetpath.Path{
+		Src:  ia,
+		Dst:  addr.MustParseIA("1-ff00:0:130"),
+		Meta: meta,
+	}
+}
+
+// nilMetadataPath is a path for which no metadata is available.
+type nilMetadataPath struct {
+	snet.Path
+}
+
+func (nilMetadataPath) Metadata() *snet.PathMetadata {
+	return nil
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pathpol
+
+import (
+	"cmp"
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/snet"
+)
+
+// OrderingCriterion is a criterion by which paths are ranked.
+type OrderingCriterion string
+
+const (
+	// OrderLatency prefers paths with the lowest total latency. Paths for
+	// which the latency is not fully announced rank last.
+	OrderLatency OrderingCriterion = "latency"
+	// OrderBandwidth prefers paths with the highest bottleneck bandwidth.
+	// Paths for which the bandwidth is not fully announced rank last.
+	OrderBandwidth OrderingCriterion = "bandwidth"
+	// OrderHops prefers paths that traverse the fewest ASes.
+	OrderHops OrderingCriterion = "hops"
+	// OrderDisjointness prefers paths that share the fewest interfaces with
+	// the reference paths (see FilterOptions.DisjointFrom).
+	OrderDisjointness OrderingCriterion = "disjointness"
+)
+
+func (c *OrderingCriterion) UnmarshalText(b []byte) error {
+	switch crit := OrderingCriterion(b); crit {
+	case OrderLatency, OrderBandwidth, OrderHops, OrderDisjointness:
+		*c = crit
+		return nil
+	default:
+		return serrors.New("unknown ordering criterion", "criterion", string(b))
+	}
+}
+
+// Ordering is a path policy that ranks paths instead of filtering them. The
+// criteria are applied in order, i.e., a later criterion is only used to break
+// ties of all previous ones. Paths that rank equally according to all criteria
+// are ordered by number of hops, interfaces, expiration time and finally
+// fingerprint. Thus, the result is deterministic for a given set of paths.
+type Ordering struct {
+	Criteria []OrderingCriterion
+}
+
+// NewOrdering parses a comma separated list of ordering criteria, e.g.,
+// "latency,hops". An empty string yields the default ordering.
+func NewOrdering(criteria string) (*Ordering, error) {
+	o := &Ordering{}
+	if criteria == "" {
+		return o, nil
+	}
+	for _, c := range strings.Split(criteria, ",") {
+		var crit OrderingCriterion
+		if err := crit.UnmarshalText([]byte(strings.TrimSpace(c))); err != nil {
+			return nil, err
+		}
+		o.Criteria = append(o.Criteria, crit)
+	}
+	return o, nil
+}
+
+// Sort sorts the paths in place according to the ordering. The disjointFrom
+// paths are the reference for the OrderDisjointness criterion.
+func (o *Ordering) Sort(paths []snet.Path, disjointFrom []snet.Path) {
+	var criteria []OrderingCriterion
+	if o != nil {
+		criteria = o.Criteria
+	}
+	var reference map[snet.PathInterface]struct{}
+	for _, crit := range criteria {
+		if crit == OrderDisjointness {
+			reference = interfaceSet(disjointFrom)
+		}
+	}
+	ranked := make([]rankedPath, 0, len(paths))
+	for _, path := range paths {
+		ranked = append(ranked, newRankedPath(path, criteria, reference))
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		return ranked[i].less(ranked[j])
+	})
+	for i := range ranked {
+		paths[i] = ranked[i].path
+	}
+}
+
+func (o *Ordering) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.Criteria)
+}
+
+func (o *Ordering) UnmarshalJSON(b []byte) error {
+	return json.Unmarshal(b, &o.Criteria)
+}
+
+func (o *Ordering) MarshalYAML() (interface{}, error) {
+	return o.Criteria, nil
+}
+
+func (o *Ordering) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshal(&o.Criteria)
+}
+
+// rankKey is the rank of a path according to a single criterion. Lower keys
+// are preferred. Keys with unknown set rank after all known keys.
+type rankKey struct {
+	unknown bool
+	value   int64
+}
+
+func (k rankKey) compare(other rankKey) int {
+	if k.unknown != other.unknown {
+		if k.unknown {
+			return 1
+		}
+		return -1
+	}
+	return cmp.Compare(k.value, other.value)
+}
+
+// rankedPath caches the metadata, rank keys and fingerprint of a path, such
+// that they are not recomputed for every comparison.
+type rankedPath struct {
+	path        snet.Path
+	meta        *snet.PathMetadata
+	keys        []rankKey
+	fingerprint snet.PathFingerprint
+}
+
+func newRankedPath(
+	path snet.Path,
+	criteria []OrderingCriterion,
+	reference map[snet.PathInterface]struct{},
+) rankedPath {
+
+	meta := pathMetadata(path)
+	keys := make([]rankKey, 0, len(criteria))
+	for _, crit := range criteria {
+		switch crit {
+		case OrderLatency:
+			keys = append(keys, latencyKey(meta))
+		case OrderBandwidth:
+			keys = append(keys, bandwidthKey(meta))
+		case OrderHops:
+			keys = append(keys, rankKey{value: int64(len(meta.Interfaces))})
+		case OrderDisjointness:
+			keys = append(keys, disjointnessKey(meta, reference))
+		}
+	}
+	return rankedPath{
+		path:        path,
+		meta:        meta,
+		keys:        keys,
+		fingerprint: snet.Fingerprint(path),
+	}
+}
+
+func (p rankedPath) less(other rankedPath) bool {
+	for i := range p.keys {
+		if c := p.keys[i].compare(other.keys[i]); c != 0 {
+			return c < 0
+		}
+	}
+	if c := compareInterfaces(p.meta, other.meta); c != 0 {
+		return c < 0
+	}
+	expA, expB := p.meta.Expiry, other.meta.Expiry
+	if !expA.Equal(expB) {
+		return expA.Before(expB)
+	}
+	return p.fingerprint < other.fingerprint
+}
+
+// compareInterfaces compares the interfaces of two paths, shorter paths first.
+func compareInterfaces(a, b *snet.PathMetadata) int {
+	if len(a.Interfaces) != len(b.Interfaces) {
+		return cmp.Compare(len(a.Interfaces), len(b.Interfaces))
+	}
+	for i := range a.Interfaces {
+		if iaA, iaB := a.Interfaces[i].IA, b.Interfaces[i].IA; iaA != iaB {
+			return cmp.Compare(iaA, iaB)
+		}
+		if idA, idB := a.Interfaces[i].ID, b.Interfaces[i].ID; idA != idB {
+			return cmp.Compare(idA, idB)
+		}
+	}
+	return 0
+}
+
+func latencyKey(meta *snet.PathMetadata) rankKey {
+	if len(meta.Latency) != len(meta.Interfaces)-1 {
+		return rankKey{unknown: true}
+	}
+	var total int64
+	for _, l := range meta.Latency {
+		if l < 0 {
+			return rankKey{unknown: true}
+		}
+		total += int64(l)
+	}
+	return rankKey{value: total}
+}
+
+func bandwidthKey(meta *snet.PathMetadata) rankKey {
+	if len(meta.Bandwidth) != len(meta.Interfaces)-1 {
+		return rankKey{unknown: true}
+	}
+	bottleneck := uint64(math.MaxInt64)
+	for _, bw := range meta.Bandwidth {
+		if bw == 0 {
+			return rankKey{unknown: true}
+		}
+		if bw < bottleneck {
+			bottleneck = bw
+		}
+	}
+	// Higher bandwidth is preferred, thus negate the value.
+	return rankKey{value: -int64(bottleneck)}
+}
+
+func disjointnessKey(
+	meta *snet.PathMetadata,
+	reference map[snet.PathInterface]struct{},
+) rankKey {
+
+	var shared int64
+	for _, intf := range meta.Interfaces {
+		if _, ok := reference[intf]; ok {
+			shared++
+		}
+	}
+	return rankKey{value: shared}
+}
+
+func interfaceSet(paths []snet.Path) map[snet.PathInterface]struct{} {
+	set := make(map[snet.PathInterface]struct{})
+	for _, path := range paths {
+		for _, intf := range pathMetadata(path).Interfaces {
+			set[intf] = struct{}{}
+		}
+	}
+	return set
+}
+
+// pathMetadata returns the metadata of the path, or empty metadata if the path
+// has none.
+func pathMetadata(path snet.Path) *snet.PathMetadata {
+	if meta := path.Metadata(); meta != nil {
+		return meta
+	}
+	return &snet.PathMetadata{}
+}
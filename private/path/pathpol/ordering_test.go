@@ -0,0 +1,147 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pathpol
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/snet"
+)
+
+func TestOrderingSort(t *testing.T) {
+	// All paths have the same interfaces, thus the tie-breaker is the
+	// expiration time.
+	now := time.Now()
+	fast := metadataPath(snet.PathMetadata{
+		Latency:   []time.Duration{5 * time.Millisecond, time.Millisecond, 5 * time.Millisecond},
+		Bandwidth: []uint64{1000, 1000, 1000},
+		Expiry:    now.Add(3 * time.Hour),
+	})
+	wide := metadataPath(snet.PathMetadata{
+		Latency:   []time.Duration{20 * time.Millisecond, time.Millisecond, 20 * time.Millisecond},
+		Bandwidth: []uint64{50000, 50000, 50000},
+		Expiry:    now.Add(2 * time.Hour),
+	})
+	unknown := metadataPath(snet.PathMetadata{
+		Latency: []time.Duration{time.Millisecond, snet.LatencyUnset, time.Millisecond},
+		Expiry:  now.Add(time.Hour),
+	})
+
+	tests := map[string]struct {
+		Ordering *Ordering
+		Expected []snet.Path
+	}{
+		"nil": {
+			Expected: []snet.Path{unknown, wide, fast},
+		},
+		"latency": {
+			Ordering: &Ordering{Criteria: []OrderingCriterion{OrderLatency}},
+			Expected: []snet.Path{fast, wide, unknown},
+		},
+		"bandwidth": {
+			Ordering: &Ordering{Criteria: []OrderingCriterion{OrderBandwidth}},
+			Expected: []snet.Path{wide, fast, unknown},
+		},
+		"hops": {
+			Ordering: &Ordering{Criteria: []OrderingCriterion{OrderHops}},
+			Expected: []snet.Path{unknown, wide, fast},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, in := range [][]snet.Path{
+				{fast, wide, unknown},
+				{unknown, fast, wide},
+				{wide, unknown, fast},
+			} {
+				paths := append([]snet.Path(nil), in...)
+				test.Ordering.Sort(paths, nil)
+				assert.Equal(t, test.Expected, paths)
+			}
+		})
+	}
+}
+
+func TestOrderingPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	pp := NewPathProvider(ctrl)
+	paths := pp.GetPaths(addr.MustParseIA("1-ff00:0:110"), addr.MustParseIA("2-ff00:0:220"))
+	require.Greater(t, len(paths), 2)
+
+	t.Run("hops", func(t *testing.T) {
+		policy := &Policy{Ordering: &Ordering{Criteria: []OrderingCriterion{OrderHops}}}
+		in := append([]snet.Path(nil), paths...)
+		out := policy.Filter(in)
+		assert.Equal(t, in, paths, "input must not be reordered")
+		require.Len(t, out, len(paths))
+		for i := 1; i < len(out); i++ {
+			prev, curr := out[i-1].Metadata().Interfaces, out[i].Metadata().Interfaces
+			assert.LessOrEqual(t, len(prev), len(curr))
+		}
+	})
+	t.Run("disjointness", func(t *testing.T) {
+		policy := &Policy{
+			Ordering: &Ordering{Criteria: []OrderingCriterion{OrderDisjointness, OrderHops}},
+		}
+		shortest := policy.Filter(paths)[0]
+		out := policy.FilterOpt(paths, FilterOptions{DisjointFrom: []snet.Path{shortest}})
+		require.Len(t, out, len(paths))
+		assert.Equal(t, shortest, out[len(out)-1])
+	})
+}
+
+func TestOrderingMarshal(t *testing.T) {
+	expected := &Ordering{Criteria: []OrderingCriterion{OrderLatency, OrderHops}}
+	t.Run("json", func(t *testing.T) {
+		raw, err := json.Marshal(expected)
+		require.NoError(t, err)
+		assert.JSONEq(t, `["latency", "hops"]`, string(raw))
+		var o Ordering
+		require.NoError(t, json.Unmarshal(raw, &o))
+		assert.Equal(t, expected, &o)
+	})
+	t.Run("yaml", func(t *testing.T) {
+		var o Ordering
+		require.NoError(t, yaml.Unmarshal([]byte("[latency, hops]"), &o))
+		assert.Equal(t, expected, &o)
+	})
+	t.Run("unknown criterion", func(t *testing.T) {
+		var o Ordering
+		assert.Error(t, json.Unmarshal([]byte(`["cost"]`), &o))
+		assert.Error(t, yaml.Unmarshal([]byte("[cost]"), &o))
+	})
+}
+
+func TestNewOrdering(t *testing.T) {
+	o, err := NewOrdering("latency, hops")
+	require.NoError(t, err)
+	assert.Equal(t, &Ordering{Criteria: []OrderingCriterion{OrderLatency, OrderHops}}, o)
+
+	o, err = NewOrdering("")
+	require.NoError(t, err)
+	assert.Empty(t, o.Criteria)
+
+	_, err = NewOrdering("latency,cost")
+	assert.Error(t, err)
+}
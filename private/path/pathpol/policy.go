@@ -14,10 +14,11 @@
 // limitations under the License.
 
 // Package pathpol implements path policies, documentation in doc/PathPolicy.md
-// Currently implemented: ACL, Sequence, Extends and Options.
+// Currently implemented: ACL, Sequence, Metadata, Ordering, Extends and Options.
 //
 // A policy has Filter() method that takes a slice of paths and returns a
-// filtered slice of paths.
+// filtered slice of paths. If the policy has an ordering, the returned paths
+// are ranked accordingly.
 package pathpol
 
 import (
@@ -42,8 +43,14 @@ type PolicyMap map[string]*ExtPolicy
 type FilterOptions struct {
 	// IgnoreSequence can be used to ignore the sequence part of policies.
 	IgnoreSequence bool
+	// DisjointFrom is the set of paths that the disjointness ordering
+	// criterion is evaluated against, e.g., the paths that are currently in
+	// use.
+	DisjointFrom []snet.Path
 }
 
+var _ snet.PathPolicy = (*Policy)(nil)
+
 // Policy is a compiled path policy object, all extended policies have been merged.
 type Policy struct {
 	Name        string       `json:"-"`
@@ -51,6 +58,8 @@ type Policy struct {
 	Sequence    *Sequence    `json:"sequence,omitempty"`
 	LocalISDAS  *LocalISDAS  `json:"local_isd_ases,omitempty"`
 	RemoteISDAS *RemoteISDAS `json:"remote_isd_ases,omitempty"`
+	Metadata    *Metadata    `json:"metadata,omitempty"`
+	Ordering    *Ordering    `json:"ordering,omitempty"`
 	Options     []Option     `json:"options,omitempty"`
 }
 
@@ -82,6 +91,9 @@ func (p *Policy) FilterOpt(paths []snet.Path, opts FilterOptions) []snet.Path {
 		paths = p.RemoteISDAS.Eval(paths)
 	}
 	paths = p.ACL.Eval(paths)
+	if p.Metadata != nil {
+		paths = p.Metadata.Eval(paths)
+	}
 	if p.Sequence != nil && !opts.IgnoreSequence {
 		paths = p.Sequence.Eval(paths)
 	}
@@ -89,6 +101,11 @@ func (p *Policy) FilterOpt(paths []snet.Path, opts FilterOptions) []snet.Path {
 	if len(p.Options) > 0 {
 		paths = p.evalOptions(paths, opts)
 	}
+	if p.Ordering != nil {
+		// Do not reorder the slice of the caller.
+		paths = append([]snet.Path(nil), paths...)
+		p.Ordering.Sort(paths, opts.DisjointFrom)
+	}
 	return paths
 }
 
@@ -145,6 +162,14 @@ func (p *Policy) applyExtended(extends []string, exPolicies []*ExtPolicy) error
 		if p.RemoteISDAS == nil {
 			p.RemoteISDAS = policy.RemoteISDAS
 		}
+		// Replace metadata filter.
+		if p.Metadata == nil {
+			p.Metadata = policy.Metadata
+		}
+		// Replace ordering.
+		if p.Ordering == nil {
+			p.Ordering = policy.Ordering
+		}
 	}
 	return nil
 }
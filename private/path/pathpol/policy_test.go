@@ -18,12 +18,14 @@ package pathpol
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/util"
 	"github.com/scionproto/scion/pkg/private/xtest/graph"
 	"github.com/scionproto/scion/pkg/segment/iface"
 	"github.com/scionproto/scion/pkg/snet"
@@ -610,6 +612,20 @@ func TestPolicyJsonConversion(t *testing.T) {
 							},
 						},
 					},
+					Metadata: &Metadata{
+						MaxLatency:       &util.DurWrap{Duration: 50 * time.Millisecond},
+						MinBandwidth:     1000,
+						ExcludeLinkTypes: []LinkType{LinkType(snet.LinkTypeOpennet)},
+						Geofence: &Geofence{
+							BoundingBoxes: []BoundingBox{
+								{MinLatitude: 45, MaxLatitude: 48, MinLongitude: 5, MaxLongitude: 11},
+							},
+							Countries: []string{"Switzerland"},
+						},
+					},
+					Ordering: &Ordering{
+						Criteria: []OrderingCriterion{OrderLatency, OrderBandwidth},
+					},
 				},
 			},
 			Weight: 0,
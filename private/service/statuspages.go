@@ -20,6 +20,7 @@ import (
 	"html/template"
 	"net/http"
 	"os"
+	"reflect"
 	"sort"
 	"strings"
 
@@ -124,11 +125,19 @@ func (s StatusPages) Register(serveMux *http.ServeMux, elemId string) error {
 	return nil
 }
 
-// NewConfigStatusPage returns a page with the specified TOML config.
+// Redacter is implemented by configurations that contain secrets, e.g.,
+// passwords. Redact removes the secrets from the configuration.
+type Redacter interface {
+	Redact()
+}
+
+// NewConfigStatusPage returns a page with the specified TOML config. The
+// secrets are removed from a copy of the config before it is shown, by calling
+// Redact on all the nested structs that implement Redacter.
 func NewConfigStatusPage(config interface{}) StatusPage {
 	handler := func(w http.ResponseWriter, r *http.Request) {
 		var buf bytes.Buffer
-		err := toml.NewEncoder(&buf).Encode(config)
+		err := toml.NewEncoder(&buf).Encode(redacted(config))
 		if err != nil {
 			http.Error(w, "Error encoding toml config", http.StatusInternalServerError)
 			return
@@ -142,6 +151,43 @@ func NewConfigStatusPage(config interface{}) StatusPage {
 	}
 }
 
+// redacted returns a copy of the config, in which all structs that implement
+// Redacter, or whose pointer implements Redacter, are redacted. Structs are
+// copied along pointers, other references, e.g., slices and maps, are shared
+// with the original config and are not redacted.
+func redacted(config interface{}) interface{} {
+	if config == nil {
+		return nil
+	}
+	return redactValue(reflect.ValueOf(config)).Interface()
+}
+
+func redactValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(redactValue(v.Elem()))
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < c.NumField(); i++ {
+			if f := c.Field(i); f.CanSet() {
+				f.Set(redactValue(f))
+			}
+		}
+		if r, ok := c.Addr().Interface().(Redacter); ok {
+			r.Redact()
+		}
+		return c
+	default:
+		return v
+	}
+}
+
 // NewInfoStatusPage returns a page with basic info about the process.
 func NewInfoStatusPage() StatusPage {
 	handler := func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,359 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/scionproto/scion/control/beacon"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	storagebeacon "github.com/scionproto/scion/private/storage/beacon"
+	"github.com/scionproto/scion/private/storage/db"
+)
+
+var _ beacon.DB = (*Backend)(nil)
+
+type Backend struct {
+	db *sql.DB
+	*executor
+}
+
+// New returns a new PostgreSQL backend connecting to the database described by the connection
+// string. If the database does not contain the schema yet, it is created. If the schema version
+// of the stored database is different from the one in schema.go, an error is returned.
+func New(connection string, ia addr.IA) (*Backend, error) {
+	db, err := db.NewPostgres(connection, SchemaName, Schema, SchemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &Backend{
+		executor: &executor{
+			db: db,
+			ia: ia,
+		},
+		db: db,
+	}, nil
+}
+
+// SetMaxOpenConns sets the maximum number of open connections.
+func (b *Backend) SetMaxOpenConns(maxOpenConns int) {
+	b.db.SetMaxOpenConns(maxOpenConns)
+}
+
+// SetMaxIdleConns sets the maximum number of idle connections.
+func (b *Backend) SetMaxIdleConns(maxIdleConns int) {
+	b.db.SetMaxIdleConns(maxIdleConns)
+}
+
+// Close closes the database.
+func (b *Backend) Close() error {
+	return b.db.Close()
+}
+
+type executor struct {
+	sync.RWMutex
+	db db.Sqler
+	ia addr.IA
+}
+
+func (e *executor) BeaconSources(ctx context.Context) ([]addr.IA, error) {
+	e.RLock()
+	defer e.RUnlock()
+	query := `SELECT DISTINCT StartIsd, StartAs FROM Beacons`
+	rows, err := e.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, db.NewReadError("Error selecting source IAs", err)
+	}
+	defer rows.Close()
+	var ias []addr.IA
+	for rows.Next() {
+		var isd, as int64
+		if err := rows.Scan(&isd, &as); err != nil {
+			return nil, err
+		}
+		ia, err := addr.IAFrom(addr.ISD(isd), addr.AS(as))
+		if err != nil {
+			return nil, err
+		}
+		ias = append(ias, ia)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ias, nil
+}
+
+func (e *executor) CandidateBeacons(
+	ctx context.Context,
+	setSize int,
+	usage beacon.Usage,
+	src addr.IA,
+) ([]beacon.Beacon, error) {
+
+	e.RLock()
+	defer e.RUnlock()
+	args := []interface{}{int64(usage), setSize}
+	srcCond := ``
+	if !src.IsZero() {
+		srcCond = `AND StartIsd = $3 AND StartAs = $4`
+		args = append(args, int64(src.ISD()), int64(src.AS()))
+	}
+	query := fmt.Sprintf(`
+		SELECT b.Beacon, b.InIntfID
+		FROM Beacons b
+		WHERE ( b.Usage & $1 ) = $1 %s
+		ORDER BY b.HopsLength ASC
+		LIMIT $2
+	`, srcCond)
+	rows, err := e.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, db.NewReadError("Error selecting beacons", err)
+	}
+	defer rows.Close()
+
+	beacons := make([]beacon.Beacon, 0, setSize)
+	for rows.Next() {
+		var rawBeacon sql.RawBytes
+		var inIfID int64
+		if err = rows.Scan(&rawBeacon, &inIfID); err != nil {
+			return nil, db.NewReadError(beacon.ErrReadingRows, err)
+		}
+		s, err := beacon.UnpackBeacon(rawBeacon)
+		if err != nil {
+			return nil, db.NewDataError(beacon.ErrParse, err)
+		}
+		beacons = append(beacons, beacon.Beacon{Segment: s, InIfID: uint16(inIfID)})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return beacons, nil
+}
+
+// InsertBeacon inserts the beacon if it is new or updates the changed
+// information. The beacon is inserted or updated in a single statement, so
+// that concurrent inserts of the same beacon, e.g., by different control
+// service instances that share the database, do not conflict.
+func (e *executor) InsertBeacon(
+	ctx context.Context,
+	b beacon.Beacon,
+	usage beacon.Usage,
+) (beacon.InsertStats, error) {
+
+	var ret beacon.InsertStats
+	e.Lock()
+	defer e.Unlock()
+	err := db.DoInTx(ctx, e.db, func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		ret, err = upsertBeacon(ctx, tx, b, usage, time.Now())
+		return err
+	})
+	if err != nil {
+		return beacon.InsertStats{}, err
+	}
+	return ret, nil
+}
+
+func (e *executor) GetBeacons(
+	ctx context.Context,
+	params *storagebeacon.QueryParams,
+) ([]storagebeacon.Beacon, error) {
+
+	e.RLock()
+	defer e.RUnlock()
+	stmt, args := e.buildQuery(params)
+	rows, err := e.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, serrors.Wrap("looking up beacons", err, "query", stmt)
+	}
+	defer rows.Close()
+	var res []storagebeacon.Beacon
+	for rows.Next() {
+		var RowID int64
+		var lastUpdated int64
+		var usage int64
+		var rawBeacon sql.RawBytes
+		var InIfID int64
+		err = rows.Scan(&RowID, &lastUpdated, &usage, &rawBeacon, &InIfID)
+		if err != nil {
+			return nil, serrors.Wrap("reading row", err)
+		}
+		seg, err := beacon.UnpackBeacon(rawBeacon)
+		if err != nil {
+			return nil, serrors.Wrap("parsing beacon", err)
+		}
+		res = append(res, storagebeacon.Beacon{
+			Beacon: beacon.Beacon{
+				Segment: seg,
+				InIfID:  uint16(InIfID),
+			},
+			Usage:       beacon.Usage(usage),
+			LastUpdated: time.Unix(0, lastUpdated),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (e *executor) DeleteBeacon(ctx context.Context, partialID string) error {
+	_, err := e.deleteInTx(ctx, func(tx *sql.Tx) (sql.Result, error) {
+		delStmt := `DELETE FROM Beacons WHERE encode(SegID, 'hex') ILIKE $1`
+		return tx.ExecContext(ctx, delStmt, partialID+"%")
+	})
+	return err
+}
+
+func (e *executor) buildQuery(params *storagebeacon.QueryParams) (string, []interface{}) {
+	var args db.PostgresArgs
+	query := "SELECT RowID, LastUpdated, Usage, Beacon, InIntfID FROM Beacons"
+	if params == nil {
+		return query, args
+	}
+	where := []string{}
+	if len(params.SegIDs) > 0 {
+		subQ := make([]string, 0, len(params.SegIDs))
+		for _, segID := range params.SegIDs {
+			subQ = append(subQ, fmt.Sprintf("position(%s::BYTEA IN SegID) = 1", args.Add(segID)))
+		}
+		where = append(where, fmt.Sprintf("(%s)", strings.Join(subQ, " OR ")))
+	}
+	if len(params.StartsAt) > 0 {
+		subQ := []string{}
+		for _, as := range params.StartsAt {
+			switch {
+			case as.IsZero():
+				continue
+			case as.ISD() == 0:
+				subQ = append(subQ, "StartAs="+args.Add(int64(as.AS())))
+			case as.AS() == 0:
+				subQ = append(subQ, "StartIsd="+args.Add(int64(as.ISD())))
+			case as.ISD() != 0 && as.AS() != 0:
+				subQ = append(subQ, fmt.Sprintf("(StartIsd=%s AND StartAs=%s)",
+					args.Add(int64(as.ISD())), args.Add(int64(as.AS()))))
+			}
+		}
+		if len(subQ) > 0 {
+			where = append(where, fmt.Sprintf("(%s)", strings.Join(subQ, " OR ")))
+		}
+	}
+	if len(params.IngressInterfaces) > 0 {
+		subQ := make([]string, 0, len(params.IngressInterfaces))
+		for _, intf := range params.IngressInterfaces {
+			subQ = append(subQ, "InIntfID="+args.Add(int64(intf)))
+		}
+		where = append(where, fmt.Sprintf("(%s)", strings.Join(subQ, " OR ")))
+	}
+
+	if len(params.Usages) > 0 {
+		subQ := []string{}
+		for _, u := range params.Usages {
+			if u > 0 {
+				p := args.Add(int64(u))
+				subQ = append(subQ, fmt.Sprintf("(Usage & %s = %s)", p, p))
+			}
+		}
+		if len(subQ) > 0 {
+			where = append(where, fmt.Sprintf("(%s)", strings.Join(subQ, " OR ")))
+		}
+	}
+
+	if !params.ValidAt.IsZero() {
+		p := args.Add(params.ValidAt.Unix())
+		where = append(where, fmt.Sprintf("(InfoTime <= %s AND %s <= ExpirationTime)", p, p))
+	}
+	// Assemble the query.
+	if len(where) > 0 {
+		query += "\n" + fmt.Sprintf("WHERE %s", strings.Join(where, " AND\n"))
+	}
+	query += "\n" + "ORDER BY LastUpdated DESC"
+	return query, args
+}
+
+// upsertBeacon inserts the beacon, or updates the changeable data of the
+// stored beacon if the beacon is newer.
+func upsertBeacon(
+	ctx context.Context,
+	tx *sql.Tx,
+	b beacon.Beacon,
+	usage beacon.Usage,
+	now time.Time,
+) (beacon.InsertStats, error) {
+
+	segID := b.Segment.ID()
+	fullID := b.Segment.FullID()
+	packed, err := beacon.PackBeacon(b.Segment)
+	if err != nil {
+		return beacon.InsertStats{}, db.NewInputDataError("pack segment", err)
+	}
+	start := b.Segment.FirstIA()
+	infoTime := b.Segment.Info.Timestamp.Unix()
+	lastUpdated := now.UnixNano()
+	expTime := b.Segment.MaxExpiry().Unix()
+
+	// A row that was inserted by the statement has no deleting transaction
+	// (xmax = 0), a row that was updated has.
+	inst := `
+	INSERT INTO Beacons (SegID, FullID, StartIsd, StartAs, InIntfID, HopsLength, InfoTime,
+		ExpirationTime, LastUpdated, Usage, Beacon)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	ON CONFLICT (SegID) DO UPDATE SET FullID=EXCLUDED.FullID, InIntfID=EXCLUDED.InIntfID,
+		HopsLength=EXCLUDED.HopsLength, InfoTime=EXCLUDED.InfoTime,
+		ExpirationTime=EXCLUDED.ExpirationTime, LastUpdated=EXCLUDED.LastUpdated,
+		Usage=EXCLUDED.Usage, Beacon=EXCLUDED.Beacon
+	WHERE Beacons.InfoTime < EXCLUDED.InfoTime
+	RETURNING xmax = 0
+	`
+	var inserted bool
+	err = tx.QueryRowContext(ctx, inst, segID, fullID, int64(start.ISD()), int64(start.AS()),
+		int64(b.InIfID), len(b.Segment.ASEntries), infoTime, expTime, lastUpdated,
+		int64(usage), packed).Scan(&inserted)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		// The stored beacon is at least as recent.
+		return beacon.InsertStats{}, nil
+	case err != nil:
+		return beacon.InsertStats{}, db.NewWriteError("insert beacon", err)
+	case inserted:
+		return beacon.InsertStats{Inserted: 1}, nil
+	default:
+		return beacon.InsertStats{Updated: 1}, nil
+	}
+}
+
+func (e *executor) DeleteExpiredBeacons(ctx context.Context, now time.Time) (int, error) {
+	return e.deleteInTx(ctx, func(tx *sql.Tx) (sql.Result, error) {
+		delStmt := `DELETE FROM Beacons WHERE ExpirationTime < $1`
+		return tx.ExecContext(ctx, delStmt, now.Unix())
+	})
+}
+
+func (e *executor) deleteInTx(
+	ctx context.Context,
+	delFunc func(tx *sql.Tx) (sql.Result, error),
+) (int, error) {
+
+	e.Lock()
+	defer e.Unlock()
+	return db.DeleteInTx(ctx, e.db, delFunc)
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/control/beacon"
+	"github.com/scionproto/scion/control/beacon/beacondbtest"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/private/storage/beacon/dbtest"
+	"github.com/scionproto/scion/private/storage/beacon/postgres"
+	"github.com/scionproto/scion/private/storage/db/pgtest"
+)
+
+var testIA = addr.MustParseIA("1-ff00:0:333")
+
+type TestBackend struct {
+	*postgres.Backend
+}
+
+func (b *TestBackend) Prepare(t *testing.T, _ context.Context) {
+	b.Backend = newDatabase(t, pgtest.Connection(t))
+}
+
+func TestBeaconDBSuite(t *testing.T) {
+	pgtest.SkipIfUnset(t)
+	tdb := &TestBackend{}
+	dbtest.Run(t, tdb)
+}
+
+// TestOpenExisting tests that New does not overwrite an existing database if
+// versions match.
+func TestOpenExisting(t *testing.T) {
+	connection := pgtest.Connection(t)
+	db := newDatabase(t, connection)
+	b := beacondbtest.InsertBeacon(t, db, beacondbtest.Info1, 2, 10, beacon.UsageProp)
+	db.Close()
+	// Open existing database
+	db = newDatabase(t, connection)
+	ctx, cancelF := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelF()
+	res, err := db.CandidateBeacons(ctx, 10, beacon.UsageProp, 0)
+	require.NoError(t, err)
+
+	beacondbtest.CheckResult(t, res, b)
+}
+
+// TestOpenNewer tests that New does not overwrite an existing database if it's
+// of a newer version.
+func TestOpenNewer(t *testing.T) {
+	connection := pgtest.Connection(t)
+	b := newDatabase(t, connection)
+	// Write a newer version
+	_, err := b.DB().Exec("UPDATE schema_versions SET version=$1 WHERE name=$2",
+		postgres.SchemaVersion+1, postgres.SchemaName)
+	require.NoError(t, err)
+	b.DB().Close()
+	b, err = postgres.New(connection, testIA)
+	assert.Error(t, err)
+	assert.Nil(t, b)
+}
+
+func newDatabase(t *testing.T, connection string) *postgres.Backend {
+	b, err := postgres.New(connection, testIA)
+	require.NoError(t, err, "Failed to open DB")
+	t.Cleanup(func() { b.Close() })
+	return b
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+// schemaVersionsTable keeps track of the schema versions of the databases stored in a PostgreSQL
+// database. PostgreSQL has no equivalent of the SQLite user_version pragma, and several of our
+// databases can share the same PostgreSQL database, so the version is recorded per name.
+const schemaVersionsTable = `CREATE TABLE IF NOT EXISTS schema_versions(
+		name TEXT PRIMARY KEY,
+		version INTEGER NOT NULL
+	)`
+
+// NewPostgres returns a new PostgreSQL backend connecting to the database described by the
+// connection string, see https://pkg.go.dev/github.com/jackc/pgx/v5/pgconn#ParseConfig for the
+// supported formats. If the database does not contain the schema with the given name yet, it is
+// created. If the schema version of the stored database is different from schemaVersion, an error
+// is returned.
+func NewPostgres(connection, name, schema string, schemaVersion int) (*sql.DB, error) {
+	var err error
+	if connection == "" {
+		return nil, serrors.New("Empty connection not allowed for postgres")
+	}
+	db, err := sql.Open("pgx", connection)
+	if err != nil {
+		return nil, serrors.Wrap("Couldn't open PostgreSQL database", err)
+	}
+	// On future errors, close the sql database before exiting
+	defer func() {
+		if err != nil {
+			db.Close()
+		}
+	}()
+	// Make sure DB is reachable
+	if err = db.Ping(); err != nil {
+		return nil, serrors.Wrap("Initial DB ping failed, connection broken?", err)
+	}
+	err = DoInTx(context.Background(), db, func(ctx context.Context, tx *sql.Tx) error {
+		return setupPostgres(ctx, tx, name, schema, schemaVersion)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+func setupPostgres(ctx context.Context, tx *sql.Tx, name, schema string, version int) error {
+	if _, err := tx.ExecContext(ctx, schemaVersionsTable); err != nil {
+		return serrors.Wrap("Failed to set up schema versions", err, "name", name)
+	}
+	// Serialize concurrent setups of the same database.
+	if _, err := tx.ExecContext(ctx, "LOCK TABLE schema_versions"); err != nil {
+		return serrors.Wrap("Failed to lock schema versions", err, "name", name)
+	}
+	var existingVersion int
+	err := tx.QueryRowContext(ctx,
+		"SELECT version FROM schema_versions WHERE name=$1", name).Scan(&existingVersion)
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return serrors.Wrap("Failed to check schema version", err, "name", name)
+	case existingVersion != version:
+		return serrors.New("Database schema version mismatch",
+			"expected", version, "have", existingVersion, "name", name)
+	default:
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, schema); err != nil {
+		return serrors.Wrap("Failed to set up PostgreSQL database", err, "name", name)
+	}
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO schema_versions (name, version) VALUES ($1, $2)", name, version)
+	if err != nil {
+		return serrors.Wrap("Failed to write schema version", err, "name", name)
+	}
+	return nil
+}
+
+// PostgresArgs collects the arguments of a PostgreSQL query that is built dynamically.
+type PostgresArgs []interface{}
+
+// Add appends the argument and returns the placeholder that refers to it in the query.
+func (a *PostgresArgs) Add(arg interface{}) string {
+	*a = append(*a, arg)
+	return fmt.Sprintf("$%d", len(*a))
+}
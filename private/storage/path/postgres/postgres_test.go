@@ -0,0 +1,96 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/private/storage/db/pgtest"
+	pathdbtest "github.com/scionproto/scion/private/storage/path/dbtest"
+)
+
+var (
+	ifs1       = []uint64{0, 5, 2, 3, 6, 3, 1, 0}
+	hpGroupIDs = []uint64{
+		0,
+		0xffffffffffffffff,
+	}
+	timeout = 5 * time.Second
+)
+
+var _ pathdbtest.TestablePathDB = (*TestPathDB)(nil)
+
+type TestPathDB struct {
+	*Backend
+}
+
+func (b *TestPathDB) Prepare(t *testing.T, _ context.Context) {
+	b.Backend = newDatabase(t, pgtest.Connection(t))
+}
+
+func TestPathDBSuite(t *testing.T) {
+	pgtest.SkipIfUnset(t)
+	tdb := &TestPathDB{}
+	pathdbtest.TestPathDB(t, tdb)
+}
+
+// TestOpenExisting tests that New does not overwrite an existing database if
+// versions match.
+func TestOpenExisting(t *testing.T) {
+	connection := pgtest.Connection(t)
+	b := newDatabase(t, connection)
+	TS := uint32(10)
+	pseg1, _ := pathdbtest.AllocPathSegment(t, ifs1, TS)
+	ctx, cancelF := context.WithTimeout(context.Background(), timeout)
+	defer cancelF()
+	pathdbtest.InsertSeg(t, ctx, b, pseg1, hpGroupIDs)
+	b.db.Close()
+	// Call
+	b = newDatabase(t, connection)
+	// Test
+	// Check that path segment is still there.
+	res, err := b.Get(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(res), "Segment still exists")
+}
+
+// TestOpenNewer tests that New does not overwrite an existing database if it's
+// of a newer version.
+func TestOpenNewer(t *testing.T) {
+	connection := pgtest.Connection(t)
+	b := newDatabase(t, connection)
+	// Write a newer version
+	_, err := b.db.Exec("UPDATE schema_versions SET version=$1 WHERE name=$2",
+		SchemaVersion+1, SchemaName)
+	require.NoError(t, err)
+	b.db.Close()
+	// Call
+	b, err = New(connection)
+	// Test
+	assert.Error(t, err)
+	assert.Nil(t, b)
+}
+
+func newDatabase(t *testing.T, connection string) *Backend {
+	b, err := New(connection)
+	require.NoError(t, err, "Failed to open DB")
+	t.Cleanup(func() { b.Close() })
+	return b
+}
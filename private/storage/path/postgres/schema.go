@@ -0,0 +1,65 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file contains a PostgreSQL backend for the PathDB.
+
+package postgres
+
+const (
+	// SchemaName identifies the PathDB schema in the PostgreSQL database.
+	SchemaName = "path_db"
+	// SchemaVersion is the version of the PostgreSQL schema understood by this backend.
+	// Whenever changes to the schema are made, this version number should be increased
+	// to prevent data corruption between incompatible database schemas.
+	SchemaVersion = 1
+	// Schema is the PostgreSQL database layout.
+	Schema = `CREATE TABLE Segments(
+		RowID BIGSERIAL PRIMARY KEY,
+		SegID BYTEA UNIQUE NOT NULL,
+		FullID BYTEA UNIQUE NOT NULL,
+		LastUpdated BIGINT NOT NULL,
+		InfoTs BIGINT NOT NULL,
+		Segment BYTEA NOT NULL,
+		MaxExpiry BIGINT NOT NULL,
+		StartIsdID BIGINT NOT NULL,
+		StartAsID BIGINT NOT NULL,
+		EndIsdID BIGINT NOT NULL,
+		EndAsID BIGINT NOT NULL
+	);
+	CREATE TABLE IntfToSeg(
+		IsdID BIGINT NOT NULL,
+		AsID BIGINT NOT NULL,
+		IntfID BIGINT NOT NULL,
+		SegRowID BIGINT NOT NULL REFERENCES Segments(RowID) ON DELETE CASCADE
+	);
+	CREATE INDEX RowIdIndex ON IntfToSeg(SegRowID);
+	CREATE TABLE SegTypes(
+		SegRowID BIGINT NOT NULL REFERENCES Segments(RowID) ON DELETE CASCADE,
+		Type INTEGER NOT NULL,
+		PRIMARY KEY (SegRowID, Type)
+	);
+	CREATE TABLE HPGroupIDs(
+		SegRowID BIGINT NOT NULL REFERENCES Segments(RowID) ON DELETE CASCADE,
+		GroupID BIGINT NOT NULL,
+		PRIMARY KEY (SegRowID, GroupID)
+	);
+	CREATE TABLE NextQuery(
+		SrcIsdID BIGINT NOT NULL,
+		SrcAsID BIGINT NOT NULL,
+		DstIsdID BIGINT NOT NULL,
+		DstAsID BIGINT NOT NULL,
+		NextQuery BIGINT NOT NULL,
+		PRIMARY KEY (SrcIsdID, SrcAsID, DstIsdID, DstAsID)
+	);`
+)
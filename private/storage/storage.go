@@ -19,26 +19,33 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/scionproto/scion/control/beacon"
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/drkey"
 	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/private/config"
 	"github.com/scionproto/scion/private/pathdb"
 	"github.com/scionproto/scion/private/periodic"
 	"github.com/scionproto/scion/private/revcache"
 	"github.com/scionproto/scion/private/revcache/memrevcache"
 	beaconstorage "github.com/scionproto/scion/private/storage/beacon"
+	postgresbeacondb "github.com/scionproto/scion/private/storage/beacon/postgres"
 	sqlitebeacondb "github.com/scionproto/scion/private/storage/beacon/sqlite"
 	"github.com/scionproto/scion/private/storage/cleaner"
 	"github.com/scionproto/scion/private/storage/db"
 	sqlitelevel1 "github.com/scionproto/scion/private/storage/drkey/level1/sqlite"
 	sqlitelevel2 "github.com/scionproto/scion/private/storage/drkey/level2/sqlite"
 	sqlitesecret "github.com/scionproto/scion/private/storage/drkey/secret/sqlite"
+	postgrespathdb "github.com/scionproto/scion/private/storage/path/postgres"
 	sqlitepathdb "github.com/scionproto/scion/private/storage/path/sqlite"
 	truststorage "github.com/scionproto/scion/private/storage/trust"
+	postgrestrustdb "github.com/scionproto/scion/private/storage/trust/postgres"
 	sqlitetrustdb "github.com/scionproto/scion/private/storage/trust/sqlite"
 	"github.com/scionproto/scion/private/trust"
 )
@@ -49,6 +56,9 @@ type Backend string
 const (
 	// BackendSqlite indicates an sqlite backend.
 	BackendSqlite Backend = "sqlite"
+	// BackendPostgres indicates a PostgreSQL backend. It is supported by the beacon, path and
+	// trust databases.
+	BackendPostgres Backend = "postgres"
 	// DefaultPath indicates the default connection string for a generic database.
 	DefaultPath              = "/share/scion.db"
 	DefaultTrustDBPath       = "/share/data/%s.trust.db"
@@ -61,21 +71,27 @@ const (
 // Default samples for various databases.
 var (
 	SampleBeaconDB = DBConfig{
+		Backend:    BackendSqlite,
 		Connection: "/share/cache/%s.beacon.db",
 	}
 	SamplePathDB = DBConfig{
+		Backend:    BackendSqlite,
 		Connection: DefaultPathDBPath,
 	}
 	SampleTrustDB = DBConfig{
+		Backend:    BackendSqlite,
 		Connection: DefaultTrustDBPath,
 	}
 	SampleDRKeyLevel1DB = DBConfig{
+		Backend:    BackendSqlite,
 		Connection: DefaultDRKeyLevel1DBPath,
 	}
 	SampleDRKeyLevel2DB = DBConfig{
+		Backend:    BackendSqlite,
 		Connection: DefaultDRKeyLevel2DBPath,
 	}
 	SampleDRKeySecretValueDB = DBConfig{
+		Backend:    BackendSqlite,
 		Connection: DefaultDRKeySVDBPath,
 	}
 )
@@ -105,13 +121,45 @@ type PathDB interface {
 	pathdb.DB
 }
 
+// dsnPassword matches the password of a keyword/value connection string.
+var dsnPassword = regexp.MustCompile(`(\bpassword\s*=\s*)('(?:[^'\\]|\\.)*'|\S+)`)
+
+// RedactConnection returns the connection string with the password replaced by
+// "xxxxx". The connection string is the file path for SQLite, and the
+// connection URI or the keyword/value connection string for PostgreSQL.
+func RedactConnection(conn string) string {
+	if !strings.HasPrefix(conn, "postgres://") && !strings.HasPrefix(conn, "postgresql://") {
+		return dsnPassword.ReplaceAllString(conn, "${1}xxxxx")
+	}
+	u, err := url.Parse(conn)
+	if err != nil {
+		// Do not risk leaking the user info of a malformed URI.
+		if i := strings.LastIndex(conn, "@"); i >= 0 {
+			return conn[:strings.Index(conn, "://")+3] + "xxxxx" + conn[i:]
+		}
+		return conn
+	}
+	if q := u.Query(); q.Has("password") {
+		q.Set("password", "xxxxx")
+		u.RawQuery = q.Encode()
+	}
+	return u.Redacted()
+}
+
 var _ (config.Config) = (*DBConfig)(nil)
 
 // DBConfig is the configuration for the connection to a database.
 type DBConfig struct {
-	Connection   string `toml:"connection,omitempty"`
-	MaxOpenConns int    `toml:"max_open_conns,omitempty"`
-	MaxIdleConns int    `toml:"max_idle_conns,omitempty"`
+	Backend      Backend `toml:"backend,omitempty"`
+	Connection   string  `toml:"connection,omitempty"`
+	MaxOpenConns int     `toml:"max_open_conns,omitempty"`
+	MaxIdleConns int     `toml:"max_idle_conns,omitempty"`
+}
+
+// Redact replaces the password in the connection string, such that the
+// configuration can be shown on the config status page.
+func (cfg *DBConfig) Redact() {
+	cfg.Connection = RedactConnection(cfg.Connection)
 }
 
 type writeDefault struct {
@@ -120,6 +168,9 @@ type writeDefault struct {
 }
 
 func (w writeDefault) InitDefaults() {
+	if w.Backend == "" {
+		w.Backend = BackendSqlite
+	}
 	if w.Connection == "" {
 		w.Connection = w.defaultPath
 	}
@@ -141,13 +192,29 @@ func SetConnLimits(d db.LimitSetter, c DBConfig) {
 }
 
 func (cfg *DBConfig) InitDefaults() {
+	if cfg.Backend == "" {
+		cfg.Backend = BackendSqlite
+	}
 	if cfg.Connection == "" {
 		cfg.Connection = DefaultPath
 	}
 }
 
 func (cfg *DBConfig) Validate() error {
-	return nil
+	switch cfg.Backend {
+	case "", BackendSqlite, BackendPostgres:
+		return nil
+	default:
+		return serrors.New("unsupported database backend", "backend", cfg.Backend)
+	}
+}
+
+// backend returns the configured backend, defaulting to sqlite if unset.
+func (cfg *DBConfig) backend() Backend {
+	if cfg.Backend == "" {
+		return BackendSqlite
+	}
+	return cfg.Backend
 }
 
 // Sample writes a config sample to the writer.
@@ -161,8 +228,9 @@ func (cfg *DBConfig) ConfigName() string {
 }
 
 func NewBeaconStorage(c DBConfig, ia addr.IA) (BeaconDB, error) {
-	log.Info("Connecting BeaconDB", "backend", BackendSqlite, "connection", c.Connection)
-	db, err := sqlitebeacondb.New(c.Connection, ia)
+	log.Info("Connecting BeaconDB", "backend", c.backend(),
+		"connection", RedactConnection(c.Connection))
+	db, err := newBeaconBackend(c, ia)
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +253,24 @@ func NewBeaconStorage(c DBConfig, ia addr.IA) (BeaconDB, error) {
 	}, nil
 }
 
+// beaconBackend is implemented by all beacon database backends.
+type beaconBackend interface {
+	BeaconDB
+	db.LimitSetter
+	DeleteExpiredBeacons(ctx context.Context, now time.Time) (int, error)
+}
+
+func newBeaconBackend(c DBConfig, ia addr.IA) (beaconBackend, error) {
+	switch c.backend() {
+	case BackendSqlite:
+		return sqlitebeacondb.New(c.Connection, ia)
+	case BackendPostgres:
+		return postgresbeacondb.New(c.Connection, ia)
+	default:
+		return nil, serrors.New("unsupported database backend", "backend", c.Backend)
+	}
+}
+
 // beaconDBWithCleaner implements the BeaconDB interface and stops both the
 // database and the cleanup task on Close.
 type beaconDBWithCleaner struct {
@@ -198,8 +284,9 @@ func (b beaconDBWithCleaner) Close() error {
 }
 
 func NewPathStorage(c DBConfig) (PathDB, error) {
-	log.Info("Connecting PathDB", "backend", BackendSqlite, "connection", c.Connection)
-	db, err := sqlitepathdb.New(c.Connection)
+	log.Info("Connecting PathDB", "backend", c.backend(),
+		"connection", RedactConnection(c.Connection))
+	db, err := newPathBackend(c)
 	if err != nil {
 		return nil, err
 	}
@@ -223,6 +310,23 @@ func NewPathStorage(c DBConfig) (PathDB, error) {
 	}, nil
 }
 
+// pathBackend is implemented by all path database backends.
+type pathBackend interface {
+	PathDB
+	db.LimitSetter
+}
+
+func newPathBackend(c DBConfig) (pathBackend, error) {
+	switch c.backend() {
+	case BackendSqlite:
+		return sqlitepathdb.New(c.Connection)
+	case BackendPostgres:
+		return postgrespathdb.New(c.Connection)
+	default:
+		return nil, serrors.New("unsupported database backend", "backend", c.Backend)
+	}
+}
+
 // pathDBWithCleaner implements the path DB interface and stops both the
 // database and the cleanup task on Close.
 type pathDBWithCleaner struct {
@@ -241,17 +345,34 @@ func NewRevocationStorage() revcache.RevCache {
 }
 
 func NewTrustStorage(c DBConfig) (TrustDB, error) {
-	log.Info("Connecting TrustDB", "backend", BackendSqlite, "connection", c.Connection)
-	db, err := sqlitetrustdb.New(c.Connection)
-	if err != nil {
-		return nil, err
+	log.Info("Connecting TrustDB", "backend", c.backend(),
+		"connection", RedactConnection(c.Connection))
+	switch c.backend() {
+	case BackendSqlite:
+		db, err := sqlitetrustdb.New(c.Connection)
+		if err != nil {
+			return nil, err
+		}
+		SetConnLimits(db, c)
+		return db, nil
+	case BackendPostgres:
+		db, err := postgrestrustdb.New(c.Connection)
+		if err != nil {
+			return nil, err
+		}
+		SetConnLimits(db, c)
+		return db, nil
+	default:
+		return nil, serrors.New("unsupported database backend", "backend", c.Backend)
 	}
-	SetConnLimits(db, c)
-	return db, nil
 }
 
 func NewDRKeySecretValueStorage(c DBConfig) (drkey.SecretValueDB, error) {
-	log.Info("Connecting DRKeySecretValueDB", "	", BackendSqlite, "connection", c.Connection)
+	log.Info("Connecting DRKeySecretValueDB", "	", BackendSqlite,
+		"connection", RedactConnection(c.Connection))
+	if c.backend() != BackendSqlite {
+		return nil, serrors.New("unsupported database backend", "backend", c.Backend)
+	}
 	db, err := sqlitesecret.NewBackend(c.Connection)
 	if err != nil {
 		return nil, err
@@ -261,7 +382,11 @@ func NewDRKeySecretValueStorage(c DBConfig) (drkey.SecretValueDB, error) {
 }
 
 func NewDRKeyLevel1Storage(c DBConfig) (drkey.Level1DB, error) {
-	log.Info("Connecting DRKeyLevel1DB", "	", BackendSqlite, "connection", c.Connection)
+	log.Info("Connecting DRKeyLevel1DB", "	", BackendSqlite,
+		"connection", RedactConnection(c.Connection))
+	if c.backend() != BackendSqlite {
+		return nil, serrors.New("unsupported database backend", "backend", c.Backend)
+	}
 	db, err := sqlitelevel1.NewBackend(c.Connection)
 	if err != nil {
 		return nil, err
@@ -271,7 +396,11 @@ func NewDRKeyLevel1Storage(c DBConfig) (drkey.Level1DB, error) {
 }
 
 func NewDRKeyLevel2Storage(c DBConfig) (drkey.Level2DB, error) {
-	log.Info("Connecting DRKeyDB", "	", BackendSqlite, "connection", c.Connection)
+	log.Info("Connecting DRKeyDB", "	", BackendSqlite,
+		"connection", RedactConnection(c.Connection))
+	if c.backend() != BackendSqlite {
+		return nil, serrors.New("unsupported database backend", "backend", c.Backend)
+	}
 	db, err := sqlitelevel2.NewBackend(c.Connection)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,93 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/private/service"
+	"github.com/scionproto/scion/private/storage"
+)
+
+func TestRedactConnection(t *testing.T) {
+	testCases := map[string]struct {
+		Connection string
+		Expected   string
+	}{
+		"sqlite": {
+			Connection: "/share/cache/cs1.beacon.db",
+			Expected:   "/share/cache/cs1.beacon.db",
+		},
+		"uri without password": {
+			Connection: "postgres://scion@db:5432/scion",
+			Expected:   "postgres://scion@db:5432/scion",
+		},
+		"uri": {
+			Connection: "postgres://scion:secret@db:5432/scion?sslmode=disable",
+			Expected:   "postgres://scion:xxxxx@db:5432/scion?sslmode=disable",
+		},
+		"uri query": {
+			Connection: "postgresql://db/scion?password=secret&user=scion",
+			Expected:   "postgresql://db/scion?password=xxxxx&user=scion",
+		},
+		"malformed uri": {
+			Connection: "postgres://scion:se%cret@db/scion",
+			Expected:   "postgres://xxxxx@db/scion",
+		},
+		"keyword/value": {
+			Connection: "host=db user=scion password=secret dbname=scion",
+			Expected:   "host=db user=scion password=xxxxx dbname=scion",
+		},
+		"keyword/value quoted": {
+			Connection: "host=db password = 'se cr\\'et' dbname=scion",
+			Expected:   "host=db password = xxxxx dbname=scion",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.Expected, storage.RedactConnection(tc.Connection))
+		})
+	}
+}
+
+func TestConfigStatusPageRedacted(t *testing.T) {
+	cfg := struct {
+		BeaconDB storage.DBConfig  `toml:"beacon_db"`
+		PathDB   *storage.DBConfig `toml:"path_db"`
+	}{
+		BeaconDB: storage.DBConfig{
+			Backend:    storage.BackendPostgres,
+			Connection: "postgres://scion:secret@db/scion",
+		},
+		PathDB: &storage.DBConfig{
+			Backend:    storage.BackendPostgres,
+			Connection: "host=db password=secret",
+		},
+	}
+	rec := httptest.NewRecorder()
+	service.NewConfigStatusPage(cfg).Handler(rec, httptest.NewRequest("GET", "/config", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "secret")
+	assert.Contains(t, rec.Body.String(), "postgres://scion:xxxxx@db/scion")
+	assert.Contains(t, rec.Body.String(), "host=db password=xxxxx")
+	// The config itself is not modified.
+	assert.Equal(t, "postgres://scion:secret@db/scion", cfg.BeaconDB.Connection)
+	assert.Equal(t, "host=db password=secret", cfg.PathDB.Connection)
+}
@@ -0,0 +1,340 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/private/storage/db"
+	truststorage "github.com/scionproto/scion/private/storage/trust"
+	"github.com/scionproto/scion/private/trust"
+)
+
+// DB implements the trust DB with a PostgreSQL backend.
+type DB struct {
+	db *sql.DB
+	*executor
+}
+
+// New returns a new PostgreSQL backend connecting to the database described by the connection
+// string. If the database does not contain the schema yet, it is created. If the schema version
+// of the stored database is different from the one in schema.go, an error is returned.
+func New(connection string) (DB, error) {
+	db, err := db.NewPostgres(connection, SchemaName, Schema, SchemaVersion)
+	if err != nil {
+		return DB{}, err
+	}
+	return DB{
+		db: db,
+		executor: &executor{
+			db: db,
+		},
+	}, nil
+}
+
+// SetMaxOpenConns sets the maximum number of open connections.
+func (db DB) SetMaxOpenConns(maxOpenConns int) {
+	db.db.SetMaxOpenConns(maxOpenConns)
+}
+
+// SetMaxIdleConns sets the maximum number of idle connections.
+func (db DB) SetMaxIdleConns(maxIdleConns int) {
+	db.db.SetMaxIdleConns(maxIdleConns)
+}
+
+// Close closes the database.
+func (db DB) Close() error {
+	return db.db.Close()
+}
+
+type executor struct {
+	sync.RWMutex
+	db db.Sqler
+}
+
+func (e *executor) SignedTRC(ctx context.Context, id cppki.TRCID) (cppki.SignedTRC, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	if id.Base.IsLatest() != id.Serial.IsLatest() {
+		return cppki.SignedTRC{}, serrors.New("unsupported TRC ID for query", "id", id)
+	}
+
+	sqlQuery := `SELECT trc FROM trcs WHERE isd_id=$1
+				ORDER BY base DESC, serial DESC
+				LIMIT 1`
+	args := []interface{}{int64(id.ISD)}
+	if !id.Base.IsLatest() {
+		sqlQuery = `SELECT trc FROM trcs WHERE isd_id=$1 AND base=$2 AND serial=$3`
+		args = append(args, int64(id.Base), int64(id.Serial))
+	}
+	r := e.db.QueryRowContext(ctx, sqlQuery, args...)
+	var rawTRC []byte
+	err := r.Scan(&rawTRC)
+	if err == sql.ErrNoRows {
+		return cppki.SignedTRC{}, nil
+	}
+	if err != nil {
+		return cppki.SignedTRC{}, serrors.JoinNoStack(db.ErrReadFailed, err)
+	}
+	trc, err := cppki.DecodeSignedTRC(rawTRC)
+	if err != nil {
+		return cppki.SignedTRC{}, serrors.JoinNoStack(db.ErrDataInvalid, err)
+	}
+	return trc, nil
+}
+
+func (e *executor) InsertTRC(ctx context.Context, trc cppki.SignedTRC) (bool, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	// The conflict is resolved by the database, so that concurrent inserts of
+	// the same TRC, e.g., by different service instances that share the
+	// database, do not fail.
+	sqlQuery := `INSERT INTO trcs (isd_id, base, serial, fingerprint, trc)
+				 VALUES ($1, $2, $3, $4, $5)
+				 ON CONFLICT (isd_id, base, serial) DO NOTHING`
+	fingerprint := trcFingerprint(trc)
+	var inserted bool
+	err := db.DoInTx(ctx, e.db, func(ctx context.Context, tx *sql.Tx) error {
+		r, err := tx.ExecContext(ctx, sqlQuery,
+			int64(trc.TRC.ID.ISD),
+			int64(trc.TRC.ID.Base),
+			int64(trc.TRC.ID.Serial),
+			fingerprint,
+			trc.Raw,
+		)
+		if err != nil {
+			return serrors.JoinNoStack(db.ErrWriteFailed, err)
+		}
+		ar, err := r.RowsAffected()
+		if err != nil {
+			return serrors.JoinNoStack(db.ErrWriteFailed, err)
+		}
+		if inserted = ar > 0; inserted {
+			return nil
+		}
+		// A different TRC with the same ID must not be silently ignored.
+		var stored []byte
+		err = tx.QueryRowContext(ctx,
+			`SELECT fingerprint FROM trcs WHERE isd_id=$1 AND base=$2 AND serial=$3`,
+			int64(trc.TRC.ID.ISD), int64(trc.TRC.ID.Base), int64(trc.TRC.ID.Serial),
+		).Scan(&stored)
+		if err != nil {
+			return serrors.JoinNoStack(db.ErrReadFailed, err)
+		}
+		if !bytes.Equal(stored, fingerprint) {
+			return db.NewWriteError("different TRC with the same ID exists", nil,
+				"id", trc.TRC.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return inserted, nil
+}
+
+func (e *executor) Chains(ctx context.Context,
+	query trust.ChainQuery) ([][]*x509.Certificate, error) {
+
+	e.RLock()
+	defer e.RUnlock()
+
+	sqlQuery := []string{"SELECT as_cert, ca_cert FROM chains"}
+	var args db.PostgresArgs
+	var filters []string
+
+	if len(query.SubjectKeyID) != 0 {
+		filters = append(filters, "key_id="+args.Add(query.SubjectKeyID))
+	}
+	if !query.Validity.IsZero() {
+		filters = append(filters, fmt.Sprintf("not_before<=%s AND not_after>=%s",
+			args.Add(query.Validity.NotBefore.UTC()), args.Add(query.Validity.NotAfter.UTC())))
+	}
+	if query.IA.ISD() != 0 {
+		filters = append(filters, "isd_id="+args.Add(int64(query.IA.ISD())))
+	}
+	if query.IA.AS() != 0 {
+		filters = append(filters, "as_id="+args.Add(int64(query.IA.AS())))
+	}
+	if len(filters) != 0 {
+		sqlQuery = append(sqlQuery, "WHERE")
+	}
+	sqlQuery = append(sqlQuery, strings.Join(filters, " AND "))
+	rows, err := e.db.QueryContext(ctx, strings.Join(sqlQuery, "\n"), args...)
+	if err != nil {
+		return nil, serrors.JoinNoStack(db.ErrReadFailed, err)
+	}
+	defer rows.Close()
+	var chains [][]*x509.Certificate
+	for rows.Next() {
+		var rawAS, rawCA []byte
+		if err := rows.Scan(&rawAS, &rawCA); err != nil {
+			return nil, serrors.JoinNoStack(db.ErrReadFailed, err)
+		}
+		as, err := x509.ParseCertificate(rawAS)
+		if err != nil {
+			return nil, serrors.JoinNoStack(db.ErrDataInvalid, err)
+		}
+		ca, err := x509.ParseCertificate(rawCA)
+		if err != nil {
+			return nil, serrors.JoinNoStack(db.ErrDataInvalid, err)
+		}
+		chains = append(chains, []*x509.Certificate{as, ca})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, serrors.JoinNoStack(db.ErrReadFailed, err)
+	}
+	return chains, nil
+}
+
+func (e *executor) Chain(ctx context.Context,
+	chainID []byte) ([]*x509.Certificate, error) {
+
+	e.RLock()
+	defer e.RUnlock()
+	sqlQuery := "SELECT as_cert, ca_cert FROM chains WHERE chain_fingerprint=$1"
+	r := e.db.QueryRowContext(ctx, sqlQuery, chainID)
+	var chain []*x509.Certificate
+	var rawAS, rawCA []byte
+	if err := r.Scan(&rawAS, &rawCA); err != nil {
+		return nil, serrors.JoinNoStack(db.ErrReadFailed, err)
+	}
+	as, err := x509.ParseCertificate(rawAS)
+	if err != nil {
+		return nil, serrors.JoinNoStack(db.ErrDataInvalid, err)
+	}
+	ca, err := x509.ParseCertificate(rawCA)
+	if err != nil {
+		return nil, serrors.JoinNoStack(db.ErrDataInvalid, err)
+	}
+	chain = []*x509.Certificate{as, ca}
+	return chain, nil
+}
+
+func (e *executor) InsertChain(ctx context.Context, chain []*x509.Certificate) (bool, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	if len(chain) != 2 {
+		return false, serrors.JoinNoStack(db.ErrInvalidInputData, nil,
+			"msg", "invalid chain length",
+			"expected", 2, "actual", len(chain))
+
+	}
+	ia, err := cppki.ExtractIA(chain[0].Subject)
+	if err != nil {
+		return false, serrors.JoinNoStack(db.ErrInvalidInputData, err,
+			"msg", "invalid AS cert, invalid ISD-AS")
+
+	}
+	query := `INSERT INTO chains (isd_id, as_id, key_id, not_before, not_after,
+								  chain_fingerprint, as_cert, ca_cert)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			  ON CONFLICT DO NOTHING`
+	var inserted bool
+	err = db.DoInTx(ctx, e.db, func(ctx context.Context, tx *sql.Tx) error {
+		r, err := tx.ExecContext(ctx, query, int64(ia.ISD()), int64(ia.AS()),
+			chain[0].SubjectKeyId, chain[0].NotBefore.UTC(), chain[0].NotAfter.UTC(),
+			truststorage.ChainID(chain), chain[0].Raw, chain[1].Raw)
+		if err != nil {
+			return serrors.JoinNoStack(db.ErrWriteFailed, err)
+		}
+		ar, err := r.RowsAffected()
+		if err != nil {
+			return serrors.JoinNoStack(db.ErrWriteFailed, err)
+		}
+		inserted = ar > 0
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return inserted, nil
+}
+
+// SignedTRCs returns the TRC from each ISD in the trust database according to the query.
+func (e *executor) SignedTRCs(ctx context.Context,
+	query truststorage.TRCsQuery) (cppki.SignedTRCs, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	var filter string
+	var args db.PostgresArgs
+	if len(query.ISD) > 0 {
+		subQ := make([]string, 0, len(query.ISD))
+		for _, ISD := range query.ISD {
+			subQ = append(subQ, "isd_id="+args.Add(int64(ISD)))
+		}
+		filter = fmt.Sprintf("WHERE (%s)", strings.Join(subQ, " OR "))
+	}
+
+	var sqlQuery string
+	if !query.Latest {
+		sqlQuery = fmt.Sprintf("SELECT trc FROM trcs %s", filter)
+	} else {
+		sqlQuery = fmt.Sprintf(`
+			SELECT trc
+			FROM (
+				SELECT
+					trc,
+					ROW_NUMBER() OVER(
+						PARTITION BY isd_id ORDER BY base DESC, serial DESC
+					) as row_number
+				FROM trcs
+				%s
+			) AS added_row_numbers
+			WHERE row_number = 1;
+
+		`, filter)
+	}
+	rows, err := e.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, serrors.JoinNoStack(db.ErrReadFailed, err)
+	}
+	defer rows.Close()
+	var res cppki.SignedTRCs
+	for rows.Next() {
+		var rawTRC []byte
+		if err := rows.Scan(&rawTRC); err != nil {
+			return nil, serrors.JoinNoStack(db.ErrReadFailed, err)
+		}
+		curRes, err := cppki.DecodeSignedTRC(rawTRC)
+		if err != nil {
+			return nil, serrors.JoinNoStack(db.ErrDataInvalid, err)
+		}
+		res = append(res, curRes)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func trcFingerprint(trc cppki.SignedTRC) []byte {
+	h := sha256.New()
+	h.Write(trc.TRC.Raw)
+	return h.Sum(nil)
+}
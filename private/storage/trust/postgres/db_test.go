@@ -0,0 +1,46 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/private/storage/db/pgtest"
+	"github.com/scionproto/scion/private/storage/trust/dbtest"
+	"github.com/scionproto/scion/private/storage/trust/postgres"
+)
+
+type testDB struct {
+	postgres.DB
+}
+
+func (b *testDB) Prepare(t *testing.T, _ context.Context) {
+	b.DB = newDatabase(t)
+}
+
+func TestDB(t *testing.T) {
+	pgtest.SkipIfUnset(t)
+	dbtest.Run(t, &testDB{}, dbtest.Config{})
+}
+
+func newDatabase(t *testing.T) postgres.DB {
+	db, err := postgres.New(pgtest.Connection(t))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+const (
+	// SchemaName identifies the TrustDB schema in the PostgreSQL database.
+	SchemaName = "trust_db"
+	// SchemaVersion is the version of the PostgreSQL schema understood by this backend.
+	// Whenever changes to the schema are made, this version number should be increased
+	// to prevent data corruption between incompatible database schemas.
+	SchemaVersion = 1
+	// Schema is the PostgreSQL database layout.
+	Schema = `
+	CREATE TABLE chains(
+		isd_id BIGINT NOT NULL,
+		as_id BIGINT NOT NULL,
+		key_id BYTEA NOT NULL,
+		not_before TIMESTAMPTZ NOT NULL,
+		not_after TIMESTAMPTZ NOT NULL,
+		chain_fingerprint BYTEA NOT NULL,
+		as_cert BYTEA NOT NULL,
+		ca_cert BYTEA NOT NULL,
+		PRIMARY KEY (isd_id, as_id, key_id, not_before, not_after, chain_fingerprint)
+	);
+	CREATE TABLE trcs(
+		isd_id BIGINT NOT NULL,
+		base BIGINT NOT NULL,
+		serial BIGINT NOT NULL,
+		fingerprint BYTEA NOT NULL,
+		trc BYTEA NOT NULL,
+		PRIMARY KEY (isd_id, base, serial)
+	);
+	`
+)
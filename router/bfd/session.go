@@ -126,6 +126,8 @@ type Session struct {
 	messagesOnce sync.Once
 	// messages is the channel on which the session receives BFD packets.
 	messages chan bfdMessage
+	// done is closed when Run returns. Messages received afterwards are dropped.
+	done chan struct{}
 
 	// localStateLock protects access to the local state.
 	localStateLock sync.RWMutex
@@ -155,12 +157,58 @@ type Session struct {
 	// If a metric is not initialized, it is not reported.
 	Metrics Metrics
 
+	// statusLock protects status.
+	statusLock sync.Mutex
+	// status holds the values reported by Status that are only known to Run. The
+	// fields that are available otherwise are filled in by Status.
+	status Status
+
 	// testLogger is set if more logs should be generated, specifically, logs about
 	// periodic events that would in production environment clog the logs. Use
 	// this field only in tests.
 	testLogger log.Logger
 }
 
+// Status is a snapshot of the state, the timers and the counters of a Session.
+type Status struct {
+	// LocalState is the state of the local session. It is AdminDown if the session is not
+	// running.
+	LocalState layers.BFDState
+	// RemoteState is the state of the remote session, as reported by the last BFD control
+	// packet received.
+	RemoteState layers.BFDState
+	// LocalDiscriminator is the discriminator of the local session.
+	LocalDiscriminator layers.BFDDiscriminator
+	// RemoteDiscriminator is the discriminator of the remote session. It is zero if the session
+	// has not been bootstrapped.
+	RemoteDiscriminator layers.BFDDiscriminator
+	// DesiredMinTxInterval is the configured desired minimum transmission interval.
+	DesiredMinTxInterval time.Duration
+	// RequiredMinRxInterval is the configured required minimum receive interval.
+	RequiredMinRxInterval time.Duration
+	// DetectMult is the configured detection time multiplier.
+	DetectMult layers.BFDDetectMultiplier
+	// RemoteMinRxInterval is the required minimum receive interval of the remote system, as
+	// reported by the last BFD control packet received.
+	RemoteMinRxInterval time.Duration
+	// TxInterval is the current interval between sent BFD control packets, before jitter is
+	// applied. It is larger than the desired interval while the session is down.
+	TxInterval time.Duration
+	// DetectionTime is the time without receiving BFD control packets after which the session
+	// is declared down. It is zero if the detection timer expired or no packet was received.
+	DetectionTime time.Duration
+	// LastReceived is the time the last BFD control packet was received.
+	LastReceived time.Time
+	// LastStateChange is the time of the last state change of the local session.
+	LastStateChange time.Time
+	// StateChanges is the number of state changes of the local session.
+	StateChanges uint64
+	// PacketsSent is the number of BFD control packets sent.
+	PacketsSent uint64
+	// PacketsReceived is the number of BFD control packets received.
+	PacketsReceived uint64
+}
+
 func (s *Session) String() string {
 	return fmt.Sprintf("local_disc %v, remote_disc %v, sender %v",
 		s.LocalDiscriminator, s.getRemoteDiscriminator(), s.Sender)
@@ -169,7 +217,8 @@ func (s *Session) String() string {
 // Run initializes the Session's timers and state machine, and starts sending out BFD control
 // packets on the point to point link.
 //
-// Run must only be called once.
+// Run must only be called once. It returns when the session is closed or when the context is
+// canceled.
 func (s *Session) Run(ctx context.Context) error {
 	logger := log.FromCtx(ctx)
 	if err := s.runOnceCheck(); err != nil {
@@ -183,6 +232,7 @@ func (s *Session) Run(ctx context.Context) error {
 	}
 	s.initMessages()
 	s.initMetrics()
+	defer close(s.done)
 
 	// detectionTimer tracks the period of time without receiving BFD packets after which the
 	// session is determined to have failed.
@@ -195,6 +245,9 @@ func (s *Session) Run(ctx context.Context) error {
 
 	s.desiredMinTXInterval = defaultTransmissionInterval
 	sendTimer := time.NewTimer(s.desiredMinTXInterval)
+	s.updateStatus(func(st *Status) {
+		st.TxInterval = s.desiredMinTXInterval
+	})
 
 	pkt := &layers.BFD{}
 MainLoop:
@@ -244,6 +297,14 @@ MainLoop:
 				}
 				sendTimer.Reset(s.computeNextSendInterval())
 			}
+			s.updateStatus(func(st *Status) {
+				st.RemoteState = layers.BFDState(s.remoteState)
+				st.RemoteMinRxInterval = s.remoteMinRxInterval
+				st.TxInterval = max(s.desiredMinTXInterval, s.remoteMinRxInterval)
+				st.DetectionTime = detectionTime
+				st.LastReceived = time.Now()
+				st.PacketsReceived++
+			})
 		case <-sendTimer.C:
 			// Send timer guaranteed to be expired, so we can reset.
 			sendTimer.Reset(s.computeNextSendInterval())
@@ -274,6 +335,9 @@ MainLoop:
 			if s.Metrics.PacketsSent != nil {
 				s.Metrics.PacketsSent.Add(1)
 			}
+			s.updateStatus(func(st *Status) {
+				st.PacketsSent++
+			})
 		case <-detectionTimer.C:
 			// detection timer guaranteed to be expired, so we can reset. We reset s.t. if some
 			// other branch wants to stop this timer, it can assume it hasn't been drained.
@@ -286,6 +350,12 @@ MainLoop:
 				// avoid flooding the network while the session is down.
 				s.desiredMinTXInterval = defaultTransmissionInterval
 			}
+			s.updateStatus(func(st *Status) {
+				st.TxInterval = max(s.desiredMinTXInterval, s.remoteMinRxInterval)
+				st.DetectionTime = 0
+			})
+		case <-ctx.Done():
+			break MainLoop
 		}
 	}
 	return nil
@@ -349,6 +419,28 @@ func (s *Session) IsUp() bool {
 	return up
 }
 
+// Status returns a snapshot of the state, the timers and the counters of the session. It is safe
+// to call Status while Run is executed.
+func (s *Session) Status() Status {
+	s.statusLock.Lock()
+	st := s.status
+	s.statusLock.Unlock()
+	st.LocalState = layers.BFDState(s.getLocalState())
+	st.LocalDiscriminator = s.LocalDiscriminator
+	st.RemoteDiscriminator = s.getRemoteDiscriminator()
+	st.DesiredMinTxInterval = s.DesiredMinTxInterval
+	st.RequiredMinRxInterval = s.RequiredMinRxInterval
+	st.DetectMult = s.DetectMult
+	return st
+}
+
+// updateStatus applies the update to the status reported by Status.
+func (s *Session) updateStatus(update func(st *Status)) {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+	update(&s.status)
+}
+
 // getLocalState is a concurrency-safe getter for local state.
 func (s *Session) getLocalState() state {
 	s.localStateLock.RLock()
@@ -383,7 +475,7 @@ func (s *Session) setRemoteDiscriminator(d layers.BFDDiscriminator) {
 // and the layers.BFD object.
 //
 // The session must be running when calling this function, i.e. Run must have
-// been called.
+// been called. Messages received after Run has returned are silently dropped.
 func (s *Session) ReceiveMessage(msg *layers.BFD) {
 	s.initMessages()
 
@@ -395,13 +487,16 @@ func (s *Session) ReceiveMessage(msg *layers.BFD) {
 		return
 	}
 
-	s.messages <- bfdMessage{
+	select {
+	case s.messages <- bfdMessage{
 		State:                 msg.State,
 		DetectMultiplier:      msg.DetectMultiplier,
 		MyDiscriminator:       msg.MyDiscriminator,
 		YourDiscriminator:     msg.YourDiscriminator,
 		DesiredMinTxInterval:  msg.DesiredMinTxInterval,
 		RequiredMinRxInterval: msg.RequiredMinRxInterval,
+	}:
+	case <-s.done:
 	}
 }
 
@@ -424,6 +519,7 @@ func (s *Session) initMetrics() {
 func (s *Session) initMessages() {
 	s.messagesOnce.Do(func() {
 		s.messages = make(chan bfdMessage, s.ReceiveQueueSize)
+		s.done = make(chan struct{})
 	})
 }
 
@@ -447,6 +543,10 @@ func (s *Session) transition(ctx context.Context, e event) {
 		if s.Metrics.StateChanges != nil {
 			s.Metrics.StateChanges.Add(1)
 		}
+		s.updateStatus(func(st *Status) {
+			st.LastStateChange = time.Now()
+			st.StateChanges++
+		})
 	}
 }
 
@@ -542,6 +542,65 @@ func TestSessionRunInit(t *testing.T) {
 	}
 }
 
+func TestSessionStatus(t *testing.T) {
+	sessionA := &bfd.Session{
+		DetectMult:            3,
+		DesiredMinTxInterval:  50 * time.Millisecond,
+		RequiredMinRxInterval: 100 * time.Millisecond,
+		LocalDiscriminator:    1,
+		ReceiveQueueSize:      10,
+	}
+	sessionB := &bfd.Session{
+		DetectMult:            3,
+		DesiredMinTxInterval:  50 * time.Millisecond,
+		RequiredMinRxInterval: 50 * time.Millisecond,
+		LocalDiscriminator:    2,
+		ReceiveQueueSize:      10,
+	}
+	status := sessionA.Status()
+	assert.Equal(t, layers.BFDStateAdminDown, status.LocalState)
+	assert.Zero(t, status.PacketsSent)
+
+	linkAToB := &redirectSender{Destination: sessionB}
+	linkBToA := &redirectSender{Destination: sessionA}
+	sessionA.Sender = linkAToB
+	sessionB.Sender = linkBToA
+	logger := testlog.NewLogger(t)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	for _, s := range []*bfd.Session{sessionA, sessionB} {
+		go func(s *bfd.Session) {
+			defer wg.Done()
+			assert.NoError(t, s.Run(log.CtxWith(context.Background(), logger)))
+		}(s)
+	}
+	linkAToB.Sending(true)
+	linkBToA.Sending(true)
+	time.Sleep(2 * time.Second)
+
+	status = sessionA.Status()
+	assert.Equal(t, layers.BFDStateUp, status.LocalState)
+	assert.Equal(t, layers.BFDStateUp, status.RemoteState)
+	assert.Equal(t, layers.BFDDiscriminator(1), status.LocalDiscriminator)
+	assert.Equal(t, layers.BFDDiscriminator(2), status.RemoteDiscriminator)
+	assert.Equal(t, 50*time.Millisecond, status.DesiredMinTxInterval)
+	assert.Equal(t, 100*time.Millisecond, status.RequiredMinRxInterval)
+	assert.Equal(t, layers.BFDDetectMultiplier(3), status.DetectMult)
+	assert.Equal(t, 50*time.Millisecond, status.RemoteMinRxInterval)
+	assert.Equal(t, 50*time.Millisecond, status.TxInterval)
+	// The remote transmits every 50ms, but the local session requires at least 100ms.
+	assert.Equal(t, 300*time.Millisecond, status.DetectionTime)
+	assert.NotZero(t, status.StateChanges)
+	assert.NotZero(t, status.PacketsSent)
+	assert.NotZero(t, status.PacketsReceived)
+	assert.WithinDuration(t, time.Now(), status.LastReceived, time.Second)
+	assert.False(t, status.LastStateChange.IsZero())
+
+	linkAToB.Close()
+	linkBToA.Close()
+	wg.Wait()
+}
+
 func TestPrintPacket(t *testing.T) {
 	testCases := []*struct {
 		packet         *layers.BFD
@@ -0,0 +1,378 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package capture implements on-demand packet capturing for the border router.
+//
+// A capture Session selects the packets processed by the router with a Filter
+// and writes them, annotated with the outcome of the processing, as pcapng
+// stream. The session is bounded in duration, number of packets, output size
+// and packet rate, so that it can safely be used in production.
+//
+// The router offers every packet to the active session, if any. The session
+// must therefore be cheap for packets that are not selected: Match does not
+// allocate, and only packets that are selected and within the rate limit are
+// copied by Add. Add never blocks; if the writer cannot keep up, the packet is
+// counted as lost.
+package capture
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net/netip"
+	"sync/atomic"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/slayers"
+)
+
+const (
+	// DefaultDuration is the default duration of a capture.
+	DefaultDuration = 30 * time.Second
+	// MaxDuration is the maximum duration of a capture.
+	MaxDuration = 10 * time.Minute
+	// DefaultMaxPackets is the default number of packets after which a capture
+	// ends.
+	DefaultMaxPackets = 1000
+	// MaxMaxPackets is the upper bound for the number of captured packets.
+	MaxMaxPackets = 100_000
+	// DefaultMaxBytes is the default size of the pcapng output after which a
+	// capture ends.
+	DefaultMaxBytes = 10 << 20
+	// MaxMaxBytes is the upper bound for the size of the pcapng output.
+	MaxMaxBytes = 100 << 20
+	// DefaultRate is the default maximum number of captured packets per second.
+	DefaultRate = 100
+	// MaxRate is the upper bound for the number of captured packets per
+	// second.
+	MaxRate = 10_000
+	// DefaultSnapLen is the default number of bytes of a SCION packet that are
+	// captured.
+	DefaultSnapLen = 512
+	// MaxSnapLen is the upper bound for the number of captured bytes per
+	// packet.
+	MaxSnapLen = 9000
+
+	// queueSize is the number of packets that can be buffered between the
+	// router and the writer.
+	queueSize = 256
+)
+
+// ErrActive indicates that a capture is already running.
+var ErrActive = serrors.New("capture already active")
+
+// Disposition is the outcome of processing a packet.
+type Disposition string
+
+const (
+	// Forwarded packets were sent to the next hop.
+	Forwarded Disposition = "forwarded"
+	// SlowPath packets were handed to the slow path, e.g., to respond with an
+	// SCMP message or to process a router alert.
+	SlowPath Disposition = "slow_path"
+	// Consumed packets were processed by the router itself, e.g., BFD.
+	Consumed Disposition = "consumed"
+	// Dropped packets were discarded. The reason is part of the annotation.
+	Dropped Disposition = "dropped"
+)
+
+// ParseDisposition parses the string representation of a disposition.
+func ParseDisposition(s string) (Disposition, error) {
+	switch d := Disposition(s); d {
+	case Forwarded, SlowPath, Consumed, Dropped:
+		return d, nil
+	default:
+		return "", serrors.New("unknown disposition", "disposition", s)
+	}
+}
+
+// Filter selects the packets that are captured. Empty fields select all
+// packets.
+type Filter struct {
+	// Interfaces selects packets that were received or sent on one of the
+	// interfaces. The internal interface has ID 0.
+	Interfaces []uint16
+	// IA selects packets with a matching source or destination ISD-AS. A zero
+	// ISD or AS number acts as wildcard.
+	IA addr.IA
+	// Dispositions selects packets with one of the dispositions.
+	Dispositions []Disposition
+}
+
+// Config is the configuration of a capture.
+type Config struct {
+	Filter Filter
+	// Duration is the maximum duration of the capture.
+	Duration time.Duration
+	// MaxPackets is the number of packets after which the capture ends.
+	MaxPackets int
+	// MaxBytes is the size of the pcapng output after which the capture ends.
+	MaxBytes int
+	// Rate is the maximum number of captured packets per second. Packets
+	// exceeding the rate are not captured.
+	Rate int
+	// SnapLen is the number of bytes of a SCION packet that are captured.
+	SnapLen int
+}
+
+// InitDefaults sets the defaults for all unset values.
+func (c *Config) InitDefaults() {
+	if c.Duration == 0 {
+		c.Duration = DefaultDuration
+	}
+	if c.MaxPackets == 0 {
+		c.MaxPackets = DefaultMaxPackets
+	}
+	if c.MaxBytes == 0 {
+		c.MaxBytes = DefaultMaxBytes
+	}
+	if c.Rate == 0 {
+		c.Rate = DefaultRate
+	}
+	if c.SnapLen == 0 {
+		c.SnapLen = DefaultSnapLen
+	}
+}
+
+// Validate checks that the limits are within the allowed bounds.
+func (c *Config) Validate() error {
+	if c.Duration <= 0 || c.Duration > MaxDuration {
+		return serrors.New("duration out of range", "duration", c.Duration, "max", MaxDuration)
+	}
+	if c.MaxPackets <= 0 || c.MaxPackets > MaxMaxPackets {
+		return serrors.New("max packets out of range",
+			"max_packets", c.MaxPackets, "max", MaxMaxPackets)
+	}
+	if c.MaxBytes <= 0 || c.MaxBytes > MaxMaxBytes {
+		return serrors.New("max bytes out of range", "max_bytes", c.MaxBytes, "max", MaxMaxBytes)
+	}
+	if c.Rate <= 0 || c.Rate > MaxRate {
+		return serrors.New("rate out of range", "rate", c.Rate, "max", MaxRate)
+	}
+	if c.SnapLen <= 0 || c.SnapLen > MaxSnapLen {
+		return serrors.New("snap length out of range", "snap_len", c.SnapLen, "max", MaxSnapLen)
+	}
+	for _, d := range c.Filter.Dispositions {
+		if _, err := ParseDisposition(string(d)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Packet is a captured packet.
+type Packet struct {
+	// Data is the (truncated) SCION packet.
+	Data []byte
+	// Length is the length of the SCION packet before truncation.
+	Length int
+	// Time is the time the packet was captured.
+	Time time.Time
+	// Src and Dst are the underlay addresses of the packet. They are zero if
+	// unknown.
+	Src, Dst        netip.AddrPort
+	Ingress, Egress uint16
+	Disposition     Disposition
+	// Reason is the reason a packet was dropped or handed to the slow path.
+	Reason string
+}
+
+// Stats summarizes a capture.
+type Stats struct {
+	// Packets is the number of written packets.
+	Packets int
+	// Bytes is the size of the pcapng output.
+	Bytes int
+	// Lost is the number of selected packets that could not be written
+	// because the writer did not keep up or the capture ended.
+	Lost int
+}
+
+// Session is a running capture. It is safe for concurrent use.
+type Session struct {
+	cfg     Config
+	packets chan Packet
+	done    chan struct{}
+	lost    atomic.Int64
+
+	// interval is the time, in nanoseconds, that one packet consumes of the
+	// rate limit.
+	interval int64
+	// tat is the theoretical arrival time, in nanoseconds since the Unix epoch,
+	// of the next packet if the packets arrived exactly at the rate limit. It
+	// implements the rate limit as lock-free token bucket (GCRA): a packet is
+	// within the limit if it does not push the theoretical arrival time more
+	// than a second ahead of the current time, i.e., bursts of up to one
+	// second worth of packets are allowed.
+	tat atomic.Int64
+}
+
+// NewSession creates a session. The configuration must be valid.
+func NewSession(cfg Config) *Session {
+	s := &Session{
+		cfg:      cfg,
+		packets:  make(chan Packet, queueSize),
+		done:     make(chan struct{}),
+		interval: int64(time.Second) / int64(cfg.Rate),
+	}
+	s.tat.Store(time.Now().UnixNano())
+	return s
+}
+
+// Match reports whether the packet is selected by the filter and within the
+// rate limit. If it returns true, the caller is expected to call Add for the
+// packet.
+func (s *Session) Match(data []byte, ingress, egress uint16, d Disposition) bool {
+	f := &s.cfg.Filter
+	if len(f.Interfaces) > 0 && !containsInterface(f.Interfaces, ingress, egress) {
+		return false
+	}
+	if len(f.Dispositions) > 0 && !containsDisposition(f.Dispositions, d) {
+		return false
+	}
+	if !f.IA.IsZero() && !matchIA(f.IA, data) {
+		return false
+	}
+	return s.take()
+}
+
+// Add copies the packet and queues it for writing. The data is truncated to
+// the snap length.
+func (s *Session) Add(
+	data []byte,
+	src, dst netip.AddrPort,
+	ingress, egress uint16,
+	d Disposition,
+	reason string,
+) {
+
+	p := Packet{
+		Data:        append([]byte(nil), data[:min(len(data), s.cfg.SnapLen)]...),
+		Length:      len(data),
+		Time:        time.Now(),
+		Src:         src,
+		Dst:         dst,
+		Ingress:     ingress,
+		Egress:      egress,
+		Disposition: d,
+		Reason:      reason,
+	}
+	select {
+	case <-s.done:
+		s.lost.Add(1)
+		return
+	default:
+	}
+	select {
+	case s.packets <- p:
+	default:
+		s.lost.Add(1)
+	}
+}
+
+// take consumes one packet of the rate limit. It returns false if the limit is
+// exhausted.
+func (s *Session) take() bool {
+	now := time.Now().UnixNano()
+	for {
+		tat := s.tat.Load()
+		next := max(tat, now) + s.interval
+		if next-now > int64(time.Second) {
+			return false
+		}
+		if s.tat.CompareAndSwap(tat, next) {
+			return true
+		}
+	}
+}
+
+// Run writes the captured packets as pcapng stream to the writer until one of
+// the limits is reached or the context is canceled. It must be called exactly
+// once.
+func (s *Session) Run(ctx context.Context, w io.Writer) (Stats, error) {
+	defer close(s.done)
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Duration)
+	defer cancel()
+
+	cw := &countingWriter{w: w}
+	pw := pcapngWriter{w: cw}
+	var stats Stats
+	finish := func(err error) (Stats, error) {
+		stats.Bytes = cw.n
+		stats.Lost = int(s.lost.Load()) + len(s.packets)
+		return stats, err
+	}
+	if err := pw.writeHeader(s.cfg.SnapLen); err != nil {
+		return finish(err)
+	}
+	for stats.Packets < s.cfg.MaxPackets && cw.n < s.cfg.MaxBytes {
+		select {
+		case <-ctx.Done():
+			return finish(nil)
+		case p := <-s.packets:
+			if err := pw.writePacket(p); err != nil {
+				return finish(err)
+			}
+			stats.Packets++
+		}
+	}
+	return finish(nil)
+}
+
+func containsInterface(intfs []uint16, ingress, egress uint16) bool {
+	for _, intf := range intfs {
+		if intf == ingress || intf == egress {
+			return true
+		}
+	}
+	return false
+}
+
+func containsDisposition(dispositions []Disposition, d Disposition) bool {
+	for _, disposition := range dispositions {
+		if disposition == d {
+			return true
+		}
+	}
+	return false
+}
+
+// matchIA checks the source and destination ISD-AS in the address header of
+// the SCION packet.
+func matchIA(filter addr.IA, data []byte) bool {
+	if len(data) < slayers.CmnHdrLen+2*addr.IABytes {
+		return false
+	}
+	dst := addr.IA(binary.BigEndian.Uint64(data[slayers.CmnHdrLen:]))
+	src := addr.IA(binary.BigEndian.Uint64(data[slayers.CmnHdrLen+addr.IABytes:]))
+	return wildcardMatch(filter, dst) || wildcardMatch(filter, src)
+}
+
+func wildcardMatch(filter, ia addr.IA) bool {
+	return (filter.ISD() == 0 || filter.ISD() == ia.ISD()) &&
+		(filter.AS() == 0 || filter.AS() == ia.AS())
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int
+}
+
+func (w *countingWriter) Write(b []byte) (int, error) {
+	n, err := w.w.Write(b)
+	w.n += n
+	return n, err
+}
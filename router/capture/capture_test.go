@@ -0,0 +1,225 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net/netip"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/router/capture"
+)
+
+// scionPacket returns a fake SCION packet with the given ISD-ASes in the
+// address header.
+func scionPacket(dst, src addr.IA, length int) []byte {
+	pkt := make([]byte, length)
+	binary.BigEndian.PutUint64(pkt[12:], uint64(dst))
+	binary.BigEndian.PutUint64(pkt[20:], uint64(src))
+	return pkt
+}
+
+func TestSessionMatch(t *testing.T) {
+	ia110 := addr.MustParseIA("1-ff00:0:110")
+	ia111 := addr.MustParseIA("1-ff00:0:111")
+	ia211 := addr.MustParseIA("2-ff00:0:211")
+	pkt := scionPacket(ia111, ia110, 64)
+
+	testCases := map[string]struct {
+		filter  capture.Filter
+		ingress uint16
+		egress  uint16
+		disp    capture.Disposition
+		match   bool
+	}{
+		"empty filter": {
+			disp:  capture.Forwarded,
+			match: true,
+		},
+		"ingress interface": {
+			filter:  capture.Filter{Interfaces: []uint16{1, 2}},
+			ingress: 2,
+			egress:  5,
+			disp:    capture.Forwarded,
+			match:   true,
+		},
+		"egress interface": {
+			filter: capture.Filter{Interfaces: []uint16{5}},
+			egress: 5,
+			disp:   capture.Forwarded,
+			match:  true,
+		},
+		"other interface": {
+			filter:  capture.Filter{Interfaces: []uint16{1}},
+			ingress: 2,
+			egress:  5,
+			disp:    capture.Forwarded,
+		},
+		"source ISD-AS": {
+			filter: capture.Filter{IA: ia110},
+			disp:   capture.Dropped,
+			match:  true,
+		},
+		"wildcard ISD": {
+			filter: capture.Filter{IA: addr.MustParseIA("1-0")},
+			disp:   capture.Dropped,
+			match:  true,
+		},
+		"other ISD-AS": {
+			filter: capture.Filter{IA: ia211},
+			disp:   capture.Dropped,
+		},
+		"disposition": {
+			filter: capture.Filter{Dispositions: []capture.Disposition{capture.Dropped}},
+			disp:   capture.Dropped,
+			match:  true,
+		},
+		"other disposition": {
+			filter: capture.Filter{Dispositions: []capture.Disposition{capture.Dropped}},
+			disp:   capture.Forwarded,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cfg := capture.Config{Filter: tc.filter}
+			cfg.InitDefaults()
+			s := capture.NewSession(cfg)
+			assert.Equal(t, tc.match, s.Match(pkt, tc.ingress, tc.egress, tc.disp))
+		})
+	}
+}
+
+func TestSessionRateLimit(t *testing.T) {
+	cfg := capture.Config{Rate: 2}
+	cfg.InitDefaults()
+	s := capture.NewSession(cfg)
+	pkt := scionPacket(0, 0, 64)
+	assert.True(t, s.Match(pkt, 1, 0, capture.Dropped))
+	assert.True(t, s.Match(pkt, 1, 0, capture.Dropped))
+	assert.False(t, s.Match(pkt, 1, 0, capture.Dropped))
+}
+
+func TestSessionRateLimitConcurrent(t *testing.T) {
+	cfg := capture.Config{Rate: 1000}
+	cfg.InitDefaults()
+	start := time.Now()
+	s := capture.NewSession(cfg)
+	pkt := scionPacket(0, 0, 64)
+
+	var accepted atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < cfg.Rate; j++ {
+				if s.Match(pkt, 1, 0, capture.Dropped) {
+					accepted.Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	// The burst is accepted in full, plus whatever the rate allows for the
+	// elapsed time.
+	refill := int64(time.Since(start).Seconds()*float64(cfg.Rate)) + 1
+	assert.GreaterOrEqual(t, accepted.Load(), int64(cfg.Rate))
+	assert.LessOrEqual(t, accepted.Load(), int64(cfg.Rate)+refill)
+}
+
+func TestSessionRun(t *testing.T) {
+	cfg := capture.Config{MaxPackets: 2, SnapLen: 40}
+	cfg.InitDefaults()
+	require.NoError(t, cfg.Validate())
+	s := capture.NewSession(cfg)
+
+	src := netip.MustParseAddrPort("192.0.2.1:50000")
+	dst := netip.MustParseAddrPort("192.0.2.2:30042")
+	ia := addr.MustParseIA("1-ff00:0:110")
+	dropped := scionPacket(ia, ia, 100)
+	forwarded := scionPacket(ia, ia, 30)
+	s.Add(dropped, src, netip.AddrPort{}, 1, 0, capture.Dropped, "MAC verification failed")
+	s.Add(forwarded, src, dst, 1, 2, capture.Forwarded, "")
+	s.Add(forwarded, src, dst, 1, 2, capture.Forwarded, "")
+
+	var buf bytes.Buffer
+	stats, err := s.Run(context.Background(), &buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stats.Packets)
+	assert.Equal(t, 1, stats.Lost)
+	assert.Equal(t, buf.Len(), stats.Bytes)
+
+	r, err := pcapgo.NewNgReader(&buf, pcapgo.DefaultNgReaderOptions)
+	require.NoError(t, err)
+	assert.Equal(t, layers.LinkTypeRaw, r.LinkType())
+
+	data, ci, err := r.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, 20+8+40, ci.CaptureLength)
+	assert.Equal(t, 20+8+100, ci.Length)
+	pkt := gopacket.NewPacket(data, layers.LayerTypeIPv4, gopacket.Default)
+	ip := pkt.Layer(layers.LayerTypeIPv4).(*layers.IPv4)
+	assert.Equal(t, src.Addr().AsSlice(), []byte(ip.SrcIP))
+	assert.True(t, ip.DstIP.IsUnspecified())
+	udp := pkt.Layer(layers.LayerTypeUDP).(*layers.UDP)
+	assert.Equal(t, layers.UDPPort(50000), udp.SrcPort)
+	assert.Equal(t, dropped[:40], udp.Payload)
+
+	data, _, err = r.ReadPacketData()
+	require.NoError(t, err)
+	pkt = gopacket.NewPacket(data, layers.LayerTypeIPv4, gopacket.Default)
+	udp = pkt.Layer(layers.LayerTypeUDP).(*layers.UDP)
+	assert.Equal(t, layers.UDPPort(30042), udp.DstPort)
+	assert.Equal(t, forwarded, udp.Payload)
+}
+
+func TestSessionRunTimeout(t *testing.T) {
+	cfg := capture.Config{Duration: 10 * time.Millisecond}
+	cfg.InitDefaults()
+	s := capture.NewSession(cfg)
+	var buf bytes.Buffer
+	stats, err := s.Run(context.Background(), &buf)
+	require.NoError(t, err)
+	assert.Zero(t, stats.Packets)
+
+	// Adding packets after the capture ended does not block.
+	s.Add(scionPacket(0, 0, 64), netip.AddrPort{}, netip.AddrPort{}, 0, 0, capture.Dropped, "")
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := capture.Config{}
+	cfg.InitDefaults()
+	assert.NoError(t, cfg.Validate())
+
+	tooLong := cfg
+	tooLong.Duration = capture.MaxDuration + time.Second
+	assert.Error(t, tooLong.Validate())
+
+	badDisposition := cfg
+	badDisposition.Filter.Dispositions = []capture.Disposition{"lost"}
+	assert.Error(t, badDisposition.Validate())
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/netip"
+)
+
+// The pcapng format is specified in
+// https://www.ietf.org/archive/id/draft-ietf-opsawg-pcapng-02.html. Only the
+// blocks required for a single interface and packets with comments are
+// implemented. All blocks are written in little endian byte order.
+const (
+	blockSectionHeader  = 0x0a0d0d0a
+	blockInterfaceDesc  = 0x00000001
+	blockEnhancedPacket = 0x00000006
+	byteOrderMagic      = 0x1a2b3c4d
+	optEndOfOpt         = 0
+	optComment          = 1
+	optShbUserAppl      = 4
+	optIfName           = 2
+	optIfTsResol        = 9
+	linkTypeRaw         = 101
+	tsResolNanos        = 9
+
+	udpHdrLen  = 8
+	ipv4HdrLen = 20
+	ipv6HdrLen = 40
+	ipProtoUDP = 17
+	defaultTTL = 64
+)
+
+// pcapngWriter writes the captured SCION packets, encapsulated in synthesized
+// IP/UDP headers such that they are recognized by the SCION Wireshark plugin.
+type pcapngWriter struct {
+	w   io.Writer
+	buf []byte
+}
+
+func (w *pcapngWriter) writeHeader(snapLen int) error {
+	w.buf = w.buf[:0]
+	w.buf = appendBlock(w.buf, blockSectionHeader, func(b []byte) []byte {
+		b = binary.LittleEndian.AppendUint32(b, byteOrderMagic)
+		b = binary.LittleEndian.AppendUint16(b, 1) // major version
+		b = binary.LittleEndian.AppendUint16(b, 0) // minor version
+		b = binary.LittleEndian.AppendUint64(b, ^uint64(0))
+		b = appendOption(b, optShbUserAppl, []byte("SCION router"))
+		return appendOption(b, optEndOfOpt, nil)
+	})
+	w.buf = appendBlock(w.buf, blockInterfaceDesc, func(b []byte) []byte {
+		b = binary.LittleEndian.AppendUint16(b, linkTypeRaw)
+		b = binary.LittleEndian.AppendUint16(b, 0) // reserved
+		b = binary.LittleEndian.AppendUint32(b, uint32(snapLen+ipv6HdrLen+udpHdrLen))
+		b = appendOption(b, optIfName, []byte("router"))
+		b = appendOption(b, optIfTsResol, []byte{tsResolNanos})
+		return appendOption(b, optEndOfOpt, nil)
+	})
+	_, err := w.w.Write(w.buf)
+	return err
+}
+
+func (w *pcapngWriter) writePacket(p Packet) error {
+	w.buf = w.buf[:0]
+	w.buf = appendBlock(w.buf, blockEnhancedPacket, func(b []byte) []byte {
+		ts := uint64(p.Time.UnixNano())
+		b = binary.LittleEndian.AppendUint32(b, 0) // interface ID
+		b = binary.LittleEndian.AppendUint32(b, uint32(ts>>32))
+		b = binary.LittleEndian.AppendUint32(b, uint32(ts))
+		// Leave room for the lengths, they are known once the headers are
+		// written.
+		lengths := len(b)
+		b = append(b, make([]byte, 8)...)
+		start := len(b)
+		b = appendUnderlayHeader(b, p.Src, p.Dst, p.Length)
+		hdrLen := len(b) - start
+		b = append(b, p.Data...)
+		binary.LittleEndian.PutUint32(b[lengths:], uint32(hdrLen+len(p.Data)))
+		binary.LittleEndian.PutUint32(b[lengths+4:], uint32(hdrLen+p.Length))
+		b = pad(b)
+		b = appendOption(b, optComment, []byte(annotation(p)))
+		return appendOption(b, optEndOfOpt, nil)
+	})
+	_, err := w.w.Write(w.buf)
+	return err
+}
+
+// annotation describes the processing outcome of the packet.
+func annotation(p Packet) string {
+	s := fmt.Sprintf("disposition=%s ingress=%d", p.Disposition, p.Ingress)
+	if p.Disposition == Forwarded || p.Egress != 0 {
+		s += fmt.Sprintf(" egress=%d", p.Egress)
+	}
+	if p.Reason != "" {
+		s += fmt.Sprintf(" reason=%q", p.Reason)
+	}
+	return s
+}
+
+// appendBlock appends a block with the body produced by the function.
+func appendBlock(b []byte, typ uint32, body func([]byte) []byte) []byte {
+	start := len(b)
+	b = binary.LittleEndian.AppendUint32(b, typ)
+	b = binary.LittleEndian.AppendUint32(b, 0) // length, set below
+	b = body(b)
+	length := uint32(len(b) - start + 4)
+	binary.LittleEndian.PutUint32(b[start+4:], length)
+	return binary.LittleEndian.AppendUint32(b, length)
+}
+
+func appendOption(b []byte, code uint16, value []byte) []byte {
+	b = binary.LittleEndian.AppendUint16(b, code)
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(value)))
+	return pad(append(b, value...))
+}
+
+func pad(b []byte) []byte {
+	for len(b)%4 != 0 {
+		b = append(b, 0)
+	}
+	return b
+}
+
+// appendUnderlayHeader appends an IP and UDP header for a SCION packet of the
+// given length. Unknown addresses are left unspecified. The address family is
+// IPv4 unless one of the addresses is an IPv6 address. The UDP checksum is not
+// set, as the packet might be truncated.
+func appendUnderlayHeader(b []byte, src, dst netip.AddrPort, length int) []byte {
+	srcIP, dstIP := src.Addr().Unmap(), dst.Addr().Unmap()
+	if srcIP.Is6() || dstIP.Is6() {
+		if !srcIP.Is6() {
+			srcIP = netip.IPv6Unspecified()
+		}
+		if !dstIP.Is6() {
+			dstIP = netip.IPv6Unspecified()
+		}
+		b = append(b, 0x60, 0, 0, 0)
+		b = binary.BigEndian.AppendUint16(b, uint16(udpHdrLen+length))
+		b = append(b, ipProtoUDP, defaultTTL)
+		b = append(b, srcIP.AsSlice()...)
+		b = append(b, dstIP.AsSlice()...)
+	} else {
+		if !srcIP.Is4() {
+			srcIP = netip.IPv4Unspecified()
+		}
+		if !dstIP.Is4() {
+			dstIP = netip.IPv4Unspecified()
+		}
+		start := len(b)
+		b = append(b, 0x45, 0)
+		b = binary.BigEndian.AppendUint16(b, uint16(ipv4HdrLen+udpHdrLen+length))
+		b = append(b, 0, 0, 0, 0) // identification, flags, fragment offset
+		b = append(b, defaultTTL, ipProtoUDP, 0, 0)
+		b = append(b, srcIP.AsSlice()...)
+		b = append(b, dstIP.AsSlice()...)
+		binary.BigEndian.PutUint16(b[start+10:], ipv4Checksum(b[start:]))
+	}
+	b = binary.BigEndian.AppendUint16(b, src.Port())
+	b = binary.BigEndian.AppendUint16(b, dst.Port())
+	b = binary.BigEndian.AppendUint16(b, uint16(udpHdrLen+length))
+	return binary.BigEndian.AppendUint16(b, 0)
+}
+
+func ipv4Checksum(hdr []byte) uint16 {
+	var sum uint32
+	for i := 0; i < len(hdr); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(hdr[i:]))
+	}
+	for sum > 0xffff {
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+	return ^uint16(sum)
+}
@@ -21,20 +21,29 @@ import (
 	"fmt"
 	"net/http"
 	_ "net/http/pprof"
+	"time"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/cors"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/resolver"
 
+	"github.com/scionproto/scion/pkg/addr"
+	libgrpc "github.com/scionproto/scion/pkg/grpc"
 	"github.com/scionproto/scion/pkg/log"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/private/app"
 	"github.com/scionproto/scion/private/app/launcher"
+	caconfig "github.com/scionproto/scion/private/ca/config"
+	"github.com/scionproto/scion/private/drkey/drkeyutil"
+	"github.com/scionproto/scion/private/mgmtapi"
+	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
+	"github.com/scionproto/scion/private/periodic"
 	"github.com/scionproto/scion/private/service"
 	"github.com/scionproto/scion/private/topology"
 	"github.com/scionproto/scion/router"
 	"github.com/scionproto/scion/router/config"
 	"github.com/scionproto/scion/router/control"
+	"github.com/scionproto/scion/router/drkey"
 	api "github.com/scionproto/scion/router/mgmtapi"
 )
 
@@ -79,7 +88,7 @@ func realMain(ctx context.Context) error {
 		"info":      service.NewInfoStatusPage(),
 		"config":    service.NewConfigStatusPage(globalCfg),
 		"log/level": service.NewLogLevelStatusPage(),
-		"topology":  topologyHandler(iaCtx.Config.Topo),
+		"topology":  topologyHandler(iaCtx),
 	}
 	if err := statusPages.Register(http.DefaultServeMux, globalCfg.General.ID); err != nil {
 		return err
@@ -92,19 +101,37 @@ func realMain(ctx context.Context) error {
 		return cleanup.Do()
 	})
 
+	if globalCfg.Features.ExperimentalSCMPAuthentication && !drkeyutil.LoadDummyKeys() {
+		provider := newDRKeyProvider(iaCtx)
+		dp.DataPlane.DRKeyProvider = provider
+		runner := periodic.Start(provider, 5*time.Second, 5*time.Second)
+		cleanup.Add(func() error { runner.Stop(); return nil })
+	}
+
 	// Initialize and start service management API.
 	if globalCfg.API.Addr != "" {
 		r := chi.NewRouter()
-		r.Use(cors.Handler(cors.Options{
-			AllowedOrigins: []string{"*"},
+		// Packet captures expose the forwarded traffic, browsers must not
+		// issue them on behalf of web pages of other origins.
+		r.Use(mgmtapi.CORS(func(r *http.Request) bool {
+			return r.URL.Path == "/api/v1/capture"
 		}))
 		r.Get("/", api.ServeSpecInteractive)
 		r.Get("/openapi.json", api.ServeSpecJSON)
 		server := api.Server{
-			Config:    service.NewConfigStatusPage(globalCfg).Handler,
-			Info:      service.NewInfoStatusPage().Handler,
-			LogLevel:  service.NewLogLevelStatusPage().Handler,
-			Dataplane: dp,
+			Config:     service.NewConfigStatusPage(globalCfg).Handler,
+			Info:       service.NewInfoStatusPage().Handler,
+			LogLevel:   service.NewLogLevelStatusPage().Handler,
+			Dataplane:  dp,
+			Capturer:   dp,
+			Statistics: dp,
+		}
+		if secret := globalCfg.Router.CaptureSecret; secret != "" {
+			verifier := &jwtauth.HTTPVerifier{
+				Generator: caconfig.NewPEMSymmetricKey(secret).Get,
+				Logger:    log.New("component", "capture"),
+			}
+			server.Authorize = verifier.AddAuthorization
 		}
 		log.Info("Exposing API", "addr", globalCfg.API.Addr)
 		h := api.HandlerFromMuxWithBaseURL(&server, r, "/api/v1")
@@ -126,6 +153,22 @@ func realMain(ctx context.Context) error {
 		defer log.HandlePanic()
 		return globalCfg.Metrics.ServePrometheus(errCtx)
 	})
+	g.Go(func() error {
+		defer log.HandlePanic()
+		reload := app.SIGHUPChannel(errCtx)
+		for {
+			select {
+			case <-reload:
+				if err := reloadControlConfig(iaCtx); err != nil {
+					log.Error("Failed to reload topology", "err", err)
+					continue
+				}
+				log.Info("Reloaded topology")
+			case <-errCtx.Done():
+				return nil
+			}
+		}
+	})
 	g.Go(func() error {
 		defer log.HandlePanic()
 		runConfig := &router.RunConfig{
@@ -150,11 +193,46 @@ func loadControlConfig() (*control.Config, error) {
 	return newConf, nil
 }
 
-func topologyHandler(topo topology.Topology) service.StatusPage {
+// reloadControlConfig loads the configuration from disk again and applies it to the running
+// dataplane.
+func reloadControlConfig(iaCtx *control.IACtx) error {
+	newConf, err := loadControlConfig()
+	if err != nil {
+		return err
+	}
+	return iaCtx.Reload(newConf)
+}
+
+// newDRKeyProvider creates the provider of the keys used to authenticate SCMP messages. The
+// secret values are fetched from the control services listed in the current topology.
+func newDRKeyProvider(iaCtx *control.IACtx) *drkey.Provider {
+	dialer := &libgrpc.TCPDialer{
+		SvcResolver: func(dst addr.SVC) []resolver.Address {
+			if base := dst.Base(); base != addr.SvcCS {
+				panic("Unsupported address type, implementation error?")
+			}
+			// An error only indicates that there is no control service.
+			addrs, _ := iaCtx.Topology().MakeHostInfos(topology.Control)
+			targets := []resolver.Address{}
+			for _, entry := range addrs {
+				targets = append(targets, resolver.Address{Addr: entry.String()})
+			}
+			return targets
+		},
+	}
+	return &drkey.Provider{
+		LocalIA:          iaCtx.Topology().IA(),
+		Fetcher:          &drkey.Fetcher{Dialer: dialer},
+		AcceptanceWindow: drkeyutil.LoadAcceptanceWindow(),
+		PrefetchLead:     drkeyutil.LoadAcceptanceWindow(),
+	}
+}
+
+func topologyHandler(iaCtx *control.IACtx) service.StatusPage {
 	handler := func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 
-		bytes, err := json.MarshalIndent(topo, "", "    ")
+		bytes, err := json.MarshalIndent(iaCtx.Topology(), "", "    ")
 		if err != nil {
 			http.Error(w, "Unable to marshal topology", http.StatusInternalServerError)
 			return
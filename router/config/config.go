@@ -48,6 +48,10 @@ type RouterConfig struct {
 	NumSlowPathProcessors int `toml:"num_slow_processors,omitempty"`
 	BatchSize             int `toml:"batch_size,omitempty"`
 	BFD                   BFD `toml:"bfd,omitempty"`
+	// CaptureSecret is the path to the PEM-encoded shared secret that is used
+	// to verify the JWT tokens of packet captures through the management API.
+	// If it is empty, packet captures are disabled.
+	CaptureSecret string `toml:"capture_secret,omitempty"`
 	// TODO: These two values were introduced to override the port range for
 	// configured router in the context of acceptance tests. However, this
 	// introduces two sources for the port configuration. We should remove this
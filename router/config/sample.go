@@ -35,4 +35,10 @@ num_slow_processors = 1
 # read or write from / to the network socket.
 # (default 256)
 batch_size = 256
+
+# The PEM-encoded shared secret that is used to verify the JWT bearer tokens
+# (HS256) of packet captures through the management API. If not set, packet
+# captures are disabled.
+# (default "")
+capture_secret = ""
 `
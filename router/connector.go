@@ -15,6 +15,8 @@
 package router
 
 import (
+	"context"
+	"io"
 	"net/netip"
 	"sync"
 
@@ -23,6 +25,7 @@ import (
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/segment/iface"
 	"github.com/scionproto/scion/private/underlay/conn"
+	"github.com/scionproto/scion/router/capture"
 	"github.com/scionproto/scion/router/config"
 	"github.com/scionproto/scion/router/control"
 )
@@ -97,24 +100,12 @@ func (c *Connector) AddExternalInterface(localIfID iface.ID, link control.LinkIn
 	if !c.ia.Equal(link.Local.IA) {
 		return serrors.JoinNoStack(errMultiIA, nil, "current", c.ia, "new", link.Local.IA)
 	}
-	if err := c.DataPlane.AddLinkType(intf, link.LinkTo); err != nil {
-		return serrors.Wrap("adding link type", err, "if_id", localIfID)
-	}
-	if err := c.DataPlane.AddNeighborIA(intf, link.Remote.IA); err != nil {
-		return serrors.Wrap("adding neighboring IA", err, "if_id", localIfID)
-	}
 
 	link.BFD = c.applyBFDDefaults(link.BFD)
-	if owned {
-		if len(c.externalInterfaces) == 0 {
-			c.externalInterfaces = make(map[uint16]control.ExternalInterface)
-		}
-		c.externalInterfaces[intf] = control.ExternalInterface{
-			IfID:  intf,
-			Link:  link,
-			State: control.InterfaceDown,
+	if !owned {
+		if err := c.DataPlane.AttachExternalInterface(intf, nil, link, false); err != nil {
+			return err
 		}
-	} else {
 		if len(c.siblingInterfaces) == 0 {
 			c.siblingInterfaces = make(map[uint16]control.SiblingInterface)
 		}
@@ -126,8 +117,7 @@ func (c *Connector) AddExternalInterface(localIfID iface.ID, link control.LinkIn
 			NeighborIA:        link.Remote.IA,
 			State:             control.InterfaceDown,
 		}
-		return c.DataPlane.AddNextHop(intf, link.Local.Addr, link.Remote.Addr,
-			link.BFD, link.Instance)
+		return nil
 	}
 
 	connection, err := conn.New(link.Local.Addr, link.Remote.Addr,
@@ -135,8 +125,35 @@ func (c *Connector) AddExternalInterface(localIfID iface.ID, link control.LinkIn
 	if err != nil {
 		return err
 	}
+	if err := c.DataPlane.AttachExternalInterface(intf, connection, link, true); err != nil {
+		connection.Close()
+		return err
+	}
+	if len(c.externalInterfaces) == 0 {
+		c.externalInterfaces = make(map[uint16]control.ExternalInterface)
+	}
+	c.externalInterfaces[intf] = control.ExternalInterface{
+		IfID:  intf,
+		Link:  link,
+		State: control.InterfaceDown,
+	}
+	return nil
+}
 
-	return c.DataPlane.AddExternalInterface(intf, connection, link.Local, link.Remote, link.BFD)
+// DelExternalInterface removes the given external interface, be it owned by this router or by a
+// sibling. This can be called while the dataplane is running.
+func (c *Connector) DelExternalInterface(localIfID iface.ID) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	intf := uint16(localIfID)
+	log.Debug("Removing external interface", "interface", localIfID)
+
+	if err := c.DataPlane.DetachExternalInterface(intf); err != nil {
+		return err
+	}
+	delete(c.externalInterfaces, intf)
+	delete(c.siblingInterfaces, intf)
+	return nil
 }
 
 // AddSvc adds the service address for the given ISD-AS.
@@ -210,6 +227,27 @@ func (c *Connector) ListSiblingInterfaces() ([]control.SiblingInterface, error)
 	return siblingInterfaceList, nil
 }
 
+// ListBFDSessions lists the BFD sessions of the interfaces.
+func (c *Connector) ListBFDSessions() ([]control.BFDSession, error) {
+	return c.DataPlane.bfdSessions(), nil
+}
+
+// ListInterfaceStatistics lists the packet counters of the interfaces owned by the router,
+// including the internal interface.
+func (c *Connector) ListInterfaceStatistics() ([]control.InterfaceStatistics, error) {
+	return c.DataPlane.interfaceStatistics(), nil
+}
+
+// Capture captures the packets processed by the dataplane. See DataPlane.Capture.
+func (c *Connector) Capture(
+	ctx context.Context,
+	cfg capture.Config,
+	w io.Writer,
+) (capture.Stats, error) {
+
+	return c.DataPlane.Capture(ctx, cfg, w)
+}
+
 // applyBFDDefaults updates the given cfg object with the global default BFD settings.
 // Link-specific settings, if configured, remain unchanged.  IMPORTANT: cfg.Disable isn't a boolean
 // but a pointer to boolean, allowing a simple representation of the unconfigured state: nil. This
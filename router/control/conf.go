@@ -25,6 +25,7 @@ import (
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/segment/iface"
 	"github.com/scionproto/scion/private/topology"
+	"github.com/scionproto/scion/router/bfd"
 )
 
 // Dataplane is the interface that a dataplane has to support to be controlled
@@ -109,6 +110,40 @@ const (
 	InterfaceDown InterfaceState = "down"
 )
 
+// BFDSession represents the BFD session that monitors an interface.
+type BFDSession struct {
+	// IfID is the identifier of the monitored interface.
+	IfID uint16
+	// Sibling indicates that the interface is owned by a sibling router. The session monitors
+	// the connectivity to the sibling router and is shared among all its interfaces.
+	Sibling bool
+	// Status is the state of the session.
+	Status bfd.Status
+}
+
+// InterfaceStatistics holds the packet counters of an interface.
+type InterfaceStatistics struct {
+	// IfID is the identifier of the interface. The internal interface has ID 0.
+	IfID uint16
+	// InputPackets is the number of packets received on the interface.
+	InputPackets uint64
+	// InputBytes is the number of bytes received on the interface.
+	InputBytes uint64
+	// OutputPackets is the number of packets sent on the interface.
+	OutputPackets uint64
+	// OutputBytes is the number of bytes sent on the interface.
+	OutputBytes uint64
+	// ProcessedPackets is the number of packets received on the interface that were processed.
+	ProcessedPackets uint64
+	// DroppedPackets is the number of dropped packets by reason, as counted for the interface by
+	// the router_dropped_pkts_total metric.
+	DroppedPackets map[string]uint64
+	// RejectedPackets is the number of packets received on the interface that were rejected as
+	// invalid, by reason. Contrary to DroppedPackets, it includes the packets that were answered
+	// with an SCMP error instead of being forwarded.
+	RejectedPackets map[string]uint64
+}
+
 // ConfigDataplane configures the data-plane with the new configuration.
 func ConfigDataplane(dp Dataplane, cfg *Config) error {
 	if cfg == nil {
@@ -163,20 +198,27 @@ func DeriveHFMacKey(k []byte) []byte {
 }
 
 func confExternalInterfaces(dp Dataplane, cfg *Config) error {
-	// Sort out keys/ifIDs to get deterministic order for unit testing
-	infoMap := cfg.Topo.IFInfoMap()
-	if len(infoMap) == 0 {
-		// nothing to do
-		return nil
-	}
-	ifIDs := []iface.ID{}
-	for k := range infoMap {
-		ifIDs = append(ifIDs, k)
+	links := externalLinks(cfg)
+	for _, ifID := range sortedIfIDs(links) {
+		if err := dp.AddExternalInterface(ifID, links[ifID].Info, links[ifID].Owned); err != nil {
+			return err
+		}
 	}
-	sort.Slice(ifIDs, func(i, j int) bool { return ifIDs[i] < ifIDs[j] })
-	// External interfaces
-	for _, ifID := range ifIDs {
-		iface := infoMap[ifID]
+	return nil
+}
+
+// externalLink is the configuration of an external interface as passed to the dataplane.
+type externalLink struct {
+	Info  LinkInfo
+	Owned bool
+}
+
+// externalLinks returns the configuration of all the external interfaces of the AS as seen by
+// this router.
+func externalLinks(cfg *Config) map[iface.ID]externalLink {
+	infoMap := cfg.Topo.IFInfoMap()
+	links := make(map[iface.ID]externalLink, len(infoMap))
+	for ifID, iface := range infoMap {
 		linkInfo := LinkInfo{
 			Local: LinkEnd{
 				IA:   cfg.IA,
@@ -206,12 +248,20 @@ func confExternalInterfaces(dp Dataplane, cfg *Config) error {
 			// For internal BFD always use the default configuration.
 			linkInfo.BFD = BFD{}
 		}
+		links[ifID] = externalLink{Info: linkInfo, Owned: owned}
+	}
+	return links
+}
 
-		if err := dp.AddExternalInterface(ifID, linkInfo, owned); err != nil {
-			return err
-		}
+// sortedIfIDs returns the interface IDs of links in increasing order. This gives a deterministic
+// configuration order for unit testing.
+func sortedIfIDs(links map[iface.ID]externalLink) []iface.ID {
+	ifIDs := make([]iface.ID, 0, len(links))
+	for k := range links {
+		ifIDs = append(ifIDs, k)
 	}
-	return nil
+	sort.Slice(ifIDs, func(i, j int) bool { return ifIDs[i] < ifIDs[j] })
+	return ifIDs
 }
 
 var svcTypes = []addr.SVC{
@@ -220,10 +270,27 @@ var svcTypes = []addr.SVC{
 }
 
 func confServices(dp Dataplane, cfg *Config) error {
+	for _, s := range serviceAddrs(cfg) {
+		if err := dp.AddSvc(cfg.IA, s.SVC, s.Addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// serviceAddr is an address that SVC traffic of the given type is resolved to.
+type serviceAddr struct {
+	SVC  addr.SVC
+	Addr netip.AddrPort
+}
+
+// serviceAddrs returns all the SVC resolution entries of the topology.
+func serviceAddrs(cfg *Config) []serviceAddr {
 	if cfg.Topo == nil {
-		// nothing to tdo
+		// nothing to do
 		return nil
 	}
+	var entries []serviceAddr
 	for _, svc := range svcTypes {
 		addrs, err := cfg.Topo.Multicast(svc)
 		if err != nil {
@@ -235,10 +302,8 @@ func confServices(dp Dataplane, cfg *Config) error {
 			return addrs[i].IP.String() < addrs[j].IP.String()
 		})
 		for _, a := range addrs {
-			if err := dp.AddSvc(cfg.IA, svc, a.AddrPort()); err != nil {
-				return err
-			}
+			entries = append(entries, serviceAddr{SVC: svc, Addr: a.AddrPort()})
 		}
 	}
-	return nil
+	return entries
 }
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"sync"
 
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/log"
@@ -94,10 +95,13 @@ func (cfg *Config) loadMasterKeys(confDir string) error {
 
 // IACtx is the context for the router for a given IA.
 type IACtx struct {
-	// Config is the router topology configuration
+	// Config is the router topology configuration. Once the dataplane is configured, use
+	// Topology to access it concurrently with Reload.
 	Config *Config
 	// DP is the underlying data plane.
 	DP Dataplane
+
+	mtx sync.Mutex
 }
 
 // Configure configures the dataplane for the given context.
@@ -120,6 +124,34 @@ func (iac *IACtx) Configure() error {
 	return nil
 }
 
+// Reload reconfigures the running dataplane with the new configuration. The dataplane must
+// implement ReloadableDataplane. If the dataplane fails to apply the change, the changes applied
+// so far are reverted and the previous configuration is kept. Only if reverting fails as well,
+// the dataplane is left inconsistent and a restart is needed (see ReconfigDataplane).
+func (iac *IACtx) Reload(newCfg *Config) error {
+	iac.mtx.Lock()
+	defer iac.mtx.Unlock()
+
+	dp, ok := iac.DP.(ReloadableDataplane)
+	if !ok {
+		return serrors.New("dataplane does not support reloading")
+	}
+	log.Debug("Reconfiguring Dataplane")
+	if err := ReconfigDataplane(dp, iac.Config, newCfg); err != nil {
+		return err
+	}
+	iac.Config = newCfg
+	log.Debug("Dataplane reconfigured successfully", "config", newCfg)
+	return nil
+}
+
+// Topology returns the topology of the current configuration.
+func (iac *IACtx) Topology() topology.Topology {
+	iac.mtx.Lock()
+	defer iac.mtx.Unlock()
+	return iac.Config.Topo
+}
+
 func dumpConfig(cfg *Config) (string, error) {
 	if cfg == nil {
 		return "", serrors.New("empty configuration")
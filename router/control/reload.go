@@ -0,0 +1,157 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/private/topology"
+)
+
+// ReloadableDataplane is a dataplane that can be reconfigured while it is running.
+type ReloadableDataplane interface {
+	Dataplane
+	DelExternalInterface(localIfID iface.ID) error
+}
+
+// ValidateReload checks that the running configuration can be replaced by the new one without
+// restarting the router. The ISD-AS, the core flag, the MTU, the internal address of this router,
+// the end host port range and the master keys must not change.
+func ValidateReload(oldCfg, newCfg *Config) error {
+	if oldCfg == nil || newCfg == nil {
+		return serrors.New("empty configuration")
+	}
+	if oldCfg.BR == nil {
+		return serrors.New("router not part of the running configuration")
+	}
+	validator := topology.RouterValidator{ID: oldCfg.BR.Name}
+	if err := validator.Validate(newCfg.Topo.Writable(), oldCfg.Topo.Writable()); err != nil {
+		return err
+	}
+	if !bytes.Equal(oldCfg.MasterKeys.Key0, newCfg.MasterKeys.Key0) ||
+		!bytes.Equal(oldCfg.MasterKeys.Key1, newCfg.MasterKeys.Key1) {
+
+		return serrors.New("master keys are immutable")
+	}
+	oldStart, oldEnd := oldCfg.Topo.PortRange()
+	newStart, newEnd := newCfg.Topo.PortRange()
+	if oldStart != newStart || oldEnd != newEnd {
+		return serrors.New("end host port range is immutable",
+			"expected", [2]uint16{oldStart, oldEnd}, "actual", [2]uint16{newStart, newEnd})
+	}
+	return nil
+}
+
+// ReconfigDataplane applies the difference between the running configuration and the new one to
+// the dataplane. Interfaces that disappear are removed, new ones are added, and interfaces whose
+// configuration changed are removed and added again. SVC resolution entries are updated
+// accordingly. The new configuration must have passed ValidateReload.
+//
+// If a change cannot be applied, the changes applied so far are reverted, so that the dataplane
+// keeps running with the old configuration. If reverting fails as well, the returned error says
+// so; the dataplane is then in an inconsistent state and the router must be restarted.
+func ReconfigDataplane(dp ReloadableDataplane, oldCfg, newCfg *Config) error {
+	if err := ValidateReload(oldCfg, newCfg); err != nil {
+		return serrors.Wrap("validating configuration", err)
+	}
+	// undo holds the inverse of every change that was applied, in the order of application.
+	var undo []func() error
+	oldLinks, newLinks := externalLinks(oldCfg), externalLinks(newCfg)
+	// Remove first, so that the sockets of changed interfaces are released before they are
+	// opened again.
+	for _, ifID := range sortedIfIDs(oldLinks) {
+		newLink, ok := newLinks[ifID]
+		if ok && reflect.DeepEqual(oldLinks[ifID], newLink) {
+			continue
+		}
+		log.Info("Removing external interface", "interface", ifID, "changed", ok)
+		if err := dp.DelExternalInterface(ifID); err != nil {
+			return rollback(undo, serrors.Wrap("removing external interface", err,
+				"if_id", ifID))
+		}
+		link := oldLinks[ifID]
+		undo = append(undo, func() error {
+			return dp.AddExternalInterface(ifID, link.Info, link.Owned)
+		})
+	}
+	for _, ifID := range sortedIfIDs(newLinks) {
+		oldLink, ok := oldLinks[ifID]
+		if ok && reflect.DeepEqual(oldLink, newLinks[ifID]) {
+			continue
+		}
+		log.Info("Adding external interface", "interface", ifID)
+		if err := dp.AddExternalInterface(ifID, newLinks[ifID].Info,
+			newLinks[ifID].Owned); err != nil {
+
+			return rollback(undo, serrors.Wrap("adding external interface", err,
+				"if_id", ifID))
+		}
+		undo = append(undo, func() error { return dp.DelExternalInterface(ifID) })
+	}
+
+	oldSvcs, newSvcs := serviceAddrs(oldCfg), serviceAddrs(newCfg)
+	for _, s := range diffServices(oldSvcs, newSvcs) {
+		if err := dp.DelSvc(newCfg.IA, s.SVC, s.Addr); err != nil {
+			return rollback(undo, serrors.Wrap("deleting service", err,
+				"svc", s.SVC, "addr", s.Addr))
+		}
+		undo = append(undo, func() error { return dp.AddSvc(newCfg.IA, s.SVC, s.Addr) })
+	}
+	for _, s := range diffServices(newSvcs, oldSvcs) {
+		if err := dp.AddSvc(newCfg.IA, s.SVC, s.Addr); err != nil {
+			return rollback(undo, serrors.Wrap("adding service", err,
+				"svc", s.SVC, "addr", s.Addr))
+		}
+		undo = append(undo, func() error { return dp.DelSvc(newCfg.IA, s.SVC, s.Addr) })
+	}
+	return nil
+}
+
+// rollback reverts the applied changes in reverse order and returns err. If a change cannot be
+// reverted, the remaining ones are still reverted and the returned error reports the
+// inconsistent state.
+func rollback(undo []func() error, err error) error {
+	log.Info("Reverting dataplane reconfiguration", "err", err)
+	var errs serrors.List
+	for i := len(undo) - 1; i >= 0; i-- {
+		if rbErr := undo[i](); rbErr != nil {
+			errs = append(errs, rbErr)
+		}
+	}
+	if len(errs) > 0 {
+		return serrors.Wrap("reverting failed, dataplane is inconsistent", errs.ToError(),
+			"cause", err)
+	}
+	return err
+}
+
+// diffServices returns the entries of a that are not in b.
+func diffServices(a, b []serviceAddr) []serviceAddr {
+	inB := make(map[serviceAddr]struct{}, len(b))
+	for _, s := range b {
+		inB[s] = struct{}{}
+	}
+	var diff []serviceAddr
+	for _, s := range a {
+		if _, ok := inB[s]; !ok {
+			diff = append(diff, s)
+		}
+	}
+	return diff
+}
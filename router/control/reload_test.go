@@ -0,0 +1,199 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/private/topology"
+	jsontopo "github.com/scionproto/scion/private/topology/json"
+	"github.com/scionproto/scion/router/control"
+)
+
+const brID = "br1-ff00_0_110-2"
+
+// recordingDataplane records the reconfiguration calls it receives. The call
+// equal to fail is recorded and fails.
+type recordingDataplane struct {
+	calls []string
+	fail  string
+}
+
+func (d *recordingDataplane) record(call string) error {
+	d.calls = append(d.calls, call)
+	if call == d.fail {
+		return fmt.Errorf("failing %q", call)
+	}
+	return nil
+}
+
+func (d *recordingDataplane) CreateIACtx(ia addr.IA) error { return nil }
+
+func (d *recordingDataplane) AddInternalInterface(ia addr.IA, local netip.AddrPort) error {
+	return nil
+}
+
+func (d *recordingDataplane) AddExternalInterface(ifID iface.ID, info control.LinkInfo,
+	owned bool) error {
+
+	return d.record(fmt.Sprintf("add %d %s owned=%t", ifID, info.Remote.Addr, owned))
+}
+
+func (d *recordingDataplane) DelExternalInterface(ifID iface.ID) error {
+	return d.record(fmt.Sprintf("del %d", ifID))
+}
+
+func (d *recordingDataplane) AddSvc(ia addr.IA, svc addr.SVC, a netip.AddrPort) error {
+	return d.record(fmt.Sprintf("addsvc %s %s", svc.BaseString(), a))
+}
+
+func (d *recordingDataplane) DelSvc(ia addr.IA, svc addr.SVC, a netip.AddrPort) error {
+	return d.record(fmt.Sprintf("delsvc %s %s", svc.BaseString(), a))
+}
+
+func (d *recordingDataplane) SetKey(ia addr.IA, index int, key []byte) error { return nil }
+
+func (d *recordingDataplane) SetPortRange(start, end uint16) {}
+
+func TestReconfigDataplane(t *testing.T) {
+	testCases := map[string]struct {
+		modify        func(topo *jsontopo.Topology, cfg *control.Config)
+		fail          string
+		expectedCalls []string
+		assertErr     assert.ErrorAssertionFunc
+	}{
+		"unchanged": {
+			modify:    func(*jsontopo.Topology, *control.Config) {},
+			assertErr: assert.NoError,
+		},
+		"interface added": {
+			modify: func(topo *jsontopo.Topology, _ *control.Config) {
+				topo.BorderRouters[brID].Interfaces[3] = &jsontopo.BRInterface{
+					Underlay: jsontopo.Underlay{
+						Local:  "127.0.0.2:50003",
+						Remote: "127.0.0.3:50003",
+					},
+					IA:     "1-ff00:0:130",
+					LinkTo: "CORE",
+					MTU:    1472,
+				}
+			},
+			expectedCalls: []string{"add 3 127.0.0.3:50003 owned=true"},
+			assertErr:     assert.NoError,
+		},
+		"interface removed": {
+			modify: func(topo *jsontopo.Topology, _ *control.Config) {
+				delete(topo.BorderRouters["br1-ff00_0_110-1"].Interfaces, 1)
+			},
+			expectedCalls: []string{"del 1"},
+			assertErr:     assert.NoError,
+		},
+		"remote underlay changed": {
+			modify: func(topo *jsontopo.Topology, _ *control.Config) {
+				topo.BorderRouters[brID].Interfaces[2].Underlay.Remote = "127.0.0.4:50000"
+			},
+			expectedCalls: []string{"del 2", "add 2 127.0.0.4:50000 owned=true"},
+			assertErr:     assert.NoError,
+		},
+		"sibling interface changed": {
+			modify: func(topo *jsontopo.Topology, _ *control.Config) {
+				topo.BorderRouters["br1-ff00_0_110-1"].Interfaces[1].MTU = 1400
+			},
+			expectedCalls: []string{"del 1", "add 1 127.0.0.1:50000 owned=false"},
+			assertErr:     assert.NoError,
+		},
+		"service changed": {
+			modify: func(topo *jsontopo.Topology, _ *control.Config) {
+				topo.ControlService["cs1-ff00_0_110-2"].Addr = "127.0.0.1:60005"
+			},
+			expectedCalls: []string{
+				"delsvc CS 127.0.0.1:60004",
+				"addsvc CS 127.0.0.1:60005",
+			},
+			assertErr: assert.NoError,
+		},
+		"failure reverted": {
+			modify: func(topo *jsontopo.Topology, _ *control.Config) {
+				topo.BorderRouters[brID].Interfaces[2].Underlay.Remote = "127.0.0.4:50000"
+				topo.ControlService["cs1-ff00_0_110-2"].Addr = "127.0.0.1:60005"
+			},
+			fail: "addsvc CS 127.0.0.1:60005",
+			expectedCalls: []string{
+				"del 2",
+				"add 2 127.0.0.4:50000 owned=true",
+				"delsvc CS 127.0.0.1:60004",
+				"addsvc CS 127.0.0.1:60005",
+				// Revert.
+				"addsvc CS 127.0.0.1:60004",
+				"del 2",
+				"add 2 127.0.0.1:50000 owned=true",
+			},
+			assertErr: assert.Error,
+		},
+		"IA changed": {
+			modify: func(topo *jsontopo.Topology, _ *control.Config) {
+				topo.IA = "1-ff00:0:111"
+			},
+			assertErr: assert.Error,
+		},
+		"internal address changed": {
+			modify: func(topo *jsontopo.Topology, _ *control.Config) {
+				topo.BorderRouters[brID].InternalAddr = "127.0.0.3:50000"
+			},
+			assertErr: assert.Error,
+		},
+		"key changed": {
+			modify: func(_ *jsontopo.Topology, cfg *control.Config) {
+				cfg.MasterKeys.Key0 = []byte("another key 0000")
+			},
+			assertErr: assert.Error,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			oldCfg, err := control.LoadConfig(brID, "testdata")
+			require.NoError(t, err)
+
+			raw, err := os.ReadFile("testdata/topology.json")
+			require.NoError(t, err)
+			var jsonTopo jsontopo.Topology
+			require.NoError(t, json.Unmarshal(raw, &jsonTopo))
+			newCfg := *oldCfg
+			tc.modify(&jsonTopo, &newCfg)
+			raw, err = json.Marshal(jsonTopo)
+			require.NoError(t, err)
+			newCfg.Topo, err = topology.FromJSONBytes(raw)
+			require.NoError(t, err)
+			newCfg.IA = newCfg.Topo.IA()
+			br, ok := newCfg.Topo.BR(brID)
+			require.True(t, ok)
+			newCfg.BR = &br
+
+			dp := &recordingDataplane{fail: tc.fail}
+			err = control.ReconfigDataplane(dp, oldCfg, &newCfg)
+			tc.assertErr(t, err)
+			assert.Equal(t, tc.expectedCalls, dp.calls)
+		})
+	}
+}
@@ -23,9 +23,11 @@ import (
 	"errors"
 	"fmt"
 	"hash"
+	"io"
 	"math/big"
 	"net"
 	"net/netip"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -54,6 +56,7 @@ import (
 	"github.com/scionproto/scion/private/topology"
 	underlayconn "github.com/scionproto/scion/private/underlay/conn"
 	"github.com/scionproto/scion/router/bfd"
+	"github.com/scionproto/scion/router/capture"
 	"github.com/scionproto/scion/router/control"
 )
 
@@ -81,6 +84,7 @@ type bfdSession interface {
 	Run(ctx context.Context) error
 	ReceiveMessage(*layers.BFD)
 	IsUp() bool
+	Status() bfd.Status
 }
 
 // BatchConn is a connection that supports batch reads and writes.
@@ -145,6 +149,19 @@ type slowPathRequest struct {
 	_        uint8
 }
 
+func (r slowPathRequest) String() string {
+	switch r.typ {
+	case slowPathSCMP:
+		return "SCMP " + slayers.CreateSCMPTypeCode(r.scmpType, r.code).String()
+	case slowPathRouterAlertIngress:
+		return "router alert (ingress)"
+	case slowPathRouterAlertEgress:
+		return "router alert (egress)"
+	default:
+		return fmt.Sprintf("unknown (%d)", r.typ)
+	}
+}
+
 // Make sure that the packet structure has the size we expect.
 const _ uintptr = 64 - unsafe.Sizeof(packet{}) // assert 64 >= sizeof(packet)
 const _ uintptr = unsafe.Sizeof(packet{}) - 64 // assert sizeof(packet) >= 64
@@ -172,26 +189,29 @@ func (p *packet) reset() {
 // from multiple sockets, performs routing, and sends them to their destinations
 // (after updating the path, if that is needed).
 type DataPlane struct {
-	interfaces          map[uint16]BatchConn
-	external            map[uint16]BatchConn
-	linkTypes           map[uint16]topology.LinkType
-	neighborIAs         map[uint16]addr.IA
-	peerInterfaces      map[uint16]uint16
+	ifTables            atomic.Pointer[interfaceTables]
 	internal            BatchConn
 	internalIP          netip.Addr
-	internalNextHops    map[uint16]netip.AddrPort
 	svc                 *services
 	macFactory          func() hash.Hash
-	bfdSessions         map[uint16]bfdSession
 	localIA             addr.IA
 	mtx                 sync.Mutex
 	running             atomic.Bool
 	Metrics             *Metrics
-	forwardingMetrics   map[uint16]interfaceMetrics
 	dispatchedPortStart uint16
 	dispatchedPortEnd   uint16
 
+	// The state of the running dataplane that is needed to start the goroutines of
+	// interfaces that are attached at runtime. Set by Run.
+	runCtx    context.Context
+	runConfig *RunConfig
+	procQs    []chan *packet
+	bfdStops  map[bfdSession]context.CancelFunc
+
 	ExperimentalSCMPAuthentication bool
+	// DRKeyProvider provides the keys used to authenticate SCMP messages if
+	// ExperimentalSCMPAuthentication is set. If nil, keys are derived from a dummy value.
+	DRKeyProvider DRKeyProvider
 
 	// The pool that stores all the packet buffers as described in the design document. See
 	// https://github.com/scionproto/scion/blob/master/doc/dev/design/BorderRouter.rst
@@ -201,7 +221,10 @@ type DataPlane struct {
 	// packet structure is fetched from the pool passed-around through the various channels and
 	// returned to the pool. To reduce the cost of copying, the packet structure is passed by
 	// reference.
-	packetPool chan *packet
+	packetPool *packetPool
+
+	// captureSession is the active packet capture, if any. See Capture.
+	captureSession atomic.Pointer[capture.Session]
 }
 
 var (
@@ -230,13 +253,84 @@ var (
 	ingressInterfaceInvalid       = errors.New("ingress interface invalid")
 	macVerificationFailed         = errors.New("MAC verification failed")
 	badPacketSize                 = errors.New("bad packet size")
+	unknownInterface              = errors.New("unknown interface")
+	errBusyProcessor              = errors.New("processor busy")
+	errBusySlowPath               = errors.New("slow path busy")
+	errBusyForwarder              = errors.New("forwarder busy")
+	errInvalidEgress              = errors.New("invalid egress")
+	errWriteFailed                = errors.New("writing to the underlay failed")
 
 	// zeroBuffer will be used to reset the Authenticator option in the
 	// scionPacketProcessor.OptAuth
 	zeroBuffer = make([]byte, 16)
 )
 
-type drkeyProvider interface {
+// interfaceTables holds the forwarding state that depends on the set of configured interfaces.
+// The packet processing goroutines read it without any locking. Therefore, once published, a
+// table set is never modified; changes are applied to a copy that then replaces the current set
+// atomically (see DataPlane.updateTables).
+type interfaceTables struct {
+	interfaces        map[uint16]BatchConn
+	external          map[uint16]BatchConn
+	linkTypes         map[uint16]topology.LinkType
+	neighborIAs       map[uint16]addr.IA
+	peerInterfaces    map[uint16]uint16
+	internalNextHops  map[uint16]netip.AddrPort
+	bfdSessions       map[uint16]bfdSession
+	forwardingMetrics map[uint16]interfaceMetrics
+	// fwQs are the queues of the forwarders, indexed by interface. They only exist once the
+	// dataplane is running.
+	fwQs map[uint16]chan *packet
+}
+
+// emptyTables is used as the interface tables of a dataplane that has none yet.
+var emptyTables interfaceTables
+
+func (t *interfaceTables) clone() *interfaceTables {
+	return &interfaceTables{
+		interfaces:        cloneMap(t.interfaces),
+		external:          cloneMap(t.external),
+		linkTypes:         cloneMap(t.linkTypes),
+		neighborIAs:       cloneMap(t.neighborIAs),
+		peerInterfaces:    cloneMap(t.peerInterfaces),
+		internalNextHops:  cloneMap(t.internalNextHops),
+		bfdSessions:       cloneMap(t.bfdSessions),
+		forwardingMetrics: cloneMap(t.forwardingMetrics),
+		fwQs:              cloneMap(t.fwQs),
+	}
+}
+
+// cloneMap returns a shallow copy of m. Contrary to maps.Clone, the result is never nil.
+func cloneMap[K comparable, V any](m map[K]V) map[K]V {
+	c := make(map[K]V, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// tables returns the current interface tables. The result must not be modified.
+func (d *DataPlane) tables() *interfaceTables {
+	if t := d.ifTables.Load(); t != nil {
+		return t
+	}
+	return &emptyTables
+}
+
+// updateTables applies modify to a copy of the current interface tables and, if modify
+// succeeds, publishes the copy. The caller must hold d.mtx.
+func (d *DataPlane) updateTables(modify func(t *interfaceTables) error) error {
+	t := d.tables().clone()
+	if err := modify(t); err != nil {
+		return err
+	}
+	d.ifTables.Store(t)
+	return nil
+}
+
+// DRKeyProvider provides the AS-Host keys for the SCMP protocol, shared between the local AS
+// and a remote host.
+type DRKeyProvider interface {
 	GetASHostKey(validTime time.Time, dstIA addr.IA, dstAddr addr.Host) (drkey.ASHostKey, error)
 	GetKeyWithinAcceptanceWindow(
 		validTime time.Time,
@@ -337,10 +431,10 @@ func (d *DataPlane) AddInternalInterface(conn BatchConn, ip netip.Addr) error {
 	if d.internal != nil {
 		return alreadySet
 	}
-	if d.interfaces == nil {
-		d.interfaces = make(map[uint16]BatchConn)
-	}
-	d.interfaces[0] = conn
+	_ = d.updateTables(func(t *interfaceTables) error {
+		t.interfaces[0] = conn
+		return nil
+	})
 	d.internal = conn
 	d.internalIP = ip
 	return nil
@@ -361,22 +455,18 @@ func (d *DataPlane) AddExternalInterface(ifID uint16, conn BatchConn,
 	if conn == nil || !src.Addr.IsValid() || !dst.Addr.IsValid() {
 		return emptyValue
 	}
-	err := d.addExternalInterfaceBFD(ifID, conn, src, dst, cfg)
-	if err != nil {
-		return serrors.Wrap("adding external BFD", err, "if_id", ifID)
-	}
-	if d.external == nil {
-		d.external = make(map[uint16]BatchConn)
-	}
-	if d.interfaces == nil {
-		d.interfaces = make(map[uint16]BatchConn)
-	}
-	if _, exists := d.external[ifID]; exists {
-		return serrors.JoinNoStack(alreadySet, nil, "ifID", ifID)
-	}
-	d.interfaces[ifID] = conn
-	d.external[ifID] = conn
-	return nil
+	return d.updateTables(func(t *interfaceTables) error {
+		err := d.addExternalInterfaceBFD(t, ifID, conn, src, dst, cfg)
+		if err != nil {
+			return serrors.Wrap("adding external BFD", err, "if_id", ifID)
+		}
+		if _, exists := t.external[ifID]; exists {
+			return serrors.JoinNoStack(alreadySet, nil, "ifID", ifID)
+		}
+		t.interfaces[ifID] = conn
+		t.external[ifID] = conn
+		return nil
+	})
 }
 
 // AddNeighborIA adds the neighboring IA for a given interface ID. If an IA for
@@ -391,14 +481,13 @@ func (d *DataPlane) AddNeighborIA(ifID uint16, remote addr.IA) error {
 	if remote.IsZero() {
 		return emptyValue
 	}
-	if _, exists := d.neighborIAs[ifID]; exists {
-		return serrors.JoinNoStack(alreadySet, nil, "ifID", ifID)
-	}
-	if d.neighborIAs == nil {
-		d.neighborIAs = make(map[uint16]addr.IA)
-	}
-	d.neighborIAs[ifID] = remote
-	return nil
+	return d.updateTables(func(t *interfaceTables) error {
+		if _, exists := t.neighborIAs[ifID]; exists {
+			return serrors.JoinNoStack(alreadySet, nil, "ifID", ifID)
+		}
+		t.neighborIAs[ifID] = remote
+		return nil
+	})
 }
 
 // AddLinkType adds the link type for a given interface ID. If a link type for
@@ -410,14 +499,13 @@ func (d *DataPlane) AddLinkType(ifID uint16, linkTo topology.LinkType) error {
 	if d.IsRunning() {
 		return modifyExisting
 	}
-	if _, exists := d.linkTypes[ifID]; exists {
-		return serrors.JoinNoStack(alreadySet, nil, "ifID", ifID)
-	}
-	if d.linkTypes == nil {
-		d.linkTypes = make(map[uint16]topology.LinkType)
-	}
-	d.linkTypes[ifID] = linkTo
-	return nil
+	return d.updateTables(func(t *interfaceTables) error {
+		if _, exists := t.linkTypes[ifID]; exists {
+			return serrors.JoinNoStack(alreadySet, nil, "ifID", ifID)
+		}
+		t.linkTypes[ifID] = linkTo
+		return nil
+	})
 }
 
 // AddRemotePeer adds the remote peering interface ID for local
@@ -425,21 +513,20 @@ func (d *DataPlane) AddLinkType(ifID uint16, linkTo topology.LinkType) error {
 // a different type, this method will return an error. This can only
 // be called on a not yet running dataplane.
 func (d *DataPlane) AddRemotePeer(local, remote uint16) error {
-	if t, ok := d.linkTypes[local]; ok && t != topology.Peer {
-		return serrors.JoinNoStack(unsupportedPathType, nil, "type", t)
-	}
-	if _, exists := d.peerInterfaces[local]; exists {
-		return serrors.JoinNoStack(alreadySet, nil, "local_interface", local)
-	}
-	if d.peerInterfaces == nil {
-		d.peerInterfaces = make(map[uint16]uint16)
-	}
-	d.peerInterfaces[local] = remote
-	return nil
+	return d.updateTables(func(t *interfaceTables) error {
+		if lt, ok := t.linkTypes[local]; ok && lt != topology.Peer {
+			return serrors.JoinNoStack(unsupportedPathType, nil, "type", lt)
+		}
+		if _, exists := t.peerInterfaces[local]; exists {
+			return serrors.JoinNoStack(alreadySet, nil, "local_interface", local)
+		}
+		t.peerInterfaces[local] = remote
+		return nil
+	})
 }
 
-// AddExternalInterfaceBFD adds the inter AS connection BFD session.
-func (d *DataPlane) addExternalInterfaceBFD(ifID uint16, conn BatchConn,
+// AddExternalInterfaceBFD adds the inter AS connection BFD session to the given tables.
+func (d *DataPlane) addExternalInterfaceBFD(t *interfaceTables, ifID uint16, conn BatchConn,
 	src, dst control.LinkEnd, cfg control.BFD) error {
 
 	if *cfg.Disable {
@@ -463,26 +550,50 @@ func (d *DataPlane) addExternalInterfaceBFD(ifID uint16, conn BatchConn,
 	if err != nil {
 		return err
 	}
-	return d.addBFDController(ifID, s, cfg, m)
+	return d.addBFDController(t, ifID, s, cfg, m)
 }
 
 // getInterfaceState checks if there is a bfd session for the input interfaceID and
 // returns InterfaceUp if the relevant bfdsession state is up, or if there is no BFD
 // session. Otherwise, it returns InterfaceDown.
 func (d *DataPlane) getInterfaceState(ifID uint16) control.InterfaceState {
-	bfdSessions := d.bfdSessions
+	bfdSessions := d.tables().bfdSessions
 	if bfdSession, ok := bfdSessions[ifID]; ok && !bfdSession.IsUp() {
 		return control.InterfaceDown
 	}
 	return control.InterfaceUp
 }
 
-func (d *DataPlane) addBFDController(ifID uint16, s *bfdSend, cfg control.BFD,
-	metrics bfd.Metrics) error {
+// bfdSessions returns the BFD sessions of the interfaces, ordered by interface ID.
+func (d *DataPlane) bfdSessions() []control.BFDSession {
+	t := d.tables()
+	sessions := make([]control.BFDSession, 0, len(t.bfdSessions))
+	for ifID, s := range t.bfdSessions {
+		_, sibling := t.internalNextHops[ifID]
+		sessions = append(sessions, control.BFDSession{
+			IfID:    ifID,
+			Sibling: sibling,
+			Status:  s.Status(),
+		})
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].IfID < sessions[j].IfID })
+	return sessions
+}
 
-	if d.bfdSessions == nil {
-		d.bfdSessions = make(map[uint16]bfdSession)
+// interfaceStatistics returns the packet counters of the interfaces owned by this router,
+// ordered by interface ID.
+func (d *DataPlane) interfaceStatistics() []control.InterfaceStatistics {
+	t := d.tables()
+	stats := make([]control.InterfaceStatistics, 0, len(t.forwardingMetrics))
+	for ifID, m := range t.forwardingMetrics {
+		stats = append(stats, m.statistics(ifID))
 	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].IfID < stats[j].IfID })
+	return stats
+}
+
+func (d *DataPlane) addBFDController(t *interfaceTables, ifID uint16, s *bfdSend,
+	cfg control.BFD, metrics bfd.Metrics) error {
 
 	// Generate random discriminator. It can't be zero.
 	discInt, err := rand.Int(rand.Reader, big.NewInt(0xfffffffe))
@@ -490,7 +601,7 @@ func (d *DataPlane) addBFDController(ifID uint16, s *bfdSend, cfg control.BFD,
 		return err
 	}
 	disc := layers.BFDDiscriminator(uint32(discInt.Uint64()) + 1)
-	d.bfdSessions[ifID] = &bfd.Session{
+	t.bfdSessions[ifID] = &bfd.Session{
 		Sender:                s,
 		DetectMult:            layers.BFDDetectMultiplier(cfg.DetectMult),
 		DesiredMinTxInterval:  cfg.DesiredMinTxInterval,
@@ -557,33 +668,32 @@ func (d *DataPlane) AddNextHop(ifID uint16, src, dst netip.AddrPort, cfg control
 	if !dst.IsValid() || !src.IsValid() {
 		return emptyValue
 	}
-	err := d.addNextHopBFD(ifID, src, dst, cfg, sibling)
-	if err != nil {
-		return serrors.Wrap("adding next hop BFD", err, "if_id", ifID)
-	}
-	if d.internalNextHops == nil {
-		d.internalNextHops = make(map[uint16]netip.AddrPort)
-	}
-	if _, exists := d.internalNextHops[ifID]; exists {
-		return serrors.JoinNoStack(alreadySet, nil, "ifID", ifID)
-	}
-	d.internalNextHops[ifID] = dst
-	return nil
+	return d.updateTables(func(t *interfaceTables) error {
+		err := d.addNextHopBFD(t, ifID, src, dst, cfg, sibling)
+		if err != nil {
+			return serrors.Wrap("adding next hop BFD", err, "if_id", ifID)
+		}
+		if _, exists := t.internalNextHops[ifID]; exists {
+			return serrors.JoinNoStack(alreadySet, nil, "ifID", ifID)
+		}
+		t.internalNextHops[ifID] = dst
+		return nil
+	})
 }
 
 // AddNextHopBFD adds the BFD session for the next hop address.
 // If the remote ifID belongs to an existing address, the existing
 // BFD session will be re-used.
-func (d *DataPlane) addNextHopBFD(ifID uint16, src, dst netip.AddrPort, cfg control.BFD,
-	sibling string) error {
+func (d *DataPlane) addNextHopBFD(t *interfaceTables, ifID uint16, src, dst netip.AddrPort,
+	cfg control.BFD, sibling string) error {
 
 	if *cfg.Disable {
 		return nil
 	}
-	for k, v := range d.internalNextHops {
+	for k, v := range t.internalNextHops {
 		if v.String() == dst.String() {
-			if c, ok := d.bfdSessions[k]; ok {
-				d.bfdSessions[ifID] = c
+			if c, ok := t.bfdSessions[k]; ok {
+				t.bfdSessions[ifID] = c
 				return nil
 			}
 		}
@@ -603,7 +713,131 @@ func (d *DataPlane) addNextHopBFD(ifID uint16, src, dst netip.AddrPort, cfg cont
 	if err != nil {
 		return err
 	}
-	return d.addBFDController(ifID, s, cfg, m)
+	return d.addBFDController(t, ifID, s, cfg, m)
+}
+
+// AttachExternalInterface installs all the forwarding state of an external interface in a single
+// step: the link type, the neighbor IA, the BFD session, and either the connection (if this router
+// owns the interface) or the next hop towards the sibling router that owns it. conn must be nil
+// for interfaces that are not owned. Contrary to the Add* methods, this can be called on a running
+// dataplane, in which case the interface is used for forwarding immediately.
+//
+// On a running dataplane, the packet pool grows as needed to serve the attached interface.
+func (d *DataPlane) AttachExternalInterface(ifID uint16, conn BatchConn, link control.LinkInfo,
+	owned bool) error {
+
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	if link.Remote.IA.IsZero() || !link.Local.Addr.IsValid() || !link.Remote.Addr.IsValid() {
+		return emptyValue
+	}
+	if owned == (conn == nil) {
+		return serrors.New("connection must be set if and only if the interface is owned",
+			"if_id", ifID, "owned", owned)
+	}
+	var fwQ chan *packet
+	err := d.updateTables(func(t *interfaceTables) error {
+		_, external := t.external[ifID]
+		_, sibling := t.internalNextHops[ifID]
+		if external || sibling {
+			return serrors.JoinNoStack(alreadySet, nil, "ifID", ifID)
+		}
+		t.linkTypes[ifID] = link.LinkTo
+		t.neighborIAs[ifID] = link.Remote.IA
+		if !owned {
+			err := d.addNextHopBFD(t, ifID, link.Local.Addr, link.Remote.Addr, link.BFD,
+				link.Instance)
+			if err != nil {
+				return serrors.Wrap("adding next hop BFD", err, "if_id", ifID)
+			}
+			t.internalNextHops[ifID] = link.Remote.Addr
+			return nil
+		}
+		err := d.addExternalInterfaceBFD(t, ifID, conn, link.Local, link.Remote, link.BFD)
+		if err != nil {
+			return serrors.Wrap("adding external BFD", err, "if_id", ifID)
+		}
+		t.interfaces[ifID] = conn
+		t.external[ifID] = conn
+		if d.IsRunning() {
+			t.forwardingMetrics[ifID] = newInterfaceMetrics(d.Metrics, ifID, d.localIA,
+				t.neighborIAs)
+			fwQ = make(chan *packet, d.runConfig.BatchSize)
+			t.fwQs[ifID] = fwQ
+		}
+		return nil
+	})
+	if err != nil || !d.IsRunning() {
+		return err
+	}
+	if owned {
+		// The pool is not shrunk when interfaces are detached, so it only grows if more
+		// interfaces are attached than ever before.
+		d.packetPool.growTo(packetPoolSize(d.runConfig, len(d.tables().interfaces),
+			cap(d.procQs[0])))
+		d.startInterface(ifID, conn, fwQ)
+	}
+	if s, ok := d.tables().bfdSessions[ifID]; ok {
+		d.startBFD(ifID, s)
+	}
+	return nil
+}
+
+// DetachExternalInterface removes all the forwarding state of the given external interface, be it
+// owned by this router or by a sibling. On a running dataplane, packets for that interface are
+// dropped from then on, the goroutines serving it terminate, and its BFD session is stopped unless
+// another interface shares it. The connection of an owned interface is closed.
+func (d *DataPlane) DetachExternalInterface(ifID uint16) error {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	old := d.tables()
+	conn, owned := old.external[ifID]
+	if _, sibling := old.internalNextHops[ifID]; !owned && !sibling {
+		return serrors.JoinNoStack(unknownInterface, nil, "ifID", ifID)
+	}
+	_ = d.updateTables(func(t *interfaceTables) error {
+		// The forwarding metrics are kept; in-flight packets may still refer to them.
+		delete(t.interfaces, ifID)
+		delete(t.external, ifID)
+		delete(t.linkTypes, ifID)
+		delete(t.neighborIAs, ifID)
+		delete(t.peerInterfaces, ifID)
+		delete(t.internalNextHops, ifID)
+		delete(t.bfdSessions, ifID)
+		delete(t.fwQs, ifID)
+		return nil
+	})
+	if s, ok := old.bfdSessions[ifID]; ok && !d.bfdInUse(s) {
+		if stop, running := d.bfdStops[s]; running {
+			stop()
+			delete(d.bfdStops, s)
+		}
+	}
+	if !owned {
+		return nil
+	}
+	if fwQ, ok := old.fwQs[ifID]; ok {
+		// Wake up the forwarder in case it waits for packets. If the queue is full, the
+		// forwarder is busy and notices the removal on its own.
+		select {
+		case fwQ <- nil:
+		default:
+		}
+	}
+	// Closing the connection unblocks the receiver.
+	return conn.Close()
+}
+
+// bfdInUse returns whether any interface still uses the given BFD session.
+func (d *DataPlane) bfdInUse(s bfdSession) bool {
+	for _, v := range d.tables().bfdSessions {
+		if v == s {
+			return true
+		}
+	}
+	return false
 }
 
 func max(a int, b int) int {
@@ -623,44 +857,45 @@ func (d *DataPlane) Run(ctx context.Context, cfg *RunConfig) error {
 	d.mtx.Lock()
 	d.setRunning()
 	d.initMetrics()
+	if d.svc == nil {
+		// Services may be added later on; make sure the processors never observe the
+		// field changing.
+		d.svc = newServices()
+	}
 
+	t := d.tables()
 	processorQueueSize := max(
-		len(d.interfaces)*cfg.BatchSize/cfg.NumProcessors,
+		len(t.interfaces)*cfg.BatchSize/cfg.NumProcessors,
 		cfg.BatchSize)
 
 	d.initPacketPool(cfg, processorQueueSize)
-	procQs, fwQs, slowQs := initQueues(cfg, d.interfaces, processorQueueSize)
+	procQs, fwQs, slowQs := initQueues(cfg, t.interfaces, processorQueueSize)
+	_ = d.updateTables(func(t *interfaceTables) error {
+		t.fwQs = fwQs
+		return nil
+	})
+	d.runCtx = ctx
+	d.runConfig = cfg
+	d.procQs = procQs
 
-	for ifID, conn := range d.interfaces {
-		go func(ifID uint16, conn BatchConn) {
-			defer log.HandlePanic()
-			d.runReceiver(ifID, conn, cfg, procQs)
-		}(ifID, conn)
-		go func(ifID uint16, conn BatchConn) {
-			defer log.HandlePanic()
-			d.runForwarder(ifID, conn, cfg, fwQs[ifID])
-		}(ifID, conn)
+	for ifID, conn := range t.interfaces {
+		d.startInterface(ifID, conn, fwQs[ifID])
 	}
 	for i := 0; i < cfg.NumProcessors; i++ {
 		go func(i int) {
 			defer log.HandlePanic()
-			d.runProcessor(i, procQs[i], fwQs, slowQs[i%cfg.NumSlowPathProcessors])
+			d.runProcessor(i, procQs[i], slowQs[i%cfg.NumSlowPathProcessors])
 		}(i)
 	}
 	for i := 0; i < cfg.NumSlowPathProcessors; i++ {
 		go func(i int) {
 			defer log.HandlePanic()
-			d.runSlowPathProcessor(i, slowQs[i], fwQs)
+			d.runSlowPathProcessor(i, slowQs[i])
 		}(i)
 	}
 
-	for k, v := range d.bfdSessions {
-		go func(ifID uint16, c bfdSession) {
-			defer log.HandlePanic()
-			if err := c.Run(ctx); err != nil && err != bfd.AlreadyRunning {
-				log.Error("BFD session failed to start", "ifID", ifID, "err", err)
-			}
-		}(k, v)
+	for k, v := range t.bfdSessions {
+		d.startBFD(k, v)
 	}
 
 	d.mtx.Unlock()
@@ -668,20 +903,141 @@ func (d *DataPlane) Run(ctx context.Context, cfg *RunConfig) error {
 	return nil
 }
 
+// startInterface starts the receiver and the forwarder of the given interface. Both stop on
+// their own once the interface is no longer part of the interface tables. The caller must hold
+// d.mtx and the dataplane must be running.
+func (d *DataPlane) startInterface(ifID uint16, conn BatchConn, fwQ chan *packet) {
+	go func() {
+		defer log.HandlePanic()
+		d.runReceiver(ifID, conn, d.runConfig, d.procQs)
+	}()
+	go func() {
+		defer log.HandlePanic()
+		d.runForwarder(ifID, conn, d.runConfig, fwQ)
+	}()
+}
+
+// startBFD starts the given BFD session, unless it is already running. The caller must hold d.mtx
+// and the dataplane must be running.
+func (d *DataPlane) startBFD(ifID uint16, s bfdSession) {
+	if _, running := d.bfdStops[s]; running {
+		// Sessions towards the same sibling router are shared among interfaces.
+		return
+	}
+	if d.bfdStops == nil {
+		d.bfdStops = make(map[bfdSession]context.CancelFunc)
+	}
+	ctx, cancel := context.WithCancel(d.runCtx)
+	d.bfdStops[s] = cancel
+	go func() {
+		defer log.HandlePanic()
+		if err := s.Run(ctx); err != nil && err != bfd.AlreadyRunning {
+			log.Error("BFD session failed to start", "ifID", ifID, "err", err)
+		}
+	}()
+}
+
+// isCurrent returns whether conn is still the connection of the given interface.
+func (d *DataPlane) isCurrent(ifID uint16, conn BatchConn) bool {
+	return d.tables().interfaces[ifID] == conn
+}
+
 // initializePacketPool calculates the size of the packet pool based on the
 // current dataplane settings and allocates all the buffers
 func (d *DataPlane) initPacketPool(cfg *RunConfig, processorQueueSize int) {
-	poolSize := len(d.interfaces)*cfg.BatchSize +
+	poolSize := packetPoolSize(cfg, len(d.tables().interfaces), processorQueueSize)
+	log.Debug("Initialize packet pool of size", "poolSize", poolSize)
+	d.packetPool = &packetPool{}
+	d.packetPool.growTo(poolSize)
+}
+
+// packetPoolSize returns the number of packets that the given number of interfaces and the
+// processors need at most.
+func packetPoolSize(cfg *RunConfig, numInterfaces int, processorQueueSize int) int {
+	return numInterfaces*cfg.BatchSize +
 		(cfg.NumProcessors+cfg.NumSlowPathProcessors)*(processorQueueSize+1) +
-		len(d.interfaces)*(2*cfg.BatchSize)
+		numInterfaces*(2*cfg.BatchSize)
+}
+
+// packetPool is the pool of packets. Contrary to a plain channel, it can grow while the
+// dataplane is running, which is needed when interfaces are attached at runtime.
+type packetPool struct {
+	// mtx protects pkts and grown. Getting and putting packets holds a read lock, so that no
+	// packet is put into a channel that was replaced. Growing the pool holds the write lock.
+	mtx sync.RWMutex
+	// pkts holds the available packets. Its capacity is the number of packets in existence,
+	// hence putting a packet never blocks.
+	pkts chan *packet
+	// grown is closed when pkts is replaced, to wake up the goroutines waiting for a packet.
+	grown chan struct{}
+}
+
+// get takes a packet from the pool. If none is available, it blocks until a packet is returned
+// or the pool grows.
+func (p *packetPool) get() *packet {
+	for {
+		p.mtx.RLock()
+		pkts, grown := p.pkts, p.grown
+		select {
+		case pkt := <-pkts:
+			p.mtx.RUnlock()
+			return pkt
+		default:
+		}
+		p.mtx.RUnlock()
+		// Wait without holding the lock, so that the pool can grow in the meantime.
+		select {
+		case pkt := <-pkts:
+			return pkt
+		case <-grown:
+		}
+	}
+}
 
-	log.Debug("Initialize packet pool of size", "poolSize", poolSize)
-	d.packetPool = make(chan *packet, poolSize)
-	pktBuffers := make([][bufSize]byte, poolSize)
-	pktStructs := make([]packet, poolSize)
-	for i := 0; i < poolSize; i++ {
-		d.packetPool <- pktStructs[i].init(&pktBuffers[i])
+// put returns a packet to the pool.
+func (p *packetPool) put(pkt *packet) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	p.pkts <- pkt
+}
+
+// len returns the number of available packets.
+func (p *packetPool) len() int {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	return len(p.pkts)
+}
+
+// size returns the number of packets in existence.
+func (p *packetPool) size() int {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	return cap(p.pkts)
+}
+
+// growTo allocates packets until the pool holds the given number of packets in total. The pool
+// never shrinks.
+func (p *packetPool) growTo(size int) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if size <= cap(p.pkts) {
+		return
 	}
+	n := size - cap(p.pkts)
+	pkts := make(chan *packet, size)
+	// Nobody puts packets while the write lock is held, so this moves all of them.
+	for len(p.pkts) > 0 {
+		pkts <- <-p.pkts
+	}
+	pktBuffers := make([][bufSize]byte, n)
+	pktStructs := make([]packet, n)
+	for i := 0; i < n; i++ {
+		pkts <- pktStructs[i].init(&pktBuffers[i])
+	}
+	if p.grown != nil {
+		close(p.grown)
+	}
+	p.pkts, p.grown = pkts, make(chan struct{})
 }
 
 // initializes the processing routines and forwarders queues
@@ -727,39 +1083,42 @@ func (d *DataPlane) runReceiver(ifID uint16, conn BatchConn, cfg *RunConfig,
 	// The packet owns the buffer that we set in the matching msg, plus the metadata that we'll add.
 	packets := make([]*packet, cfg.BatchSize)
 
-	numReusable := 0                     // unused buffers from previous loop
-	metrics := d.forwardingMetrics[ifID] // If receiver exists, fw metrics exist too.
+	numReusable := 0                              // unused buffers from previous loop
+	metrics := d.tables().forwardingMetrics[ifID] // If receiver exists, fw metrics exist too.
 
 	enqueueForProcessing := func(size int, srcAddr *net.UDPAddr, pkt *packet) {
 		sc := classOfSize(size)
 		metrics[sc].InputPacketsTotal.Inc()
 		metrics[sc].InputBytesTotal.Add(float64(size))
 
+		pkt.rawPacket = pkt.rawPacket[:size] // Update size; readBatch does not.
+		pkt.ingress = ifID
+		pkt.srcAddr = srcAddr
+
 		procID, err := computeProcID(pkt.rawPacket, cfg.NumProcessors, hashSeed)
 		if err != nil {
 			log.Debug("Error while computing procID", "err", err)
+			d.capturePacket(pkt, capture.Dropped, err)
 			d.returnPacketToPool(pkt)
 			metrics[sc].DroppedPacketsInvalid.Inc()
 			return
 		}
 
-		pkt.rawPacket = pkt.rawPacket[:size] // Update size; readBatch does not.
-		pkt.ingress = ifID
-		pkt.srcAddr = srcAddr
 		select {
 		case procQs[procID] <- pkt:
 		default:
+			d.capturePacket(pkt, capture.Dropped, errBusyProcessor)
 			d.returnPacketToPool(pkt)
 			metrics[sc].DroppedPacketsBusyProcessor.Inc()
 		}
 	}
 
-	for d.IsRunning() {
+	for d.IsRunning() && d.isCurrent(ifID, conn) {
 		// collect packets.
 
 		// Give a new buffer to the msgs elements that have been used in the previous loop.
 		for i := 0; i < cfg.BatchSize-numReusable; i++ {
-			p := <-d.packetPool
+			p := d.packetPool.get()
 			p.reset()
 			packets[i] = p
 			msgs[i].Buffers[0] = p.rawPacket
@@ -776,6 +1135,13 @@ func (d *DataPlane) runReceiver(ifID uint16, conn BatchConn, cfg *RunConfig,
 			enqueueForProcessing(msg.N, msg.Addr.(*net.UDPAddr), packets[i])
 		}
 	}
+	if d.IsRunning() {
+		// The interface was removed while the dataplane keeps running. Give back the buffers
+		// that we hold so that the pool does not shrink with every reconfiguration.
+		for _, p := range packets[cfg.BatchSize-numReusable:] {
+			d.returnPacketToPool(p)
+		}
+	}
 }
 
 func computeProcID(data []byte, numProcRoutines int, hashSeed uint32) (uint32, error) {
@@ -806,11 +1172,74 @@ func computeProcID(data []byte, numProcRoutines int, hashSeed uint32) (uint32, e
 }
 
 func (d *DataPlane) returnPacketToPool(pkt *packet) {
-	d.packetPool <- pkt
+	d.packetPool.put(pkt)
+}
+
+// reclaimDetached returns the packets in the forwarder queue q of the given interface to the
+// pool if the interface was detached in the meantime. The forwarder of a detached interface
+// drains its queue when it stops, but a processor that still used the previous interface tables
+// may enqueue a packet after that. Such a processor observes the removal here, because it loads
+// the tables after enqueuing.
+func (d *DataPlane) reclaimDetached(ifID uint16, q chan *packet) {
+	if d.tables().fwQs[ifID] == q {
+		return
+	}
+	for {
+		select {
+		case p := <-q:
+			if p != nil {
+				d.returnPacketToPool(p)
+			}
+		default:
+			return
+		}
+	}
+}
+
+// Capture captures the packets processed by the dataplane that are selected by the configuration
+// and writes them as pcapng stream to w, until one of the configured limits is reached or the
+// context is canceled. Only one capture can be active at a time; capture.ErrActive is returned if
+// another capture is running.
+func (d *DataPlane) Capture(
+	ctx context.Context,
+	cfg capture.Config,
+	w io.Writer,
+) (capture.Stats, error) {
+
+	if err := cfg.Validate(); err != nil {
+		return capture.Stats{}, err
+	}
+	s := capture.NewSession(cfg)
+	if !d.captureSession.CompareAndSwap(nil, s) {
+		return capture.Stats{}, capture.ErrActive
+	}
+	defer d.captureSession.Store(nil)
+	return s.Run(ctx, w)
+}
+
+// capturePacket offers the packet to the active capture session, if any. The reason is only
+// evaluated if the packet is captured.
+func (d *DataPlane) capturePacket(p *packet, disp capture.Disposition, reason error) {
+	s := d.captureSession.Load()
+	if s == nil || !s.Match(p.rawPacket, p.ingress, p.egress, disp) {
+		return
+	}
+	var r string
+	if reason != nil {
+		r = reason.Error()
+	}
+	addCaptured(s, p, disp, r)
 }
 
-func (d *DataPlane) runProcessor(id int, q <-chan *packet,
-	fwQs map[uint16]chan *packet, slowQ chan<- *packet) {
+func addCaptured(s *capture.Session, p *packet, disp capture.Disposition, reason string) {
+	var dst netip.AddrPort
+	if len(p.dstAddr.IP) != 0 {
+		dst = p.dstAddr.AddrPort()
+	}
+	s.Add(p.rawPacket, p.srcAddr.AddrPort(), dst, p.ingress, p.egress, disp, reason)
+}
+
+func (d *DataPlane) runProcessor(id int, q <-chan *packet, slowQ chan<- *packet) {
 
 	log.Debug("Initialize processor with", "id", id)
 	processor := newPacketProcessor(d)
@@ -822,7 +1251,7 @@ func (d *DataPlane) runProcessor(id int, q <-chan *packet,
 		disp := processor.processPkt(p)
 
 		sc := classOfSize(len(p.rawPacket))
-		metrics := d.forwardingMetrics[p.ingress][sc]
+		metrics := processor.tables.forwardingMetrics[p.ingress][sc]
 		metrics.ProcessedPackets.Inc()
 
 		switch disp {
@@ -830,18 +1259,25 @@ func (d *DataPlane) runProcessor(id int, q <-chan *packet,
 			// Normal processing proceeds.
 		case pSlowPath:
 			// Not an error, processing continues on the slow path.
+			if p.slowPathRequest.typ == slowPathSCMP {
+				metrics.Rejected.inc(scmpRejectReason(p.slowPathRequest))
+			}
 			select {
 			case slowQ <- p:
 			default:
 				metrics.DroppedPacketsBusySlowPath.Inc()
+				d.capturePacket(p, capture.Dropped, errBusySlowPath)
 				d.returnPacketToPool(p)
 			}
 			continue
 		case pDone: // Packets that don't need more processing (e.g. BFD)
+			d.capturePacket(p, capture.Consumed, nil)
 			d.returnPacketToPool(p)
 			continue
 		case pDiscard: // Everything else
 			metrics.DroppedPacketsInvalid.Inc()
+			metrics.Rejected.inc(rejectReasonOf(processor.discardErr))
+			d.capturePacket(p, capture.Dropped, processor.discardErr)
 			d.returnPacketToPool(p)
 			continue
 		default: // Newly added dispositions need to be handled.
@@ -849,25 +1285,30 @@ func (d *DataPlane) runProcessor(id int, q <-chan *packet,
 			d.returnPacketToPool(p)
 			continue
 		}
-		fwCh, ok := fwQs[p.egress]
+		egress := p.egress
+		fwCh, ok := processor.tables.fwQs[egress]
 		if !ok {
-			log.Debug("Error determining forwarder. Egress is invalid", "egress", p.egress)
+			log.Debug("Error determining forwarder. Egress is invalid", "egress", egress)
 			metrics.DroppedPacketsInvalid.Inc()
+			metrics.Rejected.inc(rrNoRoute)
+			d.capturePacket(p, capture.Dropped, errInvalidEgress)
 			d.returnPacketToPool(p)
 			continue
 		}
 
 		select {
 		case fwCh <- p:
+			// The packet belongs to the forwarder now.
+			d.reclaimDetached(egress, fwCh)
 		default:
+			d.capturePacket(p, capture.Dropped, errBusyForwarder)
 			d.returnPacketToPool(p)
 			metrics.DroppedPacketsBusyForwarder.Inc()
 		}
 	}
 }
 
-func (d *DataPlane) runSlowPathProcessor(id int, q <-chan *packet,
-	fwQs map[uint16]chan *packet) {
+func (d *DataPlane) runSlowPathProcessor(id int, q <-chan *packet) {
 
 	log.Debug("Initialize slow-path processor with", "id", id)
 	processor := newSlowPathProcessor(d)
@@ -876,23 +1317,30 @@ func (d *DataPlane) runSlowPathProcessor(id int, q <-chan *packet,
 		if !ok {
 			continue
 		}
+		if s := d.captureSession.Load(); s != nil &&
+			s.Match(p.rawPacket, p.ingress, p.egress, capture.SlowPath) {
+
+			addCaptured(s, p, capture.SlowPath, p.slowPathRequest.String())
+		}
 		err := processor.processPacket(p)
 		sc := classOfSize(len(p.rawPacket))
-		metrics := d.forwardingMetrics[p.ingress][sc]
+		metrics := processor.tables.forwardingMetrics[p.ingress][sc]
 		if err != nil {
 			log.Debug("Error processing packet", "err", err)
 			metrics.DroppedPacketsInvalid.Inc()
 			d.returnPacketToPool(p)
 			continue
 		}
-		fwCh, ok := fwQs[p.egress]
+		egress := p.egress
+		fwCh, ok := processor.tables.fwQs[egress]
 		if !ok {
-			log.Debug("Error determining forwarder. Egress is invalid", "egress", p.egress)
+			log.Debug("Error determining forwarder. Egress is invalid", "egress", egress)
 			d.returnPacketToPool(p)
 			continue
 		}
 		select {
 		case fwCh <- p:
+			d.reclaimDetached(egress, fwCh)
 		default:
 			d.returnPacketToPool(p)
 		}
@@ -900,16 +1348,20 @@ func (d *DataPlane) runSlowPathProcessor(id int, q <-chan *packet,
 }
 
 func newSlowPathProcessor(d *DataPlane) *slowPathPacketProcessor {
+	var provider DRKeyProvider = d.DRKeyProvider
+	if provider == nil {
+		provider = &drkeyutil.FakeProvider{
+			EpochDuration:    drkeyutil.LoadEpochDuration(),
+			AcceptanceWindow: drkeyutil.LoadAcceptanceWindow(),
+		}
+	}
 	p := &slowPathPacketProcessor{
 		d:              d,
 		buffer:         gopacket.NewSerializeBuffer(),
 		macInputBuffer: make([]byte, spao.MACBufferSize),
-		drkeyProvider: &drkeyutil.FakeProvider{
-			EpochDuration:    drkeyutil.LoadEpochDuration(),
-			AcceptanceWindow: drkeyutil.LoadAcceptanceWindow(),
-		},
-		optAuth:      slayers.PacketAuthOption{EndToEndOption: new(slayers.EndToEndOption)},
-		validAuthBuf: make([]byte, 16),
+		drkeyProvider:  provider,
+		optAuth:        slayers.PacketAuthOption{EndToEndOption: new(slayers.EndToEndOption)},
+		validAuthBuf:   make([]byte, 16),
 	}
 	p.scionLayer.RecyclePaths()
 	return p
@@ -917,6 +1369,7 @@ func newSlowPathProcessor(d *DataPlane) *slowPathPacketProcessor {
 
 type slowPathPacketProcessor struct {
 	d      *DataPlane
+	tables *interfaceTables
 	pkt    *packet
 	buffer gopacket.SerializeBuffer
 
@@ -936,7 +1389,7 @@ type slowPathPacketProcessor struct {
 	validAuthBuf []byte
 
 	// DRKey key derivation for SCMP authentication
-	drkeyProvider drkeyProvider
+	drkeyProvider DRKeyProvider
 }
 
 func (p *slowPathPacketProcessor) reset() {
@@ -952,6 +1405,7 @@ func (p *slowPathPacketProcessor) reset() {
 
 func (p *slowPathPacketProcessor) processPacket(pkt *packet) error {
 	var err error
+	p.tables = p.d.tables()
 	p.reset()
 	p.pkt = pkt
 
@@ -1045,11 +1499,15 @@ func (d *DataPlane) runForwarder(ifID uint16, conn BatchConn, cfg *RunConfig, c
 		msgs[i].Buffers = make([][]byte, 1)
 	}
 
-	metrics := d.forwardingMetrics[ifID]
+	metrics := d.tables().forwardingMetrics[ifID]
 
 	toWrite := 0
-	for d.IsRunning() {
+	for d.IsRunning() && d.isCurrent(ifID, conn) {
 		toWrite += readUpTo(c, cfg.BatchSize-toWrite, toWrite == 0, pkts[toWrite:])
+		if toWrite == 0 {
+			// Woken up without packets; the interface is likely being removed.
+			continue
+		}
 
 		// Turn the packets into underlay messages that WriteBatch can send.
 		for i, p := range pkts[:toWrite] {
@@ -1069,6 +1527,7 @@ func (d *DataPlane) runForwarder(ifID uint16, conn BatchConn, cfg *RunConfig, c
 		updateOutputMetrics(metrics, pkts[:written])
 
 		for _, p := range pkts[:written] {
+			d.capturePacket(p, capture.Forwarded, nil)
 			d.returnPacketToPool(p)
 		}
 
@@ -1076,6 +1535,7 @@ func (d *DataPlane) runForwarder(ifID uint16, conn BatchConn, cfg *RunConfig, c
 			// Only one is dropped at this time. We'll retry the rest.
 			sc := classOfSize(len(pkts[written].rawPacket))
 			metrics[sc].DroppedPacketsInvalid.Inc()
+			d.capturePacket(pkts[written], capture.Dropped, errWriteFailed)
 			d.returnPacketToPool(pkts[written])
 			toWrite -= (written + 1)
 			// Shift the leftovers to the head of the buffers.
@@ -1087,13 +1547,34 @@ func (d *DataPlane) runForwarder(ifID uint16, conn BatchConn, cfg *RunConfig, c
 			toWrite = 0
 		}
 	}
+	if d.IsRunning() {
+		// The interface was removed while the dataplane keeps running. Give back the packets
+		// that can no longer be sent. Packets that processors enqueue after this point are
+		// reclaimed by the processors themselves (see reclaimDetached).
+		for _, p := range pkts[:toWrite] {
+			d.returnPacketToPool(p)
+		}
+		for {
+			select {
+			case p := <-c:
+				if p != nil {
+					d.returnPacketToPool(p)
+				}
+			default:
+				return
+			}
+		}
+	}
 }
 
+// readUpTo reads up to n packets from c into pkts and returns the number of packets read. If
+// needsBlocking is set, it waits for the first packet. A nil packet is not a packet but a wake-up
+// call for a forwarder whose interface has been removed; reading stops there.
 func readUpTo(c <-chan *packet, n int, needsBlocking bool, pkts []*packet) int {
 	i := 0
 	if needsBlocking {
 		p, ok := <-c
-		if !ok {
+		if !ok || p == nil {
 			return i
 		}
 		pkts[i] = p
@@ -1103,7 +1584,7 @@ func readUpTo(c <-chan *packet, n int, needsBlocking bool, pkts []*packet) int {
 	for ; i < n; i++ {
 		select {
 		case p, ok := <-c:
-			if !ok {
+			if !ok || p == nil {
 				return i
 			}
 			pkts[i] = p
@@ -1134,6 +1615,7 @@ func (p *scionPacketProcessor) reset() error {
 	p.infoField = path.InfoField{}
 	p.effectiveXover = false
 	p.peering = false
+	p.discardErr = nil
 	if err := p.buffer.Clear(); err != nil {
 		// The serializeBuffer returned by NewSerializeBuffer isn't actually capable of failing to
 		// clear, so planning on doing something about it is pointless (and what might that be?).
@@ -1148,16 +1630,24 @@ func (p *scionPacketProcessor) reset() error {
 	return nil
 }
 
-// Convenience function to log an error and return the pDiscard disposition.
+// Convenience function to log an error and return the pDiscard disposition. The error is kept
+// as reason for the packet capture.
 // We do almost nothing with errors, so, we shouldn't invest in creating them.
-func errorDiscard(ctx ...any) disposition {
+func (p *scionPacketProcessor) errorDiscard(ctx ...any) disposition {
 	log.Debug("Discarding packet", ctx...)
+	for i := 0; i+1 < len(ctx); i += 2 {
+		if err, ok := ctx[i+1].(error); ok && ctx[i] == "error" {
+			p.discardErr = err
+		}
+	}
 	return pDiscard
 }
 
 func (p *scionPacketProcessor) processPkt(pkt *packet) disposition {
+	// Take the tables first; the caller uses them even if the packet gets discarded.
+	p.tables = p.d.tables()
 	if err := p.reset(); err != nil {
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 	p.pkt = pkt
 
@@ -1165,7 +1655,7 @@ func (p *scionPacketProcessor) processPkt(pkt *packet) disposition {
 	var err error
 	p.lastLayer, err = decodeLayers(pkt.rawPacket, &p.scionLayer, &p.hbhLayer, &p.e2eLayer)
 	if err != nil {
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 
 	pld := p.lastLayer.LayerPayload()
@@ -1176,13 +1666,13 @@ func (p *scionPacketProcessor) processPkt(pkt *packet) disposition {
 		if p.lastLayer.NextLayerType() == layers.LayerTypeBFD {
 			return p.processIntraBFD(pld)
 		}
-		return errorDiscard("error", unsupportedPathTypeNextHeader)
+		return p.errorDiscard("error", unsupportedPathTypeNextHeader)
 
 	case onehop.PathType:
 		if p.lastLayer.NextLayerType() == layers.LayerTypeBFD {
 			ohp, ok := p.scionLayer.Path.(*onehop.Path)
 			if !ok {
-				return errorDiscard("error", malformedPath)
+				return p.errorDiscard("error", malformedPath)
 			}
 			return p.processInterBFD(ohp, pld)
 		}
@@ -1192,53 +1682,53 @@ func (p *scionPacketProcessor) processPkt(pkt *packet) disposition {
 	case epic.PathType:
 		return p.processEPIC()
 	default:
-		return errorDiscard("error", unsupportedPathType)
+		return p.errorDiscard("error", unsupportedPathType)
 	}
 }
 
 func (p *scionPacketProcessor) processInterBFD(oh *onehop.Path, data []byte) disposition {
-	if len(p.d.bfdSessions) == 0 {
-		return errorDiscard("error", noBFDSessionConfigured)
+	if len(p.tables.bfdSessions) == 0 {
+		return p.errorDiscard("error", noBFDSessionConfigured)
 	}
 
 	bfd := &p.bfdLayer
 	if err := bfd.DecodeFromBytes(data, gopacket.NilDecodeFeedback); err != nil {
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 
-	if v, ok := p.d.bfdSessions[p.pkt.ingress]; ok {
+	if v, ok := p.tables.bfdSessions[p.pkt.ingress]; ok {
 		v.ReceiveMessage(bfd)
-		return pDiscard // All's fine. That packet's journey ends here.
+		return pDone // All's fine. That packet's journey ends here.
 	}
 
-	return errorDiscard("error", noBFDSessionFound)
+	return p.errorDiscard("error", noBFDSessionFound)
 }
 
 func (p *scionPacketProcessor) processIntraBFD(data []byte) disposition {
-	if len(p.d.bfdSessions) == 0 {
-		return errorDiscard("error", noBFDSessionConfigured)
+	if len(p.tables.bfdSessions) == 0 {
+		return p.errorDiscard("error", noBFDSessionConfigured)
 	}
 
 	bfd := &p.bfdLayer
 	if err := bfd.DecodeFromBytes(data, gopacket.NilDecodeFeedback); err != nil {
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 
 	ifID := uint16(0)
 	src := p.pkt.srcAddr.AddrPort() // POSSIBLY EXPENSIVE CONVERSION
-	for k, v := range p.d.internalNextHops {
+	for k, v := range p.tables.internalNextHops {
 		if src == v {
 			ifID = k
 			break
 		}
 	}
 
-	if v, ok := p.d.bfdSessions[ifID]; ok {
+	if v, ok := p.tables.bfdSessions[ifID]; ok {
 		v.ReceiveMessage(bfd)
-		return pDiscard // All's fine. That packet's journey ends here.
+		return pDone // All's fine. That packet's journey ends here.
 	}
 
-	return errorDiscard("error", noBFDSessionFound)
+	return p.errorDiscard("error", noBFDSessionFound)
 }
 
 func (p *scionPacketProcessor) processSCION() disposition {
@@ -1247,7 +1737,7 @@ func (p *scionPacketProcessor) processSCION() disposition {
 	p.path, ok = p.scionLayer.Path.(*scion.Raw)
 	if !ok {
 		// TODO(lukedirtwalker) parameter problem invalid path?
-		return errorDiscard("error", malformedPath)
+		return p.errorDiscard("error", malformedPath)
 	}
 	return p.process()
 }
@@ -1256,12 +1746,12 @@ func (p *scionPacketProcessor) processEPIC() disposition {
 
 	epicPath, ok := p.scionLayer.Path.(*epic.Path)
 	if !ok {
-		return errorDiscard("error", malformedPath)
+		return p.errorDiscard("error", malformedPath)
 	}
 
 	p.path = epicPath.ScionPath
 	if p.path == nil {
-		return errorDiscard("error", malformedPath)
+		return p.errorDiscard("error", malformedPath)
 	}
 
 	isPenultimate := p.path.IsPenultimateHop()
@@ -1275,14 +1765,14 @@ func (p *scionPacketProcessor) processEPIC() disposition {
 	if isPenultimate || isLast {
 		firstInfo, err := p.path.GetInfoField(0)
 		if err != nil {
-			return errorDiscard("error", err)
+			return p.errorDiscard("error", err)
 		}
 
 		timestamp := time.Unix(int64(firstInfo.Timestamp), 0)
 		err = libepic.VerifyTimestamp(timestamp, epicPath.PktID.Timestamp, time.Now())
 		if err != nil {
 			// TODO(mawyss): Send back SCMP packet
-			return errorDiscard("error", err)
+			return p.errorDiscard("error", err)
 		}
 
 		HVF := epicPath.PHVF
@@ -1293,7 +1783,7 @@ func (p *scionPacketProcessor) processEPIC() disposition {
 			&p.scionLayer, firstInfo.Timestamp, HVF, p.macInputBuffer[:libepic.MACBufferSize])
 		if err != nil {
 			// TODO(mawyss): Send back SCMP packet
-			return errorDiscard("error", err)
+			return p.errorDiscard("error", err)
 		}
 	}
 
@@ -1306,6 +1796,8 @@ func (p *scionPacketProcessor) processEPIC() disposition {
 type scionPacketProcessor struct {
 	// d is a reference to the dataplane instance that initiated this processor.
 	d *DataPlane
+	// tables are the interface tables used for the packet currently being processed.
+	tables *interfaceTables
 	// pkt is the packet currently being processed by this processor.
 	pkt *packet
 	// buffer is the buffer that can be used to serialize gopacket layers.
@@ -1340,6 +1832,9 @@ type scionPacketProcessor struct {
 
 	// bfdLayer is reusable buffer for parsing BFD messages
 	bfdLayer layers.BFD
+
+	// discardErr is the reason the last packet was discarded, if any.
+	discardErr error
 }
 
 type slowPathType uint8
@@ -1388,12 +1883,12 @@ func (p *scionPacketProcessor) parsePath() disposition {
 	p.hopField, err = p.path.GetCurrentHopField()
 	if err != nil {
 		// TODO(lukedirtwalker) parameter problem invalid path?
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 	p.infoField, err = p.path.GetCurrentInfoField()
 	if err != nil {
 		// TODO(lukedirtwalker) parameter problem invalid path?
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 	// Segments without the Peering flag must consist of at least two HFs:
 	// https://github.com/scionproto/scion/issues/4524
@@ -1401,10 +1896,10 @@ func (p *scionPacketProcessor) parsePath() disposition {
 		p.path.PathMeta.SegLen[1] == 1 ||
 		p.path.PathMeta.SegLen[2] == 1
 	if !p.infoField.Peer && hasSingletonSegment {
-		return errorDiscard("error", malformedPath)
+		return p.errorDiscard("error", malformedPath)
 	}
 	if !p.path.CurrINFMatchesCurrHF() {
-		return errorDiscard("error", malformedPath)
+		return p.errorDiscard("error", malformedPath)
 	}
 	return pForward
 }
@@ -1438,7 +1933,7 @@ func (p *scionPacketProcessor) determinePeer() disposition {
 	peer, err := determinePeer(p.path.PathMeta, p.infoField)
 	p.peering = peer
 	if err != nil {
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 	return pForward
 }
@@ -1540,15 +2035,15 @@ func (p *scionPacketProcessor) validateTransitUnderlaySrc() disposition {
 		return pForward
 	}
 	pktIngressID := p.ingressInterface()
-	expectedSrc, okE := p.d.internalNextHops[pktIngressID]
+	expectedSrc, okE := p.tables.internalNextHops[pktIngressID]
 	if !okE {
 		// Drop
-		return errorDiscard("error", invalidSrcAddrForTransit)
+		return p.errorDiscard("error", invalidSrcAddrForTransit)
 	}
 	src, okS := netip.AddrFromSlice(p.pkt.srcAddr.IP)
 	if !(okS && expectedSrc.Addr() == src) {
 		// Drop
-		return errorDiscard("error", invalidSrcAddrForTransit)
+		return p.errorDiscard("error", invalidSrcAddrForTransit)
 	}
 	return pForward
 }
@@ -1556,8 +2051,8 @@ func (p *scionPacketProcessor) validateTransitUnderlaySrc() disposition {
 // Validates the egress interface referenced by the current hop.
 func (p *scionPacketProcessor) validateEgressID() disposition {
 	egressID := p.pkt.egress
-	_, ih := p.d.internalNextHops[egressID]
-	_, eh := p.d.external[egressID]
+	_, ih := p.tables.internalNextHops[egressID]
+	_, eh := p.tables.external[egressID]
 	// egress interface must be a known interface
 	// packet coming from internal interface, must go to an external interface
 	// packet coming from external interface can go to either internal or external interface
@@ -1575,7 +2070,7 @@ func (p *scionPacketProcessor) validateEgressID() disposition {
 		return pSlowPath
 	}
 
-	ingressLT, egressLT := p.d.linkTypes[p.pkt.ingress], p.d.linkTypes[egressID]
+	ingressLT, egressLT := p.tables.linkTypes[p.pkt.ingress], p.tables.linkTypes[egressID]
 	if !p.effectiveXover {
 		// Check that the interface pair is valid within a single segment.
 		// No check required if the packet is received from an internal interface.
@@ -1639,7 +2134,7 @@ func (p *scionPacketProcessor) updateNonConsDirIngressSegID() disposition {
 	if !p.infoField.ConsDir && p.pkt.ingress != 0 && !p.peering {
 		p.infoField.UpdateSegID(p.hopField.Mac)
 		if err := p.path.SetInfoField(p.infoField, int(p.path.PathMeta.CurrINF)); err != nil {
-			return errorDiscard("error", err)
+			return p.errorDiscard("error", err)
 		}
 	}
 	return pForward
@@ -1699,7 +2194,7 @@ func (p *scionPacketProcessor) resolveInbound() disposition {
 		}
 		return pSlowPath
 	default:
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 }
 
@@ -1713,12 +2208,12 @@ func (p *scionPacketProcessor) processEgress() disposition {
 		p.infoField.UpdateSegID(p.hopField.Mac)
 		if err := p.path.SetInfoField(p.infoField, int(p.path.PathMeta.CurrINF)); err != nil {
 			// TODO parameter problem invalid path
-			return errorDiscard("error", err)
+			return p.errorDiscard("error", err)
 		}
 	}
 	if err := p.path.IncPath(); err != nil {
 		// TODO parameter problem invalid path
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 	return pForward
 }
@@ -1727,16 +2222,16 @@ func (p *scionPacketProcessor) doXover() disposition {
 	p.effectiveXover = true
 	if err := p.path.IncPath(); err != nil {
 		// TODO parameter problem invalid path
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 	var err error
 	if p.hopField, err = p.path.GetCurrentHopField(); err != nil {
 		// TODO parameter problem invalid path
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 	if p.infoField, err = p.path.GetCurrentInfoField(); err != nil {
 		// TODO parameter problem invalid path
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 	return pForward
 }
@@ -1770,10 +2265,10 @@ func (p *scionPacketProcessor) egressInterface() uint16 {
 
 func (p *scionPacketProcessor) validateEgressUp() disposition {
 	egressID := p.pkt.egress
-	if v, ok := p.d.bfdSessions[egressID]; ok {
+	if v, ok := p.tables.bfdSessions[egressID]; ok {
 		if !v.IsUp() {
 			log.Debug("SCMP response", "cause", errBFDSessionDown)
-			if _, external := p.d.external[p.pkt.egress]; !external {
+			if _, external := p.tables.external[p.pkt.egress]; !external {
 				p.pkt.slowPathRequest = slowPathRequest{
 					scmpType: slayers.SCMPTypeInternalConnectivityDown,
 					code:     0,
@@ -1800,7 +2295,7 @@ func (p *scionPacketProcessor) handleIngressRouterAlert() disposition {
 	}
 	*alert = false
 	if err := p.path.SetHopField(p.hopField, int(p.path.PathMeta.CurrHF)); err != nil {
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 	p.pkt.slowPathRequest = slowPathRequest{
 		typ: slowPathRouterAlertIngress,
@@ -1820,12 +2315,12 @@ func (p *scionPacketProcessor) handleEgressRouterAlert() disposition {
 	if !*alert {
 		return pForward
 	}
-	if _, ok := p.d.external[p.pkt.egress]; !ok {
+	if _, ok := p.tables.external[p.pkt.egress]; !ok {
 		return pForward
 	}
 	*alert = false
 	if err := p.path.SetHopField(p.hopField, int(p.path.PathMeta.CurrHF)); err != nil {
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 	p.pkt.slowPathRequest = slowPathRequest{
 		typ: slowPathRouterAlertEgress,
@@ -1991,7 +2486,7 @@ func (p *scionPacketProcessor) process() disposition {
 		return disp
 	}
 
-	if _, ok := p.d.external[egressID]; ok {
+	if _, ok := p.tables.external[egressID]; ok {
 		// Not ASTransit in
 		if disp := p.processEgress(); disp != pForward {
 			return disp
@@ -2013,7 +2508,7 @@ func (p *scionPacketProcessor) process() disposition {
 	}
 
 	// ASTransit in: pkt leaving this AS through another BR.
-	if a, ok := p.d.internalNextHops[egressID]; ok {
+	if a, ok := p.tables.internalNextHops[egressID]; ok {
 		p.pkt.trafficType = ttInTransit
 		updateNetAddrFromAddrPort(p.pkt.dstAddr, a)
 		// The packet must go to the other router via the internal interface.
@@ -2039,36 +2534,36 @@ func (p *scionPacketProcessor) processOHP() disposition {
 	ohp, ok := s.Path.(*onehop.Path)
 	if !ok {
 		// TODO parameter problem -> invalid path
-		return errorDiscard("error", malformedPath)
+		return p.errorDiscard("error", malformedPath)
 	}
 	if !ohp.Info.ConsDir {
 		// TODO parameter problem -> invalid path
-		return errorDiscard("error", malformedPath)
+		return p.errorDiscard("error", malformedPath)
 	}
 
 	// OHP leaving our IA
 	if p.pkt.ingress == 0 {
 		if !p.d.localIA.Equal(s.SrcIA) {
 			// TODO parameter problem -> invalid path
-			return errorDiscard("error", cannotRoute)
+			return p.errorDiscard("error", cannotRoute)
 		}
-		neighborIA, ok := p.d.neighborIAs[ohp.FirstHop.ConsEgress]
+		neighborIA, ok := p.tables.neighborIAs[ohp.FirstHop.ConsEgress]
 		if !ok {
 			// TODO parameter problem invalid interface
-			return errorDiscard("error", cannotRoute)
+			return p.errorDiscard("error", cannotRoute)
 		}
 		if !neighborIA.Equal(s.DstIA) {
-			return errorDiscard("error", cannotRoute)
+			return p.errorDiscard("error", cannotRoute)
 		}
 		mac := path.MAC(p.mac, ohp.Info, ohp.FirstHop, p.macInputBuffer[:path.MACBufferSize])
 		if subtle.ConstantTimeCompare(ohp.FirstHop.Mac[:], mac[:]) == 0 {
 			// TODO parameter problem -> invalid MAC
-			return errorDiscard("error", macVerificationFailed)
+			return p.errorDiscard("error", macVerificationFailed)
 		}
 		ohp.Info.UpdateSegID(ohp.FirstHop.Mac)
 
 		if err := updateSCIONLayer(p.pkt.rawPacket, s, p.buffer); err != nil {
-			return errorDiscard("error", err)
+			return p.errorDiscard("error", err)
 		}
 		p.pkt.egress = ohp.FirstHop.ConsEgress
 		return pForward
@@ -2076,11 +2571,11 @@ func (p *scionPacketProcessor) processOHP() disposition {
 
 	// OHP entering our IA
 	if !p.d.localIA.Equal(s.DstIA) {
-		return errorDiscard("error", cannotRoute)
+		return p.errorDiscard("error", cannotRoute)
 	}
-	neighborIA := p.d.neighborIAs[p.pkt.ingress]
+	neighborIA := p.tables.neighborIAs[p.pkt.ingress]
 	if !neighborIA.Equal(s.SrcIA) {
-		return errorDiscard("error", cannotRoute)
+		return p.errorDiscard("error", cannotRoute)
 	}
 
 	ohp.SecondHop = path.HopField{
@@ -2094,11 +2589,11 @@ func (p *scionPacketProcessor) processOHP() disposition {
 		p.macInputBuffer[:path.MACBufferSize])
 
 	if err := updateSCIONLayer(p.pkt.rawPacket, s, p.buffer); err != nil {
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 	err := p.d.resolveLocalDst(p.pkt.dstAddr, s, p.lastLayer)
 	if err != nil {
-		return errorDiscard("error", err)
+		return p.errorDiscard("error", err)
 	}
 
 	return pForward
@@ -2468,7 +2963,7 @@ func (p *slowPathPacketProcessor) prepareSCMP(
 	}
 	// If the packet is sent to an external router, we need to increment the
 	// path to prepare it for the next hop.
-	_, external := p.d.external[p.pkt.ingress]
+	_, external := p.tables.external[p.pkt.ingress]
 	if external {
 		infoField := &revPath.InfoFields[revPath.PathMeta.CurrINF]
 		if infoField.ConsDir && !peering {
@@ -2719,14 +3214,18 @@ func nextHdr(layer gopacket.DecodingLayer) slayers.L4ProtocolType {
 // instantiated for all the relevant interfaces so this will not have to be repeated during packet
 // forwarding.
 func (d *DataPlane) initMetrics() {
-	d.forwardingMetrics = make(map[uint16]interfaceMetrics)
-	d.forwardingMetrics[0] = newInterfaceMetrics(d.Metrics, 0, d.localIA, d.neighborIAs)
-	for ifID := range d.external {
-		if _, notOwned := d.internalNextHops[ifID]; notOwned {
-			continue
+	_ = d.updateTables(func(t *interfaceTables) error {
+		t.forwardingMetrics = make(map[uint16]interfaceMetrics)
+		t.forwardingMetrics[0] = newInterfaceMetrics(d.Metrics, 0, d.localIA, t.neighborIAs)
+		for ifID := range t.external {
+			if _, notOwned := t.internalNextHops[ifID]; notOwned {
+				continue
+			}
+			t.forwardingMetrics[ifID] = newInterfaceMetrics(d.Metrics, ifID, d.localIA,
+				t.neighborIAs)
 		}
-		d.forwardingMetrics[ifID] = newInterfaceMetrics(d.Metrics, ifID, d.localIA, d.neighborIAs)
-	}
+		return nil
+	})
 
 	// Start our custom /proc/pid/stat collector to export iowait time and (in the future) other
 	// process-wide metrics that prometheus does not.
@@ -16,8 +16,10 @@ package router
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"hash/fnv"
+	"io"
 	"math/rand"
 	"net"
 	"net/netip"
@@ -38,6 +40,7 @@ import (
 	"github.com/scionproto/scion/pkg/slayers/path"
 	"github.com/scionproto/scion/pkg/slayers/path/scion"
 	underlayconn "github.com/scionproto/scion/private/underlay/conn"
+	"github.com/scionproto/scion/router/capture"
 	"github.com/scionproto/scion/router/mock_router"
 )
 
@@ -84,8 +87,8 @@ func TestReceiver(t *testing.T) {
 		BatchSize:     64,
 	}
 	dp.initPacketPool(runConfig, 64)
-	procCh, _, _ := initQueues(runConfig, dp.interfaces, 64)
-	initialPoolSize := len(dp.packetPool)
+	procCh, _, _ := initQueues(runConfig, dp.tables().interfaces, 64)
+	initialPoolSize := dp.packetPool.len()
 	dp.setRunning()
 	dp.initMetrics()
 	go func() {
@@ -96,7 +99,7 @@ func TestReceiver(t *testing.T) {
 		select {
 		case pkt := <-procCh[0]:
 			// make sure that the pool size has decreased
-			assert.Greater(t, initialPoolSize, len(dp.packetPool))
+			assert.Greater(t, initialPoolSize, dp.packetPool.len())
 			// make sure that the packet has the right size
 			assert.Equal(t, 84+i%10*18, len(pkt.rawPacket))
 			// make sure that the source address was set correctly
@@ -115,7 +118,7 @@ func TestReceiver(t *testing.T) {
 	}
 	<-done
 	// make sure that the packet pool has the expected size after the test
-	assert.Equal(t, initialPoolSize-runConfig.BatchSize-20, len(dp.packetPool))
+	assert.Equal(t, initialPoolSize-runConfig.BatchSize-20, dp.packetPool.len())
 }
 
 // TestForwarder sets up a mocked batchConn, starts the forwarder that will write to
@@ -178,15 +181,15 @@ func TestForwarder(t *testing.T) {
 		BatchSize:     64,
 	}
 	dp.initPacketPool(runConfig, 64)
-	_, fwCh, _ := initQueues(runConfig, dp.interfaces, 64)
-	initialPoolSize := len(dp.packetPool)
+	_, fwCh, _ := initQueues(runConfig, dp.tables().interfaces, 64)
+	initialPoolSize := dp.packetPool.len()
 	dp.setRunning()
 	dp.initMetrics()
 	go dp.runForwarder(0, dp.internal, runConfig, fwCh[0])
 
 	dstAddr := &net.UDPAddr{IP: net.IP{10, 0, 200, 200}}
 	for i := 0; i < 255; i++ {
-		pkt := <-dp.packetPool
+		pkt := dp.packetPool.get()
 		pkt.reset()
 		pkt.rawPacket = pkt.rawPacket[:1]
 		pkt.rawPacket[0] = byte(i)
@@ -197,7 +200,7 @@ func TestForwarder(t *testing.T) {
 		pkt.srcAddr = &net.UDPAddr{} // Receiver always sets this.
 		pkt.ingress = 0
 
-		assert.NotEqual(t, initialPoolSize, len(dp.packetPool))
+		assert.NotEqual(t, initialPoolSize, dp.packetPool.len())
 
 		select {
 		case fwCh[0] <- pkt:
@@ -208,13 +211,50 @@ func TestForwarder(t *testing.T) {
 	select {
 	case <-done:
 		time.Sleep(100 * time.Millisecond)
-		assert.Equal(t, initialPoolSize, len(dp.packetPool))
+		assert.Equal(t, initialPoolSize, dp.packetPool.len())
 	case <-time.After(100 * time.Millisecond):
 		t.Fail()
 		dp.setStopping()
 	}
 }
 
+func TestPacketPool(t *testing.T) {
+	t.Run("grow wakes up waiting getters", func(t *testing.T) {
+		pool := &packetPool{}
+		pool.growTo(1)
+		pkt := pool.get()
+		got := make(chan *packet)
+		go func() { got <- pool.get() }()
+		pool.growTo(2)
+		select {
+		case p := <-got:
+			assert.NotSame(t, pkt, p)
+		case <-time.After(time.Second):
+			t.Fatal("getter not woken up")
+		}
+		pool.put(pkt)
+		pool.growTo(1)
+		assert.Equal(t, 2, pool.size())
+		assert.Equal(t, 1, pool.len())
+	})
+	t.Run("detached forwarder queue is reclaimed", func(t *testing.T) {
+		dp := &DataPlane{}
+		dp.packetPool = &packetPool{}
+		dp.packetPool.growTo(2)
+		fwQ := make(chan *packet, 2)
+		dp.ifTables.Store(&interfaceTables{fwQs: map[uint16]chan *packet{1: fwQ}})
+		fwQ <- dp.packetPool.get()
+		dp.reclaimDetached(1, fwQ)
+		assert.Equal(t, 1, dp.packetPool.len(), "queue of attached interface is kept")
+
+		dp.ifTables.Store(&interfaceTables{fwQs: map[uint16]chan *packet{}})
+		fwQ <- nil
+		dp.reclaimDetached(1, fwQ)
+		assert.Equal(t, 2, dp.packetPool.len())
+		assert.Empty(t, fwQ)
+	})
+}
+
 func TestComputeProcId(t *testing.T) {
 	randomValueBytes := []byte{1, 2, 3, 4}
 	numProcs := 10000
@@ -752,3 +792,84 @@ func prepBaseMsgHop0Out(t *testing.T, payload []byte, flowId uint32) *slayers.SC
 	spkt.Path = dpath
 	return spkt
 }
+
+func TestCapture(t *testing.T) {
+	dp := &DataPlane{}
+	cfg := capture.Config{
+		MaxPackets: 1,
+		Filter:     capture.Filter{Dispositions: []capture.Disposition{capture.Dropped}},
+	}
+	cfg.InitDefaults()
+	var buf bytes.Buffer
+	done := make(chan error)
+	go func() {
+		_, err := dp.Capture(context.Background(), cfg, &buf)
+		done <- err
+	}()
+	require.Eventually(t, func() bool { return dp.captureSession.Load() != nil },
+		time.Second, time.Millisecond)
+	_, err := dp.Capture(context.Background(), cfg, io.Discard)
+	assert.ErrorIs(t, err, capture.ErrActive)
+
+	pkt := (&packet{}).init(&[bufSize]byte{})
+	raw := serializedBaseMsg(t, []byte("payload"), 0)
+	pkt.rawPacket = pkt.rawPacket[:copy(pkt.rawPacket, raw)]
+	pkt.srcAddr = &net.UDPAddr{IP: net.IP{10, 0, 200, 200}, Port: 30041}
+	pkt.ingress = 1
+	// Not selected by the filter.
+	dp.capturePacket(pkt, capture.Forwarded, nil)
+	dp.capturePacket(pkt, capture.Dropped, macVerificationFailed)
+
+	require.NoError(t, <-done)
+	assert.Nil(t, dp.captureSession.Load())
+	assert.Contains(t, buf.String(),
+		`disposition=dropped ingress=1 reason="MAC verification failed"`)
+	assert.Contains(t, buf.String(), string(raw))
+}
+
+func TestInterfaceStatistics(t *testing.T) {
+	// An ISD-AS of its own keeps the counters apart from the ones of the other tests.
+	localIA := addr.MustParseIA("1-ff00:0:999")
+	m := newInterfaceMetrics(metrics, 1, localIA, map[uint16]addr.IA{1: localIA})
+	small, large := m[classOfSize(100)], m[classOfSize(1000)]
+	small.InputPacketsTotal.Add(2)
+	small.InputBytesTotal.Add(200)
+	small.ProcessedPackets.Add(2)
+	large.InputPacketsTotal.Inc()
+	large.InputBytesTotal.Add(1000)
+	large.ProcessedPackets.Inc()
+	large.Output[ttOut].OutputPacketsTotal.Inc()
+	large.Output[ttOut].OutputBytesTotal.Add(1000)
+	small.DroppedPacketsInvalid.Inc()
+	small.Rejected.inc(rejectReasonOf(serrors.JoinNoStack(macVerificationFailed, nil,
+		"if_id", 1)))
+	small.Rejected.inc(scmpRejectReason(slowPathRequest{
+		typ:      slowPathSCMP,
+		scmpType: slayers.SCMPTypeParameterProblem,
+		code:     slayers.SCMPCodePathExpired,
+	}))
+
+	stats := m.statistics(1)
+	assert.Equal(t, uint16(1), stats.IfID)
+	assert.Equal(t, uint64(3), stats.InputPackets)
+	assert.Equal(t, uint64(1200), stats.InputBytes)
+	assert.Equal(t, uint64(3), stats.ProcessedPackets)
+	assert.Equal(t, uint64(1), stats.OutputPackets)
+	assert.Equal(t, uint64(1000), stats.OutputBytes)
+	assert.Equal(t, map[string]uint64{
+		"invalid":        1,
+		"busy_processor": 0,
+		"busy_forwarder": 0,
+		"busy_slow_path": 0,
+	}, stats.DroppedPackets)
+	assert.Equal(t, map[string]uint64{
+		"other":           0,
+		"malformed":       0,
+		"invalid_mac":     1,
+		"expired_hop":     1,
+		"bad_interface":   0,
+		"invalid_address": 0,
+		"no_route":        0,
+		"interface_down":  0,
+	}, stats.RejectedPackets)
+}
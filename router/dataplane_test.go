@@ -228,6 +228,146 @@ func TestDataPlaneAddNextHop(t *testing.T) {
 	})
 }
 
+func TestDataPlaneAttachDetachExternalInterface(t *testing.T) {
+	link := control.LinkInfo{
+		Local: control.LinkEnd{
+			IA:   addr.MustParseIA("1-ff00:0:1"),
+			Addr: netip.MustParseAddrPort("10.0.0.100:0"),
+		},
+		Remote: control.LinkEnd{
+			IA:   addr.MustParseIA("1-ff00:0:3"),
+			Addr: netip.MustParseAddrPort("10.0.0.200:0"),
+		},
+		LinkTo: topology.Child,
+		BFD:    control.BFD{Disable: ptr.To(true)},
+	}
+	t.Run("attach and detach before serve", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := &router.DataPlane{}
+		conn := mock_router.NewMockBatchConn(ctrl)
+		conn.EXPECT().Close().Return(nil)
+		assert.NoError(t, d.AttachExternalInterface(42, conn, link, true))
+		assert.NoError(t, d.AttachExternalInterface(43, nil, link, false))
+		assert.Error(t, d.AttachExternalInterface(42, nil, link, false))
+		assert.NoError(t, d.DetachExternalInterface(42))
+		assert.NoError(t, d.DetachExternalInterface(43))
+		assert.Error(t, d.DetachExternalInterface(42))
+		assert.NoError(t, d.AttachExternalInterface(43, nil, link, false))
+	})
+	t.Run("connection must match ownership", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := &router.DataPlane{}
+		assert.Error(t, d.AttachExternalInterface(42, nil, link, true))
+		assert.Error(t,
+			d.AttachExternalInterface(42, mock_router.NewMockBatchConn(ctrl), link, false))
+	})
+	t.Run("attach and detach while running", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := &router.DataPlane{Metrics: metrics}
+		require.NoError(t, d.SetIA(addr.MustParseIA("1-ff00:0:1")))
+		require.NoError(t, d.SetKey([]byte("testkey_xxxxxxxx")))
+		mInternal := mock_router.NewMockBatchConn(ctrl)
+		mInternal.EXPECT().ReadBatch(gomock.Any()).Return(0, nil).AnyTimes()
+		require.NoError(t, d.AddInternalInterface(mInternal, netip.Addr{}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			_ = d.Run(ctx, &router.RunConfig{
+				NumProcessors:         1,
+				NumSlowPathProcessors: 1,
+				BatchSize:             16,
+			})
+		}()
+		require.Eventually(t, d.IsRunning, time.Second, 10*time.Millisecond)
+
+		reading := make(chan struct{}, 1)
+		closed := make(chan struct{})
+		mExternal := mock_router.NewMockBatchConn(ctrl)
+		mExternal.EXPECT().ReadBatch(gomock.Any()).DoAndReturn(
+			func(underlayconn.Messages) (int, error) {
+				select {
+				case reading <- struct{}{}:
+				default:
+				}
+				<-closed
+				return 0, fmt.Errorf("closed")
+			}).MinTimes(1)
+		mExternal.EXPECT().Close().DoAndReturn(func() error {
+			close(closed)
+			return nil
+		})
+
+		require.NoError(t, d.AttachExternalInterface(42, mExternal, link, true))
+		select {
+		case <-reading:
+		case <-time.After(time.Second):
+			t.Fatal("receiver of attached interface not started")
+		}
+		require.NoError(t, d.DetachExternalInterface(42))
+		assert.Error(t, d.DetachExternalInterface(42))
+	})
+	t.Run("attach more interfaces than the pool was sized for", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := &router.DataPlane{Metrics: metrics}
+		require.NoError(t, d.SetIA(addr.MustParseIA("1-ff00:0:1")))
+		require.NoError(t, d.SetKey([]byte("testkey_xxxxxxxx")))
+		mInternal := mock_router.NewMockBatchConn(ctrl)
+		mInternal.EXPECT().ReadBatch(gomock.Any()).Return(0, nil).AnyTimes()
+		require.NoError(t, d.AddInternalInterface(mInternal, netip.Addr{}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			_ = d.Run(ctx, &router.RunConfig{
+				NumProcessors:         1,
+				NumSlowPathProcessors: 1,
+				BatchSize:             16,
+			})
+		}()
+		require.Eventually(t, d.IsRunning, time.Second, 10*time.Millisecond)
+
+		// Every receiver holds a batch of packets from the pool before it reads. The pool
+		// that was sized for the internal interface only cannot serve all of them.
+		const numInterfaces = 8
+		reading := make(chan struct{}, numInterfaces)
+		for ifID := uint16(1); ifID <= numInterfaces; ifID++ {
+			var once sync.Once
+			closed := make(chan struct{})
+			conn := mock_router.NewMockBatchConn(ctrl)
+			conn.EXPECT().ReadBatch(gomock.Any()).DoAndReturn(
+				func(underlayconn.Messages) (int, error) {
+					once.Do(func() { reading <- struct{}{} })
+					<-closed
+					return 0, fmt.Errorf("closed")
+				}).MinTimes(1)
+			conn.EXPECT().Close().DoAndReturn(func() error {
+				close(closed)
+				return nil
+			})
+			require.NoError(t, d.AttachExternalInterface(ifID, conn, link, true))
+		}
+		for i := 0; i < numInterfaces; i++ {
+			select {
+			case <-reading:
+			case <-time.After(time.Second):
+				t.Fatalf("only %d of %d receivers got packets from the pool", i, numInterfaces)
+			}
+		}
+		for ifID := uint16(1); ifID <= numInterfaces; ifID++ {
+			require.NoError(t, d.DetachExternalInterface(ifID))
+		}
+	})
+}
+
 func TestDataPlaneRun(t *testing.T) {
 	t.Parallel()
 	ctrl := gomock.NewController(t)
@@ -0,0 +1,89 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drkey
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/drkey"
+	sc_grpc "github.com/scionproto/scion/pkg/grpc"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
+	drkeypb "github.com/scionproto/scion/pkg/proto/drkey"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/pkg/snet"
+)
+
+// Fetcher obtains secret values from the local CS.
+type Fetcher struct {
+	Dialer sc_grpc.Dialer
+}
+
+// SecretValue queries the local CS for the secret value described by meta.
+func (f *Fetcher) SecretValue(
+	ctx context.Context,
+	meta drkey.SecretValueMeta,
+) (drkey.SecretValue, error) {
+
+	conn, err := f.Dialer.Dial(ctx, &snet.SVCAddr{SVC: addr.SvcCS})
+	if err != nil {
+		return drkey.SecretValue{}, serrors.Wrap("dialing", err)
+	}
+	defer conn.Close()
+	client := cppb.NewDRKeyIntraServiceClient(conn)
+	rep, err := client.DRKeySecretValue(ctx, &cppb.DRKeySecretValueRequest{
+		ValTime:    timestamppb.New(meta.Validity),
+		ProtocolId: drkeypb.Protocol(meta.ProtoId),
+	})
+	if err != nil {
+		return drkey.SecretValue{}, serrors.Wrap("requesting secret value", err)
+	}
+	sv, err := getSecretValueFromReply(rep, meta)
+	if err != nil {
+		return drkey.SecretValue{}, serrors.Wrap("obtaining secret value from reply", err)
+	}
+	return sv, nil
+}
+
+func getSecretValueFromReply(
+	rep *cppb.DRKeySecretValueResponse,
+	meta drkey.SecretValueMeta,
+) (drkey.SecretValue, error) {
+
+	if err := rep.EpochBegin.CheckValid(); err != nil {
+		return drkey.SecretValue{}, serrors.Wrap("invalid EpochBegin from response", err)
+	}
+	if err := rep.EpochEnd.CheckValid(); err != nil {
+		return drkey.SecretValue{}, serrors.Wrap("invalid EpochEnd from response", err)
+	}
+	if len(rep.Key) != 16 {
+		return drkey.SecretValue{}, serrors.New("key size in reply is not 16 bytes",
+			"len", len(rep.Key))
+	}
+	sv := drkey.SecretValue{
+		ProtoId: meta.ProtoId,
+		Epoch: drkey.Epoch{
+			Validity: cppki.Validity{
+				NotBefore: rep.EpochBegin.AsTime(),
+				NotAfter:  rep.EpochEnd.AsTime(),
+			},
+		},
+	}
+	copy(sv.Key[:], rep.Key)
+	return sv, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drkey_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/scionproto/scion/pkg/drkey"
+	"github.com/scionproto/scion/pkg/private/xtest"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
+	mock_cppb "github.com/scionproto/scion/pkg/proto/control_plane/mock_control_plane"
+	drkeypb "github.com/scionproto/scion/pkg/proto/drkey"
+	rdrkey "github.com/scionproto/scion/router/drkey"
+)
+
+var _ rdrkey.SecretValueFetcher = (*rdrkey.Fetcher)(nil)
+
+func TestFetcherSecretValue(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	rawKey := xtest.MustParseHexString("c584cad32613547c64823c756651b6f5")
+
+	testCases := map[string]struct {
+		key       []byte
+		assertErr assert.ErrorAssertionFunc
+	}{
+		"valid": {
+			key:       rawKey,
+			assertErr: assert.NoError,
+		},
+		"short key": {
+			key:       rawKey[:8],
+			assertErr: assert.Error,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			csSrv := mock_cppb.NewMockDRKeyIntraServiceServer(ctrl)
+			csSrv.EXPECT().DRKeySecretValue(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context,
+					req *cppb.DRKeySecretValueRequest,
+				) (*cppb.DRKeySecretValueResponse, error) {
+
+					assert.Equal(t, drkeypb.Protocol_PROTOCOL_SCMP, req.ProtocolId)
+					assert.Equal(t, now, req.ValTime.AsTime())
+					return &cppb.DRKeySecretValueResponse{
+						Key:        tc.key,
+						EpochBegin: timestamppb.New(now.Add(-time.Hour)),
+						EpochEnd:   timestamppb.New(now.Add(time.Hour)),
+					}, nil
+				},
+			)
+
+			server := xtest.NewGRPCService()
+			cppb.RegisterDRKeyIntraServiceServer(server.Server(), csSrv)
+			server.Start(t)
+
+			fetcher := rdrkey.Fetcher{Dialer: server}
+			sv, err := fetcher.SecretValue(context.Background(), drkey.SecretValueMeta{
+				Validity: now,
+				ProtoId:  drkey.SCMP,
+			})
+			tc.assertErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, drkey.SCMP, sv.ProtoId)
+			assert.Equal(t, rawKey, sv.Key[:])
+			assert.True(t, sv.Epoch.NotBefore.Equal(now.Add(-time.Hour)))
+			assert.True(t, sv.Epoch.NotAfter.Equal(now.Add(time.Hour)))
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package drkey provides the router with the DRKeys used to authenticate SCMP messages.
+//
+// The router is an infrastructure node of the fast side of the AS-Host keys used for SCMP
+// authentication. Instead of requesting each key from the control service, it fetches the SCMP
+// secret value of each epoch and derives the keys locally. The control service must therefore
+// list the address of the router in its drkey.delegation configuration for the scmp protocol.
+package drkey
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/drkey"
+	"github.com/scionproto/scion/pkg/drkey/specific"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/pkg/spao"
+)
+
+// ErrSecretValueNotFound indicates that no secret value is cached for the requested time.
+var ErrSecretValueNotFound = serrors.New("secret value not found")
+
+// SecretValueFetcher obtains secret values from the local control service.
+type SecretValueFetcher interface {
+	SecretValue(ctx context.Context, meta drkey.SecretValueMeta) (drkey.SecretValue, error)
+}
+
+// Provider derives the AS-Host keys for the SCMP protocol from cached secret values. The
+// secret values are fetched by Run, which is meant to be executed periodically. Key lookups never
+// block on the network; if the secret value for the requested epoch has not been fetched yet, an
+// error is returned.
+type Provider struct {
+	// LocalIA is the ISD-AS of the router.
+	LocalIA addr.IA
+	// Fetcher fetches the secret values from the control service.
+	Fetcher SecretValueFetcher
+	// AcceptanceWindow is the time width for accepting the timestamps of incoming packets.
+	AcceptanceWindow time.Duration
+	// PrefetchLead is how long before the end of the current epoch the secret value of the next
+	// epoch is fetched. If it is shorter than half of the acceptance window, half of the
+	// acceptance window is used instead.
+	PrefetchLead time.Duration
+
+	mtx sync.RWMutex
+	// svs contains the cached secret values, sorted by the start of their epoch.
+	svs []drkey.SecretValue
+}
+
+// Name returns the tasks name.
+func (p *Provider) Name() string {
+	return "router_drkey_secret_value_fetcher"
+}
+
+// Run fetches the secret values that are needed now, or that will be needed once the current
+// epoch ends, and evicts those that can no longer be used.
+func (p *Provider) Run(ctx context.Context) {
+	if err := p.Refresh(ctx, time.Now()); err != nil {
+		log.FromCtx(ctx).Info("Failed to fetch DRKey secret values", "err", err)
+	}
+}
+
+// Refresh makes sure that the secret values needed at time now are cached. These are the ones for
+// the epochs covering the acceptance window around now and the one following the current epoch,
+// if the current epoch ends within the prefetch lead.
+func (p *Provider) Refresh(ctx context.Context, now time.Time) error {
+	p.evict(now)
+
+	halfWindow := p.AcceptanceWindow / 2
+	lead := max(p.PrefetchLead, halfWindow)
+	var errs serrors.List
+	for _, t := range []time.Time{now.Add(-halfWindow), now, now.Add(lead)} {
+		if _, ok := p.secretValue(t); ok {
+			continue
+		}
+		sv, err := p.Fetcher.SecretValue(ctx, drkey.SecretValueMeta{
+			Validity: t,
+			ProtoId:  drkey.SCMP,
+		})
+		if err != nil {
+			errs = append(errs, serrors.Wrap("fetching secret value", err, "validity", t))
+			continue
+		}
+		if sv.ProtoId != drkey.SCMP || !contains(sv.Epoch, t) {
+			errs = append(errs, serrors.New("received unexpected secret value",
+				"validity", t, "protocol", sv.ProtoId, "epoch", sv.Epoch))
+			continue
+		}
+		p.insert(sv)
+	}
+	return errs.ToError()
+}
+
+// GetASHostKey returns the key for the given destination that is valid at validTime.
+func (p *Provider) GetASHostKey(
+	validTime time.Time,
+	dstIA addr.IA,
+	dstAddr addr.Host,
+) (drkey.ASHostKey, error) {
+
+	sv, ok := p.secretValue(validTime)
+	if !ok {
+		return drkey.ASHostKey{}, serrors.JoinNoStack(ErrSecretValueNotFound, nil,
+			"validity", validTime)
+	}
+	return p.deriveASHost(sv, dstIA, dstAddr)
+}
+
+// GetKeyWithinAcceptanceWindow returns the key for the given destination whose epoch maps the
+// relative timestamp to an absolute time within the acceptance window around t. Keys of the epoch
+// containing t are preferred over keys of neighbouring epochs.
+func (p *Provider) GetKeyWithinAcceptanceWindow(
+	t time.Time,
+	timestamp uint64,
+	dstIA addr.IA,
+	dstAddr addr.Host,
+) (drkey.ASHostKey, error) {
+
+	validity := cppki.Validity{
+		NotBefore: t.Add(-(p.AcceptanceWindow / 2)),
+		NotAfter:  t.Add(p.AcceptanceWindow / 2),
+	}
+	p.mtx.RLock()
+	candidates := make([]drkey.SecretValue, 0, len(p.svs))
+	for _, sv := range p.svs {
+		if contains(sv.Epoch, t) {
+			candidates = append([]drkey.SecretValue{sv}, candidates...)
+		} else {
+			candidates = append(candidates, sv)
+		}
+	}
+	p.mtx.RUnlock()
+
+	for _, sv := range candidates {
+		if validity.Contains(spao.AbsoluteTimestamp(sv.Epoch, timestamp)) {
+			return p.deriveASHost(sv, dstIA, dstAddr)
+		}
+	}
+	return drkey.ASHostKey{}, serrors.JoinNoStack(ErrSecretValueNotFound, nil,
+		"awBegin", validity.NotBefore, "awEnd", validity.NotAfter, "timestamp", timestamp)
+}
+
+func (p *Provider) deriveASHost(
+	sv drkey.SecretValue,
+	dstIA addr.IA,
+	dstAddr addr.Host,
+) (drkey.ASHostKey, error) {
+
+	var deriver specific.Deriver
+	lvl1, err := deriver.DeriveLevel1(dstIA, sv.Key)
+	if err != nil {
+		return drkey.ASHostKey{}, serrors.Wrap("deriving level 1 key", err)
+	}
+	key, err := deriver.DeriveASHost(dstAddr.String(), lvl1)
+	if err != nil {
+		return drkey.ASHostKey{}, serrors.Wrap("deriving AS-Host key", err)
+	}
+	return drkey.ASHostKey{
+		ProtoId: drkey.SCMP,
+		Epoch:   sv.Epoch,
+		SrcIA:   p.LocalIA,
+		DstIA:   dstIA,
+		DstHost: dstAddr.String(),
+		Key:     key,
+	}, nil
+}
+
+func (p *Provider) secretValue(t time.Time) (drkey.SecretValue, bool) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	for _, sv := range p.svs {
+		if contains(sv.Epoch, t) {
+			return sv, true
+		}
+	}
+	return drkey.SecretValue{}, false
+}
+
+func (p *Provider) insert(sv drkey.SecretValue) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, cached := range p.svs {
+		if cached.Epoch.NotBefore.Equal(sv.Epoch.NotBefore) {
+			return
+		}
+	}
+	p.svs = append(p.svs, sv)
+	sort.Slice(p.svs, func(i, j int) bool {
+		return p.svs[i].Epoch.NotBefore.Before(p.svs[j].Epoch.NotBefore)
+	})
+}
+
+// evict removes the secret values whose epoch ended before the acceptance window around now.
+func (p *Provider) evict(now time.Time) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	oldest := now.Add(-(p.AcceptanceWindow / 2))
+	svs := p.svs[:0]
+	for _, sv := range p.svs {
+		if sv.Epoch.NotAfter.After(oldest) {
+			svs = append(svs, sv)
+		}
+	}
+	p.svs = svs
+}
+
+// contains checks whether t is in the half-open interval [NotBefore, NotAfter) of the epoch.
+// Consecutive epochs share their boundary, so the end of an epoch belongs to the next one.
+func contains(epoch drkey.Epoch, t time.Time) bool {
+	return !t.Before(epoch.NotBefore) && t.Before(epoch.NotAfter)
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drkey_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/drkey"
+	"github.com/scionproto/scion/pkg/drkey/specific"
+	"github.com/scionproto/scion/pkg/spao"
+	"github.com/scionproto/scion/private/periodic"
+	"github.com/scionproto/scion/router"
+	rdrkey "github.com/scionproto/scion/router/drkey"
+)
+
+var _ periodic.Task = (*rdrkey.Provider)(nil)
+var _ router.DRKeyProvider = (*rdrkey.Provider)(nil)
+
+const epochDuration = time.Hour
+
+var (
+	localIA = addr.MustParseIA("1-ff00:0:110")
+	dstIA   = addr.MustParseIA("1-ff00:0:111")
+	dstHost = addr.MustParseHost("10.0.0.1")
+)
+
+// svFetcher derives the secret values like the control service does and counts the requests.
+type svFetcher struct {
+	requests []time.Time
+}
+
+func (f *svFetcher) SecretValue(
+	_ context.Context,
+	meta drkey.SecretValueMeta,
+) (drkey.SecretValue, error) {
+
+	f.requests = append(f.requests, meta.Validity)
+	return deriveSV(meta.Validity), nil
+}
+
+func deriveSV(t time.Time) drkey.SecretValue {
+	begin := t.Truncate(epochDuration)
+	epoch := drkey.NewEpoch(uint32(begin.Unix()), uint32(begin.Add(epochDuration).Unix()))
+	sv, err := drkey.DeriveSV(drkey.SCMP, epoch, []byte("0123456789abcdef"))
+	if err != nil {
+		panic(err)
+	}
+	return sv
+}
+
+func expectedKey(t *testing.T, sv drkey.SecretValue) drkey.Key {
+	var deriver specific.Deriver
+	lvl1, err := deriver.DeriveLevel1(dstIA, sv.Key)
+	require.NoError(t, err)
+	key, err := deriver.DeriveASHost(dstHost.String(), lvl1)
+	require.NoError(t, err)
+	return key
+}
+
+func newProvider(fetcher rdrkey.SecretValueFetcher) *rdrkey.Provider {
+	return &rdrkey.Provider{
+		LocalIA:          localIA,
+		Fetcher:          fetcher,
+		AcceptanceWindow: 5 * time.Minute,
+		PrefetchLead:     10 * time.Minute,
+	}
+}
+
+func TestProviderGetASHostKey(t *testing.T) {
+	epochStart := time.Now().Truncate(epochDuration).Add(-epochDuration)
+	fetcher := &svFetcher{}
+	p := newProvider(fetcher)
+
+	now := epochStart.Add(epochDuration / 2)
+	_, err := p.GetASHostKey(now, dstIA, dstHost)
+	assert.ErrorIs(t, err, rdrkey.ErrSecretValueNotFound)
+
+	require.NoError(t, p.Refresh(context.Background(), now))
+	assert.Len(t, fetcher.requests, 1, "all required times fall into the same epoch")
+	key, err := p.GetASHostKey(now, dstIA, dstHost)
+	require.NoError(t, err)
+	current := deriveSV(now)
+	assert.Equal(t, expectedKey(t, current), key.Key)
+	assert.Equal(t, current.Epoch, key.Epoch)
+	assert.Equal(t, drkey.SCMP, key.ProtoId)
+	assert.Equal(t, localIA, key.SrcIA)
+	assert.Equal(t, dstIA, key.DstIA)
+
+	// The key of the next epoch is not available yet.
+	_, err = p.GetASHostKey(epochStart.Add(epochDuration), dstIA, dstHost)
+	assert.ErrorIs(t, err, rdrkey.ErrSecretValueNotFound)
+
+	// Within the prefetch lead, the next secret value is fetched ahead of time.
+	now = epochStart.Add(epochDuration - 5*time.Minute)
+	require.NoError(t, p.Refresh(context.Background(), now))
+	assert.Len(t, fetcher.requests, 2)
+	next := deriveSV(epochStart.Add(epochDuration))
+	key, err = p.GetASHostKey(epochStart.Add(epochDuration), dstIA, dstHost)
+	require.NoError(t, err)
+	assert.Equal(t, expectedKey(t, next), key.Key)
+	assert.Equal(t, next.Epoch, key.Epoch)
+
+	// Refreshing again does not fetch anything new.
+	require.NoError(t, p.Refresh(context.Background(), now))
+	assert.Len(t, fetcher.requests, 2)
+
+	// Once the acceptance window has moved past the first epoch, its secret value is evicted.
+	now = epochStart.Add(epochDuration + 3*time.Minute)
+	require.NoError(t, p.Refresh(context.Background(), now))
+	assert.Len(t, fetcher.requests, 2)
+	_, err = p.GetASHostKey(now.Add(-epochDuration/2), dstIA, dstHost)
+	assert.ErrorIs(t, err, rdrkey.ErrSecretValueNotFound)
+}
+
+func TestProviderGetKeyWithinAcceptanceWindow(t *testing.T) {
+	epochStart := time.Now().Truncate(epochDuration).Add(-epochDuration)
+	boundary := epochStart.Add(epochDuration)
+	p := newProvider(&svFetcher{})
+	now := boundary.Add(time.Minute)
+	require.NoError(t, p.Refresh(context.Background(), now))
+	previous, current := deriveSV(epochStart), deriveSV(boundary)
+
+	testCases := map[string]struct {
+		sendTime  time.Time
+		epoch     drkey.SecretValue
+		expected  drkey.SecretValue
+		assertErr assert.ErrorAssertionFunc
+	}{
+		"current epoch": {
+			sendTime:  now,
+			epoch:     current,
+			expected:  current,
+			assertErr: assert.NoError,
+		},
+		"previous epoch": {
+			sendTime:  boundary.Add(-time.Second),
+			epoch:     previous,
+			expected:  previous,
+			assertErr: assert.NoError,
+		},
+		"outside acceptance window": {
+			sendTime:  now.Add(-10 * time.Minute),
+			epoch:     previous,
+			assertErr: assert.Error,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			timestamp, err := spao.RelativeTimestamp(tc.epoch.Epoch, tc.sendTime)
+			require.NoError(t, err)
+			key, err := p.GetKeyWithinAcceptanceWindow(now, timestamp, dstIA, dstHost)
+			tc.assertErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, expectedKey(t, tc.expected), key.Key)
+			assert.Equal(t, tc.expected.Epoch, key.Epoch)
+		})
+	}
+}
@@ -77,10 +77,6 @@ func NewDP(
 
 	dp := &DataPlane{
 		localIA:             local,
-		external:            external,
-		linkTypes:           linkTypes,
-		neighborIAs:         neighbors,
-		internalNextHops:    internalNextHops,
 		dispatchedPortStart: uint16(dispatchedPortStart),
 		dispatchedPortEnd:   uint16(dispatchedPortEnd),
 		svc:                 &services{m: svc},
@@ -88,6 +84,12 @@ func NewDP(
 		internalIP:          netip.MustParseAddr("198.51.100.1"),
 		Metrics:             metrics,
 	}
+	dp.ifTables.Store(&interfaceTables{
+		external:         external,
+		linkTypes:        linkTypes,
+		neighborIAs:      neighbors,
+		internalNextHops: internalNextHops,
+	})
 	if err := dp.SetKey(key); err != nil {
 		panic(err)
 	}
@@ -15,14 +15,19 @@
 package router
 
 import (
+	"errors"
 	"math/bits"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	dto "github.com/prometheus/client_model/go"
 
 	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/slayers"
+	"github.com/scionproto/scion/router/control"
 )
 
 // Metrics defines the data-plane metrics for the BR.
@@ -252,7 +257,7 @@ func (sc sizeClass) String() string {
 // access by the using code. forwardingMetrics is a map of interface to interfaceMetrics. To access
 // a specific InputPacketsTotal counter, one refers to:
 //
-//	dataplane.forwardingMetrics[interface][size-class].
+//	dataplane.tables().forwardingMetrics[interface][size-class].
 //
 // trafficMetrics.Output is an array of outputMetrics indexed by traffic type.
 type interfaceMetrics map[sizeClass]trafficMetrics
@@ -268,6 +273,8 @@ type trafficMetrics struct {
 	DroppedPacketsBusySlowPath  prometheus.Counter
 	ProcessedPackets            prometheus.Counter
 	Output                      [ttMax]outputMetrics
+	// Rejected is shared by all the size classes of the interface.
+	Rejected *rejectCounters
 }
 
 // outputMetrics groups all the metrics about traffic that has reached the output stage. Metrics
@@ -286,22 +293,72 @@ func newInterfaceMetrics(
 
 	ifLabels := interfaceLabels(id, localIA, neighbors)
 	m := interfaceMetrics{}
+	rejected := &rejectCounters{}
 	for sc := minSizeClass; sc < maxSizeClass; sc++ {
 		scLabels := prometheus.Labels{"sizeclass": sc.String()}
-		m[sc] = newTrafficMetrics(metrics, ifLabels, scLabels)
+		m[sc] = newTrafficMetrics(metrics, ifLabels, scLabels, rejected)
 	}
 	return m
 }
 
+// statistics sums up the counters of all size classes.
+func (m interfaceMetrics) statistics(ifID uint16) control.InterfaceStatistics {
+	stats := control.InterfaceStatistics{
+		IfID: ifID,
+		DroppedPackets: map[string]uint64{
+			"invalid":        0,
+			"busy_processor": 0,
+			"busy_forwarder": 0,
+			"busy_slow_path": 0,
+		},
+		RejectedPackets: make(map[string]uint64, rrMax),
+	}
+	for _, c := range m {
+		stats.InputPackets += counterValue(c.InputPacketsTotal)
+		stats.InputBytes += counterValue(c.InputBytesTotal)
+		stats.ProcessedPackets += counterValue(c.ProcessedPackets)
+		stats.DroppedPackets["invalid"] += counterValue(c.DroppedPacketsInvalid)
+		stats.DroppedPackets["busy_processor"] += counterValue(c.DroppedPacketsBusyProcessor)
+		stats.DroppedPackets["busy_forwarder"] += counterValue(c.DroppedPacketsBusyForwarder)
+		stats.DroppedPackets["busy_slow_path"] += counterValue(c.DroppedPacketsBusySlowPath)
+		for _, o := range c.Output {
+			stats.OutputPackets += counterValue(o.OutputPacketsTotal)
+			stats.OutputBytes += counterValue(o.OutputBytesTotal)
+		}
+	}
+	var rejected *rejectCounters
+	if c, ok := m[minSizeClass]; ok {
+		rejected = c.Rejected
+	}
+	for r := rrOther; r < rrMax; r++ {
+		stats.RejectedPackets[r.String()] = rejected.get(r)
+	}
+	return stats
+}
+
+// counterValue returns the current value of the counter.
+func counterValue(c prometheus.Counter) uint64 {
+	if c == nil {
+		return 0
+	}
+	var m dto.Metric
+	if err := c.Write(&m); err != nil {
+		return 0
+	}
+	return uint64(m.GetCounter().GetValue())
+}
+
 func newTrafficMetrics(
 	metrics *Metrics,
 	ifLabels prometheus.Labels,
-	scLabels prometheus.Labels) trafficMetrics {
+	scLabels prometheus.Labels,
+	rejected *rejectCounters) trafficMetrics {
 
 	c := trafficMetrics{
 		InputBytesTotal:   metrics.InputBytesTotal.MustCurryWith(ifLabels).With(scLabels),
 		InputPacketsTotal: metrics.InputPacketsTotal.MustCurryWith(ifLabels).With(scLabels),
 		ProcessedPackets:  metrics.ProcessedPackets.MustCurryWith(ifLabels).With(scLabels),
+		Rejected:          rejected,
 	}
 
 	// Output metrics have the extra "trafficType" label.
@@ -339,6 +396,113 @@ func newTrafficMetrics(
 	return c
 }
 
+// rejectReason classifies why a received packet was rejected, i.e., dropped as invalid or answered
+// with an SCMP error instead of being forwarded.
+type rejectReason uint8
+
+const (
+	rrOther rejectReason = iota
+	rrMalformed
+	rrInvalidMAC
+	rrExpiredHop
+	rrBadInterface
+	rrInvalidAddress
+	rrNoRoute
+	rrInterfaceDown
+	rrMax
+)
+
+// Returns a human-friendly representation of the given reject reason.
+func (r rejectReason) String() string {
+	switch r {
+	case rrMalformed:
+		return "malformed"
+	case rrInvalidMAC:
+		return "invalid_mac"
+	case rrExpiredHop:
+		return "expired_hop"
+	case rrBadInterface:
+		return "bad_interface"
+	case rrInvalidAddress:
+		return "invalid_address"
+	case rrNoRoute:
+		return "no_route"
+	case rrInterfaceDown:
+		return "interface_down"
+	}
+	return "other"
+}
+
+// rejectReasonOf classifies the error for which a packet was discarded.
+func rejectReasonOf(err error) rejectReason {
+	switch {
+	case err == nil:
+		return rrOther
+	case errors.Is(err, macVerificationFailed):
+		return rrInvalidMAC
+	case errors.Is(err, expiredHop):
+		return rrExpiredHop
+	case errors.Is(err, ingressInterfaceInvalid), errors.Is(err, unknownInterface):
+		return rrBadInterface
+	case errors.Is(err, malformedPath), errors.Is(err, errShortPacket),
+		errors.Is(err, badPacketSize), errors.Is(err, unsupportedPathType),
+		errors.Is(err, unsupportedPathTypeNextHeader), errors.Is(err, errPeeringEmptySeg0),
+		errors.Is(err, errPeeringEmptySeg1), errors.Is(err, errPeeringNonemptySeg2):
+		return rrMalformed
+	case errors.Is(err, invalidSrcIA), errors.Is(err, invalidDstIA),
+		errors.Is(err, invalidSrcAddrForTransit), errors.Is(err, invalidDstAddr),
+		errors.Is(err, unsupportedV4MappedV6Address),
+		errors.Is(err, unsupportedUnspecifiedAddress), errors.Is(err, noSVCBackend):
+		return rrInvalidAddress
+	case errors.Is(err, cannotRoute), errors.Is(err, errInvalidEgress):
+		return rrNoRoute
+	}
+	return rrOther
+}
+
+// scmpRejectReason classifies the SCMP error with which a packet was answered.
+func scmpRejectReason(r slowPathRequest) rejectReason {
+	switch r.scmpType {
+	case slayers.SCMPTypeParameterProblem:
+		switch r.code {
+		case slayers.SCMPCodeInvalidHopFieldMAC:
+			return rrInvalidMAC
+		case slayers.SCMPCodePathExpired:
+			return rrExpiredHop
+		case slayers.SCMPCodeUnknownHopFieldIngress, slayers.SCMPCodeUnknownHopFieldEgress:
+			return rrBadInterface
+		case slayers.SCMPCodeInvalidSourceAddress, slayers.SCMPCodeInvalidDestinationAddress:
+			return rrInvalidAddress
+		case slayers.SCMPCodeInvalidPath, slayers.SCMPCodeInvalidSegmentChange,
+			slayers.SCMPCodeInvalidPacketSize:
+			return rrMalformed
+		}
+	case slayers.SCMPTypeDestinationUnreachable:
+		return rrNoRoute
+	case slayers.SCMPTypeExternalInterfaceDown, slayers.SCMPTypeInternalConnectivityDown:
+		return rrInterfaceDown
+	}
+	return rrOther
+}
+
+// rejectCounters counts the rejected packets of an interface by reason. They are only updated
+// for rejected packets and thus do not slow down the forwarding of valid packets.
+type rejectCounters [rrMax]atomic.Uint64
+
+func (c *rejectCounters) inc(r rejectReason) {
+	if c == nil {
+		return
+	}
+	c[r].Add(1)
+}
+
+func (c *rejectCounters) get(r rejectReason) uint64 {
+	if c == nil {
+		return 0
+	}
+	return c[r].Load()
+}
+
 func newOutputMetrics(
 	metrics *Metrics,
 	ifLabels prometheus.Labels,
@@ -15,20 +15,51 @@
 package mgmtapi
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
+	"time"
+
+	"github.com/google/gopacket/layers"
 
 	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
 	api "github.com/scionproto/scion/private/mgmtapi"
+	"github.com/scionproto/scion/router/capture"
 	"github.com/scionproto/scion/router/control"
 )
 
+// Capturer captures the packets processed by the router.
+type Capturer interface {
+	Capture(ctx context.Context, cfg capture.Config, w io.Writer) (capture.Stats, error)
+}
+
+// Statistics provides the state of the BFD sessions and the packet counters of
+// the interfaces.
+type Statistics interface {
+	ListBFDSessions() ([]control.BFDSession, error)
+	ListInterfaceStatistics() ([]control.InterfaceStatistics, error)
+}
+
 // Server implements the Control Service API.
 type Server struct {
 	Config    http.HandlerFunc
 	Info      http.HandlerFunc
 	LogLevel  http.HandlerFunc
 	Dataplane control.ObservableDataplane
+	// Capturer is used for packet captures. If nil, captures are not
+	// supported.
+	Capturer Capturer
+	// Authorize wraps the handler of packet captures, and only calls it for
+	// authorized requests. If it is nil, packet captures are disabled.
+	Authorize func(http.Handler) http.Handler
+	// Statistics is used to report the BFD sessions and the packet counters.
+	// If nil, they are not supported.
+	Statistics Statistics
 }
 
 // GetConfig is an indirection to the http handler.
@@ -148,6 +179,262 @@ func (s *Server) GetInterfaces(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetBfdSessions lists the BFD sessions of the interfaces.
+func (s *Server) GetBfdSessions(w http.ResponseWriter, r *http.Request) {
+	if s.Statistics == nil {
+		ErrorResponse(w, Problem{
+			Status: http.StatusNotImplemented,
+			Title:  "BFD session state not supported",
+			Type:   api.StringRef(api.NotImplemented),
+		})
+		return
+	}
+	sessions, err := s.Statistics.ListBFDSessions()
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "error getting BFD sessions",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	rep := BFDSessionsResponse{
+		Sessions: make([]BFDSession, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		st := session.Status
+		entry := BFDSession{
+			InterfaceId:              int(session.IfID), // nolint - name from published API.
+			Sibling:                  session.Sibling,
+			LocalState:               bfdState(st.LocalState),
+			RemoteState:              bfdState(st.RemoteState),
+			LocalDiscriminator:       int64(st.LocalDiscriminator),
+			RemoteDiscriminator:      int64(st.RemoteDiscriminator),
+			DesiredMinimumTxInterval: st.DesiredMinTxInterval.String(),
+			RequiredMinimumReceive:   st.RequiredMinRxInterval.String(),
+			DetectionMultiplier:      int(st.DetectMult),
+			RemoteMinimumReceive:     st.RemoteMinRxInterval.String(),
+			TxInterval:               st.TxInterval.String(),
+			DetectionTime:            st.DetectionTime.String(),
+			StateChanges:             int64(st.StateChanges),
+			PacketsSent:              int64(st.PacketsSent),
+			PacketsReceived:          int64(st.PacketsReceived),
+		}
+		if !st.LastReceived.IsZero() {
+			t := st.LastReceived.UTC()
+			entry.LastReceived = &t
+		}
+		if !st.LastStateChange.IsZero() {
+			t := st.LastStateChange.UTC()
+			entry.LastStateChange = &t
+		}
+		rep.Sessions = append(rep.Sessions, entry)
+	}
+	writeJSON(w, rep)
+}
+
+// GetStatistics lists the packet counters of the interfaces.
+func (s *Server) GetStatistics(w http.ResponseWriter, r *http.Request) {
+	if s.Statistics == nil {
+		ErrorResponse(w, Problem{
+			Status: http.StatusNotImplemented,
+			Title:  "statistics not supported",
+			Type:   api.StringRef(api.NotImplemented),
+		})
+		return
+	}
+	stats, err := s.Statistics.ListInterfaceStatistics()
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "error getting statistics",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	rep := StatisticsResponse{
+		Interfaces: make([]InterfaceStatistics, 0, len(stats)),
+	}
+	for _, st := range stats {
+		rep.Interfaces = append(rep.Interfaces, InterfaceStatistics{
+			InterfaceId:      int(st.IfID), // nolint - name from published API.
+			InputPackets:     int64(st.InputPackets),
+			InputBytes:       int64(st.InputBytes),
+			OutputPackets:    int64(st.OutputPackets),
+			OutputBytes:      int64(st.OutputBytes),
+			ProcessedPackets: int64(st.ProcessedPackets),
+			DroppedPackets:   counters(st.DroppedPackets),
+			RejectedPackets:  counters(st.RejectedPackets),
+		})
+	}
+	writeJSON(w, rep)
+}
+
+func bfdState(s layers.BFDState) BFDState {
+	switch s {
+	case layers.BFDStateDown:
+		return Down
+	case layers.BFDStateInit:
+		return Init
+	case layers.BFDStateUp:
+		return Up
+	default:
+		return AdminDown
+	}
+}
+
+func counters(c map[string]uint64) map[string]int64 {
+	r := make(map[string]int64, len(c))
+	for k, v := range c {
+		r[k] = int64(v)
+	}
+	return r
+}
+
+// writeJSON writes the indented JSON response.
+func writeJSON(w http.ResponseWriter, rep any) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(buf.Bytes())
+}
+
+// GetCapture captures the processed packets and streams them as pcapng.
+func (s *Server) GetCapture(w http.ResponseWriter, r *http.Request, params GetCaptureParams) {
+	if s.Capturer == nil {
+		ErrorResponse(w, Problem{
+			Status: http.StatusNotImplemented,
+			Title:  "packet capture not supported",
+			Type:   api.StringRef(api.NotImplemented),
+		})
+		return
+	}
+	if s.Authorize == nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef("no shared secret for packet captures configured"),
+			Status: http.StatusForbidden,
+			Title:  "packet capture disabled",
+			Type:   api.StringRef(api.Forbidden),
+		})
+		return
+	}
+	s.Authorize(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.capture(w, r, params)
+	})).ServeHTTP(w, r)
+}
+
+func (s *Server) capture(w http.ResponseWriter, r *http.Request, params GetCaptureParams) {
+	cfg, err := captureConfig(params)
+	if err == nil {
+		cfg.InitDefaults()
+		err = cfg.Validate()
+	}
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "invalid capture parameters",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-pcapng")
+	w.Header().Set("Content-Disposition", `attachment; filename="router.pcapng"`)
+	stats, err := s.Capturer.Capture(r.Context(), cfg, flushWriter{w: w})
+	if errors.Is(err, capture.ErrActive) {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusConflict,
+			Title:  "another capture is active",
+			Type:   api.StringRef(api.Conflict),
+		})
+		return
+	}
+	if err != nil {
+		// The stream has already started, the error cannot be reported to the
+		// client anymore.
+		log.Info("Packet capture failed", "err", err)
+		return
+	}
+	log.Info("Packet capture finished",
+		"packets", stats.Packets, "bytes", stats.Bytes, "lost", stats.Lost)
+}
+
+func captureConfig(params GetCaptureParams) (capture.Config, error) {
+	var cfg capture.Config
+	if params.Interfaces != nil {
+		for _, intf := range *params.Interfaces {
+			if intf < 0 || intf > 65535 {
+				return capture.Config{}, serrors.New("interface out of range", "interface", intf)
+			}
+			cfg.Filter.Interfaces = append(cfg.Filter.Interfaces, uint16(intf))
+		}
+	}
+	if params.IsdAs != nil {
+		ia, err := addr.ParseIA(*params.IsdAs)
+		if err != nil {
+			return capture.Config{}, err
+		}
+		cfg.Filter.IA = ia
+	}
+	if params.Dispositions != nil {
+		for _, d := range *params.Dispositions {
+			disp, err := capture.ParseDisposition(string(d))
+			if err != nil {
+				return capture.Config{}, err
+			}
+			cfg.Filter.Dispositions = append(cfg.Filter.Dispositions, disp)
+		}
+	}
+	if params.Duration != nil {
+		d, err := time.ParseDuration(*params.Duration)
+		if err != nil {
+			return capture.Config{}, err
+		}
+		cfg.Duration = d
+	}
+	if params.MaxPackets != nil {
+		cfg.MaxPackets = *params.MaxPackets
+	}
+	if params.MaxBytes != nil {
+		cfg.MaxBytes = *params.MaxBytes
+	}
+	if params.Rate != nil {
+		cfg.Rate = *params.Rate
+	}
+	if params.SnapLen != nil {
+		cfg.SnapLen = *params.SnapLen
+	}
+	return cfg, nil
+}
+
+// flushWriter flushes every write, such that captured packets are streamed to
+// the client as they arrive.
+type flushWriter struct {
+	w http.ResponseWriter
+}
+
+func (fw flushWriter) Write(b []byte) (int, error) {
+	n, err := fw.w.Write(b)
+	if f, ok := fw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return n, err
+}
+
 // Error creates an detailed error response.
 func ErrorResponse(w http.ResponseWriter, p Problem) {
 	w.Header().Set("Content-Type", "application/problem+json")
@@ -15,6 +15,8 @@
 package mgmtapi
 
 import (
+	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/netip"
@@ -23,6 +25,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/gopacket/layers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -30,7 +33,10 @@ import (
 	"github.com/scionproto/scion/pkg/private/ptr"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/private/xtest"
+	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
 	"github.com/scionproto/scion/private/topology"
+	"github.com/scionproto/scion/router/bfd"
+	"github.com/scionproto/scion/router/capture"
 	"github.com/scionproto/scion/router/control"
 	"github.com/scionproto/scion/router/control/mock_api"
 )
@@ -273,3 +279,249 @@ func createSiblingIntfs(t *testing.T) []control.SiblingInterface {
 		},
 	}
 }
+
+type captureFunc func(context.Context, capture.Config, io.Writer) (capture.Stats, error)
+
+func (f captureFunc) Capture(
+	ctx context.Context,
+	cfg capture.Config,
+	w io.Writer,
+) (capture.Stats, error) {
+
+	return f(ctx, cfg, w)
+}
+
+func TestCapture(t *testing.T) {
+	secret := func() ([]byte, error) {
+		return []byte("0123456789abcdef0123456789abcdef"), nil
+	}
+	verifier := &jwtauth.HTTPVerifier{Generator: secret}
+	token, err := (&jwtauth.JWTTokenSource{Subject: "operator", Generator: secret}).Token()
+	require.NoError(t, err)
+
+	serve := func(s *Server, url string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("GET", url, nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token.String())
+		if s.Authorize == nil {
+			s.Authorize = verifier.AddAuthorization
+		}
+		rr := httptest.NewRecorder()
+		Handler(s).ServeHTTP(rr, req)
+		return rr
+	}
+	unexpected := captureFunc(
+		func(context.Context, capture.Config, io.Writer) (capture.Stats, error) {
+			t.Fatal("unexpected capture")
+			return capture.Stats{}, nil
+		},
+	)
+
+	t.Run("streams capture", func(t *testing.T) {
+		var got capture.Config
+		s := &Server{Capturer: captureFunc(
+			func(_ context.Context, cfg capture.Config, w io.Writer) (capture.Stats, error) {
+				got = cfg
+				_, err := w.Write([]byte("pcapng"))
+				return capture.Stats{Packets: 1}, err
+			},
+		)}
+		rr := serve(s, "/capture?interfaces=1&interfaces=2&isd_as=1-ff00:0:110"+
+			"&dispositions=dropped&duration=5s&rate=10")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/x-pcapng", rr.Header().Get("Content-Type"))
+		assert.Equal(t, "pcapng", rr.Body.String())
+
+		expected := capture.Config{
+			Filter: capture.Filter{
+				Interfaces:   []uint16{1, 2},
+				IA:           addr.MustParseIA("1-ff00:0:110"),
+				Dispositions: []capture.Disposition{capture.Dropped},
+			},
+			Duration: 5 * time.Second,
+			Rate:     10,
+		}
+		expected.InitDefaults()
+		assert.Equal(t, expected, got)
+	})
+	t.Run("invalid parameters", func(t *testing.T) {
+		s := &Server{Capturer: unexpected}
+		assert.Equal(t, http.StatusBadRequest, serve(s, "/capture?duration=1h").Code)
+		assert.Equal(t, http.StatusBadRequest, serve(s, "/capture?dispositions=lost").Code)
+	})
+	t.Run("unauthorized", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/capture", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		s := &Server{Capturer: unexpected, Authorize: verifier.AddAuthorization}
+		Handler(s).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		s := &Server{Capturer: unexpected}
+		req, err := http.NewRequest("GET", "/capture", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		Handler(s).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+	t.Run("capture active", func(t *testing.T) {
+		s := &Server{Capturer: captureFunc(
+			func(context.Context, capture.Config, io.Writer) (capture.Stats, error) {
+				return capture.Stats{}, capture.ErrActive
+			},
+		)}
+		assert.Equal(t, http.StatusConflict, serve(s, "/capture").Code)
+	})
+	t.Run("not supported", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotImplemented, serve(&Server{}, "/capture").Code)
+	})
+}
+
+type fakeStatistics struct {
+	sessions []control.BFDSession
+	stats    []control.InterfaceStatistics
+	err      error
+}
+
+func (f fakeStatistics) ListBFDSessions() ([]control.BFDSession, error) {
+	return f.sessions, f.err
+}
+
+func (f fakeStatistics) ListInterfaceStatistics() ([]control.InterfaceStatistics, error) {
+	return f.stats, f.err
+}
+
+func TestStatistics(t *testing.T) {
+	received := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	stats := fakeStatistics{
+		sessions: []control.BFDSession{
+			{
+				IfID: 1,
+				Status: bfd.Status{
+					LocalState:            layers.BFDStateUp,
+					RemoteState:           layers.BFDStateUp,
+					LocalDiscriminator:    1234,
+					RemoteDiscriminator:   4321,
+					DesiredMinTxInterval:  200 * time.Millisecond,
+					RequiredMinRxInterval: 200 * time.Millisecond,
+					DetectMult:            3,
+					RemoteMinRxInterval:   200 * time.Millisecond,
+					TxInterval:            200 * time.Millisecond,
+					DetectionTime:         600 * time.Millisecond,
+					LastReceived:          received,
+					LastStateChange:       received.Add(-time.Minute),
+					StateChanges:          2,
+					PacketsSent:           300,
+					PacketsReceived:       299,
+				},
+			},
+			{
+				IfID:    5,
+				Sibling: true,
+				Status: bfd.Status{
+					LocalState:            layers.BFDStateDown,
+					RemoteState:           layers.BFDStateDown,
+					LocalDiscriminator:    5678,
+					DesiredMinTxInterval:  200 * time.Millisecond,
+					RequiredMinRxInterval: 200 * time.Millisecond,
+					DetectMult:            3,
+					TxInterval:            time.Second,
+					PacketsSent:           10,
+				},
+			},
+		},
+		stats: []control.InterfaceStatistics{
+			{
+				IfID:             0,
+				InputPackets:     100,
+				InputBytes:       10000,
+				OutputPackets:    90,
+				OutputBytes:      9000,
+				ProcessedPackets: 100,
+				DroppedPackets: map[string]uint64{
+					"invalid":        4,
+					"busy_processor": 0,
+					"busy_forwarder": 0,
+					"busy_slow_path": 0,
+				},
+				RejectedPackets: map[string]uint64{"invalid_mac": 3, "no_route": 1},
+			},
+			{
+				IfID:             1,
+				InputPackets:     50,
+				InputBytes:       5000,
+				OutputPackets:    60,
+				OutputBytes:      6000,
+				ProcessedPackets: 50,
+				DroppedPackets: map[string]uint64{
+					"invalid":        0,
+					"busy_processor": 0,
+					"busy_forwarder": 0,
+					"busy_slow_path": 0,
+				},
+				RejectedPackets: map[string]uint64{},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		Statistics   Statistics
+		RequestURL   string
+		ResponseFile string
+		Status       int
+	}{
+		"bfd sessions": {
+			Statistics:   stats,
+			RequestURL:   "/bfd",
+			ResponseFile: "testdata/bfd.json",
+			Status:       http.StatusOK,
+		},
+		"bfd sessions error": {
+			Statistics:   fakeStatistics{err: serrors.New("internal")},
+			RequestURL:   "/bfd",
+			ResponseFile: "testdata/bfd-error.json",
+			Status:       http.StatusInternalServerError,
+		},
+		"bfd sessions not supported": {
+			RequestURL:   "/bfd",
+			ResponseFile: "testdata/bfd-not-supported.json",
+			Status:       http.StatusNotImplemented,
+		},
+		"statistics": {
+			Statistics:   stats,
+			RequestURL:   "/statistics",
+			ResponseFile: "testdata/statistics.json",
+			Status:       http.StatusOK,
+		},
+		"statistics error": {
+			Statistics:   fakeStatistics{err: serrors.New("internal")},
+			RequestURL:   "/statistics",
+			ResponseFile: "testdata/statistics-error.json",
+			Status:       http.StatusInternalServerError,
+		},
+		"statistics not supported": {
+			RequestURL:   "/statistics",
+			ResponseFile: "testdata/statistics-not-supported.json",
+			Status:       http.StatusNotImplemented,
+		},
+	}
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req, err := http.NewRequest("GET", tc.RequestURL, nil)
+			require.NoError(t, err)
+			rr := httptest.NewRecorder()
+			Handler(&Server{Statistics: tc.Statistics}).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.Status, rr.Result().StatusCode)
+			if *update {
+				require.NoError(t, os.WriteFile(tc.ResponseFile, rr.Body.Bytes(), 0666))
+			}
+			golden, err := os.ReadFile(tc.ResponseFile)
+			require.NoError(t, err)
+			assert.Equal(t, string(golden), rr.Body.String())
+		})
+	}
+}
@@ -18,45 +18,80 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xZ33PbNhL+VzBoH5op9cNOem305thOq5kk1lj29KH1eSBiRaIGARYAZet8+t9vFiAp",
-	"/rLs5K7tXJ9skQD2w7ffLnbBRxrrLNcKlLN09kgN2FwrC/7HO8Yv4fcCrMNfsVYOlP+X5bkUMXNCq8lv",
-	"Vit8ZuMUMob/fW1gTWf0q8l+6Ul4aydLxxRnhp8bow3d7XYR5WBjI3JcjM7QJjGlUXxbTvRw3p/hn9zo",
-	"HIwTASMHKwzw20wokRXZrXu4FcqB2TBZvm4sfpUCKQeSahRZgbsHUMQZpmwmrBVaEb0m796fEdyz0ZLk",
-	"LL4DZ4lLmSMuBYIQmNOGBPt2TK5SYcmGyQKIsITxDWK0wInTfkYOYCKS6nvYgPFPWOwKJvdAChwtLLE5",
-	"xGItgJPVljh2J1Tix2fswSPX69IqH5WbGbmHUb0MU9wPD1j02v8wkGkHntnWRAMxiA3sQfhZYxpReGBZ",
-	"LoHO6PF0mlkaUbfN8ad1RqiEes85iJHa26yQTuRSgBkmXRXZCgyCaTGZFdaRFfrElkxxiCUzQByyaSE4",
-	"g1nC9b1CjoHURveY1zoQih6r5ghLYibjQjIXiCwhbis2W/QoSLQTfmhLBnuRbAOkPj2va2JwcAIGmQHF",
-	"VhJ4n4y54mXgoOn7FFwKxgMXlpSzvAdjrdYiKQxwolWw7cGsWdy270wBNYSV1hKYQgiVq+vIKF39mVFR",
-	"zuKHwgFdtbUOMmJTXUhObJHn2rjng6KUJcYGPhKBHWjJfe3TgYq35BsxhnHUxjoKWGrgr2rkTwJGJHEM",
-	"uUO2KyRSx0yW23iR/BsU09kvB/PQE5Gyl8kBb91E1AnngbwTXJiwDJPkvTb3zHCU81kdEpVqaoUx1ZZN",
-	"uQm9+g1ihzKZV2/7qXW15s+lc0zJu4jWJm7FgOaXp/OLT3sYRHBQDhOceT6Q/CzF5K1o4uzrl3FuwFrc",
-	"cjWFdO1WqVAXrmOaHr09Hh/944fx8fh49vpoOp0OpTsFIklX2jxHSk3pp2qC14r0TrGpyJ9b4INQd5fN",
-	"8f4c9OpxxbMnLA78eHXtJznm4CXWln5gV9Mttzb230QTeZlUpjr7HPRfQ9HBQ/O9h1TDQwfV+qnhi7Zq",
-	"SyX0ZXJ9tpjMF6RQHIxk26Zk0GgHyxfoQ1h+GyqVg+qw/MT2qQ5zoxp+g6VqrxjqXU2D4rkWylW7kELd",
-	"HY5ze1mWeH3q6mXDLweZfbHW0UZplBnDtl5+YiWFSm6/YN1lmHpg+d2eoGpHRArrkKWQzPEYLSGQBoQh",
-	"crxPZo9Nj4/W6+l0Np0dHaGzc+ZQyHRG//nrr/zb0Te/sNF6Onp783gUvdnNXj0e79qPXv0bx31N9yjn",
-	"y7PRyZLM6+w3pKFe6CMoVWSokdOLy3Ma0dOf5h/OaEQXJ5fnn67wn/PzS9TLHnw1ZHD5ZZUUqnWvFzSi",
-	"Zxc/f2ov4h/3V9DJB9iA7KtHVo/bYfdBJ4n3iX8d1VY5rIrEZ4i1xse+IWgBKN8cPnfDsjcDTl0YvZKQ",
-	"DbUMjokBpCckLTKGJQ/jvjSAh1wyFc7SsiiPQ70gLNFxXBgDan+w5MFgXWSkIPN1IXEGCrIsa6pRqM4E",
-	"S2/GNyLkvlTf4+Dc6BiAj8nPRjgHeIKTc5VIYVM/q8aHdS+oRCgAYyNS2IJJuSVKO2ILgcUsjlCYVSFO",
-	"lfAVjmN3kGrJwVi/Go728SL+Bbyd9U61UmVhgaU5c2zFLBAnMqxKCzeYBJV1TA0d0yfk+nJODKwhsBZo",
-	"qqLBenJqlp9kNyIwTsZYjzPuix9G1oYlGajGYoZoQ2yxGuXMpXUDVrlnm8OYfGRb7DyKshhtOMhoXaZT",
-	"YetJIpxMVhcmBhJr3jkgJuXASVxzNvKS/srpO1Aj1PIIHTfy7I0Ce2ttMubojBZGjGpmhmjF47Www7XP",
-	"T1dXCxIGeGQkAYXdadlAYrdqRCIUsWCw9wzt0iEJt/b23fR1RMtinM6+e/s2omWRSmdH0+lQ1VamvL4C",
-	"bKoNijPLmNn24sY75q8W/RKMj8drxTZMSLQ55JDwAHe4ZoVEH7KVLtxsJZm6o9FLtF8o8XsBctsNgiYf",
-	"RCscENTnb2AeXIO3jeDAycliPiYXea4bnVUVSaxslcnl+9PR9z9Mv4+I8NlJgfC9p4FYZxkoHuausMOu",
-	"gHrCka9QYzhNWMiRo9odXMcFBl+wo7QhidQr75Kwv7o7b7n5ZcHzGSHSORbKeKmkOHQ+1IXycENcdp+t",
-	"64BCIXeKrLYOrN9YqMfK9rLsdw3kBiyWM9W+nY619Ak0LPHN4uz6VbvwlGwLxnMtbC3qxg0GszWkc/Sb",
-	"AkdytpWacTIi8wX5CRgHQ0bk+qz60WL56M33x0Ox2qu0ni4L/5Lubl61c516PdR4f3gzV9LzN2vlBoh/",
-	"sr/rdHQBSLOJK0vs+cESu8tjX2X/fff0v+6Z2pfVPcRQPW4L1o8mGVjLkucTVV33dqzvdmVp3D9FF/M6",
-	"p4atXdb9ctUQ+QekOspOFnMa0Q0YG1aYjqfjI9ygzkGxXNAZfT2ejo9Dn5P6zU3CVRL+m4C/9A9X3kKr",
-	"Oacz+iO40zAian80OJ5OO18L8Mya5JKJzneCLjG9bwHLIo7BWqyhLyrjCPtNMDGkkxrKpPHxwn9HCDUH",
-	"ndGFEVVqvrr4+KFzZ7YWMlyUscSif/Bw1Ire4BqTyiFPMTIPHcv/Fx/vmBUxwa3hSYsc5CwB4suZuuww",
-	"WvryEeXk+xNrD7DUbPdLrjpNobCuIeD9jFAZMQO9C/Dmxd0A8Y3c8wz9X/7xauAOZcBLfm963dvauOGq",
-	"J+CUddC3nweranQHsMzVhklRf1Ebd1z/pBsarm1c3HnvSp1M6lb/qUCobwn+QG/UNv60SPkRsGtoX2f0",
-	"IiCieTFAyrJDil//nebbP4WP6hKmaT+cQM4UsPtbeWn5Ei/5Kb4dxuePtDCSzmjqXD6bTB5Tbd1u9phr",
-	"43YTlovJ5ggPUGYE9jyeIxzS7v98Qeofowa06bx+PX3z5hhZuKnh9CqHDZitSxG4L7pCR9bPIxFVLKtK",
-	"6ep6tLvYqd8qnv0EHkJruNqWi5WZvLlUyczuZvefAAAA//9UPMQGEyAAAA==",
+	"H4sIAAAAAAAC/+RbW3PbOLL+KyjuPkxqKVm242SiNydOdrSViyp2ah5mfFQQ2RIxJgEOANrW5Pi/n2oA",
+	"BMGLLvZOMufsyUssikA3+obur1tfo0QUpeDAtYqmXyMJqhRcgfnwmqaf4fcKlMZPieAauPmTlmXOEqqZ",
+	"4Ee/KcHxmUoyKCj+9XcJq2ga/e2o2frIfquOLjXlKZXpWymFjB4eHuIoBZVIVuJm0RRpEumI4rduoWHn",
+	"3QX+V0pRgtTM8piCYhLSRcE4K6pioe8XjGuQtzR3XwebX2VA3IukfossQd8BcKIl5apgSjHBiViR1+8u",
+	"CJ5ZipyUNLkBrYjOqCY6A4IsUC0ksfTVmFxlTJFbmldAmCI0vUUeFaREC7OiBJAxycQd3II0T2iiK5o3",
+	"jFT4NlNElZCwFYOULDdE0xvG1+b9gt4bzsXKUU1H7jAjfT/y21CemtctL2JlPkgohAYj2dZCCQmwW2iY",
+	"MKvGURzBPS3KHKJpdDKZFCqKI70p8aPSkvF1ZDSnIUHRLooq16zMGchhofOqWIJEZlqSLCqlyRJ1opyk",
+	"UkhyKoFolKYCqwyqSCruOMoYiCfa8LwSVqCosXoNUySheVLlVFtBOhY3tTRb4uGwFpqZV1tm0BjJxrLU",
+	"F8+pFwy+vAaJkgFOlzmkfWHMeOocB0nfZaAzkIZxpohbZTSYCL5i60pCSgS3tA0zK5q06WtZgWdhKUQO",
+	"lCMLtaq9ZzhVP9Ir3Kp0lzugqjZKQ0FUJqo8JaoqSyH1fqdwZom+gY+YlQ60zH2FJwGebMgPbAzjuM3r",
+	"yPLiGX/mOd/KMHKSJFBqlHbNSS4SmrtjHGT+gYij6S8749AWT2nMZIe2ruNIM20Yec1SJu02NCfvhLyj",
+	"MkVzvvAuUVuNtzDK22bjDiGWv0Gi0Uxev7u4tC7zJ8TWwGjdWq+sQbf60+NMi4FaKJoVQJqV+723oYdL",
+	"hymZTe+YzkSlnZOgKoaMjq40SHKXsSQzphaEKBfuUhffZsY4J4qwFeHCbUDuqGrcUDGeWP9oH1ASuC9R",
+	"4m2ZvtgmU28VCzYQpi7fzD59bCyHsBS4xjvpAOnlVOnafNMdwjNeR5UekFnryEhxJWRBdTSNUqphhMuH",
+	"zmQoK001LJKM8vUu1bnAbxgwS4hd4r+w8cCq6hEs4LJFypBmwTimCH0mLsKvtxL0Uj4+OX1+9uJlwALj",
+	"+sXzaFD4hr450L5UDD3fvPcQR85Ud6jto7/Bh0w81JXn+/lkMjmI6Zq6cunlYyjjmqdRtXfPk3Rll3pl",
+	"1X5LmP3aPScZVYQLzHCAk6UQWmlJy7Ijppcvzp6fnhw/hueDrvT6SvEhuJfrdU5j7r6YGNfD69sloDvc",
+	"dFDt2+O44/7R1vm4VCa4A/aK4EDGFVvm+OeB6ZzOHAUbOxURd9wKkxK3FZGi0iBtSlvbi86Ak0JwpoVU",
+	"ZpdEcA6JZrdMb+pMpbNDeIIVzdVgMhgGRbXLxcJQqPaHppODrHZ/3lBJCVz3s0/07iG3j8kSVkIC+Y1p",
+	"DdKkdFiTQlo7Y07l2qiCcndb2nzEk7jLWA69+1jctQ94YPbXuk0be2nH444DDF8WW+JSvCfB3OoiW3PP",
+	"LaGkra1eLtQ1pU7wHrhJwgQ2qM+cmaMhPyJHVZ8dPtFPVt3G5m+moVCHRBi7JnrwRKmUdNNTr987OEzN",
+	"CcmZ0nVRGZxQbT1KHf6AVwXuTtOC8QWaHsrb/sc4Q2lWZUjSrDRZfUjIlMewYtxYN/n87g05+/HHyXgo",
+	"kL2hpa4kXDBVCsW0y/prRla2pDAFicrF3aKkOoviKBFcVYV5nEqBF1jI1adKJ8JmVKUUCTKFSnWuOsjG",
+	"rFZ3X43LVXqA4r5tBmtWcZovWMhnP2zRNJWgTJisl5Au3fqO7Qfr6PjVyfj4xY/jk/HJ9PR4MpkMiYoD",
+	"W2dLIfcJxYv0Y73A2HBuLieVsXLfBu8Zv/kcvm/ANxM2dLUX1sMXP1x98XfNIdTc/b47lPrzh9zExkxq",
+	"Up1zDuov9CKjoVmjIR5oaMhn+6LtWa2zhL6ZfLmYH83mpOIpyJxuQpNBoh1enmAfTKULujfWzVR6rvqi",
+	"tmtjz34gpfqs6MtdmwaeloJx7fMDxm/GOyWHqmZKs0QNoAw2pCzczeHEySzMMW+9ekCusS2xcVR83bDc",
+	"EAlUCW4S3kRUXEPqkcTmrMtNYB0Lz+uNVgstNM1JAVqyxCZydkdFqMQtbmnO0pgsK7VZuMgopPtch1pp",
+	"wD7zyEfclhV8jdrvR9NJHLW3bB75Lcwjx0E0PZ48DCiH8bLSi+VG784IzQsN/iB4W0Ct0ni7WiyxQMXb",
+	"yHUryqcTfOLtYBTpw3nzChZzswsy2X99iEo/QrImxX3iIR2lR4j136HmDA7SxxDcqkeLxd6BBOI3PpAR",
+	"CWjD3zxkPOYINUsYTBrPr2PMmLzB+oVKU8R1Al5co/tJXqVg674WXm0IUK7w/9RgjpgyX775MCeAbTTC",
+	"uNJAU2NTgAHbJ3L9sFTQHEUCaVzzuShoEtfo4SITZUyWNG3uzuZFd03EhIuFCYhxIwuTv5pgJrAG7sSw",
+	"YPdo+jKOAtLR9LQfnXbnBO1g0o5kPafo+OOQGce9O2jAxoK7cW4eEeUvtYOQdn8Z7ihi/A6HlzF+234V",
+	"4wvRxRP2vbRLd2z/sKMYspABmoNjgQQsDAnHJCjTr43RRMej1WoymU6mx8cTU1hqjMnRNPqvX39N/zH6",
+	"4Rc6Wk1Gr66/HsfPH6bPvp48tB89+2987+9Rw+Xs8mJ0fklmPtgPJVS9PDgokN58+vw2iqM3P83eX0Rx",
+	"ND///PbjFf7x9u1nNJCG+fqVwe17FeCXeRRHF59+/tje5Mt8cAexfg+3kPetJ68ftyPae7FeG52Yr2NP",
+	"NYVltTbusxL42LTkWwy4b3ZjH3bb6wGlzqVY5lAMNZY0ZQOcnpOsKijHgJWa5hzclznlRhd1WzyxOBhT",
+	"RCQWN2qqrNIS9G2+DPJyVeW4Ihe+sVi/hda5RjSQprfMFgKZuMOXTYjA6PmzZFoDejZ5y9c5U5lZ5fnD",
+	"fBH4mnEAqWJSqYrm+caAvqpidUbJ8eKAJOPM4Gia3kAm8hSkMrvh28Zf2B9dKPWNw/9wA0FSqumSKtvB",
+	"SImo9HBnR2nKh2rWc/Ll84xIWIGVmhVT7Q325vFS3irdmMB4PTZoZpraUn8l6boAHmwmiZBEVcsRZqN+",
+	"BKJWz6aEMflAN2QJduqhrSAphKstmPKLmL18lahkAiQRaadaOnIvHiVeZiNj0n/T4gb4CG15hIozvZt0",
+	"ZKXn04NKspGXzJBYMdpXahgI+Onqak7sC4YzsgYOkgYIupBszThRIHH6w5YZu0y4dbazyWkcuXZ4ND17",
+	"9SqOHGyHuf1kEHK1Ia9vASoTEo2zKDAbEasBxfzVRn8J0vjjF05vKcuR5pBC7AM84YpWOeqQLkWlp8uc",
+	"8psoPsT2K85+ryDfdJ0glAcRPN/U1mdmoO51ILdblkJKzuezMflUliKYbag9ibphFUTmRi9/nLyMCTPR",
+	"iQMz7QIJiSgK4KlduwSSQs2oETjKyxbcWhBqY+TIqyMVSYXOZ+lwIck6F0ujEns+X9W21HyY8zzCRbqY",
+	"qfWX2hSH7gePGg2PpND7/uRAxVF23NVOeDBbyrkszU2cSCglKODaq1OLROQmgNotfphffHnWRmFyunHl",
+	"OFPeqIMZIqo8S29Rbxw0KekmFzQlIzKbk5+AYkE/Il8u6g/tPu7zlydDvtrLtLanhX8J1Dlz73TBK5vj",
+	"fXNk04nnPwzXHBD8VrCzA29aRkJE06XYs50pdleOfSv796HEPxtAbI+L9jiG+nHbYM3bpACl6Hp/oPJ5",
+	"7xB1V+B9m4qt2X9vByogs68HVQ5Vpy3cYqg1hQYOSSWZ3lwin24CGKgEeV7pDD8tzad39X3wr5+vIjeg",
+	"a9rN5ttm50zr0k74mjKin4vMZ/5msgby2UPw9fnMA1InBOfzWRRHtyDtuFo0GU/GxwYHK4HTkkXT6HQ8",
+	"GZ/YajEzJzhyDaU1DIyWvGfKjvMal4rNn2aYSvkpWosMSy/EsMPnhlhtJ7Mr4sAb/ZxYq2snwSZBTOka",
+	"qGmW2y5fu9VPVEZlu2UdMrBtYoDqIEbbuWUm+CyNptE/Qb9epXVv1USaYPz7ZDL50+a+h1q4A9PfRiFu",
+	"0qfpoz7E0dlOXlz28o/H8VSXpwN8XNo83YQGR//4e9IPDcXYpskXha4nazFlxlUujw9NORQduhJdq1YA",
+	"ia5x4VFiO8FbXcN1ittwpIfO2m0RZ4ZUuwHHFcu1S6WUlkALs4sv4cqElhakLKgek7e3IDekHuNFz+NC",
+	"m4zLQJ24QjQtZpfPuTazrUWt41qUk9BwhtDheta7Wpmi8T9MsEvl8jvGidpwnYHCkoTM5keYx2Umj3Ou",
+	"nlAp7cG7DT1QMWErcsNxgORXjuSchPGyUzgaZA+f1sO5kNNSIQwbzjr35+TxFG4n966FM/G4SQZpazly",
+	"HhMXi5KcAdckyW1REsQGBKTnbn+4TwDS+u6QaGo5K5j28akmPiafsBASvDlZQjlWKxTDDRCqCTXRc/wr",
+	"99ZTT/jTSmdCsj9qrVLyr5+viL0yiCnQx2QWtsrd8JyNeSlRkEjQ5r5I6r2bQa+4eYhsp0yZuerBgOdY",
+	"M3eEpAVokOggW+x/AIlv2gHSt1ME98YZXgGPaSX9chyfXONdGU2j3yuQmyiOOC3qfN3ndU1s8cmGhwZe",
+	"nJ2dngXgwDA00Mkz9p3c6cu4N9qJQ2Dsb180c+icxVbtkes6IaG8bmuQO5anCZWpIj9MnpGl0Jm//GeX",
+	"FyZWnF9uK126WPCgkOpm9mHB16exBx0/0G/azM6otgLD4ZghDsOVw4rcxfDA6M4ByvzgIkMa/CZAN8Gp",
+	"/kmNQVAIU+R0otoR6XjS+TnE8URt0UBNo3W2Xu69v2XYnZcPA2mPYawuuxzjv/EWJgt6H3R5Gj4bQK3v",
+	"M32mL9kf3iBcPPagxCPZ/8Be9/j/wF7v4r/uZD2V+w+926YO8s1ND5IoSARPhyQ+IPBt/Eo7ofNUVrvd",
+	"cpMWhxe5DcxUQnBRdRg+Oz5pM/xqB7+K03KRAz+c5+tHpc33I5v+tNNEj7EtGaeGna7f9FNUk1kNKc8k",
+	"rM+/b8I8sw3d+qebjoXT78mC68puyQQMP6++Jz/n3HTCvf8z5TKlv6KgOXf5l7sEJFHtCico/00yFBb+",
+	"v1w/XIelRn1DBql4Y3xByeEOXhccJlsL6o1+Zmbf2OtOiMAflTllHdHsd5kqQWaxI/ipJh74ypBoPStH",
+	"wY+h25XXXLIaaL769OF95zd4K5bDOBSKKArBnUxqYGSbRGa2//p/Sx6vqWIJYdzGNJRBSddATHPGN1Fw",
+	"ll85WMfZ0A4phRDbbiCngzSq4Hro/KAWdqEiLST1m4EiAxMhOzCR7tH+d4T5YRCiy+sOICIX6yM/uLDN",
+	"EfzMwzfUhqfx3Tzln4A90PZwRs8D4qisBoRy2RGK2f+1SDffRR71SElI30LVWlbw8B+lpctDtISWrFpD",
+	"zrvjlEtezfDvRvdh5hAJrn881sSs2JXVNWwzgDGYshVBhthD2bw3DE1NW99NLvYmo20i7TmrsWfBAWEj",
+	"2+umisylKEBnUCk3C61iM18AKalKIjDLoLn/ySBCVMryRA2YokA36Jg9Hj7VVGo1GJuDrsk3tLOB3s/2",
+	"nHN3r+X/H3zdCM/DiPuB6wP6VtuuEdzQHNkieZXMXf9penT0NRNKP0y/IvWHI1qyo9tj7CJRybA0MIaD",
+	"r7RHSUxv2zzGACxk5+vTyfPnJ3jya89RrwmJsLY2iJnp39rhjv4l3kf5oof4sM3KsOJhfB1sZp8NbfXG",
+	"hCzspXknXm4cXy4jC7lyEe7h+uF/BgD9xM7kK0gAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
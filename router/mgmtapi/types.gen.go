@@ -3,6 +3,30 @@
 // Code generated by unknown module path version unknown version DO NOT EDIT.
 package mgmtapi
 
+import (
+	"time"
+)
+
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Defines values for BFDState.
+const (
+	AdminDown BFDState = "admin_down"
+	Down      BFDState = "down"
+	Init      BFDState = "init"
+	Up        BFDState = "up"
+)
+
+// Defines values for CaptureDisposition.
+const (
+	Consumed  CaptureDisposition = "consumed"
+	Dropped   CaptureDisposition = "dropped"
+	Forwarded CaptureDisposition = "forwarded"
+	SlowPath  CaptureDisposition = "slow_path"
+)
+
 // Defines values for LinkRelationship.
 const (
 	CHILD  LinkRelationship = "CHILD"
@@ -39,6 +63,67 @@ type BFD struct {
 	RequiredMinimumReceive string `json:"required_minimum_receive"`
 }
 
+// BFDSession defines model for BFDSession.
+type BFDSession struct {
+	// DesiredMinimumTxInterval The configured desired minimum transmission interval.
+	DesiredMinimumTxInterval string `json:"desired_minimum_tx_interval"`
+
+	// DetectionMultiplier The configured detection time multiplier.
+	DetectionMultiplier int `json:"detection_multiplier"`
+
+	// DetectionTime The time without receiving BFD control packets after which the session is declared down. It is 0s if no packet was received since the detection timer expired.
+	DetectionTime string `json:"detection_time"`
+
+	// InterfaceId SCION interface identifier.
+	InterfaceId int `json:"interface_id"`
+
+	// LastReceived The time the last BFD control packet was received.
+	LastReceived *time.Time `json:"last_received,omitempty"`
+
+	// LastStateChange The time of the last state change of the local session.
+	LastStateChange *time.Time `json:"last_state_change,omitempty"`
+
+	// LocalDiscriminator Discriminator of the local session.
+	LocalDiscriminator int64    `json:"local_discriminator"`
+	LocalState         BFDState `json:"local_state"`
+
+	// PacketsReceived Number of BFD control packets received.
+	PacketsReceived int64 `json:"packets_received"`
+
+	// PacketsSent Number of BFD control packets sent.
+	PacketsSent int64 `json:"packets_sent"`
+
+	// RemoteDiscriminator Discriminator of the remote session. It is 0 if the session has not been bootstrapped.
+	RemoteDiscriminator int64 `json:"remote_discriminator"`
+
+	// RemoteMinimumReceive The required minimum receive interval of the remote system, as reported by the last BFD control packet received.
+	RemoteMinimumReceive string   `json:"remote_minimum_receive"`
+	RemoteState          BFDState `json:"remote_state"`
+
+	// RequiredMinimumReceive The configured required minimum receive interval.
+	RequiredMinimumReceive string `json:"required_minimum_receive"`
+
+	// Sibling Indication of whether the interface is owned by a sibling router. The session then monitors the connectivity to the sibling router.
+	Sibling bool `json:"sibling"`
+
+	// StateChanges Number of state changes of the local session.
+	StateChanges int64 `json:"state_changes"`
+
+	// TxInterval The current interval between sent BFD control packets, before jitter is applied. It is larger than the desired interval while the session is down.
+	TxInterval string `json:"tx_interval"`
+}
+
+// BFDSessionsResponse defines model for BFDSessionsResponse.
+type BFDSessionsResponse struct {
+	Sessions []BFDSession `json:"sessions"`
+}
+
+// BFDState defines model for BFDState.
+type BFDState string
+
+// CaptureDisposition defines model for CaptureDisposition.
+type CaptureDisposition string
+
 // Interface defines model for Interface.
 type Interface struct {
 	Bfd BFD `json:"bfd"`
@@ -63,6 +148,33 @@ type InterfaceNeighbor struct {
 	IsdAs   IsdAs  `json:"isd_as"`
 }
 
+// InterfaceStatistics defines model for InterfaceStatistics.
+type InterfaceStatistics struct {
+	// DroppedPackets Number of dropped packets by reason, as counted for the interface by the router_dropped_pkts_total metric. The reasons are invalid, busy_processor, busy_forwarder and busy_slow_path.
+	DroppedPackets map[string]int64 `json:"dropped_packets"`
+
+	// InputBytes Number of bytes received on the interface.
+	InputBytes int64 `json:"input_bytes"`
+
+	// InputPackets Number of packets received on the interface.
+	InputPackets int64 `json:"input_packets"`
+
+	// InterfaceId SCION interface identifier. The internal interface has ID 0.
+	InterfaceId int `json:"interface_id"`
+
+	// OutputBytes Number of bytes sent on the interface.
+	OutputBytes int64 `json:"output_bytes"`
+
+	// OutputPackets Number of packets sent on the interface.
+	OutputPackets int64 `json:"output_packets"`
+
+	// ProcessedPackets Number of packets received on the interface that were processed.
+	ProcessedPackets int64 `json:"processed_packets"`
+
+	// RejectedPackets Number of packets received on the interface that were rejected as invalid, by reason. Contrary to dropped_packets, this includes the packets that were answered with an SCMP error instead of being forwarded. The reasons are malformed, invalid_mac, expired_hop, bad_interface, invalid_address, no_route, interface_down and other.
+	RejectedPackets map[string]int64 `json:"rejected_packets"`
+}
+
 // InterfacesResponse defines model for InterfacesResponse.
 type InterfacesResponse struct {
 	Interfaces        *[]Interface        `json:"interfaces,omitempty"`
@@ -134,8 +246,40 @@ type StandardError struct {
 	Error string `json:"error"`
 }
 
+// StatisticsResponse defines model for StatisticsResponse.
+type StatisticsResponse struct {
+	Interfaces []InterfaceStatistics `json:"interfaces"`
+}
+
 // BadRequest defines model for BadRequest.
 type BadRequest = StandardError
 
+// GetCaptureParams defines parameters for GetCapture.
+type GetCaptureParams struct {
+	// Interfaces Capture packets that were received or sent on one of the interfaces. The internal interface has ID 0.
+	Interfaces *[]int `form:"interfaces,omitempty" json:"interfaces,omitempty"`
+
+	// IsdAs Capture packets with a matching source or destination ISD-AS. The address can include wildcards (0) both for the ISD and AS identifier.
+	IsdAs *IsdAs `form:"isd_as,omitempty" json:"isd_as,omitempty"`
+
+	// Dispositions Capture packets with one of the dispositions.
+	Dispositions *[]CaptureDisposition `form:"dispositions,omitempty" json:"dispositions,omitempty"`
+
+	// Duration Maximum duration of the capture. The default is 30s, the maximum 10m.
+	Duration *string `form:"duration,omitempty" json:"duration,omitempty"`
+
+	// MaxPackets Number of packets after which the capture ends. The default is 1000, the maximum 100000.
+	MaxPackets *int `form:"max_packets,omitempty" json:"max_packets,omitempty"`
+
+	// MaxBytes Size of the output in bytes after which the capture ends. The default is 10MiB, the maximum 100MiB.
+	MaxBytes *int `form:"max_bytes,omitempty" json:"max_bytes,omitempty"`
+
+	// Rate Maximum number of captured packets per second. The default is 100, the maximum 10000.
+	Rate *int `form:"rate,omitempty" json:"rate,omitempty"`
+
+	// SnapLen Number of bytes of a SCION packet that are captured. The default is 512, the maximum 9000.
+	SnapLen *int `form:"snap_len,omitempty" json:"snap_len,omitempty"`
+}
+
 // SetLogLevelJSONRequestBody defines body for SetLogLevel for application/json ContentType.
 type SetLogLevelJSONRequestBody = LogLevel
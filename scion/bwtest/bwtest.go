@@ -0,0 +1,381 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bwtest implements a bandwidth test between a client and a server.
+//
+// The client sends data packets of a fixed size at a configured rate for a
+// configured duration to the server. Afterwards, it requests the statistics
+// the server collected for the test, i.e., the number of packets and bytes
+// that arrived, how many of them arrived out of order, and the time between
+// the arrival of the first and the last packet. From these, the client
+// computes the achieved bandwidth and the packet loss.
+//
+// All messages start with a one byte type and the eight byte test ID that the
+// client chose randomly. Data packets additionally carry a four byte sequence
+// number and are padded to the configured size. Result requests are padded to
+// the size of the result reply, so that the server never sends more than it
+// receives.
+package bwtest
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"net"
+	"os"
+	"time"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+const (
+	// MinPacketSize is the smallest size of a data packet.
+	MinPacketSize = dataHdrLen
+
+	// DefaultResultTimeout is the default time to wait for the reply to a
+	// result request.
+	DefaultResultTimeout = time.Second
+	// DefaultResultAttempts is the default number of result requests sent
+	// before giving up.
+	DefaultResultAttempts = 3
+	// DefaultIdleTimeout is the default time after which the server discards
+	// the statistics of a test that did not receive any packets.
+	DefaultIdleTimeout = time.Minute
+)
+
+const (
+	typeData uint8 = iota + 1
+	typeResultRequest
+	typeResultReply
+)
+
+const (
+	hdrLen     = 1 + 8
+	dataHdrLen = hdrLen + 4
+	resultLen  = hdrLen + 4 + 4 + 8 + 8
+)
+
+// Config configures the client side of a bandwidth test.
+type Config struct {
+	// Conn is the connection the test is run on. Its read deadline is modified
+	// while waiting for the results.
+	Conn net.PacketConn
+	// Remote is the address of the server.
+	Remote net.Addr
+	// Rate is the sending rate in bits per second.
+	Rate uint64
+	// PacketSize is the payload size of the data packets in bytes. It must be
+	// at least MinPacketSize.
+	PacketSize int
+	// Duration is the time during which data packets are sent.
+	Duration time.Duration
+	// ResultTimeout is the time to wait for the reply to a result request. If
+	// zero, DefaultResultTimeout is used.
+	ResultTimeout time.Duration
+	// ResultAttempts is the number of result requests sent before giving up. If
+	// zero, DefaultResultAttempts is used.
+	ResultAttempts int
+}
+
+// Result contains the statistics of a bandwidth test.
+type Result struct {
+	// Sent is the number of data packets sent.
+	Sent int `json:"sent" yaml:"sent"`
+	// SentBytes is the number of payload bytes sent.
+	SentBytes int64 `json:"sent_bytes" yaml:"sent_bytes"`
+	// SendDuration is the time it took to send the data packets.
+	SendDuration time.Duration `json:"-" yaml:"-"`
+	// Received is the number of data packets received by the server.
+	Received int `json:"received" yaml:"received"`
+	// ReceivedBytes is the number of payload bytes received by the server.
+	ReceivedBytes int64 `json:"received_bytes" yaml:"received_bytes"`
+	// Reordered is the number of data packets that arrived after a packet
+	// with a higher sequence number.
+	Reordered int `json:"reordered" yaml:"reordered"`
+	// ReceiveDuration is the time between the arrival of the first and the
+	// last data packet at the server.
+	ReceiveDuration time.Duration `json:"-" yaml:"-"`
+}
+
+// Loss returns the fraction of data packets that did not arrive.
+func (r Result) Loss() float64 {
+	if r.Sent == 0 || r.Received >= r.Sent {
+		return 0
+	}
+	return 1 - float64(r.Received)/float64(r.Sent)
+}
+
+// SendRate returns the rate, in bits per second, at which the data packets were
+// sent.
+func (r Result) SendRate() float64 {
+	if r.SendDuration <= 0 {
+		return 0
+	}
+	return float64(r.SentBytes*8) / r.SendDuration.Seconds()
+}
+
+// Bandwidth returns the achieved bandwidth in bits per second. It is computed
+// from the packets that arrived after the first one, as the first packet marks
+// the start of the measurement.
+func (r Result) Bandwidth() float64 {
+	if r.Received < 2 || r.ReceiveDuration <= 0 {
+		return 0
+	}
+	first := r.ReceivedBytes / int64(r.Received)
+	return float64((r.ReceivedBytes-first)*8) / r.ReceiveDuration.Seconds()
+}
+
+// Run runs the client side of a bandwidth test with the configuration. It
+// blocks until the data packets are sent and the results are received from
+// the server, or the context is canceled.
+func Run(ctx context.Context, cfg Config) (Result, error) {
+	if cfg.PacketSize < MinPacketSize {
+		return Result{}, serrors.New("packet size below minimum",
+			"packet_size", cfg.PacketSize, "minimum", MinPacketSize)
+	}
+	if cfg.Rate == 0 {
+		return Result{}, serrors.New("rate must be positive")
+	}
+	if cfg.Duration <= 0 {
+		return Result{}, serrors.New("duration must be positive")
+	}
+	if cfg.ResultTimeout == 0 {
+		cfg.ResultTimeout = DefaultResultTimeout
+	}
+	if cfg.ResultAttempts == 0 {
+		cfg.ResultAttempts = DefaultResultAttempts
+	}
+	id := rand.Uint64()
+	res, err := send(ctx, cfg, id)
+	if err != nil {
+		return res, err
+	}
+	for i := 0; i < cfg.ResultAttempts; i++ {
+		ok, err := fetchResult(ctx, cfg, id, &res)
+		if err != nil {
+			return res, err
+		}
+		if ok {
+			return res, nil
+		}
+	}
+	return res, serrors.New("no result received from server", "attempts", cfg.ResultAttempts)
+}
+
+// send sends the data packets at the configured rate. The packets are
+// scheduled relative to the start of the test, so that a delayed packet is
+// followed by a burst that catches up with the rate.
+func send(ctx context.Context, cfg Config, id uint64) (Result, error) {
+	interval := time.Duration(float64(cfg.PacketSize*8) / float64(cfg.Rate) * float64(time.Second))
+	interval = max(interval, time.Nanosecond)
+	pkt := make([]byte, cfg.PacketSize)
+	pkt[0] = typeData
+	binary.BigEndian.PutUint64(pkt[1:], id)
+
+	var res Result
+	start := time.Now()
+	for seq := 0; ; seq++ {
+		offset := time.Duration(seq) * interval
+		if offset >= cfg.Duration {
+			break
+		}
+		if wait := time.Until(start.Add(offset)); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return res, ctx.Err()
+			case <-time.After(wait):
+			}
+		} else if err := ctx.Err(); err != nil {
+			return res, err
+		}
+		binary.BigEndian.PutUint32(pkt[hdrLen:], uint32(seq))
+		if _, err := cfg.Conn.WriteTo(pkt, cfg.Remote); err != nil {
+			return res, serrors.Wrap("sending data packet", err, "seq", seq)
+		}
+		res.Sent++
+		res.SentBytes += int64(len(pkt))
+	}
+	res.SendDuration = time.Since(start)
+	return res, nil
+}
+
+// fetchResult requests the results of the test from the server and waits for
+// the reply. It returns false if no reply arrived in time.
+func fetchResult(ctx context.Context, cfg Config, id uint64, res *Result) (bool, error) {
+	req := make([]byte, resultLen)
+	req[0] = typeResultRequest
+	binary.BigEndian.PutUint64(req[1:], id)
+	if _, err := cfg.Conn.WriteTo(req, cfg.Remote); err != nil {
+		return false, serrors.Wrap("sending result request", err)
+	}
+	deadline := time.Now().Add(cfg.ResultTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := cfg.Conn.SetReadDeadline(deadline); err != nil {
+		return false, err
+	}
+	defer func() { _ = cfg.Conn.SetReadDeadline(time.Time{}) }()
+
+	buf := make([]byte, resultLen)
+	for {
+		n, _, err := cfg.Conn.ReadFrom(buf)
+		switch {
+		case errors.Is(err, os.ErrDeadlineExceeded):
+			return false, ctx.Err()
+		case errors.Is(err, net.ErrClosed):
+			return false, err
+		case err != nil:
+			// Errors caused by other packets, e.g., SCMP messages, do not
+			// affect the test.
+			continue
+		}
+		if n != resultLen || buf[0] != typeResultReply ||
+			binary.BigEndian.Uint64(buf[1:]) != id {
+			continue
+		}
+		res.Received = int(binary.BigEndian.Uint32(buf[hdrLen:]))
+		res.Reordered = int(binary.BigEndian.Uint32(buf[hdrLen+4:]))
+		res.ReceivedBytes = int64(binary.BigEndian.Uint64(buf[hdrLen+8:]))
+		res.ReceiveDuration = time.Duration(binary.BigEndian.Uint64(buf[hdrLen+16:]))
+		return true, nil
+	}
+}
+
+// Server is the server side of bandwidth tests. It collects the statistics of
+// the tests run against it and reports them to the clients on request.
+type Server struct {
+	// Conn is the connection the server listens on.
+	Conn net.PacketConn
+	// IdleTimeout is the time after which the statistics of a test are
+	// discarded if no packets were received for it. If zero,
+	// DefaultIdleTimeout is used.
+	IdleTimeout time.Duration
+	// ErrHandler is invoked for every error that does not cause serving to
+	// abort. Execution time must be small, as it is run synchronously.
+	ErrHandler func(err error)
+
+	tests map[uint64]*testState
+}
+
+type testState struct {
+	received  uint32
+	reordered uint32
+	bytes     uint64
+	maxSeq    uint32
+	first     time.Time
+	last      time.Time
+}
+
+// Serve handles the packets received on the connection until the context is
+// canceled or the connection is closed.
+func (s *Server) Serve(ctx context.Context) error {
+	idleTimeout := s.IdleTimeout
+	if idleTimeout == 0 {
+		idleTimeout = DefaultIdleTimeout
+	}
+	// The read deadline is renewed periodically to check the context and to
+	// discard idle tests.
+	const checkInterval = time.Second
+	buf := make([]byte, 1<<16)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil
+		}
+		now := time.Now()
+		s.evict(now, idleTimeout)
+		if err := s.Conn.SetReadDeadline(now.Add(checkInterval)); err != nil {
+			return err
+		}
+		for {
+			n, from, err := s.Conn.ReadFrom(buf)
+			if errors.Is(err, os.ErrDeadlineExceeded) {
+				break
+			}
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			if err != nil {
+				s.handleErr(serrors.Wrap("reading packet", err))
+				continue
+			}
+			if err := s.handle(buf[:n], from, time.Now()); err != nil {
+				s.handleErr(err)
+			}
+		}
+	}
+}
+
+func (s *Server) handle(pkt []byte, from net.Addr, now time.Time) error {
+	if len(pkt) < hdrLen {
+		return serrors.New("packet too short", "len", len(pkt), "source", from)
+	}
+	id := binary.BigEndian.Uint64(pkt[1:])
+	switch pkt[0] {
+	case typeData:
+		if len(pkt) < dataHdrLen {
+			return serrors.New("data packet too short", "len", len(pkt), "source", from)
+		}
+		if s.tests == nil {
+			s.tests = make(map[uint64]*testState)
+		}
+		t, ok := s.tests[id]
+		if !ok {
+			t = &testState{first: now}
+			s.tests[id] = t
+		}
+		seq := binary.BigEndian.Uint32(pkt[hdrLen:])
+		if t.received > 0 && seq < t.maxSeq {
+			t.reordered++
+		}
+		t.maxSeq = max(t.maxSeq, seq)
+		t.received++
+		t.bytes += uint64(len(pkt))
+		t.last = now
+	case typeResultRequest:
+		if len(pkt) < resultLen {
+			return serrors.New("result request too short", "len", len(pkt), "source", from)
+		}
+		reply := make([]byte, resultLen)
+		reply[0] = typeResultReply
+		binary.BigEndian.PutUint64(reply[1:], id)
+		if t, ok := s.tests[id]; ok {
+			binary.BigEndian.PutUint32(reply[hdrLen:], t.received)
+			binary.BigEndian.PutUint32(reply[hdrLen+4:], t.reordered)
+			binary.BigEndian.PutUint64(reply[hdrLen+8:], t.bytes)
+			binary.BigEndian.PutUint64(reply[hdrLen+16:], uint64(t.last.Sub(t.first)))
+		}
+		if _, err := s.Conn.WriteTo(reply, from); err != nil {
+			return serrors.Wrap("sending result reply", err, "destination", from)
+		}
+	default:
+		return serrors.New("unknown message type", "type", pkt[0], "source", from)
+	}
+	return nil
+}
+
+func (s *Server) evict(now time.Time, idleTimeout time.Duration) {
+	for id, t := range s.tests {
+		if now.Sub(t.last) > idleTimeout {
+			delete(s.tests, id)
+		}
+	}
+}
+
+func (s *Server) handleErr(err error) {
+	if s.ErrHandler != nil {
+		s.ErrHandler(err)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bwtest_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/scion/bwtest"
+)
+
+func listen(t *testing.T) net.PacketConn {
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IP{127, 0, 0, 1}})
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestRun(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	server := &bwtest.Server{Conn: listen(t)}
+	done := make(chan error, 1)
+	go func() { done <- server.Serve(ctx) }()
+
+	client := listen(t)
+	res, err := bwtest.Run(ctx, bwtest.Config{
+		Conn:       client,
+		Remote:     server.Conn.LocalAddr(),
+		Rate:       800_000,
+		PacketSize: 1000,
+		Duration:   200 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 20, res.Sent)
+	assert.Equal(t, int64(20_000), res.SentBytes)
+	assert.Equal(t, res.Sent, res.Received)
+	assert.Equal(t, res.SentBytes, res.ReceivedBytes)
+	assert.Zero(t, res.Loss())
+	assert.Positive(t, res.Bandwidth())
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestRunNoServer(t *testing.T) {
+	client := listen(t)
+	_, err := bwtest.Run(context.Background(), bwtest.Config{
+		Conn:           client,
+		Remote:         listen(t).LocalAddr(),
+		Rate:           1_000_000,
+		PacketSize:     100,
+		Duration:       10 * time.Millisecond,
+		ResultTimeout:  10 * time.Millisecond,
+		ResultAttempts: 2,
+	})
+	assert.Error(t, err)
+}
+
+func TestRunInvalidConfig(t *testing.T) {
+	_, err := bwtest.Run(context.Background(), bwtest.Config{
+		Rate:       1_000_000,
+		PacketSize: bwtest.MinPacketSize - 1,
+		Duration:   time.Second,
+	})
+	assert.Error(t, err)
+}
+
+func TestResult(t *testing.T) {
+	res := bwtest.Result{
+		Sent:            10,
+		SentBytes:       10_000,
+		SendDuration:    time.Second,
+		Received:        5,
+		ReceivedBytes:   5_000,
+		ReceiveDuration: time.Second,
+	}
+	assert.Equal(t, 0.5, res.Loss())
+	assert.Equal(t, 80_000.0, res.SendRate())
+	assert.Equal(t, 32_000.0, res.Bandwidth())
+
+	assert.Zero(t, bwtest.Result{}.Loss())
+	assert.Zero(t, bwtest.Result{Received: 1, ReceivedBytes: 100}.Bandwidth())
+}
+
+func TestParseRate(t *testing.T) {
+	testCases := map[string]struct {
+		input     string
+		expected  uint64
+		assertErr assert.ErrorAssertionFunc
+	}{
+		"plain":         {input: "1000", expected: 1000, assertErr: assert.NoError},
+		"bps":           {input: "1000bps", expected: 1000, assertErr: assert.NoError},
+		"kilo":          {input: "500kbps", expected: 500_000, assertErr: assert.NoError},
+		"mega":          {input: "10Mbps", expected: 10_000_000, assertErr: assert.NoError},
+		"giga fraction": {input: "1.5G", expected: 1_500_000_000, assertErr: assert.NoError},
+		"zero":          {input: "0", assertErr: assert.Error},
+		"negative":      {input: "-1M", assertErr: assert.Error},
+		"garbage":       {input: "fast", assertErr: assert.Error},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rate, err := bwtest.ParseRate(tc.input)
+			tc.assertErr(t, err)
+			assert.Equal(t, tc.expected, rate)
+		})
+	}
+}
+
+func TestFormatRate(t *testing.T) {
+	assert.Equal(t, "12.50 Mbps", bwtest.FormatRate(12_500_000))
+	assert.Equal(t, "999.00 bps", bwtest.FormatRate(999))
+	assert.Equal(t, "0.50 bps", bwtest.FormatRate(0.5))
+}
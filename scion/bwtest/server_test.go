@@ -0,0 +1,53 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bwtest
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerReordering(t *testing.T) {
+	from := &net.UDPAddr{IP: net.IP{127, 0, 0, 1}, Port: 40000}
+	data := func(seq uint32) []byte {
+		pkt := make([]byte, 100)
+		pkt[0] = typeData
+		binary.BigEndian.PutUint64(pkt[1:], 42)
+		binary.BigEndian.PutUint32(pkt[hdrLen:], seq)
+		return pkt
+	}
+
+	var s Server
+	start := time.Now()
+	for i, seq := range []uint32{0, 2, 1, 3, 5, 4} {
+		require.NoError(t, s.handle(data(seq), from, start.Add(time.Duration(i)*time.Second)))
+	}
+	require.Contains(t, s.tests, uint64(42))
+	state := s.tests[42]
+	assert.Equal(t, uint32(6), state.received)
+	assert.Equal(t, uint32(2), state.reordered)
+	assert.Equal(t, uint64(600), state.bytes)
+	assert.Equal(t, 5*time.Second, state.last.Sub(state.first))
+
+	s.evict(start.Add(time.Minute), time.Minute)
+	assert.Contains(t, s.tests, uint64(42))
+	s.evict(start.Add(2*time.Minute), time.Minute)
+	assert.NotContains(t, s.tests, uint64(42))
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bwtest
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+var rateUnits = []struct {
+	prefix string
+	factor float64
+}{
+	{"G", 1e9},
+	{"M", 1e6},
+	{"k", 1e3},
+	{"", 1},
+}
+
+// ParseRate parses a rate in bits per second, e.g., "500kbps", "10Mbps" or
+// "1G". The unit prefixes are decimal and the "bps" suffix is optional.
+func ParseRate(s string) (uint64, error) {
+	num := strings.TrimSuffix(strings.TrimSpace(s), "bps")
+	factor := 1.0
+	for _, u := range rateUnits {
+		if u.prefix != "" && strings.HasSuffix(num, u.prefix) {
+			num, factor = strings.TrimSuffix(num, u.prefix), u.factor
+			break
+		}
+	}
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return 0, serrors.Wrap("parsing rate", err, "rate", s)
+	}
+	rate := v * factor
+	if rate < 1 || rate > math.MaxUint64 || math.IsNaN(rate) {
+		return 0, serrors.New("rate out of range", "rate", s)
+	}
+	return uint64(rate), nil
+}
+
+// FormatRate formats a rate in bits per second with the largest unit prefix
+// that keeps the value at or above one.
+func FormatRate(bps float64) string {
+	for _, u := range rateUnits {
+		if bps >= u.factor {
+			return fmt.Sprintf("%.2f %sbps", bps/u.factor, u.prefix)
+		}
+	}
+	return fmt.Sprintf("%.2f bps", bps)
+}
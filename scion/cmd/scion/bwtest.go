@@ -0,0 +1,413 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/daemon"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/snet"
+	"github.com/scionproto/scion/pkg/snet/addrutil"
+	"github.com/scionproto/scion/private/app"
+	"github.com/scionproto/scion/private/app/flag"
+	"github.com/scionproto/scion/private/app/path"
+	"github.com/scionproto/scion/private/path/pathpol"
+	"github.com/scionproto/scion/scion/bwtest"
+)
+
+type ResultBwtest struct {
+	Remote      string       `json:"remote" yaml:"remote"`
+	PayloadSize int          `json:"payload_size" yaml:"payload_size"`
+	Rate        uint64       `json:"rate_bps" yaml:"rate_bps"`
+	Duration    string       `json:"duration" yaml:"duration"`
+	Tests       []BwtestPath `json:"tests" yaml:"tests"`
+}
+
+type BwtestPath struct {
+	Path          Path `json:"path" yaml:"path"`
+	bwtest.Result `yaml:",inline"`
+	Loss          float64        `json:"packet_loss" yaml:"packet_loss"`
+	SendRate      float64        `json:"send_rate_bps" yaml:"send_rate_bps"`
+	Bandwidth     float64        `json:"bandwidth_bps" yaml:"bandwidth_bps"`
+	SendTime      durationMillis `json:"send_time" yaml:"send_time"`
+	ReceiveTime   durationMillis `json:"receive_time" yaml:"receive_time"`
+	Error         string         `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+func newBwtest(pather CommandPather) *cobra.Command {
+	var envFlags flag.SCIONEnvironment
+	var flags struct {
+		server      bool
+		port        uint16
+		rate        string
+		size        uint
+		duration    time.Duration
+		paths       uint
+		interactive bool
+		noColor     bool
+		refresh     bool
+		sequence    string
+		ordering    string
+		logLevel    string
+		format      string
+	}
+
+	var cmd = &cobra.Command{
+		Use:   "bwtest [flags] [<remote>]",
+		Short: "Measure the bandwidth to a remote SCION host",
+		Example: fmt.Sprintf(`  %[1]s bwtest --server --port 40001
+  %[1]s bwtest 1-ff00:0:110,10.0.0.1:40001 --rate 10Mbps --duration 5s
+  %[1]s bwtest 1-ff00:0:110,10.0.0.1:40001 --paths 3 --format json`, pather.CommandPath()),
+		Long: fmt.Sprintf(`'bwtest' measures the bandwidth to a remote SCION host.
+
+With the \--server option, bwtest listens for bandwidth tests on the given port
+until it is interrupted.
+
+Otherwise, bwtest runs a test against the server at the remote address. It
+sends packets of the given payload size at the given rate for the given duration
+and reports the bandwidth achieved at the server, the packet loss and the number
+of packets that arrived out of order.
+
+When the \--paths option is set to more than one, the test is run over several
+paths in parallel. Without the \--interactive option, the paths are picked from
+the paths matching the sequence in the order given by the \--ordering option,
+i.e., in the order displayed by showpaths with the same option.
+
+If no packet reached the server on any path, bwtest will exit with code 1.
+On other errors, bwtest will exit with code 2.
+
+%s`, app.SequenceHelp),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if flags.server != (len(args) == 0) {
+				return serrors.New("either the remote address or --server must be specified")
+			}
+			if err := app.SetupLog(flags.logLevel); err != nil {
+				return serrors.Wrap("setting up logging", err)
+			}
+			printf, err := getPrintf(flags.format, cmd.OutOrStdout())
+			if err != nil {
+				return serrors.Wrap("get formatting", err)
+			}
+			var remote *snet.UDPAddr
+			var rate uint64
+			var ordering *pathpol.Ordering
+			if !flags.server {
+				if remote, err = snet.ParseUDPAddr(args[0]); err != nil {
+					return serrors.Wrap("parsing remote", err)
+				}
+				if rate, err = bwtest.ParseRate(flags.rate); err != nil {
+					return err
+				}
+				if flags.paths == 0 {
+					return serrors.New("number of paths must be positive")
+				}
+				if ordering, err = pathpol.NewOrdering(flags.ordering); err != nil {
+					return serrors.Wrap("invalid ordering", err)
+				}
+			}
+
+			cmd.SilenceUsage = true
+
+			if err := envFlags.LoadExternalVars(); err != nil {
+				return err
+			}
+			daemonAddr := envFlags.Daemon()
+			localIP := net.IP(envFlags.Local().AsSlice())
+			log.Debug("Resolved SCION environment flags",
+				"daemon", daemonAddr,
+				"local", localIP,
+			)
+
+			ctx, cancelF := context.WithTimeout(context.Background(), time.Second)
+			defer cancelF()
+			sd, err := daemon.NewService(daemonAddr).Connect(ctx)
+			if err != nil {
+				return serrors.Wrap("connecting to SCION Daemon", err)
+			}
+			defer sd.Close()
+			info, err := app.QueryASInfo(context.Background(), sd)
+			if err != nil {
+				return err
+			}
+			network := &snet.SCIONNetwork{Topology: sd}
+			ctx = app.WithSignal(context.Background(), os.Interrupt, syscall.SIGTERM)
+
+			if flags.server {
+				if localIP == nil {
+					if localIP, err = addrutil.DefaultLocalIP(ctx, sd); err != nil {
+						return serrors.Wrap("determining local address", err)
+					}
+				}
+				conn, err := network.Listen(ctx, "udp",
+					&net.UDPAddr{IP: localIP, Port: int(flags.port)})
+				if err != nil {
+					return serrors.Wrap("listening", err)
+				}
+				defer conn.Close()
+				printf("Listening on %s,%s\n", info.IA, conn.LocalAddr())
+				server := &bwtest.Server{
+					Conn: conn,
+					ErrHandler: func(err error) {
+						log.Debug("Ignoring packet", "err", err)
+					},
+				}
+				return server.Serve(ctx)
+			}
+
+			paths, err := choosePaths(ctx, sd, remote.IA, int(flags.paths),
+				flags.interactive, flags.refresh, flags.sequence, ordering,
+				path.DefaultColorScheme(flags.noColor),
+			)
+			if err != nil {
+				return err
+			}
+
+			res := ResultBwtest{
+				Remote:      remote.String(),
+				PayloadSize: int(flags.size),
+				Rate:        rate,
+				Duration:    flags.duration.String(),
+				Tests:       make([]BwtestPath, len(paths)),
+			}
+			for i, p := range paths {
+				printf("Using path %d:\n  %s\n", i, p)
+			}
+			printf("\nBWTEST %s pld=%dB rate=%s duration=%s\n", remote, flags.size,
+				bwtest.FormatRate(float64(rate)), flags.duration)
+			var wg sync.WaitGroup
+			for i, p := range paths {
+				wg.Add(1)
+				go func() {
+					defer log.HandlePanic()
+					defer wg.Done()
+					res.Tests[i] = runBwtest(ctx, network, info.IA, localIP, remote, p,
+						bwtest.Config{
+							Rate:       rate,
+							PacketSize: int(flags.size),
+							Duration:   flags.duration,
+						})
+				}()
+			}
+			wg.Wait()
+
+			var received bool
+			for _, t := range res.Tests {
+				received = received || t.Received > 0
+			}
+			switch flags.format {
+			case "human":
+				printf("\n--- %s bandwidth test statistics ---\n", remote)
+				for i, t := range res.Tests {
+					if t.Error != "" {
+						printf("[%d] error: %s\n", i, t.Error)
+						continue
+					}
+					printf("[%d] %d packets sent at %s, %d received, %.1f%% packet loss, "+
+						"%d reordered\n", i, t.Sent, bwtest.FormatRate(t.SendRate), t.Received,
+						t.Loss, t.Reordered)
+					printf("    bandwidth %s\n", bwtest.FormatRate(t.Bandwidth))
+				}
+			case "json":
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				enc.SetEscapeHTML(false)
+				if err := enc.Encode(res); err != nil {
+					return err
+				}
+			case "yaml":
+				enc := yaml.NewEncoder(os.Stdout)
+				if err := enc.Encode(res); err != nil {
+					return err
+				}
+			}
+			if !received {
+				return app.WithExitCode(serrors.New("no packet reached the server"), 1)
+			}
+			return nil
+		},
+	}
+
+	envFlags.Register(cmd.Flags())
+	cmd.Flags().BoolVar(&flags.server, "server", false, "listen for bandwidth tests")
+	cmd.Flags().Uint16Var(&flags.port, "port", 0,
+		"port to listen on in server mode (default: any free port)")
+	cmd.Flags().StringVar(&flags.rate, "rate", "1Mbps",
+		"sending rate in bits per second, with an optional k, M or G prefix")
+	cmd.Flags().UintVarP(&flags.size, "payload-size", "s", 1000,
+		"number of bytes in the UDP payload of each packet")
+	cmd.Flags().DurationVar(&flags.duration, "duration", 3*time.Second,
+		"time during which packets are sent")
+	cmd.Flags().UintVar(&flags.paths, "paths", 1, "number of paths tested in parallel")
+	cmd.Flags().BoolVarP(&flags.interactive, "interactive", "i", false, "interactive mode")
+	cmd.Flags().BoolVar(&flags.noColor, "no-color", false, "disable colored output")
+	cmd.Flags().StringVar(&flags.sequence, "sequence", "", app.SequenceUsage)
+	cmd.Flags().StringVar(&flags.ordering, "ordering", "", app.OrderingUsage)
+	cmd.Flags().BoolVar(&flags.refresh, "refresh", false, "set refresh flag for path request")
+	cmd.Flags().StringVar(&flags.logLevel, "log.level", "", app.LogLevelUsage)
+	cmd.Flags().StringVar(&flags.format, "format", "human",
+		"Specify the output format (human|json|yaml)")
+	return cmd
+}
+
+// choosePaths selects n paths to the remote. In interactive mode, the user
+// chooses each path. Otherwise, the first n paths matching the sequence are
+// selected according to the ordering.
+func choosePaths(
+	ctx context.Context,
+	sd daemon.Connector,
+	remote addr.IA,
+	n int,
+	interactive bool,
+	refresh bool,
+	sequence string,
+	ordering *pathpol.Ordering,
+	cs path.ColorScheme,
+) ([]snet.Path, error) {
+
+	if interactive || n == 1 {
+		paths := make([]snet.Path, 0, n)
+		for i := 0; i < n; i++ {
+			p, err := path.Choose(ctx, sd, remote,
+				path.WithInteractive(interactive),
+				path.WithRefresh(refresh && i == 0),
+				path.WithSequence(sequence),
+				path.WithOrdering(ordering),
+				path.WithColorScheme(cs),
+			)
+			if err != nil {
+				return nil, err
+			}
+			paths = append(paths, p)
+		}
+		return paths, nil
+	}
+	all, err := sd.Paths(ctx, remote, 0, daemon.PathReqFlags{Refresh: refresh})
+	if err != nil {
+		return nil, serrors.Wrap("retrieving paths", err)
+	}
+	paths, err := path.Filter(sequence, all)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, serrors.New("no path available")
+	}
+	ordering.Sort(paths, nil)
+	if len(paths) < n {
+		log.Info("Fewer paths available than requested", "requested", n, "available", len(paths))
+		n = len(paths)
+	}
+	return paths[:n], nil
+}
+
+// runBwtest runs a bandwidth test over the path and converts the outcome to
+// the output format.
+func runBwtest(
+	ctx context.Context,
+	network *snet.SCIONNetwork,
+	localIA addr.IA,
+	localIP net.IP,
+	remote *snet.UDPAddr,
+	p snet.Path,
+	cfg bwtest.Config,
+) BwtestPath {
+
+	res, err := func() (BwtestPath, error) {
+		var res BwtestPath
+		remote := remote.Copy()
+		remote.Path = p.Dataplane()
+		remote.NextHop = p.UnderlayNextHop()
+		if localIP == nil {
+			target := remote.Host.IP
+			if remote.NextHop != nil {
+				target = remote.NextHop.IP
+			}
+			var err error
+			if localIP, err = addrutil.ResolveLocal(target); err != nil {
+				return res, serrors.Wrap("resolving local address", err)
+			}
+		}
+		seq, err := pathpol.GetSequence(p)
+		if err != nil {
+			return res, serrors.New("get sequence from used path")
+		}
+		res.Path = Path{
+			Fingerprint: snet.Fingerprint(p).String(),
+			Hops:        getHops(p),
+			Sequence:    seq,
+			LocalIP:     localIP,
+			NextHop:     p.UnderlayNextHop().String(),
+		}
+		if mtu := p.Metadata().MTU; mtu != 0 {
+			hostIP, ok := netip.AddrFromSlice(remote.Host.IP)
+			if !ok {
+				return res, serrors.New("invalid remote IP", "ip", remote.Host.IP)
+			}
+			localHost, ok := netip.AddrFromSlice(localIP)
+			if !ok {
+				return res, serrors.New("invalid local IP", "ip", localIP)
+			}
+			maxPayload, err := snet.MaxUDPPayload(int(mtu),
+				addr.Addr{IA: localIA, Host: addr.HostIP(localHost)},
+				addr.Addr{IA: remote.IA, Host: addr.HostIP(hostIP)},
+				remote.Path,
+			)
+			if err != nil {
+				return res, err
+			}
+			if cfg.PacketSize > maxPayload {
+				return res, serrors.New("payload size exceeds path MTU",
+					"payload_size", cfg.PacketSize, "max_payload_size", maxPayload, "mtu", mtu)
+			}
+		}
+
+		conn, err := network.Dial(ctx, "udp", &net.UDPAddr{IP: localIP}, remote)
+		if err != nil {
+			return res, serrors.Wrap("opening connection", err)
+		}
+		defer conn.Close()
+		cfg.Conn = conn
+		cfg.Remote = remote
+		res.Result, err = bwtest.Run(ctx, cfg)
+		if err != nil {
+			return res, err
+		}
+		res.Loss = res.Result.Loss() * 100
+		res.SendRate = res.Result.SendRate()
+		res.Bandwidth = res.Result.Bandwidth()
+		res.SendTime = durationMillis(res.SendDuration)
+		res.ReceiveTime = durationMillis(res.ReceiveDuration)
+		return res, nil
+	}()
+	if err != nil {
+		res.Error = err.Error()
+	}
+	return res
+}
@@ -15,17 +15,21 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"math"
 	"net"
+	"os"
 	"time"
 
 	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/daemon"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/segment/iface"
 	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
 )
 
 // Path defines the base model for the `ping` and `traceroute` result path
@@ -100,3 +104,43 @@ func (d durationMillis) Millis() float64 {
 func (d durationMillis) MillisRounded() float64 {
 	return math.Round(float64(d)/1000) / 1000
 }
+
+// recordedPaths is a SCION Daemon connector that serves the paths from a
+// recording. All other requests are served by the daemon.
+type recordedPaths struct {
+	daemon.Connector
+	recording *snetpath.Recording
+}
+
+// withRecordedPaths loads the path recording from the file and returns a
+// connector that uses it as path source. If file is empty, the daemon
+// connector is returned unchanged. A warning is printed if the paths were
+// recorded in a different AS than the local one.
+func withRecordedPaths(
+	sd daemon.Connector,
+	localIA addr.IA,
+	file string,
+) (daemon.Connector, error) {
+
+	if file == "" {
+		return sd, nil
+	}
+	recording, err := snetpath.LoadRecording(file)
+	if err != nil {
+		return nil, err
+	}
+	if recording.LocalIA != localIA {
+		fmt.Fprintf(os.Stderr, "WARNING: paths were recorded in %s, local ISD-AS is %s\n",
+			recording.LocalIA, localIA)
+	}
+	return recordedPaths{Connector: sd, recording: recording}, nil
+}
+
+func (c recordedPaths) Paths(
+	ctx context.Context,
+	dst, _ addr.IA,
+	_ daemon.PathReqFlags,
+) ([]snet.Path, error) {
+
+	return c.recording.Query(ctx, dst)
+}
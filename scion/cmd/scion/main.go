@@ -54,6 +54,7 @@ func main() {
 		newPing(cmd),
 		newShowpaths(cmd),
 		newTraceroute(cmd),
+		newBwtest(cmd),
 		newAddress(cmd),
 		newGendocs(cmd),
 	)
@@ -82,12 +82,14 @@ func newPing(pather CommandPather) *cobra.Command {
 		refresh     bool
 		healthyOnly bool
 		sequence    string
+		ordering    string
 		size        uint
 		pktSize     uint
 		timeout     time.Duration
 		tracer      string
 		epic        bool
 		format      string
+		pathsFrom   string
 	}
 
 	var cmd = &cobra.Command{
@@ -103,6 +105,11 @@ and reports back the statistics.
 When the \--healthy-only option is set, ping first determines healthy paths through probing and
 chooses amongst them.
 
+With the \--paths-from option, the paths are loaded from a file recorded with
+'showpaths \--export-paths' instead of being requested from the SCION Daemon.
+This allows reproducing a problem with the exact same paths. The SCION Daemon
+is still required for the local AS information.
+
 If no reply packet is received at all, ping will exit with code 1.
 On other errors, ping will exit with code 2.
 
@@ -113,6 +120,10 @@ On other errors, ping will exit with code 2.
 			if err != nil {
 				return serrors.Wrap("parsing remote", err)
 			}
+			ordering, err := pathpol.NewOrdering(flags.ordering)
+			if err != nil {
+				return serrors.Wrap("invalid ordering", err)
+			}
 			if err := app.SetupLog(flags.logLevel); err != nil {
 				return serrors.Wrap("setting up logging", err)
 			}
@@ -156,11 +167,16 @@ On other errors, ping will exit with code 2.
 				return err
 			}
 			span.SetTag("src.isd_as", info.IA)
+			pathSource, err := withRecordedPaths(sd, info.IA, flags.pathsFrom)
+			if err != nil {
+				return err
+			}
 
 			opts := []path.Option{
 				path.WithInteractive(flags.interactive),
 				path.WithRefresh(flags.refresh),
 				path.WithSequence(flags.sequence),
+				path.WithOrdering(ordering),
 				path.WithColorScheme(path.DefaultColorScheme(flags.noColor)),
 				path.WithEPIC(flags.epic),
 			}
@@ -171,7 +187,7 @@ On other errors, ping will exit with code 2.
 				}))
 			}
 
-			path, err := path.Choose(traceCtx, sd, remote.IA, opts...)
+			path, err := path.Choose(traceCtx, pathSource, remote.IA, opts...)
 			if err != nil {
 				return err
 			}
@@ -343,8 +359,11 @@ On other errors, ping will exit with code 2.
 	cmd.Flags().BoolVar(&flags.noColor, "no-color", false, "disable colored output")
 	cmd.Flags().DurationVar(&flags.timeout, "timeout", time.Second, "timeout per packet")
 	cmd.Flags().StringVar(&flags.sequence, "sequence", "", app.SequenceUsage)
+	cmd.Flags().StringVar(&flags.ordering, "ordering", "", app.OrderingUsage)
 	cmd.Flags().BoolVar(&flags.healthyOnly, "healthy-only", false, "only use healthy paths")
 	cmd.Flags().BoolVar(&flags.refresh, "refresh", false, "set refresh flag for path request")
+	cmd.Flags().StringVar(&flags.pathsFrom, "paths-from", "",
+		"load the paths from a file recorded with 'showpaths --export-paths' instead of the daemon")
 	cmd.Flags().DurationVar(&flags.interval, "interval", time.Second, "time between packets")
 	cmd.Flags().Uint16VarP(&flags.count, "count", "c", 0, "total number of packets to send")
 	cmd.Flags().UintVarP(&flags.size, "payload-size", "s", 0,
@@ -18,8 +18,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"os"
+	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -28,8 +30,11 @@ import (
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/log"
 	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
 	"github.com/scionproto/scion/private/app"
 	"github.com/scionproto/scion/private/app/flag"
+	"github.com/scionproto/scion/private/path/pathpol"
 	"github.com/scionproto/scion/private/tracing"
 	"github.com/scionproto/scion/scion/showpaths"
 )
@@ -39,12 +44,16 @@ func newShowpaths(pather CommandPather) *cobra.Command {
 	var flags struct {
 		timeout  time.Duration
 		cfg      showpaths.Config
+		ordering string
+		watch    bool
+		wcfg     showpaths.WatchConfig
 		extended bool
 		json     bool
 		logLevel string
 		noColor  bool
 		tracer   string
 		format   string
+		export   string
 	}
 
 	var cmd = &cobra.Command{
@@ -57,7 +66,8 @@ func newShowpaths(pather CommandPather) *cobra.Command {
   %[1]s showpaths 1-ff00:0:111 --sequence="0-0#2 0*" # outgoing IfID=2
   %[1]s showpaths 1-ff00:0:111 --sequence="0* 0-0#41" # incoming IfID=41 at dstIA
   %[1]s showpaths 1-ff00:0:111 --sequence="0* 1-ff00:0:112 0*" # 1-ff00:0:112 on the path
-  %[1]s showpaths 1-ff00:0:110 --no-probe`, pather.CommandPath()),
+  %[1]s showpaths 1-ff00:0:110 --no-probe
+  %[1]s showpaths 1-ff00:0:110 --watch --interval 1m --format json`, pather.CommandPath()),
 		Long: fmt.Sprintf(`'showpaths' lists available paths between the local and the specified
 SCION ASe a.
 
@@ -69,12 +79,27 @@ If no alive path is discovered, json output is not enabled, and probing is not
 disabled, showpaths will exit with the code 1.
 On other errors, showpaths will exit with code 2.
 
+With the \--watch option, showpaths periodically fetches and probes the paths
+until it is interrupted, and prints the changes between two rounds: paths that
+became available or vanished, status changes, and paths whose expiration time
+is approaching. All paths are considered unless \--maxpaths is set explicitly.
+With the json format, every change is written as a single JSON line. In watch
+mode, the timeout applies to each round.
+
+With the \--export-paths option, the listed paths are additionally written to a
+file, including the raw dataplane paths and all metadata. The file can be used
+as path source for 'ping' and 'traceroute' with the \--paths-from option, e.g.,
+to reproduce a problem with the exact same paths.
+
 %s`, app.SequenceHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			dst, err := addr.ParseIA(args[0])
 			if err != nil {
 				return serrors.Wrap("invalid destination ISD-AS", err)
 			}
+			if flags.cfg.Ordering, err = pathpol.NewOrdering(flags.ordering); err != nil {
+				return serrors.Wrap("invalid ordering", err)
+			}
 			if err := app.SetupLog(flags.logLevel); err != nil {
 				return serrors.Wrap("setting up logging", err)
 			}
@@ -109,12 +134,30 @@ On other errors, showpaths will exit with code 2.
 			span.SetTag("dst.isd_as", dst)
 			defer span.Finish()
 
+			if flags.watch {
+				if flags.export != "" {
+					return serrors.New("--export-paths cannot be combined with --watch")
+				}
+				if !cmd.Flags().Lookup("maxpaths").Changed {
+					flags.cfg.MaxPaths = 0
+				}
+				flags.wcfg.Timeout = flags.timeout
+				ctx := app.WithSignal(traceCtx, os.Interrupt, syscall.SIGTERM)
+				return showpaths.Watch(ctx, dst, flags.cfg, flags.wcfg,
+					watchHandler(flags.format, cmd.OutOrStdout(), !flags.noColor))
+			}
+
 			ctx, cancel := context.WithTimeout(traceCtx, flags.timeout)
 			defer cancel()
 			res, err := showpaths.Run(ctx, dst, flags.cfg)
 			if err != nil {
 				return err
 			}
+			if flags.export != "" {
+				if err := exportPaths(flags.export, res); err != nil {
+					return err
+				}
+			}
 
 			switch flags.format {
 			case "human":
@@ -148,6 +191,7 @@ On other errors, showpaths will exit with code 2.
 	envFlags.Register(cmd.Flags())
 	cmd.Flags().DurationVar(&flags.timeout, "timeout", 5*time.Second, "Timeout")
 	cmd.Flags().StringVar(&flags.cfg.Sequence, "sequence", "", app.SequenceUsage)
+	cmd.Flags().StringVar(&flags.ordering, "ordering", "", app.OrderingUsage)
 	cmd.Flags().IntVarP(&flags.cfg.MaxPaths, "maxpaths", "m", 10,
 		"Maximum number of paths that are displayed")
 	cmd.Flags().BoolVarP(&flags.extended, "extended", "e", false,
@@ -164,9 +208,66 @@ On other errors, showpaths will exit with code 2.
 	cmd.Flags().StringVar(&flags.logLevel, "log.level", "", app.LogLevelUsage)
 	cmd.Flags().StringVar(&flags.tracer, "tracing.agent", "", "Tracing agent address")
 	cmd.Flags().BoolVar(&flags.cfg.Epic, "epic", false, "Enable EPIC.")
+	cmd.Flags().BoolVar(&flags.watch, "watch", false,
+		"Periodically fetch and probe the paths and print the changes")
+	cmd.Flags().DurationVar(&flags.wcfg.Interval, "interval", showpaths.DefaultWatchInterval,
+		"Time between two rounds in watch mode")
+	cmd.Flags().DurationVar(&flags.wcfg.ExpiryWarning, "expiry-warning",
+		showpaths.DefaultExpiryWarning,
+		"Remaining lifetime at which an approaching path expiration is reported in watch mode")
+	cmd.Flags().StringVar(&flags.export, "export-paths", "",
+		"Write the listed paths to the file for later use with --paths-from")
 	err := cmd.Flags().MarkDeprecated("json", "json flag is deprecated, use format flag")
 	if err != nil {
 		panic(err)
 	}
 	return cmd
 }
+
+// watchHandler returns the handler that writes the events of the watch mode in
+// the given format. In the json format, every event is written as a single
+// line.
+func watchHandler(format string, w io.Writer, colored bool) func(showpaths.Event) {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		return func(e showpaths.Event) {
+			if err := enc.Encode(e); err != nil {
+				log.Error("Failed to write event", "err", err)
+			}
+		}
+	case "yaml":
+		enc := yaml.NewEncoder(w)
+		return func(e showpaths.Event) {
+			if err := enc.Encode(e); err != nil {
+				log.Error("Failed to write event", "err", err)
+			}
+		}
+	default:
+		return func(e showpaths.Event) {
+			e.Human(w, colored)
+		}
+	}
+}
+
+// exportPaths writes the paths of the result to the file as path recording.
+func exportPaths(file string, res *showpaths.Result) error {
+	paths := make([]snet.Path, 0, len(res.Paths))
+	for _, p := range res.Paths {
+		paths = append(paths, p.FullPath)
+	}
+	rec, err := snetpath.NewRecording(res.LocalIA, paths)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		return serrors.Wrap("creating path recording", err)
+	}
+	if err := snetpath.WriteRecording(f, rec); err != nil {
+		f.Close()
+		return serrors.Wrap("writing path recording", err, "file", file)
+	}
+	return f.Close()
+}
@@ -22,6 +22,7 @@ import (
 	"net/netip"
 	"os"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -37,6 +38,7 @@ import (
 	"github.com/scionproto/scion/private/app"
 	"github.com/scionproto/scion/private/app/flag"
 	"github.com/scionproto/scion/private/app/path"
+	"github.com/scionproto/scion/private/app/path/pathprobe"
 	"github.com/scionproto/scion/private/path/pathpol"
 	"github.com/scionproto/scion/private/topology"
 	"github.com/scionproto/scion/private/tracing"
@@ -46,6 +48,9 @@ import (
 type ResultTraceroute struct {
 	Path Path      `json:"path" yaml:"path"`
 	Hops []HopInfo `json:"hops" yaml:"hops"`
+	// Error is the reason why tracing the path failed. It is only set with
+	// --all-paths, where the other paths are still traced.
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
 }
 
 type HopInfo struct {
@@ -65,20 +70,37 @@ func newTraceroute(pather CommandPather) *cobra.Command {
 		noColor     bool
 		refresh     bool
 		sequence    string
+		ordering    string
 		timeout     time.Duration
 		tracer      string
 		epic        bool
 		format      string
+		allPaths    bool
+		pathsFrom   string
 	}
 
 	var cmd = &cobra.Command{
 		Use:     "traceroute [flags] <remote>",
 		Aliases: []string{"tr"},
 		Short:   "Trace the SCION route to a remote SCION AS using SCMP traceroute packets",
-		Example: fmt.Sprintf("  %[1]s traceroute 1-ff00:0:110,10.0.0.1", pather.CommandPath()),
+		Example: fmt.Sprintf(`  %[1]s traceroute 1-ff00:0:110,10.0.0.1
+  %[1]s traceroute 1-ff00:0:110,10.0.0.1 --all-paths --format dot | dot -Tsvg > paths.svg`,
+			pather.CommandPath()),
 		Long: fmt.Sprintf(`'traceroute' traces the SCION path to a remote AS using
 SCMP traceroute packets.
 
+With the \--all-paths option, traceroute traces all paths matching the sequence
+concurrently and merges the per-hop RTTs into a graph of ASes and interfaces.
+The graph is part of the json and yaml output, and the dot format writes it in
+the Graphviz DOT language. If tracing a path fails, the error is reported for
+that path and the other paths are still part of the result; traceroute only
+fails if no path could be traced.
+
+With the \--paths-from option, the paths are loaded from a file recorded with
+'showpaths \--export-paths' instead of being requested from the SCION Daemon.
+This allows reproducing a problem with the exact same paths. The SCION Daemon
+is still required for the local AS information.
+
 If any packet is dropped, traceroute will exit with code 1.
 On other errors, traceroute will exit with code 2.
 %s`, app.SequenceHelp),
@@ -97,7 +119,21 @@ On other errors, traceroute will exit with code 2.
 				return serrors.Wrap("setting up tracing", err)
 			}
 			defer closer()
-			printf, err := getPrintf(flags.format, cmd.OutOrStdout())
+			printFormat := flags.format
+			if flags.format == "dot" {
+				if !flags.allPaths {
+					return serrors.New("dot format requires --all-paths")
+				}
+				printFormat = "json"
+			}
+			if flags.allPaths && flags.interactive {
+				return serrors.New("--all-paths cannot be combined with --interactive")
+			}
+			ordering, err := pathpol.NewOrdering(flags.ordering)
+			if err != nil {
+				return serrors.Wrap("invalid ordering", err)
+			}
+			printf, err := getPrintf(printFormat, cmd.OutOrStdout())
 			if err != nil {
 				return serrors.Wrap("get formatting", err)
 			}
@@ -130,10 +166,22 @@ On other errors, traceroute will exit with code 2.
 				return err
 			}
 			span.SetTag("src.isd_as", info.IA)
-			path, err := path.Choose(traceCtx, sd, remote.IA,
+			pathSource, err := withRecordedPaths(sd, info.IA, flags.pathsFrom)
+			if err != nil {
+				return err
+			}
+			if flags.allPaths {
+				return traceAllPaths(
+					app.WithSignal(traceCtx, os.Interrupt, syscall.SIGTERM),
+					pathSource, info.IA, localIP, remote, flags.refresh, flags.sequence,
+					ordering, flags.timeout, flags.epic, flags.format, printf,
+				)
+			}
+			path, err := path.Choose(traceCtx, pathSource, remote.IA,
 				path.WithInteractive(flags.interactive),
 				path.WithRefresh(flags.refresh),
 				path.WithSequence(flags.sequence),
+				path.WithOrdering(ordering),
 				path.WithColorScheme(path.DefaultColorScheme(flags.noColor)),
 				path.WithEPIC(flags.epic),
 			)
@@ -237,11 +285,16 @@ On other errors, traceroute will exit with code 2.
 	cmd.Flags().BoolVar(&flags.noColor, "no-color", false, "disable colored output")
 	cmd.Flags().DurationVar(&flags.timeout, "timeout", time.Second, "timeout per packet")
 	cmd.Flags().StringVar(&flags.sequence, "sequence", "", app.SequenceUsage)
+	cmd.Flags().StringVar(&flags.ordering, "ordering", "", app.OrderingUsage)
 	cmd.Flags().StringVar(&flags.logLevel, "log.level", "", app.LogLevelUsage)
 	cmd.Flags().StringVar(&flags.tracer, "tracing.agent", "", "Tracing agent address")
 	cmd.Flags().BoolVar(&flags.epic, "epic", false, "Enable EPIC.")
+	cmd.Flags().StringVar(&flags.pathsFrom, "paths-from", "",
+		"load the paths from a file recorded with 'showpaths --export-paths' instead of the daemon")
+	cmd.Flags().BoolVar(&flags.allPaths, "all-paths", false,
+		"trace all paths matching the sequence concurrently and merge the results")
 	cmd.Flags().StringVar(&flags.format, "format", "human",
-		"Specify the output format (human|json|yaml)")
+		"Specify the output format (human|json|yaml|dot), dot requires --all-paths")
 	return cmd
 }
 
@@ -279,3 +332,225 @@ func getHopInfo(u traceroute.Update, hop Hop) HopInfo {
 		RoundTripTimes: RTTs,
 	}
 }
+
+type ResultTracerouteAll struct {
+	Paths []ResultTraceroute `json:"paths" yaml:"paths"`
+	Graph TracerouteGraph    `json:"graph" yaml:"graph"`
+}
+
+type TracerouteGraph struct {
+	Nodes []GraphNode `json:"nodes" yaml:"nodes"`
+	Links []GraphLink `json:"links" yaml:"links"`
+}
+
+type GraphInterface struct {
+	IA          addr.IA `json:"isd_as" yaml:"isd_as"`
+	InterfaceID uint64  `json:"interface_id" yaml:"interface_id"`
+}
+
+type GraphNode struct {
+	GraphInterface `yaml:",inline"`
+	Paths          int            `json:"paths" yaml:"paths"`
+	Probes         int            `json:"probes" yaml:"probes"`
+	Replies        int            `json:"replies" yaml:"replies"`
+	MinRTT         durationMillis `json:"min_rtt" yaml:"min_rtt"`
+	AvgRTT         durationMillis `json:"avg_rtt" yaml:"avg_rtt"`
+	MaxRTT         durationMillis `json:"max_rtt" yaml:"max_rtt"`
+}
+
+type GraphLink struct {
+	From        GraphInterface `json:"from" yaml:"from"`
+	To          GraphInterface `json:"to" yaml:"to"`
+	Paths       int            `json:"paths" yaml:"paths"`
+	RTTIncrease durationMillis `json:"rtt_increase" yaml:"rtt_increase"`
+}
+
+// traceAllPaths traces all paths to the remote that match the sequence
+// concurrently and writes the merged result in the given format.
+func traceAllPaths(
+	ctx context.Context,
+	sd daemon.Connector,
+	localIA addr.IA,
+	localIP net.IP,
+	remote addr.Addr,
+	refresh bool,
+	sequence string,
+	ordering *pathpol.Ordering,
+	timeout time.Duration,
+	epic bool,
+	format string,
+	printf func(format string, ctx ...interface{}),
+) error {
+
+	allPaths, err := sd.Paths(ctx, remote.IA, 0, daemon.PathReqFlags{Refresh: refresh})
+	if err != nil {
+		return serrors.Wrap("retrieving paths", err)
+	}
+	paths, err := path.Filter(sequence, allPaths)
+	if err != nil {
+		return err
+	}
+	paths = pathprobe.FilterEmptyPaths(paths)
+	if epic {
+		epicPaths := paths[:0]
+		for _, p := range paths {
+			if p.Metadata().EpicAuths.SupportsEpic() {
+				epicPaths = append(epicPaths, p)
+			}
+		}
+		paths = epicPaths
+	}
+	if len(paths) == 0 {
+		return serrors.New("no path available")
+	}
+	ordering.Sort(paths, nil)
+
+	traces := make([]traceroute.Trace, len(paths))
+	results := make([]ResultTraceroute, len(paths))
+	errs := make([]error, len(paths))
+	var lost bool
+	var mtx sync.Mutex
+	var wg sync.WaitGroup
+	for i, p := range paths {
+		printf("Tracing path [%d]:\n  %s\n", i, p)
+		wg.Add(1)
+		go func() {
+			defer log.HandlePanic()
+			defer wg.Done()
+			traces[i].Path = p
+			nextHop := p.UnderlayNextHop()
+			localIP := localIP
+			if localIP == nil {
+				if localIP, errs[i] = addrutil.ResolveLocal(nextHop.IP); errs[i] != nil {
+					errs[i] = serrors.Wrap("resolving local address", errs[i])
+					return
+				}
+			}
+			seq, err := pathpol.GetSequence(p)
+			if err != nil {
+				errs[i] = serrors.New("get sequence from used path")
+				return
+			}
+			results[i].Path = Path{
+				Fingerprint: snet.Fingerprint(p).String(),
+				Hops:        getHops(p),
+				Sequence:    seq,
+				LocalIP:     localIP,
+				NextHop:     nextHop.String(),
+			}
+			asNetipAddr, ok := netip.AddrFromSlice(localIP)
+			if !ok {
+				errs[i] = serrors.New("invalid local IP address", "ip", localIP)
+				return
+			}
+			stats, err := traceroute.Run(ctx, traceroute.Config{
+				Topology:     sd,
+				Remote:       remote,
+				NextHop:      nextHop,
+				MTU:          p.Metadata().MTU,
+				Local:        addr.Addr{IA: localIA, Host: addr.HostIP(asNetipAddr)},
+				PathEntry:    p,
+				Timeout:      timeout,
+				ProbesPerHop: 3,
+				ErrHandler: func(err error) {
+					fmt.Fprintf(os.Stderr, "ERROR: path [%d]: %s\n", i, err)
+				},
+				UpdateHandler: func(u traceroute.Update) {
+					traces[i].Updates = append(traces[i].Updates, u)
+				},
+				EPIC: epic,
+			})
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			if stats.Sent != stats.Recv {
+				mtx.Lock()
+				lost = true
+				mtx.Unlock()
+			}
+			hops := getHops(p)
+			for j, u := range traces[i].Updates {
+				results[i].Hops = append(results[i].Hops, getHopInfo(u, hops[j]))
+			}
+		}()
+	}
+	wg.Wait()
+
+	var failed serrors.List
+	traced := make([]traceroute.Trace, 0, len(traces))
+	for i, err := range errs {
+		if err != nil {
+			if format != "human" {
+				fmt.Fprintf(os.Stderr, "ERROR: path [%d]: tracing failed: %s\n", i, err)
+			}
+			results[i].Error = err.Error()
+			failed = append(failed, serrors.Wrap("tracing path", err, "path_index", i))
+			continue
+		}
+		traced = append(traced, traces[i])
+	}
+	if len(traced) == 0 {
+		return failed.ToError()
+	}
+	graph := traceroute.NewGraph(traced, timeout)
+	switch format {
+	case "human":
+		for i, trace := range traces {
+			printf("\nPath [%d]:\n", i)
+			if errs[i] != nil {
+				printf("tracing failed: %s\n", errs[i])
+				continue
+			}
+			for _, u := range trace.Updates {
+				printf("%d %s %s\n", u.Index, fmtRemote(u.Remote, u.Interface),
+					fmtRTTs(u.RTTs, timeout))
+			}
+		}
+		printf("\nInterfaces:\n")
+		for _, n := range graph.Nodes {
+			rtt := "*"
+			if n.Replies > 0 {
+				rtt = fmt.Sprintf("rtt min/avg/max = %.3f/%.3f/%.3f ms",
+					durationMillis(n.MinRTT).Millis(), durationMillis(n.AvgRTT).Millis(),
+					durationMillis(n.MaxRTT).Millis())
+			}
+			printf("%s paths=%d replies=%d/%d %s\n", n.Interface, n.Paths, n.Replies,
+				n.Probes, rtt)
+		}
+		if lost {
+			return app.WithExitCode(serrors.New("packets were lost"), 1)
+		}
+		return nil
+	case "dot":
+		return graph.WriteDOT(os.Stdout)
+	}
+
+	res := ResultTracerouteAll{Paths: results}
+	for _, n := range graph.Nodes {
+		res.Graph.Nodes = append(res.Graph.Nodes, GraphNode{
+			GraphInterface: GraphInterface{IA: n.IA, InterfaceID: n.ID},
+			Paths:          n.Paths,
+			Probes:         n.Probes,
+			Replies:        n.Replies,
+			MinRTT:         durationMillis(n.MinRTT),
+			AvgRTT:         durationMillis(n.AvgRTT),
+			MaxRTT:         durationMillis(n.MaxRTT),
+		})
+	}
+	for _, l := range graph.Links {
+		res.Graph.Links = append(res.Graph.Links, GraphLink{
+			From:        GraphInterface{IA: l.From.IA, InterfaceID: l.From.ID},
+			To:          GraphInterface{IA: l.To.IA, InterfaceID: l.To.ID},
+			Paths:       l.Paths,
+			RTTIncrease: durationMillis(l.RTTIncrease),
+		})
+	}
+	if format == "yaml" {
+		return yaml.NewEncoder(os.Stdout).Encode(res)
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(res)
+}
@@ -16,6 +16,8 @@ package showpaths
 
 import (
 	"net"
+
+	"github.com/scionproto/scion/private/path/pathpol"
 )
 
 // DefaultMaxPaths is the maximum number of paths that are displayed by default.
@@ -38,6 +40,9 @@ type Config struct {
 	// Sequence is a string of space separated Hop Predicates that is used for
 	// filtering.
 	Sequence string
+	// Ordering determines the order in which the paths are displayed. If nil,
+	// the default ordering is used.
+	Ordering *pathpol.Ordering
 	// Epic filters paths for which EPIC is not available, and when probing, the
 	// EPIC path type header is used.
 	Epic bool
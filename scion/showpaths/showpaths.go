@@ -313,6 +313,19 @@ func Run(ctx context.Context, dst addr.IA, cfg Config) (*Result, error) {
 	if err != nil {
 		return nil, serrors.Wrap("determining local ISD-AS", err)
 	}
+	return run(ctx, sdConn, localIA, dst, cfg)
+}
+
+// run fetches and probes the paths to the specified ISD-AS using an
+// established daemon connection.
+func run(
+	ctx context.Context,
+	sdConn daemon.Connector,
+	localIA addr.IA,
+	dst addr.IA,
+	cfg Config,
+) (*Result, error) {
+
 	if dst == localIA {
 		return &Result{
 			LocalIA:     localIA,
@@ -361,7 +374,7 @@ func Run(ctx context.Context, dst addr.IA, cfg Config) (*Result, error) {
 			return nil, serrors.Wrap("getting statuses", err)
 		}
 	}
-	path.Sort(paths)
+	cfg.Ordering.Sort(paths, nil)
 	res := &Result{
 		LocalIA:     localIA,
 		Destination: dst,
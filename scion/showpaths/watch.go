@@ -0,0 +1,239 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package showpaths
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/daemon"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/snet"
+	"github.com/scionproto/scion/private/app/path"
+	"github.com/scionproto/scion/private/app/path/pathprobe"
+)
+
+const (
+	// DefaultWatchInterval is the default time between two rounds of the watch
+	// mode.
+	DefaultWatchInterval = 30 * time.Second
+	// DefaultExpiryWarning is the default remaining lifetime of a path at which
+	// an EventExpiring is reported.
+	DefaultExpiryWarning = 10 * time.Minute
+)
+
+// WatchConfig configures the watch mode.
+type WatchConfig struct {
+	// Interval is the time between two rounds of fetching and probing the
+	// paths. If zero, DefaultWatchInterval is used.
+	Interval time.Duration
+	// Timeout is the time after which a round is aborted. If zero, the
+	// interval is used.
+	Timeout time.Duration
+	// ExpiryWarning is the remaining lifetime of a path at which an
+	// EventExpiring is reported. If zero, DefaultExpiryWarning is used.
+	ExpiryWarning time.Duration
+}
+
+// EventType is the type of a change observed in watch mode.
+type EventType string
+
+const (
+	// EventAdded indicates that a path became available. In the first round,
+	// it is reported for all paths.
+	EventAdded EventType = "added"
+	// EventRemoved indicates that a path is no longer available.
+	EventRemoved EventType = "removed"
+	// EventStatusChanged indicates that the probed status of a path changed.
+	EventStatusChanged EventType = "status_changed"
+	// EventExpiring indicates that the remaining lifetime of a path dropped
+	// below the configured warning threshold.
+	EventExpiring EventType = "expiring"
+	// EventError indicates that a round failed.
+	EventError EventType = "error"
+)
+
+// Event is a change observed in watch mode.
+type Event struct {
+	Time        time.Time `json:"time" yaml:"time"`
+	Type        EventType `json:"event" yaml:"event"`
+	Destination addr.IA   `json:"destination" yaml:"destination"`
+	// Path is the affected path. For EventRemoved, it is the path as last
+	// observed. It is not set for EventError.
+	Path *Path `json:"path,omitempty" yaml:"path,omitempty"`
+	// PreviousStatus and PreviousStatusInfo describe the status before an
+	// EventStatusChanged.
+	PreviousStatus     string `json:"previous_status,omitempty" yaml:"previous_status,omitempty"`
+	PreviousStatusInfo string `json:"previous_status_info,omitempty" yaml:"previous_status_info,omitempty"` // nolint:lll
+	// Error is the reason of an EventError.
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// Human writes the event as a single human readable line to the writer.
+func (e Event) Human(w io.Writer, colored bool) {
+	cs := path.DefaultColorScheme(!colored)
+	ts := e.Time.Format(time.RFC3339)
+	if e.Path == nil {
+		fmt.Fprintf(w, "%s %-14s %s\n", ts, e.Type, cs.Bad.Sprint(e.Error))
+		return
+	}
+	p := e.Path
+	entries := cs.KeyValues(
+		"Hops", cs.Path(p.FullPath),
+		"Fingerprint", p.Fingerprint,
+	)
+	switch e.Type {
+	case EventStatusChanged:
+		entries = append(entries, cs.KeyValue("Status", fmt.Sprintf("%s -> %s",
+			humanStatus(cs, e.PreviousStatus, e.PreviousStatusInfo),
+			humanStatus(cs, p.Status, p.StatusInfo))))
+	case EventExpiring:
+		ttl := p.Expiry.Sub(e.Time).Truncate(time.Second)
+		entries = append(entries, cs.KeyValue("Expires", fmt.Sprintf("%s (%s)", p.Expiry, ttl)))
+	default:
+		if p.Status != "" {
+			entries = append(entries, cs.KeyValue("Status",
+				humanStatus(cs, p.Status, p.StatusInfo)))
+		}
+	}
+	fmt.Fprintf(w, "%s %-14s %s\n", ts, e.Type, strings.Join(entries, " "))
+}
+
+func humanStatus(cs path.ColorScheme, status, info string) string {
+	statusColor := cs.Bad
+	if strings.EqualFold(status, string(pathprobe.StatusAlive)) {
+		statusColor = cs.Good
+	}
+	if info != "" {
+		return statusColor.Sprintf("%s(%s)", status, info)
+	}
+	return statusColor.Sprint(status)
+}
+
+// Watch periodically fetches and probes the paths to the specified ISD-AS and
+// reports the changes between two rounds to the handler. It blocks until the
+// context is canceled. Failed rounds are reported as EventError and do not
+// abort watching.
+func Watch(
+	ctx context.Context,
+	dst addr.IA,
+	cfg Config,
+	wcfg WatchConfig,
+	handler func(Event),
+) error {
+
+	if wcfg.Interval == 0 {
+		wcfg.Interval = DefaultWatchInterval
+	}
+	if wcfg.Timeout == 0 {
+		wcfg.Timeout = wcfg.Interval
+	}
+	if wcfg.ExpiryWarning == 0 {
+		wcfg.ExpiryWarning = DefaultExpiryWarning
+	}
+	sdConn, err := daemon.NewService(cfg.Daemon).Connect(ctx)
+	if err != nil {
+		return serrors.Wrap("connecting to the SCION Daemon", err, "addr", cfg.Daemon)
+	}
+	defer sdConn.Close()
+	localIA, err := sdConn.LocalIA(ctx)
+	if err != nil {
+		return serrors.Wrap("determining local ISD-AS", err)
+	}
+	if dst == localIA {
+		return serrors.New("destination is the local AS", "isd_as", dst)
+	}
+
+	w := watcher{destination: dst, expiryWarning: wcfg.ExpiryWarning}
+	ticker := time.NewTicker(wcfg.Interval)
+	defer ticker.Stop()
+	for {
+		roundCtx, cancel := context.WithTimeout(ctx, wcfg.Timeout)
+		res, err := run(roundCtx, sdConn, localIA, dst, cfg)
+		cancel()
+		if ctx.Err() != nil {
+			return nil
+		}
+		now := time.Now()
+		if err != nil {
+			handler(Event{Time: now, Type: EventError, Destination: dst, Error: err.Error()})
+		} else {
+			for _, e := range w.update(res.Paths, now) {
+				handler(e)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// watcher computes the changes between consecutive sets of paths.
+type watcher struct {
+	destination   addr.IA
+	expiryWarning time.Duration
+
+	// paths are the paths observed in the last round, last is the time of
+	// that round.
+	paths []Path
+	last  time.Time
+}
+
+// update records the paths observed at time now and returns the changes
+// compared to the previous round.
+func (w *watcher) update(paths []Path, now time.Time) []Event {
+	prev := make(map[snet.PathFingerprint]Path, len(w.paths))
+	for _, p := range w.paths {
+		prev[snet.Fingerprint(p.FullPath)] = p
+	}
+	current := make(map[snet.PathFingerprint]struct{}, len(paths))
+	event := func(t EventType, p Path) Event {
+		return Event{Time: now, Type: t, Destination: w.destination, Path: &p}
+	}
+
+	var events []Event
+	for _, p := range paths {
+		fp := snet.Fingerprint(p.FullPath)
+		current[fp] = struct{}{}
+		old, ok := prev[fp]
+		if !ok {
+			events = append(events, event(EventAdded, p))
+			continue
+		}
+		if old.Status != p.Status || old.StatusInfo != p.StatusInfo {
+			e := event(EventStatusChanged, p)
+			e.PreviousStatus, e.PreviousStatusInfo = old.Status, old.StatusInfo
+			events = append(events, e)
+		}
+		// The warning is reported once, in the round in which the remaining
+		// lifetime drops below the threshold.
+		if old.Expiry.Sub(w.last) > w.expiryWarning && p.Expiry.Sub(now) <= w.expiryWarning {
+			events = append(events, event(EventExpiring, p))
+		}
+	}
+	for _, p := range w.paths {
+		if _, ok := current[snet.Fingerprint(p.FullPath)]; !ok {
+			events = append(events, event(EventRemoved, p))
+		}
+	}
+	w.paths, w.last = paths, now
+	return events
+}
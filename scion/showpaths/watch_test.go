@@ -0,0 +1,114 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package showpaths
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+)
+
+var (
+	localIA = addr.MustParseIA("1-ff00:0:110")
+	dstIA   = addr.MustParseIA("1-ff00:0:111")
+)
+
+func testPath(ifID uint16, status string, expiry time.Time) Path {
+	return Path{
+		FullPath: snetpath.Path{
+			Src: localIA,
+			Dst: dstIA,
+			Meta: snet.PathMetadata{
+				Interfaces: []snet.PathInterface{
+					{IA: localIA, ID: iface.ID(ifID)},
+					{IA: dstIA, ID: iface.ID(ifID + 10)},
+				},
+			},
+		},
+		Fingerprint: "fp",
+		Status:      status,
+		Expiry:      expiry,
+	}
+}
+
+func eventTypes(events []Event) []EventType {
+	var types []EventType
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	return types
+}
+
+func TestWatcherUpdate(t *testing.T) {
+	start := time.Now()
+	expiry := start.Add(time.Hour)
+	w := watcher{destination: dstIA, expiryWarning: 10 * time.Minute}
+
+	events := w.update([]Path{
+		testPath(1, "alive", expiry),
+		testPath(2, "alive", expiry),
+	}, start)
+	assert.Equal(t, []EventType{EventAdded, EventAdded}, eventTypes(events))
+
+	// Path 1 times out, path 2 vanishes and path 3 appears.
+	events = w.update([]Path{
+		testPath(1, "timeout", expiry),
+		testPath(3, "alive", expiry),
+	}, start.Add(time.Minute))
+	assert.Equal(t, []EventType{EventStatusChanged, EventAdded, EventRemoved},
+		eventTypes(events))
+	assert.Equal(t, "alive", events[0].PreviousStatus)
+	assert.Equal(t, "timeout", events[0].Path.Status)
+	assert.Equal(t, iface.ID(2), events[2].Path.FullPath.Metadata().Interfaces[0].ID)
+
+	// Path 1 approaches its expiry, which is reported once.
+	events = w.update([]Path{
+		testPath(1, "timeout", expiry),
+		testPath(3, "alive", expiry.Add(time.Hour)),
+	}, start.Add(55*time.Minute))
+	assert.Equal(t, []EventType{EventExpiring}, eventTypes(events))
+	assert.Equal(t, iface.ID(1), events[0].Path.FullPath.Metadata().Interfaces[0].ID)
+
+	events = w.update([]Path{
+		testPath(1, "timeout", expiry),
+		testPath(3, "alive", expiry.Add(time.Hour)),
+	}, start.Add(56*time.Minute))
+	assert.Empty(t, events)
+}
+
+func TestEventHuman(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	p := testPath(1, "timeout", now.Add(time.Hour))
+	var buf bytes.Buffer
+	Event{
+		Time:           now,
+		Type:           EventStatusChanged,
+		Path:           &p,
+		PreviousStatus: "alive",
+	}.Human(&buf, false)
+	assert.Equal(t, "2026-01-02T03:04:05Z status_changed Hops: [1-ff00:0:110 1>11 1-ff00:0:111] "+
+		"Fingerprint: fp Status: alive -> timeout\n", buf.String())
+
+	buf.Reset()
+	Event{Time: now, Type: EventError, Error: "daemon unavailable"}.Human(&buf, false)
+	assert.Equal(t, "2026-01-02T03:04:05Z error          daemon unavailable\n", buf.String())
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceroute
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/snet"
+)
+
+// Trace is the outcome of tracing a single path.
+type Trace struct {
+	Path    snet.Path
+	Updates []Update
+}
+
+// Interface identifies an interface of an AS.
+type Interface struct {
+	IA addr.IA
+	ID uint64
+}
+
+func (i Interface) String() string {
+	return fmt.Sprintf("%s#%d", i.IA, i.ID)
+}
+
+// Node is an interface traversed by at least one of the traced paths, together
+// with the RTTs measured to it.
+type Node struct {
+	Interface
+	// Paths is the number of traced paths that traverse the interface.
+	Paths int
+	// Probes is the number of probes sent to the interface, Replies the number
+	// of probes that were answered in time.
+	Probes, Replies int
+	// MinRTT, AvgRTT and MaxRTT summarize the RTTs of the answered probes.
+	// They are zero if no probe was answered.
+	MinRTT, AvgRTT, MaxRTT time.Duration
+}
+
+// Link connects two interfaces that are consecutive on at least one of the
+// traced paths. This is either a link between two ASes or the crossing of an
+// AS.
+type Link struct {
+	From, To Interface
+	// Paths is the number of traced paths that traverse the link.
+	Paths int
+	// RTTIncrease is the difference between the average RTTs of the two
+	// interfaces. It is zero if one of the interfaces did not answer.
+	RTTIncrease time.Duration
+}
+
+// Graph is the merged view of the traces of several paths.
+type Graph struct {
+	Nodes []Node
+	Links []Link
+}
+
+// NewGraph merges the traces into a graph. The interfaces of a hop are taken
+// from the path metadata if available, otherwise from the reply to the probes.
+// Probes with an RTT above the timeout are considered lost.
+func NewGraph(traces []Trace, timeout time.Duration) Graph {
+	nodes := make(map[Interface]*Node)
+	rttSums := make(map[Interface]time.Duration)
+	links := make(map[[2]Interface]*Link)
+	for _, trace := range traces {
+		var intfs []snet.PathInterface
+		if md := trace.Path.Metadata(); md != nil {
+			intfs = md.Interfaces
+		}
+		var prev *Interface
+		for _, u := range trace.Updates {
+			intf := Interface{IA: u.Remote.IA, ID: u.Interface}
+			if u.Index < len(intfs) {
+				intf = Interface{IA: intfs[u.Index].IA, ID: uint64(intfs[u.Index].ID)}
+			}
+			if intf.IA.IsZero() {
+				// Neither metadata nor a reply identify the hop, the chain
+				// of links is interrupted.
+				prev = nil
+				continue
+			}
+			n, ok := nodes[intf]
+			if !ok {
+				n = &Node{Interface: intf}
+				nodes[intf] = n
+			}
+			n.Paths++
+			for _, rtt := range u.RTTs {
+				n.Probes++
+				if rtt > timeout {
+					continue
+				}
+				if n.Replies == 0 || rtt < n.MinRTT {
+					n.MinRTT = rtt
+				}
+				n.MaxRTT = max(n.MaxRTT, rtt)
+				n.Replies++
+				rttSums[intf] += rtt
+			}
+			if prev != nil {
+				key := [2]Interface{*prev, intf}
+				l, ok := links[key]
+				if !ok {
+					l = &Link{From: *prev, To: intf}
+					links[key] = l
+				}
+				l.Paths++
+			}
+			prev = &intf
+		}
+	}
+
+	var g Graph
+	for intf, n := range nodes {
+		if n.Replies > 0 {
+			n.AvgRTT = rttSums[intf] / time.Duration(n.Replies)
+		}
+		g.Nodes = append(g.Nodes, *n)
+	}
+	for _, l := range links {
+		from, to := nodes[l.From], nodes[l.To]
+		if from.Replies > 0 && to.Replies > 0 {
+			l.RTTIncrease = to.AvgRTT - from.AvgRTT
+		}
+		g.Links = append(g.Links, *l)
+	}
+	sort.Slice(g.Nodes, func(i, j int) bool {
+		return lessInterface(g.Nodes[i].Interface, g.Nodes[j].Interface)
+	})
+	sort.Slice(g.Links, func(i, j int) bool {
+		if g.Links[i].From != g.Links[j].From {
+			return lessInterface(g.Links[i].From, g.Links[j].From)
+		}
+		return lessInterface(g.Links[i].To, g.Links[j].To)
+	})
+	return g
+}
+
+func lessInterface(a, b Interface) bool {
+	if a.IA != b.IA {
+		return a.IA < b.IA
+	}
+	return a.ID < b.ID
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language. The interfaces of an
+// AS are grouped in a cluster, nodes are labeled with the average RTT and links
+// with the increase of the average RTT along the link.
+func (g Graph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph traceroute {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	for i := 0; i < len(g.Nodes); {
+		ia := g.Nodes[i].IA
+		fmt.Fprintf(&b, "  subgraph %q {\n", "cluster_"+ia.String())
+		fmt.Fprintf(&b, "    label=%q;\n", ia.String())
+		for ; i < len(g.Nodes) && g.Nodes[i].IA == ia; i++ {
+			n := g.Nodes[i]
+			label := fmt.Sprintf("IfID %d\\n%s", n.ID, dotRTT(n))
+			fmt.Fprintf(&b, "    %q [label=\"%s\"];\n", n.Interface.String(), label)
+		}
+		b.WriteString("  }\n")
+	}
+	for _, l := range g.Links {
+		var label string
+		if l.RTTIncrease != 0 {
+			label = fmt.Sprintf("%+.3fms", millis(l.RTTIncrease))
+		}
+		fmt.Fprintf(&b, "  %q -> %q [label=%q, penwidth=%d];\n",
+			l.From.String(), l.To.String(), label, l.Paths)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func dotRTT(n Node) string {
+	if n.Replies == 0 {
+		return "*"
+	}
+	return fmt.Sprintf("%.3fms (%d/%d)", millis(n.AvgRTT), n.Replies, n.Probes)
+}
+
+func millis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
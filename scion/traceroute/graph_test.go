@@ -0,0 +1,103 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceroute_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+	"github.com/scionproto/scion/scion/traceroute"
+)
+
+var (
+	ia110 = addr.MustParseIA("1-ff00:0:110")
+	ia111 = addr.MustParseIA("1-ff00:0:111")
+	ia112 = addr.MustParseIA("1-ff00:0:112")
+)
+
+func testPath(intfs ...snet.PathInterface) snet.Path {
+	return snetpath.Path{Meta: snet.PathMetadata{Interfaces: intfs}}
+}
+
+func intf(ia addr.IA, id uint16) snet.PathInterface {
+	return snet.PathInterface{IA: ia, ID: iface.ID(id)}
+}
+
+func TestNewGraph(t *testing.T) {
+	timeout := time.Second
+	lost := timeout + 1
+	traces := []traceroute.Trace{
+		{
+			// 110#1 -> 111#2 (direct)
+			Path: testPath(intf(ia110, 1), intf(ia111, 2)),
+			Updates: []traceroute.Update{
+				{Index: 0, RTTs: []time.Duration{time.Millisecond, 3 * time.Millisecond}},
+				{Index: 1, RTTs: []time.Duration{10 * time.Millisecond, lost}},
+			},
+		},
+		{
+			// 110#1 -> 112#3, 112#4 -> 111#5
+			Path: testPath(intf(ia110, 1), intf(ia112, 3), intf(ia112, 4), intf(ia111, 5)),
+			Updates: []traceroute.Update{
+				{Index: 0, RTTs: []time.Duration{2 * time.Millisecond}},
+				{Index: 1, RTTs: []time.Duration{lost}},
+				{Index: 2, RTTs: []time.Duration{5 * time.Millisecond}},
+				{Index: 3, RTTs: []time.Duration{9 * time.Millisecond}},
+			},
+		},
+	}
+	g := traceroute.NewGraph(traces, timeout)
+
+	require.Len(t, g.Nodes, 5)
+	first := g.Nodes[0]
+	assert.Equal(t, traceroute.Interface{IA: ia110, ID: 1}, first.Interface)
+	assert.Equal(t, 2, first.Paths)
+	assert.Equal(t, 3, first.Probes)
+	assert.Equal(t, 3, first.Replies)
+	assert.Equal(t, time.Millisecond, first.MinRTT)
+	assert.Equal(t, 2*time.Millisecond, first.AvgRTT)
+	assert.Equal(t, 3*time.Millisecond, first.MaxRTT)
+
+	silent := g.Nodes[3]
+	assert.Equal(t, traceroute.Interface{IA: ia112, ID: 3}, silent.Interface)
+	assert.Equal(t, 1, silent.Probes)
+	assert.Zero(t, silent.Replies)
+
+	require.Len(t, g.Links, 4)
+	assert.Equal(t, traceroute.Link{
+		From:        traceroute.Interface{IA: ia110, ID: 1},
+		To:          traceroute.Interface{IA: ia111, ID: 2},
+		Paths:       1,
+		RTTIncrease: 8 * time.Millisecond,
+	}, g.Links[0])
+	assert.Equal(t, traceroute.Interface{IA: ia112, ID: 3}, g.Links[1].To)
+	assert.Zero(t, g.Links[1].RTTIncrease, "no reply from 112#3")
+
+	var buf bytes.Buffer
+	require.NoError(t, g.WriteDOT(&buf))
+	dot := buf.String()
+	assert.Contains(t, dot, `subgraph "cluster_1-ff00:0:112" {`)
+	assert.Contains(t, dot, `"1-ff00:0:110#1" [label="IfID 1\n2.000ms (3/3)"];`)
+	assert.Contains(t, dot, `"1-ff00:0:112#3" [label="IfID 3\n*"];`)
+	assert.Contains(t, dot, `"1-ff00:0:110#1" -> "1-ff00:0:111#2" [label="+8.000ms", penwidth=1];`)
+}